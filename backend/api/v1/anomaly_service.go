@@ -24,12 +24,13 @@ const (
 )
 
 var typesMap = map[string]api.AnomalyType{
-	"INSTANCE_CONNECTION":              api.AnomalyInstanceConnection,
-	"MIGRATION_SCHEMA":                 api.AnomalyInstanceMigrationSchema,
-	"DATABASE_BACKUP_POLICY_VIOLATION": api.AnomalyDatabaseBackupPolicyViolation,
-	"DATABASE_BACKUP_MISSING":          api.AnomalyDatabaseBackupMissing,
-	"DATABASE_CONNECTION":              api.AnomalyDatabaseConnection,
-	"DATABASE_SCHEMA_DRIFT":            api.AnomalyDatabaseSchemaDrift,
+	"INSTANCE_CONNECTION":                 api.AnomalyInstanceConnection,
+	"MIGRATION_SCHEMA":                    api.AnomalyInstanceMigrationSchema,
+	"DATABASE_BACKUP_POLICY_VIOLATION":    api.AnomalyDatabaseBackupPolicyViolation,
+	"DATABASE_BACKUP_MISSING":             api.AnomalyDatabaseBackupMissing,
+	"DATABASE_CONNECTION":                 api.AnomalyDatabaseConnection,
+	"DATABASE_SCHEMA_DRIFT":               api.AnomalyDatabaseSchemaDrift,
+	"DATABASE_BACKUP_VERIFICATION_FAILED": api.AnomalyDatabaseBackupVerificationFailed,
 }
 
 // AnomalyService implements the anomaly service.
@@ -212,6 +213,19 @@ func (s *AnomalyService) convertToAnomaly(ctx context.Context, anomaly *store.An
 				ActualSchema:   detail.Actual,
 			},
 		}
+	case api.AnomalyDatabaseBackupVerificationFailed:
+		var detail api.AnomalyDatabaseBackupVerificationFailedPayload
+		if err := json.Unmarshal([]byte(anomaly.Payload), &detail); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal database backup verification failed anomaly payload")
+		}
+		pbAnomaly.Type = v1pb.Anomaly_DATABASE_BACKUP_VERIFICATION_FAILED
+		pbAnomaly.Detail = &v1pb.Anomaly_DatabaseBackupVerificationFailedDetail_{
+			DatabaseBackupVerificationFailedDetail: &v1pb.Anomaly_DatabaseBackupVerificationFailedDetail{
+				Backup:     fmt.Sprintf("%s/%s%s", pbAnomaly.Resource, common.BackupPrefix, detail.BackupName),
+				VerifyTime: timestamppb.New(time.Unix(detail.VerifiedTs, 0)),
+				Detail:     detail.Detail,
+			},
+		}
 	}
 	pbAnomaly.Severity = getSeverityFromAnomalyType(pbAnomaly.Type)
 	return pbAnomaly, nil
@@ -221,7 +235,7 @@ func getSeverityFromAnomalyType(tp v1pb.Anomaly_AnomalyType) v1pb.Anomaly_Anomal
 	switch tp {
 	case v1pb.Anomaly_DATABASE_BACKUP_POLICY_VIOLATION:
 		return v1pb.Anomaly_MEDIUM
-	case v1pb.Anomaly_DATABASE_BACKUP_MISSING:
+	case v1pb.Anomaly_DATABASE_BACKUP_MISSING, v1pb.Anomaly_DATABASE_BACKUP_VERIFICATION_FAILED:
 		return v1pb.Anomaly_HIGH
 	case v1pb.Anomaly_INSTANCE_CONNECTION, v1pb.Anomaly_MIGRATION_SCHEMA, v1pb.Anomaly_DATABASE_CONNECTION, v1pb.Anomaly_DATABASE_SCHEMA_DRIFT:
 		return v1pb.Anomaly_CRITICAL
@@ -22,6 +22,7 @@ import (
 	"github.com/bytebase/bytebase/backend/api/auth"
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/sqlproxy"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	metricAPI "github.com/bytebase/bytebase/backend/metric"
@@ -645,6 +646,24 @@ func (*AuthService) Logout(ctx context.Context, _ *v1pb.LogoutRequest) (*emptypb
 	return &emptypb.Empty{}, nil
 }
 
+// GetSQLProxyCredential gets the credential of the current user to connect the SQL proxy.
+func (s *AuthService) GetSQLProxyCredential(ctx context.Context, _ *v1pb.GetSQLProxyCredentialRequest) (*v1pb.SQLProxyCredential, error) {
+	principalID := ctx.Value(common.PrincipalIDContextKey).(int)
+	user, err := s.store.GetUserByID(ctx, principalID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user, error: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", principalID)
+	}
+	return &v1pb.SQLProxyCredential{
+		User:         user.Email,
+		Password:     sqlproxy.GetPassword(s.secret, user),
+		MysqlPort:    int32(s.profile.SQLProxyMySQLPort),
+		PostgresPort: int32(s.profile.SQLProxyPostgresPort),
+	}, nil
+}
+
 func (s *AuthService) getAndVerifyUser(ctx context.Context, request *v1pb.LoginRequest) (*store.UserMessage, error) {
 	user, err := s.store.GetUser(ctx, &store.FindUserMessage{
 		Email:       &request.Email,
@@ -758,6 +777,20 @@ func (s *AuthService) getOrCreateUserWithIDP(ctx context.Context, request *v1pb.
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get user info: %v", err)
 		}
+	} else if idp.Type == storepb.IdentityProviderType_SAML {
+		samlContext := request.IdpContext.GetSamlContext()
+		if samlContext == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "missing SAML context")
+		}
+		samlIDP, err := NewSAMLIdentityProvider(setting.ExternalUrl, idp.ResourceID, idp.Config.GetSamlConfig())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create new SAML identity provider: %v", err)
+		}
+
+		userInfo, err = samlIDP.UserInfo(samlContext.SamlResponse)
+		if err != nil {
+			return nil, status.Errorf(codes.Unauthenticated, "failed to validate SAML response: %v", err)
+		}
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "identity provider type %s not supported", idp.Type.String())
 	}
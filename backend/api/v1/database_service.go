@@ -27,6 +27,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -64,15 +65,17 @@ type DatabaseService struct {
 	store          *store.Store
 	backupRunner   *backuprun.Runner
 	schemaSyncer   *schemasync.Syncer
+	dbFactory      *dbfactory.DBFactory
 	licenseService enterpriseAPI.LicenseService
 }
 
 // NewDatabaseService creates a new DatabaseService.
-func NewDatabaseService(store *store.Store, br *backuprun.Runner, schemaSyncer *schemasync.Syncer, licenseService enterpriseAPI.LicenseService) *DatabaseService {
+func NewDatabaseService(store *store.Store, br *backuprun.Runner, schemaSyncer *schemasync.Syncer, dbFactory *dbfactory.DBFactory, licenseService enterpriseAPI.LicenseService) *DatabaseService {
 	return &DatabaseService{
 		store:          store,
 		backupRunner:   br,
 		schemaSyncer:   schemaSyncer,
+		dbFactory:      dbFactory,
 		licenseService: licenseService,
 	}
 }
@@ -916,6 +919,8 @@ func convertToVcsType(t storepb.VcsType) v1pb.VcsType {
 		return v1pb.VcsType_GITHUB
 	case storepb.VcsType_BITBUCKET:
 		return v1pb.VcsType_BITBUCKET
+	case storepb.VcsType_GITEA:
+		return v1pb.VcsType_GITEA
 	case storepb.VcsType_VCS_TYPE_UNSPECIFIED:
 		return v1pb.VcsType_VCS_TYPE_UNSPECIFIED
 	default:
@@ -1529,14 +1534,23 @@ func convertToBackup(backup *store.BackupMessage, instanceID string, databaseNam
 	case api.BackupTypePITR:
 		backupType = v1pb.Backup_PITR
 	}
+	var verification *v1pb.Backup_Verification
+	if v := backup.Payload.Verification; v != nil {
+		verification = &v1pb.Backup_Verification{
+			VerifyTime: timestamppb.New(time.Unix(v.VerifiedTs, 0)),
+			Passed:     v.Passed,
+			Detail:     v.Detail,
+		}
+	}
 	return &v1pb.Backup{
-		Name:       fmt.Sprintf("%s%s/%s%s/%s%s", common.InstanceNamePrefix, instanceID, common.DatabaseIDPrefix, databaseName, common.BackupPrefix, backup.Name),
-		CreateTime: createTime,
-		UpdateTime: updateTime,
-		State:      backupState,
-		BackupType: backupType,
-		Comment:    backup.Comment,
-		Uid:        fmt.Sprintf("%d", backup.UID),
+		Name:         fmt.Sprintf("%s%s/%s%s/%s%s", common.InstanceNamePrefix, instanceID, common.DatabaseIDPrefix, databaseName, common.BackupPrefix, backup.Name),
+		CreateTime:   createTime,
+		UpdateTime:   updateTime,
+		State:        backupState,
+		BackupType:   backupType,
+		Comment:      backup.Comment,
+		Uid:          fmt.Sprintf("%d", backup.UID),
+		Verification: verification,
 	}
 }
 
@@ -1594,16 +1608,17 @@ func convertDatabaseMetadata(metadata *storepb.DatabaseSchemaMetadata) *v1pb.Dat
 			}
 			for _, column := range table.Columns {
 				t.Columns = append(t.Columns, &v1pb.ColumnMetadata{
-					Name:           column.Name,
-					Position:       column.Position,
-					Default:        column.Default,
-					Nullable:       column.Nullable,
-					Type:           column.Type,
-					CharacterSet:   column.CharacterSet,
-					Collation:      column.Collation,
-					Comment:        column.Comment,
-					Classification: column.Classification,
-					UserComment:    column.UserComment,
+					Name:                     column.Name,
+					Position:                 column.Position,
+					Default:                  column.Default,
+					Nullable:                 column.Nullable,
+					Type:                     column.Type,
+					CharacterSet:             column.CharacterSet,
+					Collation:                column.Collation,
+					Comment:                  column.Comment,
+					Classification:           column.Classification,
+					UserComment:              column.UserComment,
+					DiscoveredClassification: column.DiscoveredClassification,
 				})
 			}
 			for _, index := range table.Indexes {
@@ -1937,10 +1952,8 @@ func isUpperCaseLetter(c rune) bool {
 }
 
 // AdviseIndex advises the index of a table.
+// It asks OpenAI if the OpenAI plugin is configured, otherwise it uses the rule-based index advisor which needs no external service.
 func (s *DatabaseService) AdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest) (*v1pb.AdviseIndexResponse, error) {
-	if err := s.licenseService.IsFeatureEnabled(api.FeaturePluginOpenAI); err != nil {
-		return nil, status.Errorf(codes.PermissionDenied, err.Error())
-	}
 	instanceID, databaseName, err := common.GetInstanceDatabaseID(request.Parent)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, err.Error())
@@ -1950,6 +1963,12 @@ func (s *DatabaseService) AdviseIndex(ctx context.Context, request *v1pb.AdviseI
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get instance %s", instanceID)
 	}
+	if instance == nil {
+		return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+	}
+	if err := s.licenseService.IsFeatureEnabledForInstance(api.FeatureIndexAdvisor, instance); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
 
 	findDatabase := &store.FindDatabaseMessage{
 		InstanceID:          &instanceID,
@@ -1965,25 +1984,75 @@ func (s *DatabaseService) AdviseIndex(ctx context.Context, request *v1pb.AdviseI
 	}
 
 	switch instance.Engine {
-	case db.Postgres:
-		return s.pgAdviseIndex(ctx, request, database)
-	case db.MySQL:
-		return s.mysqlAdviseIndex(ctx, request, database)
+	case db.Postgres, db.MySQL:
+		key, err := s.getOpenAIKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if key == "" {
+			break
+		}
+		if instance.Engine == db.Postgres {
+			return s.pgAdviseIndex(ctx, request, database, key)
+		}
+		return s.mysqlAdviseIndex(ctx, request, database, key)
+	case db.MariaDB, db.TiDB, db.MSSQL:
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, "AdviseIndex is not implemented for engine: %v", instance.Engine)
 	}
+	return s.ruleBasedAdviseIndex(ctx, request, instance, database)
 }
 
-func (s *DatabaseService) mysqlAdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest, database *store.DatabaseMessage) (*v1pb.AdviseIndexResponse, error) {
+// getOpenAIKey returns the OpenAI key, or empty string if the OpenAI plugin is not enabled or configured.
+func (s *DatabaseService) getOpenAIKey(ctx context.Context) (string, error) {
+	if s.licenseService.IsFeatureEnabled(api.FeaturePluginOpenAI) != nil {
+		return "", nil
+	}
 	openaiKeyName := api.SettingPluginOpenAIKey
-	key, err := s.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &openaiKeyName})
+	setting, err := s.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &openaiKeyName})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to get setting: %v", err)
+		return "", status.Errorf(codes.Internal, "Failed to get setting: %v", err)
 	}
-	if key.Value == "" {
-		return nil, status.Errorf(codes.FailedPrecondition, "OpenAI key is not set")
+	if setting == nil {
+		return "", nil
 	}
+	return setting.Value, nil
+}
 
+func (s *DatabaseService) ruleBasedAdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) (*v1pb.AdviseIndexResponse, error) {
+	schema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get database schema: %v", err)
+	}
+	if schema == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "database %q has not been synced", database.DatabaseName)
+	}
+
+	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get database driver: %v", err)
+	}
+	defer driver.Close(ctx)
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get database connection: %v", err)
+	}
+	defer conn.Close()
+
+	advisor := newIndexAdvisor(instance.Engine)
+	var response *v1pb.AdviseIndexResponse
+	if instance.Engine == db.MSSQL {
+		response, err = advisor.adviseMSSQLIndex(ctx, conn, schema.Metadata, database.DatabaseName, request.Statement)
+	} else {
+		response, err = advisor.adviseIndex(ctx, conn, schema.Metadata, request.Statement)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to advise index: %v", err)
+	}
+	return response, nil
+}
+
+func (s *DatabaseService) mysqlAdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest, database *store.DatabaseMessage, key string) (*v1pb.AdviseIndexResponse, error) {
 	var schemas []*store.DBSchema
 
 	// Deal with the cross database query
@@ -2108,7 +2177,7 @@ func (s *DatabaseService) mysqlAdviseIndex(ctx context.Context, request *v1pb.Ad
 		return nil
 	}
 
-	result, err := getOpenAIResponse(ctx, messages, key.Value, generateFunc)
+	result, err := getOpenAIResponse(ctx, messages, key, generateFunc)
 	if err != nil {
 		return nil, err
 	}
@@ -2144,16 +2213,7 @@ func restoreNode(node tidbast.Node) (string, error) {
 	return buffer.String(), nil
 }
 
-func (s *DatabaseService) pgAdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest, database *store.DatabaseMessage) (*v1pb.AdviseIndexResponse, error) {
-	openaiKeyName := api.SettingPluginOpenAIKey
-	key, err := s.store.GetSettingV2(ctx, &store.FindSettingMessage{Name: &openaiKeyName})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Failed to get setting: %v", err)
-	}
-	if key.Value == "" {
-		return nil, status.Errorf(codes.FailedPrecondition, "OpenAI key is not set")
-	}
-
+func (s *DatabaseService) pgAdviseIndex(ctx context.Context, request *v1pb.AdviseIndexRequest, database *store.DatabaseMessage, key string) (*v1pb.AdviseIndexResponse, error) {
 	schema, err := s.store.GetDBSchema(ctx, database.UID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "Failed to get database schema: %v", err)
@@ -2221,7 +2281,7 @@ func (s *DatabaseService) pgAdviseIndex(ctx context.Context, request *v1pb.Advis
 		return nil
 	}
 
-	result, err := getOpenAIResponse(ctx, messages, key.Value, generateFunc)
+	result, err := getOpenAIResponse(ctx, messages, key, generateFunc)
 	if err != nil {
 		return nil, err
 	}
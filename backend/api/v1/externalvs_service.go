@@ -317,6 +317,8 @@ func convertToExternalVersionControl(externalVersionControl *store.ExternalVersi
 		tp = v1pb.ExternalVersionControl_BITBUCKET
 	case vcs.AzureDevOps:
 		tp = v1pb.ExternalVersionControl_AZURE_DEVOPS
+	case vcs.Gitea:
+		tp = v1pb.ExternalVersionControl_GITEA
 	}
 
 	return &v1pb.ExternalVersionControl{
@@ -372,6 +374,8 @@ func convertExternalVersionControlTypeToVCSType(tp v1pb.ExternalVersionControl_T
 		return vcs.Bitbucket, nil
 	case v1pb.ExternalVersionControl_AZURE_DEVOPS:
 		return vcs.AzureDevOps, nil
+	case v1pb.ExternalVersionControl_GITEA:
+		return vcs.Gitea, nil
 	}
 	return "", errors.Errorf("unknown external version control type: %v", tp)
 }
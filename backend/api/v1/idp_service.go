@@ -15,6 +15,7 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/idp/ldap"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oauth2"
 	"github.com/bytebase/bytebase/backend/plugin/idp/oidc"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -144,6 +145,10 @@ func (s *IdentityProviderService) UpdateIdentityProvider(ctx context.Context, re
 			if request.IdentityProvider.Config.GetLdapConfig().BindPassword == "" {
 				patch.Config.GetLdapConfig().BindPassword = identityProvider.Config.GetLdapConfig().BindPassword
 			}
+		} else if identityProvider.Type == storepb.IdentityProviderType_SAML {
+			if request.IdentityProvider.Config.GetSamlConfig().SpPrivateKey == "" {
+				patch.Config.GetSamlConfig().SpPrivateKey = identityProvider.Config.GetSamlConfig().SpPrivateKey
+			}
 		}
 	}
 
@@ -314,6 +319,28 @@ func (s *IdentityProviderService) TestIdentityProvider(ctx context.Context, requ
 			return nil, status.Errorf(codes.InvalidArgument, "failed to test connection, error: %s", err.Error())
 		}
 		_ = conn.Close()
+	} else if identityProvider.Type == v1pb.IdentityProviderType_SAML {
+		// Retrieve service provider private key from stored identity provider if not provided.
+		if request.IdentityProvider.Config.GetSamlConfig().SpPrivateKey == "" {
+			storedIdentityProvider, err := s.getIdentityProviderMessage(ctx, request.IdentityProvider.Name)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to find identity provider, error: %s", err.Error())
+			}
+			if storedIdentityProvider == nil {
+				return nil, status.Errorf(codes.Internal, "identity provider %s not found", request.IdentityProvider.Name)
+			}
+			request.IdentityProvider.Config.GetSamlConfig().SpPrivateKey = storedIdentityProvider.Config.GetSamlConfig().SpPrivateKey
+		}
+		identityProviderID, err := common.GetIdentityProviderID(request.IdentityProvider.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		}
+		// The SAML assertion is posted by the identity provider through the browser, so we can only
+		// validate the certificates and the key here.
+		identityProviderConfig := convertIdentityProviderConfigToStore(identityProvider.Config).GetSamlConfig()
+		if _, err := NewSAMLIdentityProvider(setting.ExternalUrl, identityProviderID, identityProviderConfig); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to create new SAML identity provider: %v", err)
+		}
 	} else {
 		return nil, status.Errorf(codes.InvalidArgument, "identity provider type %s not supported", identityProvider.Type.String())
 	}
@@ -418,6 +445,25 @@ func convertIdentityProviderConfigFromStore(identityProviderConfig *storepb.Iden
 				},
 			},
 		}
+	} else if v := identityProviderConfig.GetSamlConfig(); v != nil {
+		fieldMapping := v1pb.FieldMapping{
+			Identifier:  v.FieldMapping.Identifier,
+			DisplayName: v.FieldMapping.DisplayName,
+			Email:       v.FieldMapping.Email,
+			Phone:       v.FieldMapping.Phone,
+		}
+		return &v1pb.IdentityProviderConfig{
+			Config: &v1pb.IdentityProviderConfig_SamlConfig{
+				SamlConfig: &v1pb.SAMLIdentityProviderConfig{
+					EntityId:      v.EntityId,
+					SsoUrl:        v.SsoUrl,
+					Certificate:   v.Certificate,
+					SpCertificate: v.SpCertificate,
+					SpPrivateKey:  "", // SECURITY: We do not expose the service provider private key
+					FieldMapping:  &fieldMapping,
+				},
+			},
+		}
 	}
 	return nil
 }
@@ -486,6 +532,25 @@ func convertIdentityProviderConfigToStore(identityProviderConfig *v1pb.IdentityP
 				},
 			},
 		}
+	} else if v := identityProviderConfig.GetSamlConfig(); v != nil {
+		fieldMapping := storepb.FieldMapping{
+			Identifier:  v.FieldMapping.Identifier,
+			DisplayName: v.FieldMapping.DisplayName,
+			Email:       v.FieldMapping.Email,
+			Phone:       v.FieldMapping.Phone,
+		}
+		return &storepb.IdentityProviderConfig{
+			Config: &storepb.IdentityProviderConfig_SamlConfig{
+				SamlConfig: &storepb.SAMLIdentityProviderConfig{
+					EntityId:      v.EntityId,
+					SsoUrl:        v.SsoUrl,
+					Certificate:   v.Certificate,
+					SpCertificate: v.SpCertificate,
+					SpPrivateKey:  v.SpPrivateKey,
+					FieldMapping:  &fieldMapping,
+				},
+			},
+		}
 	} else {
 		return nil
 	}
@@ -505,8 +570,27 @@ func validIdentityProviderConfig(identityProviderType v1pb.IdentityProviderType,
 		if identityProviderConfig.GetLdapConfig() == nil {
 			return errors.Errorf("unexpected provider config value")
 		}
+	} else if identityProviderType == v1pb.IdentityProviderType_SAML {
+		if identityProviderConfig.GetSamlConfig() == nil {
+			return errors.Errorf("unexpected provider config value")
+		}
 	} else {
 		return errors.Errorf("unexpected provider type %s", identityProviderType)
 	}
 	return nil
 }
+
+// NewSAMLIdentityProvider creates the SAML identity provider, for which Bytebase serves as the
+// service provider under the external URL.
+func NewSAMLIdentityProvider(externalURL, identityProviderID string, config *storepb.SAMLIdentityProviderConfig) (*saml.IdentityProvider, error) {
+	return saml.NewIdentityProvider(saml.IdentityProviderConfig{
+		EntityID:      config.EntityId,
+		SSOURL:        config.SsoUrl,
+		Certificate:   config.Certificate,
+		SPEntityID:    fmt.Sprintf("%s/saml/metadata/%s", externalURL, identityProviderID),
+		ACSURL:        fmt.Sprintf("%s/saml/acs/%s", externalURL, identityProviderID),
+		SPCertificate: config.SpCertificate,
+		SPPrivateKey:  config.SpPrivateKey,
+		FieldMapping:  config.FieldMapping,
+	})
+}
@@ -0,0 +1,1154 @@
+package v1
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	tidbparser "github.com/pingcap/tidb/parser"
+	tidbast "github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/opcode"
+	driver "github.com/pingcap/tidb/types/parser_driver"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// indexAdvisorMinRowCount is the minimum estimated row count of a table to advise an index for.
+	// Scanning a smaller table is cheap enough.
+	indexAdvisorMinRowCount = 1000
+	// indexAdvisorMaxColumns is the maximum number of columns of an advised index.
+	indexAdvisorMaxColumns = 5
+	// indexAdvisorMaxIndexNameLength is the maximum length of an advised index name, which is the limit of Postgres.
+	indexAdvisorMaxIndexNameLength = 63
+	// fullScanWarningRowCount is the minimum estimated row count of a table to warn about scanning it fully in SQL Editor.
+	fullScanWarningRowCount = 100000
+
+	noUsableIndex     = "No usable index"
+	noIndexSuggestion = "N/A"
+)
+
+// tableRef is a table referenced in a query block.
+type tableRef struct {
+	schema string
+	name   string
+	alias  string
+}
+
+// columnRef is a column referenced in a query block, the qualifier is the table name or alias.
+type columnRef struct {
+	qualifier string
+	name      string
+}
+
+// queryBlock is a SELECT, UPDATE or DELETE query block with the columns in its predicates, joins and ORDER BY.
+// Only the predicates combined by AND can be served by an index together, so the predicates under OR and NOT are ignored.
+type queryBlock struct {
+	tables []*tableRef
+	// equalities are the columns compared by =, IN and IS NULL, including the join keys.
+	equalities []*columnRef
+	// ranges are the columns compared by <, <=, >, >=, BETWEEN and LIKE with a constant prefix.
+	ranges []*columnRef
+	// orderBy is the columns of ORDER BY, it's empty if any ORDER BY item is not a column.
+	orderBy []*columnRef
+}
+
+// tableAccessPattern is how a query block accesses a table.
+type tableAccessPattern struct {
+	schemaName string
+	tableName  string
+	// alias is the alias of the table in the query, or the table name if the table has no alias.
+	alias string
+	// table is the synced metadata of the table, it's nil if the table is not synced.
+	table *storepb.TableMetadata
+
+	equalityColumns []string
+	rangeColumns    []string
+	orderByColumns  []string
+}
+
+// indexAdvice is the advice on a table.
+type indexAdvice struct {
+	pattern *tableAccessPattern
+	// currentIndex is the existing index serving the most leading columns of the access pattern.
+	currentIndex *storepb.IndexMetadata
+	// columns are the columns of the suggested index, it's empty if no index is suggested.
+	columns        []string
+	includeColumns []string
+}
+
+// indexAdvisor is the rule-based index advisor.
+//
+// It extracts the columns used by the predicates, joins and ORDER BY of the statement, and suggests a B-tree index
+// leading with the equality columns, followed by the first range column or the ORDER BY columns. The suggestion is
+// skipped if an existing index already serves these columns or the table is small. The candidate is then validated
+// against the database, with hypothetical indexes if HypoPG is installed in Postgres, or EXPLAIN otherwise.
+type indexAdvisor struct {
+	engine        db.Type
+	defaultSchema string
+	// ignoreCase is whether the table and column names are compared case-insensitively.
+	ignoreCase bool
+	indexType  string
+}
+
+func newIndexAdvisor(engine db.Type) *indexAdvisor {
+	switch engine {
+	case db.Postgres:
+		return &indexAdvisor{engine: engine, defaultSchema: "public", indexType: "btree"}
+	case db.MSSQL:
+		return &indexAdvisor{engine: engine, defaultSchema: "dbo", ignoreCase: true, indexType: "NONCLUSTERED"}
+	default:
+		return &indexAdvisor{engine: engine, ignoreCase: true, indexType: "BTREE"}
+	}
+}
+
+// extractQueryBlocks extracts the query blocks of a single SELECT, UPDATE or DELETE statement.
+// It returns nil for other statements.
+func extractQueryBlocks(engine db.Type, statement string) ([]*queryBlock, error) {
+	switch engine {
+	case db.Postgres:
+		return extractPGQueryBlocks(statement)
+	case db.MySQL, db.MariaDB, db.TiDB:
+		return extractMySQLQueryBlocks(statement)
+	default:
+		return nil, errors.Errorf("unsupported engine %s", engine)
+	}
+}
+
+func extractMySQLQueryBlocks(statement string) ([]*queryBlock, error) {
+	nodes, _, err := tidbparser.New().Parse(statement, "", "")
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) != 1 {
+		return nil, errors.Errorf("expect one statement, but got %d", len(nodes))
+	}
+	switch nodes[0].(type) {
+	case *tidbast.SelectStmt, *tidbast.SetOprStmt, *tidbast.UpdateStmt, *tidbast.DeleteStmt:
+	default:
+		return nil, nil
+	}
+	extractor := &mysqlQueryBlockExtractor{}
+	nodes[0].Accept(extractor)
+	return extractor.blocks, nil
+}
+
+type mysqlQueryBlockExtractor struct {
+	blocks []*queryBlock
+}
+
+// Enter implements the ast.Visitor interface.
+func (e *mysqlQueryBlockExtractor) Enter(in tidbast.Node) (tidbast.Node, bool) {
+	block := &queryBlock{}
+	switch node := in.(type) {
+	case *tidbast.SelectStmt:
+		if node.From != nil {
+			block.addMySQLTableRefs(node.From.TableRefs)
+		}
+		block.addMySQLPredicate(node.Where)
+		block.addMySQLOrderBy(node.OrderBy)
+	case *tidbast.UpdateStmt:
+		if node.TableRefs != nil {
+			block.addMySQLTableRefs(node.TableRefs.TableRefs)
+		}
+		block.addMySQLPredicate(node.Where)
+		block.addMySQLOrderBy(node.Order)
+	case *tidbast.DeleteStmt:
+		if node.TableRefs != nil {
+			block.addMySQLTableRefs(node.TableRefs.TableRefs)
+		}
+		block.addMySQLPredicate(node.Where)
+		block.addMySQLOrderBy(node.Order)
+	default:
+		return in, false
+	}
+	e.blocks = append(e.blocks, block)
+	return in, false
+}
+
+// Leave implements the ast.Visitor interface.
+func (*mysqlQueryBlockExtractor) Leave(in tidbast.Node) (tidbast.Node, bool) {
+	return in, true
+}
+
+func (b *queryBlock) addMySQLTableRefs(node tidbast.ResultSetNode) {
+	switch node := node.(type) {
+	case *tidbast.Join:
+		b.addMySQLTableRefs(node.Left)
+		b.addMySQLTableRefs(node.Right)
+		if node.On != nil {
+			b.addMySQLPredicate(node.On.Expr)
+		}
+	case *tidbast.TableSource:
+		// The subqueries are visited as query blocks themselves.
+		if table, ok := node.Source.(*tidbast.TableName); ok {
+			b.tables = append(b.tables, &tableRef{schema: table.Schema.O, name: table.Name.O, alias: node.AsName.O})
+		}
+	}
+}
+
+func (b *queryBlock) addMySQLPredicate(expr tidbast.ExprNode) {
+	switch expr := expr.(type) {
+	case *tidbast.ParenthesesExpr:
+		b.addMySQLPredicate(expr.Expr)
+	case *tidbast.BinaryOperationExpr:
+		switch expr.Op {
+		case opcode.LogicAnd:
+			b.addMySQLPredicate(expr.L)
+			b.addMySQLPredicate(expr.R)
+		case opcode.EQ, opcode.NullEQ:
+			b.equalities = appendColumnRefs(b.equalities, mysqlColumnRef(expr.L), mysqlColumnRef(expr.R))
+		case opcode.LT, opcode.LE, opcode.GT, opcode.GE:
+			b.ranges = appendColumnRefs(b.ranges, mysqlColumnRef(expr.L), mysqlColumnRef(expr.R))
+		}
+	case *tidbast.PatternInExpr:
+		if !expr.Not {
+			b.equalities = appendColumnRefs(b.equalities, mysqlColumnRef(expr.Expr))
+		}
+	case *tidbast.IsNullExpr:
+		if !expr.Not {
+			b.equalities = appendColumnRefs(b.equalities, mysqlColumnRef(expr.Expr))
+		}
+	case *tidbast.BetweenExpr:
+		if !expr.Not {
+			b.ranges = appendColumnRefs(b.ranges, mysqlColumnRef(expr.Expr))
+		}
+	case *tidbast.PatternLikeExpr:
+		if pattern, ok := expr.Pattern.(*driver.ValueExpr); ok && !expr.Not && isPrefixPattern(pattern.GetString()) {
+			b.ranges = appendColumnRefs(b.ranges, mysqlColumnRef(expr.Expr))
+		}
+	}
+}
+
+func (b *queryBlock) addMySQLOrderBy(orderBy *tidbast.OrderByClause) {
+	if orderBy == nil {
+		return
+	}
+	var columns []*columnRef
+	for _, item := range orderBy.Items {
+		column := mysqlColumnRef(item.Expr)
+		if column == nil {
+			return
+		}
+		columns = append(columns, column)
+	}
+	b.orderBy = columns
+}
+
+func mysqlColumnRef(expr tidbast.ExprNode) *columnRef {
+	switch expr := expr.(type) {
+	case *tidbast.ParenthesesExpr:
+		return mysqlColumnRef(expr.Expr)
+	case *tidbast.ColumnNameExpr:
+		return &columnRef{qualifier: expr.Name.Table.O, name: expr.Name.Name.O}
+	}
+	return nil
+}
+
+func extractPGQueryBlocks(statement string) ([]*queryBlock, error) {
+	result, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Stmts) != 1 {
+		return nil, errors.Errorf("expect one statement, but got %d", len(result.Stmts))
+	}
+	switch result.Stmts[0].Stmt.Node.(type) {
+	case *pgquery.Node_SelectStmt, *pgquery.Node_UpdateStmt, *pgquery.Node_DeleteStmt:
+	default:
+		return nil, nil
+	}
+	extractor := &pgQueryBlockExtractor{}
+	extractor.extractStmt(result.Stmts[0].Stmt)
+	return extractor.blocks, nil
+}
+
+type pgQueryBlockExtractor struct {
+	blocks []*queryBlock
+}
+
+func (e *pgQueryBlockExtractor) extractStmt(node *pgquery.Node) {
+	switch node := node.GetNode().(type) {
+	case *pgquery.Node_SelectStmt:
+		e.extractSelect(node.SelectStmt)
+	case *pgquery.Node_UpdateStmt:
+		e.extractWithClause(node.UpdateStmt.WithClause)
+		block := &queryBlock{}
+		e.addFromItem(block, &pgquery.Node{Node: &pgquery.Node_RangeVar{RangeVar: node.UpdateStmt.Relation}})
+		for _, item := range node.UpdateStmt.FromClause {
+			e.addFromItem(block, item)
+		}
+		e.addPredicate(block, node.UpdateStmt.WhereClause)
+		e.blocks = append(e.blocks, block)
+	case *pgquery.Node_DeleteStmt:
+		e.extractWithClause(node.DeleteStmt.WithClause)
+		block := &queryBlock{}
+		e.addFromItem(block, &pgquery.Node{Node: &pgquery.Node_RangeVar{RangeVar: node.DeleteStmt.Relation}})
+		for _, item := range node.DeleteStmt.UsingClause {
+			e.addFromItem(block, item)
+		}
+		e.addPredicate(block, node.DeleteStmt.WhereClause)
+		e.blocks = append(e.blocks, block)
+	}
+}
+
+func (e *pgQueryBlockExtractor) extractWithClause(with *pgquery.WithClause) {
+	if with == nil {
+		return
+	}
+	for _, cte := range with.Ctes {
+		e.extractStmt(cte.GetCommonTableExpr().GetCtequery())
+	}
+}
+
+func (e *pgQueryBlockExtractor) extractSelect(stmt *pgquery.SelectStmt) {
+	if stmt == nil {
+		return
+	}
+	e.extractWithClause(stmt.WithClause)
+	if stmt.Op != pgquery.SetOperation_SETOP_NONE {
+		e.extractSelect(stmt.Larg)
+		e.extractSelect(stmt.Rarg)
+		return
+	}
+	block := &queryBlock{}
+	for _, item := range stmt.FromClause {
+		e.addFromItem(block, item)
+	}
+	e.addPredicate(block, stmt.WhereClause)
+	var orderBy []*columnRef
+	for _, item := range stmt.SortClause {
+		column := pgColumnRef(item.GetSortBy().GetNode())
+		if column == nil {
+			orderBy = nil
+			break
+		}
+		orderBy = append(orderBy, column)
+	}
+	block.orderBy = orderBy
+	e.blocks = append(e.blocks, block)
+}
+
+func (e *pgQueryBlockExtractor) addFromItem(block *queryBlock, node *pgquery.Node) {
+	switch node := node.GetNode().(type) {
+	case *pgquery.Node_RangeVar:
+		block.tables = append(block.tables, &tableRef{
+			schema: node.RangeVar.Schemaname,
+			name:   node.RangeVar.Relname,
+			alias:  node.RangeVar.GetAlias().GetAliasname(),
+		})
+	case *pgquery.Node_JoinExpr:
+		e.addFromItem(block, node.JoinExpr.Larg)
+		e.addFromItem(block, node.JoinExpr.Rarg)
+		e.addPredicate(block, node.JoinExpr.Quals)
+	case *pgquery.Node_RangeSubselect:
+		e.extractStmt(node.RangeSubselect.Subquery)
+	}
+}
+
+func (e *pgQueryBlockExtractor) addPredicate(block *queryBlock, node *pgquery.Node) {
+	switch node := node.GetNode().(type) {
+	case *pgquery.Node_BoolExpr:
+		if node.BoolExpr.Boolop == pgquery.BoolExprType_AND_EXPR {
+			for _, arg := range node.BoolExpr.Args {
+				e.addPredicate(block, arg)
+			}
+		}
+	case *pgquery.Node_AExpr:
+		expr := node.AExpr
+		var operator string
+		if len(expr.Name) > 0 {
+			operator = expr.Name[len(expr.Name)-1].GetString_().GetSval()
+		}
+		switch expr.Kind {
+		case pgquery.A_Expr_Kind_AEXPR_OP:
+			switch operator {
+			case "=":
+				block.equalities = appendColumnRefs(block.equalities, pgColumnRef(expr.Lexpr), pgColumnRef(expr.Rexpr))
+			case "<", "<=", ">", ">=":
+				block.ranges = appendColumnRefs(block.ranges, pgColumnRef(expr.Lexpr), pgColumnRef(expr.Rexpr))
+			}
+		case pgquery.A_Expr_Kind_AEXPR_OP_ANY:
+			if operator == "=" {
+				block.equalities = appendColumnRefs(block.equalities, pgColumnRef(expr.Lexpr))
+			}
+		case pgquery.A_Expr_Kind_AEXPR_IN:
+			if operator == "=" {
+				block.equalities = appendColumnRefs(block.equalities, pgColumnRef(expr.Lexpr))
+			}
+		case pgquery.A_Expr_Kind_AEXPR_BETWEEN:
+			block.ranges = appendColumnRefs(block.ranges, pgColumnRef(expr.Lexpr))
+		case pgquery.A_Expr_Kind_AEXPR_LIKE:
+			if operator == "~~" && isPrefixPattern(expr.Rexpr.GetAConst().GetSval().GetSval()) {
+				block.ranges = appendColumnRefs(block.ranges, pgColumnRef(expr.Lexpr))
+			}
+		}
+	case *pgquery.Node_NullTest:
+		if node.NullTest.Nulltesttype == pgquery.NullTestType_IS_NULL {
+			block.equalities = appendColumnRefs(block.equalities, pgColumnRef(node.NullTest.Arg))
+		}
+	case *pgquery.Node_SubLink:
+		if node.SubLink.SubLinkType == pgquery.SubLinkType_ANY_SUBLINK {
+			block.equalities = appendColumnRefs(block.equalities, pgColumnRef(node.SubLink.Testexpr))
+		}
+		e.extractStmt(node.SubLink.Subselect)
+	}
+}
+
+func pgColumnRef(node *pgquery.Node) *columnRef {
+	column, ok := node.GetNode().(*pgquery.Node_ColumnRef)
+	if !ok {
+		return nil
+	}
+	var names []string
+	for _, field := range column.ColumnRef.Fields {
+		s, ok := field.GetNode().(*pgquery.Node_String_)
+		if !ok {
+			// The field is *.
+			return nil
+		}
+		names = append(names, s.String_.Sval)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	ref := &columnRef{name: names[len(names)-1]}
+	if len(names) > 1 {
+		ref.qualifier = names[len(names)-2]
+	}
+	return ref
+}
+
+func appendColumnRefs(refs []*columnRef, columns ...*columnRef) []*columnRef {
+	for _, column := range columns {
+		if column != nil {
+			refs = append(refs, column)
+		}
+	}
+	return refs
+}
+
+// isPrefixPattern returns whether the LIKE pattern has a constant prefix, which can be served by a B-tree index.
+func isPrefixPattern(pattern string) bool {
+	return pattern != "" && pattern[0] != '%' && pattern[0] != '_'
+}
+
+// buildTableAccessPatterns resolves the columns of the query blocks to the synced tables.
+// The columns which cannot be resolved to exactly one table are ignored.
+func (a *indexAdvisor) buildTableAccessPatterns(blocks []*queryBlock, metadata *storepb.DatabaseSchemaMetadata) []*tableAccessPattern {
+	var result []*tableAccessPattern
+	for _, block := range blocks {
+		patterns := make([]*tableAccessPattern, len(block.tables))
+		for i, ref := range block.tables {
+			schemaName := ref.schema
+			if schemaName == "" {
+				schemaName = a.defaultSchema
+			}
+			table := a.findTable(metadata, schemaName, ref.name)
+			if table == nil {
+				continue
+			}
+			alias := ref.alias
+			if alias == "" {
+				alias = ref.name
+			}
+			patterns[i] = &tableAccessPattern{schemaName: schemaName, tableName: table.Name, alias: alias, table: table}
+		}
+
+		resolve := func(ref *columnRef) (*tableAccessPattern, string) {
+			var found *tableAccessPattern
+			var foundColumn string
+			for _, pattern := range patterns {
+				if pattern == nil {
+					continue
+				}
+				if ref.qualifier != "" && !a.equalName(ref.qualifier, pattern.alias) {
+					continue
+				}
+				column := a.findColumn(pattern.table, ref.name)
+				if column == "" {
+					continue
+				}
+				if found != nil {
+					// The column is ambiguous.
+					return nil, ""
+				}
+				found, foundColumn = pattern, column
+			}
+			return found, foundColumn
+		}
+		for _, ref := range block.equalities {
+			if pattern, column := resolve(ref); pattern != nil {
+				pattern.equalityColumns = appendUniqueColumn(pattern.equalityColumns, column)
+			}
+		}
+		for _, ref := range block.ranges {
+			if pattern, column := resolve(ref); pattern != nil {
+				pattern.rangeColumns = appendUniqueColumn(pattern.rangeColumns, column)
+			}
+		}
+		// The index can serve the ORDER BY only if all ORDER BY columns are from the same table.
+		var orderByPattern *tableAccessPattern
+		var orderByColumns []string
+		for _, ref := range block.orderBy {
+			pattern, column := resolve(ref)
+			if pattern == nil || (orderByPattern != nil && pattern != orderByPattern) {
+				orderByPattern = nil
+				break
+			}
+			orderByPattern = pattern
+			orderByColumns = appendUniqueColumn(orderByColumns, column)
+		}
+		if orderByPattern != nil {
+			orderByPattern.orderByColumns = orderByColumns
+		}
+
+		for _, pattern := range patterns {
+			if pattern == nil {
+				continue
+			}
+			if len(pattern.equalityColumns) == 0 && len(pattern.rangeColumns) == 0 && len(pattern.orderByColumns) == 0 {
+				continue
+			}
+			result = append(result, pattern)
+		}
+	}
+	return result
+}
+
+func (a *indexAdvisor) findTable(metadata *storepb.DatabaseSchemaMetadata, schemaName string, tableName string) *storepb.TableMetadata {
+	for _, schema := range metadata.GetSchemas() {
+		if !a.equalName(schema.Name, schemaName) {
+			continue
+		}
+		for _, table := range schema.Tables {
+			if a.equalName(table.Name, tableName) {
+				return table
+			}
+		}
+	}
+	return nil
+}
+
+// findColumn returns the column name in the metadata, or empty string if the table doesn't have the column.
+func (a *indexAdvisor) findColumn(table *storepb.TableMetadata, columnName string) string {
+	for _, column := range table.Columns {
+		if a.equalName(column.Name, columnName) {
+			return column.Name
+		}
+	}
+	return ""
+}
+
+func (a *indexAdvisor) equalName(x, y string) bool {
+	if a.ignoreCase {
+		return strings.EqualFold(x, y)
+	}
+	return x == y
+}
+
+func (a *indexAdvisor) containsColumn(columns []string, expression string) bool {
+	// The synced index expressions may be quoted.
+	expression = strings.Trim(expression, "`\"[]")
+	for _, column := range columns {
+		if a.equalName(column, expression) {
+			return true
+		}
+	}
+	return false
+}
+
+func appendUniqueColumn(columns []string, column string) []string {
+	for _, c := range columns {
+		if c == column {
+			return columns
+		}
+	}
+	return append(columns, column)
+}
+
+// candidateColumns returns the columns of the index best serving the access pattern, which are the equality columns
+// followed by the first range column, or the ORDER BY columns if there is no range column.
+func candidateColumns(pattern *tableAccessPattern) []string {
+	columns := append([]string{}, pattern.equalityColumns...)
+	if len(pattern.rangeColumns) > 0 {
+		columns = appendUniqueColumn(columns, pattern.rangeColumns[0])
+	} else {
+		for _, column := range pattern.orderByColumns {
+			columns = appendUniqueColumn(columns, column)
+		}
+	}
+	if len(columns) > indexAdvisorMaxColumns {
+		columns = columns[:indexAdvisorMaxColumns]
+	}
+	return columns
+}
+
+// indexMatchLength returns the number of leading index expressions usable by the access pattern.
+func (a *indexAdvisor) indexMatchLength(index *storepb.IndexMetadata, pattern *tableAccessPattern) int {
+	expressions := index.Expressions
+	n := 0
+	for n < len(expressions) && a.containsColumn(pattern.equalityColumns, expressions[n]) {
+		n++
+	}
+	if n == len(expressions) {
+		return n
+	}
+	if len(pattern.rangeColumns) > 0 {
+		if a.containsColumn(pattern.rangeColumns, expressions[n]) {
+			n++
+		}
+		return n
+	}
+	for _, column := range pattern.orderByColumns {
+		if a.containsColumn(pattern.equalityColumns, column) {
+			continue
+		}
+		if n == len(expressions) || !a.containsColumn([]string{column}, expressions[n]) {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// adviseTable finds the existing index serving the access pattern, and suggests a new index if the existing ones
+// cannot serve all the candidate columns.
+func (a *indexAdvisor) adviseTable(pattern *tableAccessPattern) *indexAdvice {
+	advice := &indexAdvice{pattern: pattern}
+	candidate := candidateColumns(pattern)
+	matched := 0
+	for _, index := range pattern.table.GetIndexes() {
+		if n := a.indexMatchLength(index, pattern); n > matched {
+			advice.currentIndex, matched = index, n
+		}
+	}
+	if matched >= len(candidate) {
+		return advice
+	}
+	// The row count is negative if the table has never been analyzed.
+	if rowCount := pattern.table.GetRowCount(); rowCount >= 0 && rowCount < indexAdvisorMinRowCount {
+		return advice
+	}
+	advice.columns = candidate
+	return advice
+}
+
+// pickIndexAdvice picks the advice to report, preferring the one with a suggestion on the largest table.
+func pickIndexAdvice(advices []*indexAdvice) *indexAdvice {
+	var picked *indexAdvice
+	for _, advice := range advices {
+		if picked == nil {
+			picked = advice
+			continue
+		}
+		if (len(advice.columns) > 0) != (len(picked.columns) > 0) {
+			if len(advice.columns) > 0 {
+				picked = advice
+			}
+			continue
+		}
+		if advice.pattern.table.GetRowCount() > picked.pattern.table.GetRowCount() {
+			picked = advice
+		}
+	}
+	return picked
+}
+
+func (a *indexAdvisor) toResponse(advice *indexAdvice) *v1pb.AdviseIndexResponse {
+	response := &v1pb.AdviseIndexResponse{
+		CurrentIndex: noUsableIndex,
+		Suggestion:   noIndexSuggestion,
+	}
+	if advice == nil {
+		return response
+	}
+	if advice.currentIndex != nil {
+		response.CurrentIndex = fmt.Sprintf("USING %s (%s)", advice.currentIndex.Type, strings.Join(advice.currentIndex.Expressions, ", "))
+	}
+	if len(advice.columns) > 0 {
+		response.Suggestion = fmt.Sprintf("USING %s (%s)", a.indexType, strings.Join(advice.columns, ", "))
+		if len(advice.includeColumns) > 0 {
+			response.Suggestion += fmt.Sprintf(" INCLUDE (%s)", strings.Join(advice.includeColumns, ", "))
+		}
+		response.CreateIndexStatement = a.createIndexStatement(advice)
+	}
+	return response
+}
+
+func (a *indexAdvisor) createIndexStatement(advice *indexAdvice) string {
+	indexName := fmt.Sprintf("idx_%s_%s", advice.pattern.tableName, strings.Join(advice.columns, "_"))
+	if len(indexName) > indexAdvisorMaxIndexNameLength {
+		indexName = indexName[:indexAdvisorMaxIndexNameLength]
+	}
+	var columns []string
+	for _, column := range advice.columns {
+		columns = append(columns, a.quote(column))
+	}
+	switch a.engine {
+	case db.Postgres:
+		return fmt.Sprintf("CREATE INDEX %s ON %s.%s (%s);", a.quote(indexName), a.quote(advice.pattern.schemaName), a.quote(advice.pattern.tableName), strings.Join(columns, ", "))
+	case db.MSSQL:
+		statement := fmt.Sprintf("CREATE NONCLUSTERED INDEX %s ON %s.%s (%s)", a.quote(indexName), a.quote(advice.pattern.schemaName), a.quote(advice.pattern.tableName), strings.Join(columns, ", "))
+		if len(advice.includeColumns) > 0 {
+			var includeColumns []string
+			for _, column := range advice.includeColumns {
+				includeColumns = append(includeColumns, a.quote(column))
+			}
+			statement += fmt.Sprintf(" INCLUDE (%s)", strings.Join(includeColumns, ", "))
+		}
+		return statement + ";"
+	default:
+		return fmt.Sprintf("CREATE INDEX %s ON %s (%s);", a.quote(indexName), a.quote(advice.pattern.tableName), strings.Join(columns, ", "))
+	}
+}
+
+func (a *indexAdvisor) quote(identifier string) string {
+	switch a.engine {
+	case db.Postgres:
+		return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+	case db.MSSQL:
+		return fmt.Sprintf("[%s]", strings.ReplaceAll(identifier, "]", "]]"))
+	default:
+		return fmt.Sprintf("`%s`", strings.ReplaceAll(identifier, "`", "``"))
+	}
+}
+
+// adviseIndex advises the index for the statement with the synced metadata, and validates the suggestion with the connection.
+func (a *indexAdvisor) adviseIndex(ctx context.Context, conn *sql.Conn, metadata *storepb.DatabaseSchemaMetadata, statement string) (*v1pb.AdviseIndexResponse, error) {
+	blocks, err := extractQueryBlocks(a.engine, statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	patterns := a.buildTableAccessPatterns(blocks, metadata)
+	if a.engine == db.Postgres {
+		if err := sortPGEqualityColumns(ctx, conn, patterns); err != nil {
+			return nil, err
+		}
+	}
+	var advices []*indexAdvice
+	for _, pattern := range patterns {
+		advices = append(advices, a.adviseTable(pattern))
+	}
+
+	switch a.engine {
+	case db.Postgres:
+		if err := a.validatePGIndexAdvices(ctx, conn, statement, advices); err != nil {
+			return nil, err
+		}
+	case db.MySQL, db.MariaDB:
+		validateMySQLIndexAdvices(ctx, conn, statement, advices)
+	}
+	return a.toResponse(pickIndexAdvice(advices)), nil
+}
+
+// sortPGEqualityColumns orders the equality columns by the number of distinct values in pg_stats,
+// so that the most selective column leads the index.
+func sortPGEqualityColumns(ctx context.Context, conn *sql.Conn, patterns []*tableAccessPattern) error {
+	for _, pattern := range patterns {
+		if len(pattern.equalityColumns) < 2 {
+			continue
+		}
+		distinct := make(map[string]float64)
+		if err := func() error {
+			rows, err := conn.QueryContext(ctx, "SELECT attname, n_distinct FROM pg_stats WHERE schemaname = $1 AND tablename = $2", pattern.schemaName, pattern.tableName)
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var name string
+				var nDistinct float64
+				if err := rows.Scan(&name, &nDistinct); err != nil {
+					return err
+				}
+				// The negative n_distinct is the negated ratio of the distinct values to the rows.
+				if nDistinct < 0 {
+					nDistinct = -nDistinct * float64(max(pattern.table.GetRowCount(), 1))
+				}
+				distinct[name] = nDistinct
+			}
+			return rows.Err()
+		}(); err != nil {
+			return errors.Wrapf(err, "failed to get the column statistics of table %q.%q", pattern.schemaName, pattern.tableName)
+		}
+		sort.SliceStable(pattern.equalityColumns, func(i, j int) bool {
+			return distinct[pattern.equalityColumns[i]] > distinct[pattern.equalityColumns[j]]
+		})
+	}
+	return nil
+}
+
+// validatePGIndexAdvices drops the suggestions that the planner would not use if HypoPG is installed,
+// otherwise drops the suggestions on the tables not scanned sequentially.
+// The suggestions are kept if the statement cannot be explained, such as the normalized statements with parameters on Postgres 15 and earlier.
+func (a *indexAdvisor) validatePGIndexAdvices(ctx context.Context, conn *sql.Conn, statement string, advices []*indexAdvice) error {
+	var hasHypoPG bool
+	if err := conn.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'hypopg')").Scan(&hasHypoPG); err != nil {
+		return errors.Wrapf(err, "failed to check the hypopg extension")
+	}
+
+	var plan *pgExplainPlan
+	if !hasHypoPG {
+		var err error
+		if plan, err = explainPG(ctx, conn, statement); err != nil {
+			log.Debug("Failed to explain statement for index advisor", zap.String("statement", statement), zap.Error(err))
+			return nil
+		}
+	}
+	for _, advice := range advices {
+		if len(advice.columns) == 0 {
+			continue
+		}
+		if !hasHypoPG {
+			if !plan.hasSeqScan(advice.pattern.schemaName, advice.pattern.tableName) {
+				advice.columns = nil
+			}
+			continue
+		}
+		used, err := usePGHypotheticalIndex(ctx, conn, statement, a.createIndexStatement(advice))
+		if err != nil {
+			log.Debug("Failed to validate hypothetical index for index advisor", zap.String("statement", statement), zap.Error(err))
+			continue
+		}
+		if !used {
+			advice.columns = nil
+		}
+	}
+	return nil
+}
+
+// usePGHypotheticalIndex returns whether the planner uses the hypothetical index created by HypoPG for the statement.
+func usePGHypotheticalIndex(ctx context.Context, conn *sql.Conn, statement string, createIndexStatement string) (bool, error) {
+	var indexName string
+	if err := conn.QueryRowContext(ctx, "SELECT indexname FROM hypopg_create_index($1)", createIndexStatement).Scan(&indexName); err != nil {
+		return false, errors.Wrapf(err, "failed to create hypothetical index")
+	}
+	defer func() {
+		if _, err := conn.ExecContext(ctx, "SELECT hypopg_reset()"); err != nil {
+			log.Warn("Failed to reset hypothetical indexes", zap.Error(err))
+		}
+	}()
+	plan, err := explainPG(ctx, conn, statement)
+	if err != nil {
+		return false, err
+	}
+	return plan.usesIndex(indexName), nil
+}
+
+// pgExplainPlan is a plan node in the JSON output of Postgres EXPLAIN.
+type pgExplainPlan struct {
+	NodeType     string           `json:"Node Type"`
+	RelationName string           `json:"Relation Name"`
+	Schema       string           `json:"Schema"`
+	IndexName    string           `json:"Index Name"`
+	Plans        []*pgExplainPlan `json:"Plans"`
+}
+
+// checkExplainStatement checks that the statement is a single SELECT or DML statement, so that no other statement
+// can be run along with the EXPLAIN on the admin connection.
+func checkExplainStatement(engine db.Type, statement string) error {
+	switch engine {
+	case db.Postgres:
+		result, err := pgquery.Parse(statement)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse statement")
+		}
+		if len(result.Stmts) != 1 {
+			return errors.Errorf("expect one statement, but got %d", len(result.Stmts))
+		}
+		switch result.Stmts[0].Stmt.Node.(type) {
+		case *pgquery.Node_SelectStmt, *pgquery.Node_InsertStmt, *pgquery.Node_UpdateStmt, *pgquery.Node_DeleteStmt:
+			return nil
+		}
+	case db.MySQL, db.MariaDB, db.TiDB:
+		nodes, _, err := tidbparser.New().Parse(statement, "", "")
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse statement")
+		}
+		if len(nodes) != 1 {
+			return errors.Errorf("expect one statement, but got %d", len(nodes))
+		}
+		switch nodes[0].(type) {
+		case *tidbast.SelectStmt, *tidbast.SetOprStmt, *tidbast.InsertStmt, *tidbast.UpdateStmt, *tidbast.DeleteStmt:
+			return nil
+		}
+	default:
+		return errors.Errorf("unsupported engine %s", engine)
+	}
+	return errors.Errorf("only SELECT and DML statements can be explained")
+}
+
+// explainPG explains the statement, and explains the generic plan if the statement has parameters.
+func explainPG(ctx context.Context, conn *sql.Conn, statement string) (*pgExplainPlan, error) {
+	if err := checkExplainStatement(db.Postgres, statement); err != nil {
+		return nil, err
+	}
+	var output string
+	err := conn.QueryRowContext(ctx, "EXPLAIN (FORMAT JSON, VERBOSE) "+statement).Scan(&output)
+	if err != nil {
+		// GENERIC_PLAN is supported since Postgres 16.
+		if genericErr := conn.QueryRowContext(ctx, "EXPLAIN (GENERIC_PLAN, FORMAT JSON, VERBOSE) "+statement).Scan(&output); genericErr != nil {
+			return nil, errors.Wrapf(err, "failed to explain statement")
+		}
+	}
+	var result []struct {
+		Plan *pgExplainPlan `json:"Plan"`
+	}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal explain output")
+	}
+	if len(result) != 1 || result[0].Plan == nil {
+		return nil, errors.Errorf("unexpected explain output %q", output)
+	}
+	return result[0].Plan, nil
+}
+
+func (p *pgExplainPlan) walk(f func(*pgExplainPlan)) {
+	f(p)
+	for _, plan := range p.Plans {
+		plan.walk(f)
+	}
+}
+
+func (p *pgExplainPlan) hasSeqScan(schemaName string, tableName string) bool {
+	found := false
+	p.walk(func(plan *pgExplainPlan) {
+		if plan.NodeType == "Seq Scan" && plan.Schema == schemaName && plan.RelationName == tableName {
+			found = true
+		}
+	})
+	return found
+}
+
+func (p *pgExplainPlan) usesIndex(indexName string) bool {
+	found := false
+	p.walk(func(plan *pgExplainPlan) {
+		if plan.IndexName == indexName {
+			found = true
+		}
+	})
+	return found
+}
+
+// mysqlExplainRow is a row of the MySQL EXPLAIN output.
+type mysqlExplainRow struct {
+	table string
+	// accessType is the join type, ALL is the full table scan and index is the full index scan.
+	accessType string
+	key        string
+	rows       int64
+}
+
+func explainMySQL(ctx context.Context, conn *sql.Conn, statement string) ([]*mysqlExplainRow, error) {
+	if err := checkExplainStatement(db.MySQL, statement); err != nil {
+		return nil, err
+	}
+	rows, err := conn.QueryContext(ctx, "EXPLAIN "+statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to explain statement")
+	}
+	defer rows.Close()
+	// The EXPLAIN columns vary among MySQL versions.
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	var result []*mysqlExplainRow
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		dest := make([]any, len(columns))
+		for i := range values {
+			dest[i] = &values[i]
+		}
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		row := &mysqlExplainRow{}
+		for i, column := range columns {
+			switch strings.ToLower(column) {
+			case "table":
+				row.table = values[i].String
+			case "type":
+				row.accessType = values[i].String
+			case "key":
+				row.key = values[i].String
+			case "rows":
+				if values[i].Valid {
+					if row.rows, err = strconv.ParseInt(values[i].String, 10, 64); err != nil {
+						return nil, errors.Wrapf(err, "failed to parse rows %q", values[i].String)
+					}
+				}
+			}
+		}
+		result = append(result, row)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// validateMySQLIndexAdvices drops the suggestions on the tables neither scanned fully nor examining many rows.
+// The suggestions are kept if the statement cannot be explained, such as the normalized statements with parameters.
+func validateMySQLIndexAdvices(ctx context.Context, conn *sql.Conn, statement string, advices []*indexAdvice) {
+	rows, err := explainMySQL(ctx, conn, statement)
+	if err != nil {
+		log.Debug("Failed to explain statement for index advisor", zap.String("statement", statement), zap.Error(err))
+		return
+	}
+	for _, advice := range advices {
+		if len(advice.columns) == 0 {
+			continue
+		}
+		for _, row := range rows {
+			if !strings.EqualFold(row.table, advice.pattern.alias) {
+				continue
+			}
+			if row.accessType != "ALL" && row.accessType != "index" && row.rows < indexAdvisorMinRowCount {
+				advice.columns = nil
+			}
+			break
+		}
+	}
+}
+
+// adviseMSSQLIndex advises the index with the missing index DMVs, which record the indexes the optimizer
+// would have used for the executed queries on the referenced tables.
+func (a *indexAdvisor) adviseMSSQLIndex(ctx context.Context, conn *sql.Conn, metadata *storepb.DatabaseSchemaMetadata, databaseName string, statement string) (*v1pb.AdviseIndexResponse, error) {
+	resources, err := parser.ExtractResourceList(parser.MSSQL, databaseName, a.defaultSchema, statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	rows, err := conn.QueryContext(ctx, `
+		SELECT
+			OBJECT_SCHEMA_NAME(d.object_id, d.database_id),
+			OBJECT_NAME(d.object_id, d.database_id),
+			ISNULL(d.equality_columns, ''),
+			ISNULL(d.inequality_columns, ''),
+			ISNULL(d.included_columns, '')
+		FROM sys.dm_db_missing_index_details d
+		JOIN sys.dm_db_missing_index_groups g ON d.index_handle = g.index_handle
+		JOIN sys.dm_db_missing_index_group_stats s ON g.index_group_handle = s.group_handle
+		WHERE d.database_id = DB_ID()
+		ORDER BY s.avg_total_user_cost * s.avg_user_impact * (s.user_seeks + s.user_scans) DESC`)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to query missing index DMVs")
+	}
+	defer rows.Close()
+	var advice *indexAdvice
+	for rows.Next() {
+		var schemaName, tableName, equalityColumns, inequalityColumns, includedColumns string
+		if err := rows.Scan(&schemaName, &tableName, &equalityColumns, &inequalityColumns, &includedColumns); err != nil {
+			return nil, err
+		}
+		if advice != nil {
+			continue
+		}
+		for _, resource := range resources {
+			if resource.LinkedServer != "" || !a.equalName(resource.Schema, schemaName) || !a.equalName(resource.Table, tableName) {
+				continue
+			}
+			advice = a.newMSSQLIndexAdvice(metadata, schemaName, tableName, equalityColumns, inequalityColumns, includedColumns)
+			break
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if advice == nil {
+		// The optimizer has not recorded any missing index for the tables.
+		return &v1pb.AdviseIndexResponse{CurrentIndex: noIndexSuggestion, Suggestion: noIndexSuggestion}, nil
+	}
+	return a.toResponse(advice), nil
+}
+
+func (a *indexAdvisor) newMSSQLIndexAdvice(metadata *storepb.DatabaseSchemaMetadata, schemaName, tableName, equalityColumns, inequalityColumns, includedColumns string) *indexAdvice {
+	pattern := &tableAccessPattern{
+		schemaName:      schemaName,
+		tableName:       tableName,
+		alias:           tableName,
+		table:           a.findTable(metadata, schemaName, tableName),
+		equalityColumns: splitMSSQLColumns(equalityColumns),
+		rangeColumns:    splitMSSQLColumns(inequalityColumns),
+	}
+	advice := &indexAdvice{
+		pattern:        pattern,
+		columns:        append(append([]string{}, pattern.equalityColumns...), pattern.rangeColumns...),
+		includeColumns: splitMSSQLColumns(includedColumns),
+	}
+	matched := 0
+	for _, index := range pattern.table.GetIndexes() {
+		if n := a.indexMatchLength(index, pattern); n > matched {
+			advice.currentIndex, matched = index, n
+		}
+	}
+	return advice
+}
+
+// splitMSSQLColumns splits the column list in the missing index DMVs, such as "[a], [b]".
+func splitMSSQLColumns(columns string) []string {
+	var result []string
+	for _, column := range strings.Split(columns, ",") {
+		column = strings.TrimSpace(column)
+		if column == "" {
+			continue
+		}
+		column = strings.TrimPrefix(column, "[")
+		column = strings.TrimSuffix(column, "]")
+		result = append(result, strings.ReplaceAll(column, "]]", "]"))
+	}
+	return result
+}
+
+// fullTableScan is a full scan of a table in the query plan.
+type fullTableScan struct {
+	table    string
+	rowCount int64
+}
+
+// findFullTableScans explains the statement and returns the full scans of the tables with at least fullScanWarningRowCount rows.
+func findFullTableScans(ctx context.Context, engine db.Type, conn *sql.Conn, metadata *storepb.DatabaseSchemaMetadata, statement string) ([]*fullTableScan, error) {
+	var result []*fullTableScan
+	switch engine {
+	case db.Postgres:
+		plan, err := explainPG(ctx, conn, statement)
+		if err != nil {
+			return nil, err
+		}
+		a := newIndexAdvisor(engine)
+		plan.walk(func(plan *pgExplainPlan) {
+			if plan.NodeType != "Seq Scan" {
+				return
+			}
+			table := a.findTable(metadata, plan.Schema, plan.RelationName)
+			if table == nil || table.RowCount < fullScanWarningRowCount {
+				return
+			}
+			result = append(result, &fullTableScan{table: fmt.Sprintf("%s.%s", plan.Schema, plan.RelationName), rowCount: table.RowCount})
+		})
+	case db.MySQL, db.MariaDB:
+		rows, err := explainMySQL(ctx, conn, statement)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			// For the full table scan, the rows is the estimated row count of the table.
+			if row.accessType == "ALL" && row.rows >= fullScanWarningRowCount {
+				result = append(result, &fullTableScan{table: row.table, rowCount: row.rows})
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,194 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func newIndexAdvisorTestMetadata(schemaName string, indexType string) *storepb.DatabaseSchemaMetadata {
+	return &storepb.DatabaseSchemaMetadata{
+		Name: "shop",
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name: schemaName,
+				Tables: []*storepb.TableMetadata{
+					{
+						Name: "orders",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id"},
+							{Name: "user_id"},
+							{Name: "status"},
+							{Name: "created_at"},
+							{Name: "amount"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "PRIMARY", Expressions: []string{"id"}, Type: indexType, Primary: true, Unique: true},
+							{Name: "idx_orders_user_id", Expressions: []string{"user_id"}, Type: indexType},
+						},
+						RowCount: 100000,
+					},
+					{
+						Name: "users",
+						Columns: []*storepb.ColumnMetadata{
+							{Name: "id"},
+							{Name: "name"},
+							{Name: "email"},
+						},
+						Indexes: []*storepb.IndexMetadata{
+							{Name: "PRIMARY", Expressions: []string{"id"}, Type: indexType, Primary: true, Unique: true},
+						},
+						RowCount: 500,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestIndexAdvisor(t *testing.T) {
+	testCases := []struct {
+		engine    db.Type
+		statement string
+		want      *v1pb.AdviseIndexResponse
+	}{
+		{
+			engine:    db.MySQL,
+			statement: "SELECT * FROM orders WHERE user_id = 1 AND status = 'paid' ORDER BY created_at",
+			want: &v1pb.AdviseIndexResponse{
+				CurrentIndex:         "USING BTREE (user_id)",
+				Suggestion:           "USING BTREE (user_id, status, created_at)",
+				CreateIndexStatement: "CREATE INDEX `idx_orders_user_id_status_created_at` ON `orders` (`user_id`, `status`, `created_at`);",
+			},
+		},
+		{
+			// The index on users is not suggested because the table is small.
+			engine:    db.MySQL,
+			statement: "SELECT * FROM orders o JOIN users u ON o.user_id = u.id WHERE u.name = 'a'",
+			want: &v1pb.AdviseIndexResponse{
+				CurrentIndex: "USING BTREE (user_id)",
+				Suggestion:   noIndexSuggestion,
+			},
+		},
+		{
+			engine:    db.MySQL,
+			statement: "SELECT * FROM orders WHERE amount > 10 OR status = 'canceled'",
+			want: &v1pb.AdviseIndexResponse{
+				CurrentIndex: noUsableIndex,
+				Suggestion:   noIndexSuggestion,
+			},
+		},
+		{
+			engine:    db.MySQL,
+			statement: "SELECT * FROM orders WHERE created_at BETWEEN ? AND ? AND status IN (?, ?)",
+			want: &v1pb.AdviseIndexResponse{
+				CurrentIndex:         noUsableIndex,
+				Suggestion:           "USING BTREE (status, created_at)",
+				CreateIndexStatement: "CREATE INDEX `idx_orders_status_created_at` ON `orders` (`status`, `created_at`);",
+			},
+		},
+		{
+			engine:    db.MySQL,
+			statement: "UPDATE orders SET amount = 0 WHERE ID = 1",
+			want: &v1pb.AdviseIndexResponse{
+				CurrentIndex: "USING BTREE (id)",
+				Suggestion:   noIndexSuggestion,
+			},
+		},
+		{
+			engine:    db.Postgres,
+			statement: "SELECT * FROM orders WHERE user_id IN (SELECT id FROM users WHERE email LIKE 'a%') AND created_at > $1",
+			want: &v1pb.AdviseIndexResponse{
+				CurrentIndex:         "USING btree (user_id)",
+				Suggestion:           "USING btree (user_id, created_at)",
+				CreateIndexStatement: `CREATE INDEX "idx_orders_user_id_created_at" ON "public"."orders" ("user_id", "created_at");`,
+			},
+		},
+		{
+			// The ORDER BY cannot be served by the index because the columns are from different tables.
+			engine:    db.Postgres,
+			statement: "SELECT * FROM public.orders o, users WHERE o.status = 'paid' AND o.user_id = users.id ORDER BY o.created_at, users.name",
+			want: &v1pb.AdviseIndexResponse{
+				CurrentIndex:         "USING btree (user_id)",
+				Suggestion:           "USING btree (status, user_id)",
+				CreateIndexStatement: `CREATE INDEX "idx_orders_status_user_id" ON "public"."orders" ("status", "user_id");`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		a := newIndexAdvisor(tc.engine)
+		metadata := newIndexAdvisorTestMetadata(a.defaultSchema, a.indexType)
+		blocks, err := extractQueryBlocks(tc.engine, tc.statement)
+		require.NoError(t, err, tc.statement)
+		var advices []*indexAdvice
+		for _, pattern := range a.buildTableAccessPatterns(blocks, metadata) {
+			advices = append(advices, a.adviseTable(pattern))
+		}
+		got := a.toResponse(pickIndexAdvice(advices))
+		require.Equal(t, tc.want.CurrentIndex, got.CurrentIndex, tc.statement)
+		require.Equal(t, tc.want.Suggestion, got.Suggestion, tc.statement)
+		require.Equal(t, tc.want.CreateIndexStatement, got.CreateIndexStatement, tc.statement)
+	}
+}
+
+func TestExtractQueryBlocksIgnoresOtherStatements(t *testing.T) {
+	blocks, err := extractQueryBlocks(db.MySQL, "CREATE TABLE t (id INT)")
+	require.NoError(t, err)
+	require.Nil(t, blocks)
+
+	blocks, err = extractQueryBlocks(db.Postgres, "EXPLAIN SELECT * FROM t")
+	require.NoError(t, err)
+	require.Nil(t, blocks)
+
+	_, err = extractQueryBlocks(db.Postgres, "SELECT 1; SELECT 2")
+	require.Error(t, err)
+}
+
+func TestCheckExplainStatement(t *testing.T) {
+	testCases := []struct {
+		engine    db.Type
+		statement string
+		wantErr   bool
+	}{
+		{engine: db.Postgres, statement: "SELECT * FROM t WHERE id = $1"},
+		{engine: db.Postgres, statement: "UPDATE t SET a = 1 WHERE id = 1;"},
+		{engine: db.Postgres, statement: "INSERT INTO t SELECT * FROM s"},
+		{engine: db.Postgres, statement: "DELETE FROM t WHERE id = 1"},
+		{engine: db.Postgres, statement: "SELECT 1; DROP TABLE t", wantErr: true},
+		{engine: db.Postgres, statement: "DROP TABLE t", wantErr: true},
+		{engine: db.Postgres, statement: "ANALYZE t", wantErr: true},
+		{engine: db.Postgres, statement: "SELECT * FROM", wantErr: true},
+		{engine: db.MySQL, statement: "SELECT * FROM t UNION SELECT * FROM s"},
+		{engine: db.MySQL, statement: "UPDATE t SET a = 1 WHERE id = 1"},
+		{engine: db.MySQL, statement: "SELECT 1; DROP TABLE t", wantErr: true},
+		{engine: db.MySQL, statement: "SET GLOBAL read_only = 1", wantErr: true},
+		{engine: db.MSSQL, statement: "SELECT 1", wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		err := checkExplainStatement(tc.engine, tc.statement)
+		if tc.wantErr {
+			require.Error(t, err, tc.statement)
+			continue
+		}
+		require.NoError(t, err, tc.statement)
+	}
+}
+
+func TestNewMSSQLIndexAdvice(t *testing.T) {
+	a := newIndexAdvisor(db.MSSQL)
+	metadata := newIndexAdvisorTestMetadata(a.defaultSchema, "NONCLUSTERED")
+	advice := a.newMSSQLIndexAdvice(metadata, "dbo", "orders", "[user_id], [status]", "[created_at]", "[amount]")
+	got := a.toResponse(advice)
+	require.Equal(t, "USING NONCLUSTERED (user_id)", got.CurrentIndex)
+	require.Equal(t, "USING NONCLUSTERED (user_id, status, created_at) INCLUDE (amount)", got.Suggestion)
+	require.Equal(t, "CREATE NONCLUSTERED INDEX [idx_orders_user_id_status_created_at] ON [dbo].[orders] ([user_id], [status], [created_at]) INCLUDE ([amount]);", got.CreateIndexStatement)
+
+	require.Equal(t, []string{"a", "b]c"}, splitMSSQLColumns("[a], [b]]c]"))
+	require.Nil(t, splitMSSQLColumns(""))
+}
@@ -292,6 +292,8 @@ func convertToInstanceRole(role *db.DatabaseRoleMessage, instance *store.Instanc
 		ConnectionLimit: &role.ConnectionLimit,
 		ValidUntil:      role.ValidUntil,
 		Attribute:       role.Attribute,
+		MemberOf:        role.MemberOf,
+		Database:        role.Database,
 	}
 }
 
@@ -300,7 +302,7 @@ func validateRole(dbType db.Type, upsert *db.DatabaseRoleUpsertMessage) error {
 		return status.Errorf(codes.InvalidArgument, "Invalid role name, role name cannot be empty")
 	}
 	switch dbType {
-	case db.Postgres, db.RisingWave:
+	case db.Postgres, db.RisingWave, db.Redshift:
 		if v := upsert.ConnectionLimit; v != nil && *v < int32(-1) {
 			return status.Errorf(codes.InvalidArgument, "Invalid connection limit, it should greater than or equal to -1")
 		}
@@ -318,6 +320,13 @@ func validateRole(dbType db.Type, upsert *db.DatabaseRoleUpsertMessage) error {
 				return status.Error(codes.InvalidArgument, "Invalid number for valid_until, mysql valid_until should be an integer.")
 			}
 		}
+	case db.MSSQL, db.MongoDB, db.Spanner:
+		if upsert.ConnectionLimit != nil {
+			return status.Errorf(codes.InvalidArgument, "connection_limit is not supported for %s", dbType)
+		}
+		if upsert.ValidUntil != nil {
+			return status.Errorf(codes.InvalidArgument, "valid_until is not supported for %s", dbType)
+		}
 	}
 
 	return nil
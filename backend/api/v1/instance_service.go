@@ -10,6 +10,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -199,12 +200,17 @@ func (s *InstanceService) UpdateInstance(ctx context.Context, request *v1pb.Upda
 			patch.Activation = &request.Instance.Activation
 		case "options.schema_tenant_mode":
 			if patch.Options == nil {
-				patch.Options = &storepb.InstanceOptions{
-					SchemaTenantMode: request.Instance.Options.SchemaTenantMode,
-				}
-			} else {
-				patch.Options.SchemaTenantMode = request.Instance.Options.SchemaTenantMode
+				patch.Options = cloneInstanceOptions(instance.Options)
+			}
+			patch.Options.SchemaTenantMode = request.Instance.Options.SchemaTenantMode
+		case "options.pitr_enabled":
+			if instance.Engine != db.Postgres {
+				return nil, status.Errorf(codes.InvalidArgument, "point-in-time recovery is not supported for engine %s", instance.Engine)
+			}
+			if patch.Options == nil {
+				patch.Options = cloneInstanceOptions(instance.Options)
 			}
+			patch.Options.PitrEnabled = request.Instance.Options.PitrEnabled
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, `unsupport update_mask "%s"`, path)
 		}
@@ -262,7 +268,7 @@ func (s *InstanceService) syncSlowQueriesForInstance(ctx context.Context, instan
 
 func (s *InstanceService) syncSlowQueriesImpl(ctx context.Context, project *store.ProjectMessage, instance *store.InstanceMessage) error {
 	switch instance.Engine {
-	case db.MySQL:
+	case db.MySQL, db.MSSQL, db.Oracle, db.Snowflake:
 		driver, err := s.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
 		if err != nil {
 			return err
@@ -356,7 +362,7 @@ func (s *InstanceService) syncSlowQueriesForProject(ctx context.Context, project
 		}
 
 		switch instance.Engine {
-		case db.MySQL, db.Postgres:
+		case db.MySQL, db.Postgres, db.MSSQL, db.Oracle, db.Snowflake:
 			if instance.Deleted {
 				continue
 			}
@@ -836,6 +842,7 @@ func convertToInstance(instance *store.InstanceMessage) *v1pb.Instance {
 	if instance.Options != nil {
 		options = &v1pb.InstanceOptions{
 			SchemaTenantMode: instance.Options.SchemaTenantMode,
+			PitrEnabled:      instance.Options.PitrEnabled,
 		}
 	}
 
@@ -854,6 +861,14 @@ func convertToInstance(instance *store.InstanceMessage) *v1pb.Instance {
 	}
 }
 
+// cloneInstanceOptions returns a copy of the options to patch, so that the options not in the update mask are kept.
+func cloneInstanceOptions(options *storepb.InstanceOptions) *storepb.InstanceOptions {
+	if options == nil {
+		return &storepb.InstanceOptions{}
+	}
+	return proto.Clone(options).(*storepb.InstanceOptions)
+}
+
 func (s *InstanceService) convertToInstanceMessage(instanceID string, instance *v1pb.Instance) (*store.InstanceMessage, error) {
 	datasources, err := s.convertToDataSourceMessages(instance.DataSources)
 	if err != nil {
@@ -867,6 +882,7 @@ func (s *InstanceService) convertToInstanceMessage(instanceID string, instance *
 	if instance.Options != nil {
 		options = &storepb.InstanceOptions{
 			SchemaTenantMode: instance.Options.SchemaTenantMode,
+			PitrEnabled:      instance.Options.PitrEnabled && convertEngine(instance.Engine) == db.Postgres,
 		}
 	}
 
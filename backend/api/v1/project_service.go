@@ -17,6 +17,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gopkg.in/yaml.v3"
 
 	"github.com/bytebase/bytebase/backend/common"
@@ -27,11 +28,13 @@ import (
 	vcsPlugin "github.com/bytebase/bytebase/backend/plugin/vcs"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
 	webhookPlugin "github.com/bytebase/bytebase/backend/plugin/webhook"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
 
@@ -831,6 +834,8 @@ func (s *ProjectService) UpdateWebhook(ctx context.Context, request *v1pb.Update
 				return nil, status.Errorf(codes.InvalidArgument, "notification types should not be empty")
 			}
 			update.ActivityList = types
+		case "secret":
+			update.Secret = &request.Webhook.Secret
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid field %q", path)
 		}
@@ -934,6 +939,7 @@ func (s *ProjectService) TestWebhook(ctx context.Context, request *v1pb.TestWebh
 		webhook.Type,
 		webhookPlugin.Context{
 			URL:          webhook.URL,
+			Secret:       webhook.Secret,
 			Level:        webhookPlugin.WebhookInfo,
 			ActivityType: string(api.ActivityIssueCreate),
 			Title:        fmt.Sprintf("Test webhook %q", webhook.Title),
@@ -953,6 +959,130 @@ func (s *ProjectService) TestWebhook(ctx context.Context, request *v1pb.TestWebh
 	return resp, nil
 }
 
+// ListWebhookDeliveries lists the deliveries of a webhook.
+func (s *ProjectService) ListWebhookDeliveries(ctx context.Context, request *v1pb.ListWebhookDeliveriesRequest) (*v1pb.ListWebhookDeliveriesResponse, error) {
+	projectID, webhookID, err := common.GetProjectIDWebhookID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	webhook, err := s.getProjectWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, offset := int(request.PageSize), 0
+	if request.PageToken != "" {
+		var pageToken storepb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.Limit < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "page size cannot be negative")
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	limitPlusOne := limit + 1
+
+	deliveries, err := s.store.ListWebhookDeliveries(ctx, &store.FindWebhookDeliveryMessage{
+		WebhookUID: &webhook.ID,
+		Limit:      &limitPlusOne,
+		Offset:     &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list webhook deliveries, error: %v", err)
+	}
+
+	resp := &v1pb.ListWebhookDeliveriesResponse{}
+	// has more pages
+	if len(deliveries) == limitPlusOne {
+		nextPageToken, err := getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+		resp.NextPageToken = nextPageToken
+		deliveries = deliveries[:limit]
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, convertToWebhookDelivery(projectID, delivery))
+	}
+	return resp, nil
+}
+
+// ReplayWebhookDelivery delivers the event of a delivery again as a new delivery.
+func (s *ProjectService) ReplayWebhookDelivery(ctx context.Context, request *v1pb.ReplayWebhookDeliveryRequest) (*v1pb.WebhookDelivery, error) {
+	projectID, webhookID, deliveryID, err := common.GetProjectIDWebhookIDDeliveryID(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	deliveryUID, err := strconv.Atoi(deliveryID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid delivery id %q", deliveryID)
+	}
+	webhook, err := s.getProjectWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, err := s.store.GetWebhookDelivery(ctx, &store.FindWebhookDeliveryMessage{
+		UID:        &deliveryUID,
+		WebhookUID: &webhook.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook delivery, error: %v", err)
+	}
+	if delivery == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook delivery %q not found", request.Name)
+	}
+
+	// Keep the original delivery as the history, the new delivery is posted by the webhook delivery runner.
+	replays, err := s.store.CreateWebhookDeliveries(ctx, &store.WebhookDeliveryMessage{
+		WebhookUID:   delivery.WebhookUID,
+		ActivityType: delivery.ActivityType,
+		Payload:      delivery.Payload,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to replay webhook delivery, error: %v", err)
+	}
+	return convertToWebhookDelivery(projectID, replays[0]), nil
+}
+
+func (s *ProjectService) getProjectWebhook(ctx context.Context, projectID, webhookID string) (*store.ProjectWebhookMessage, error) {
+	webhookIDInt, err := strconv.Atoi(webhookID)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook id %q", webhookID)
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{
+		ResourceID: &projectID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if project == nil {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	if project.Deleted {
+		return nil, status.Errorf(codes.NotFound, "project %q has been deleted", projectID)
+	}
+	webhook, err := s.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{
+		ProjectID: &project.UID,
+		ID:        &webhookIDInt,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	if webhook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook %q not found", webhookID)
+	}
+	return webhook, nil
+}
+
 func (s *ProjectService) findProjectRepository(ctx context.Context, projectName string) (*store.RepositoryMessage, error) {
 	project, err := s.getProjectMessage(ctx, projectName)
 	if err != nil {
@@ -1168,7 +1298,11 @@ func (s *ProjectService) setupVCSSQLReviewCI(ctx context.Context, repository *st
 
 	switch vcs.Type {
 	case vcsPlugin.GitHub:
-		if err := s.setupVCSSQLReviewCIForGitHub(ctx, repository, vcs, branch, sqlReviewEndpoint); err != nil {
+		if err := s.setupVCSSQLReviewCIForGitHub(ctx, repository, vcs, branch, github.SQLReviewActionFilePath, github.SetupSQLReviewCI(sqlReviewEndpoint)); err != nil {
+			return nil, err
+		}
+	case vcsPlugin.Gitea:
+		if err := s.setupVCSSQLReviewCIForGitHub(ctx, repository, vcs, branch, gitea.SQLReviewActionFilePath, gitea.SetupSQLReviewCI(sqlReviewEndpoint)); err != nil {
 			return nil, err
 		}
 	case vcsPlugin.GitLab:
@@ -1255,15 +1389,16 @@ func (s *ProjectService) setupVCSSQLReviewBranch(ctx context.Context, repository
 	return branchCreate, nil
 }
 
-// setupVCSSQLReviewCIForGitHub will create the pull request in GitHub to setup SQL review action.
+// setupVCSSQLReviewCIForGitHub will create or update the SQL review action file in GitHub to setup SQL review action.
+// It also works for Gitea as Gitea Actions is compatible with GitHub Actions.
 func (s *ProjectService) setupVCSSQLReviewCIForGitHub(
 	ctx context.Context,
 	repository *store.RepositoryMessage,
 	vcs *store.ExternalVersionControlMessage,
 	branch *vcsPlugin.BranchInfo,
-	sqlReviewEndpoint string,
+	sqlReviewActionFilePath string,
+	sqlReviewConfig string,
 ) error {
-	sqlReviewConfig := github.SetupSQLReviewCI(sqlReviewEndpoint)
 	fileLastCommitID := ""
 	fileSHA := ""
 
@@ -1287,7 +1422,7 @@ func (s *ProjectService) setupVCSSQLReviewCIForGitHub(
 		},
 		vcs.InstanceURL,
 		repository.ExternalID,
-		github.SQLReviewActionFilePath,
+		sqlReviewActionFilePath,
 		vcsPlugin.RefInfo{
 			RefType: vcsPlugin.RefTypeBranch,
 			RefName: branch.Name,
@@ -1296,7 +1431,7 @@ func (s *ProjectService) setupVCSSQLReviewCIForGitHub(
 	if err != nil {
 		log.Debug(
 			"Failed to get file meta",
-			zap.String("file", github.SQLReviewActionFilePath),
+			zap.String("file", sqlReviewActionFilePath),
 			zap.String("last_commit", branch.LastCommitID),
 			zap.Int("code", common.ErrorCode(err).Int()),
 			zap.Error(err),
@@ -1318,7 +1453,7 @@ func (s *ProjectService) setupVCSSQLReviewCIForGitHub(
 		},
 		vcs.InstanceURL,
 		repository.ExternalID,
-		github.SQLReviewActionFilePath,
+		sqlReviewActionFilePath,
 		vcsPlugin.FileCommitCreate{
 			Branch:        branch.Name,
 			CommitMessage: sqlReviewInVCSPRTitle,
@@ -2212,9 +2347,35 @@ func convertToStoreProjectWebhookMessage(webhook *v1pb.Webhook) (*store.ProjectW
 		URL:          webhook.Url,
 		Title:        webhook.Title,
 		ActivityList: activityTypes,
+		Secret:       webhook.Secret,
 	}, nil
 }
 
+func convertToWebhookDelivery(projectID string, delivery *store.WebhookDeliveryMessage) *v1pb.WebhookDelivery {
+	v1Delivery := &v1pb.WebhookDelivery{
+		Name:       fmt.Sprintf("%s%s/%s%d/%s%d", common.ProjectNamePrefix, projectID, common.WebhookIDPrefix, delivery.WebhookUID, common.WebhookDeliveryPrefix, delivery.UID),
+		Status:     v1pb.WebhookDelivery_STATUS_UNSPECIFIED,
+		Attempt:    int32(delivery.Attempt),
+		Error:      delivery.LastError,
+		Payload:    delivery.Payload,
+		CreateTime: timestamppb.New(time.Unix(delivery.CreatedTs, 0)),
+		UpdateTime: timestamppb.New(time.Unix(delivery.UpdatedTs, 0)),
+	}
+	if activityTypes := convertNotificationTypeStrings([]string{delivery.ActivityType}); len(activityTypes) == 1 {
+		v1Delivery.ActivityType = activityTypes[0]
+	}
+	switch delivery.Status {
+	case store.WebhookDeliveryPending:
+		v1Delivery.Status = v1pb.WebhookDelivery_PENDING
+		v1Delivery.NextAttemptTime = timestamppb.New(time.Unix(delivery.NextAttemptTs, 0))
+	case store.WebhookDeliveryDone:
+		v1Delivery.Status = v1pb.WebhookDelivery_DONE
+	case store.WebhookDeliveryFailed:
+		v1Delivery.Status = v1pb.WebhookDelivery_FAILED
+	}
+	return v1Delivery
+}
+
 func convertToActivityTypeStrings(types []v1pb.Activity_Type) ([]string, error) {
 	var result []string
 	for _, tp := range types {
@@ -2931,6 +3092,21 @@ func createVCSWebhook(ctx context.Context, vcsType vcsPlugin.Type, webhookEndpoi
 		if err != nil {
 			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
 		}
+	case vcsPlugin.Gitea:
+		webhookPost := gitea.WebhookCreateOrUpdate{
+			Type: "gitea",
+			Config: gitea.WebhookConfig{
+				URL:         fmt.Sprintf("%s/hook/gitea/%s", gitopsWebhookURL, webhookEndpointID),
+				ContentType: "json",
+				Secret:      secretToken,
+			},
+			Events: []string{"push"},
+			Active: true,
+		}
+		webhookCreatePayload, err = json.Marshal(webhookPost)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to marshal request body for creating webhook")
+		}
 	case vcsPlugin.Bitbucket:
 		webhookPost := bitbucket.WebhookCreateOrUpdate{
 			Description: "Bytebase GitOps",
@@ -19,11 +19,13 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
+	"github.com/bytebase/bytebase/backend/runner/planschedule"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -39,10 +41,11 @@ type RolloutService struct {
 	planCheckScheduler *plancheck.Scheduler
 	stateCfg           *state.State
 	activityManager    *activity.Manager
+	profile            *config.Profile
 }
 
 // NewRolloutService returns a rollout service instance.
-func NewRolloutService(store *store.Store, licenseService enterpriseAPI.LicenseService, dbFactory *dbfactory.DBFactory, planCheckScheduler *plancheck.Scheduler, stateCfg *state.State, activityManager *activity.Manager) *RolloutService {
+func NewRolloutService(store *store.Store, licenseService enterpriseAPI.LicenseService, dbFactory *dbfactory.DBFactory, planCheckScheduler *plancheck.Scheduler, stateCfg *state.State, activityManager *activity.Manager, profile *config.Profile) *RolloutService {
 	return &RolloutService{
 		store:              store,
 		licenseService:     licenseService,
@@ -50,6 +53,7 @@ func NewRolloutService(store *store.Store, licenseService enterpriseAPI.LicenseS
 		planCheckScheduler: planCheckScheduler,
 		stateCfg:           stateCfg,
 		activityManager:    activityManager,
+		profile:            profile,
 	}
 }
 
@@ -147,6 +151,9 @@ func (s *RolloutService) CreatePlan(ctx context.Context, request *v1pb.CreatePla
 	if err := validateSteps(request.Plan.Steps); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "failed to validate plan steps, error: %v", err)
 	}
+	if err := s.validatePlanSchedule(request.Plan.Schedule, request.Plan.Steps); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to validate plan schedule, error: %v", err)
+	}
 
 	planMessage := &store.PlanMessage{
 		ProjectID:   projectID,
@@ -154,7 +161,8 @@ func (s *RolloutService) CreatePlan(ctx context.Context, request *v1pb.CreatePla
 		Name:        request.Plan.Title,
 		Description: request.Plan.Description,
 		Config: &storepb.PlanConfig{
-			Steps: convertPlanSteps(request.Plan.Steps),
+			Steps:    convertPlanSteps(request.Plan.Steps),
+			Schedule: convertPlanSchedule(request.Plan.Schedule),
 		},
 	}
 
@@ -163,7 +171,7 @@ func (s *RolloutService) CreatePlan(ctx context.Context, request *v1pb.CreatePla
 		return nil, status.Errorf(codes.Internal, "failed to create plan, error: %v", err)
 	}
 
-	planCheckRuns, err := getPlanCheckRunsFromPlan(ctx, s.store, plan)
+	planCheckRuns, err := GetPlanCheckRunsFromPlan(ctx, s.store, plan)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get plan check runs for plan, error: %v", err)
 	}
@@ -348,7 +356,7 @@ func (s *RolloutService) RunPlanChecks(ctx context.Context, request *v1pb.RunPla
 		return nil, status.Errorf(codes.NotFound, "plan not found")
 	}
 
-	planCheckRuns, err := getPlanCheckRunsFromPlan(ctx, s.store, plan)
+	planCheckRuns, err := GetPlanCheckRunsFromPlan(ctx, s.store, plan)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get plan check runs for plan, error: %v", err)
 	}
@@ -426,6 +434,14 @@ func (s *RolloutService) BatchRunTasks(ctx context.Context, request *v1pb.BatchR
 		return nil, status.Errorf(codes.NotFound, "issue not found for rollout %v", rolloutID)
 	}
 
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{PipelineID: &rolloutID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find plan, error: %v", err)
+	}
+	if plan != nil && plan.Config.Schedule != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "the tasks of a scheduled plan are run by its schedule")
+	}
+
 	stages, err := s.store.ListStageV2(ctx, rolloutID)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list stages, error: %v", err)
@@ -727,9 +743,13 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 	if request.UpdateMask == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "update_mask must be set")
 	}
+	var updateSteps, updateSchedule bool
 	for _, path := range request.UpdateMask.Paths {
 		switch path {
 		case "steps":
+			updateSteps = true
+		case "schedule":
+			updateSchedule = true
 		default:
 			return nil, status.Errorf(codes.InvalidArgument, "invalid update_mask path %q", path)
 		}
@@ -752,17 +772,38 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 		return nil, status.Errorf(codes.Internal, "failed to get issue: %v", err)
 	}
 
-	removed, added, updated := diffSpecs(oldSteps, request.Plan.Steps)
+	newSteps := oldSteps
+	if updateSteps {
+		if err := validateSteps(request.Plan.Steps); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to validate plan steps, error: %v", err)
+		}
+		newSteps = request.Plan.Steps
+	}
+	removed, added, updated := diffSpecs(oldSteps, newSteps)
 	if len(removed) > 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot remove specs from plan")
 	}
 	if len(added) > 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "cannot add specs to plan")
 	}
-	if len(updated) == 0 {
+	if updateSteps && len(updated) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "no specs updated")
 	}
 
+	schedule := oldPlan.Config.Schedule
+	var doUpdateSchedule bool
+	if updateSchedule {
+		if err := s.validatePlanSchedule(request.Plan.Schedule, newSteps); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "failed to validate plan schedule, error: %v", err)
+		}
+		schedule = convertPlanSchedule(request.Plan.Schedule)
+		if schedule != nil && oldPlan.Config.Schedule != nil {
+			schedule.Paused = oldPlan.Config.Schedule.Paused
+		}
+		// Changing the schedule requires the approval again.
+		doUpdateSchedule = true
+	}
+
 	updatedByID := make(map[string]*v1pb.Plan_Spec)
 	for _, spec := range updated {
 		updatedByID[spec.Id] = spec
@@ -821,10 +862,48 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 				return nil, err
 			}
 
+			// BatchConfig
+			if err := func() error {
+				if task.Type != api.TaskDatabaseDataUpdate {
+					return nil
+				}
+				payload := &api.TaskDatabaseDataUpdatePayload{}
+				if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+					return status.Errorf(codes.Internal, "failed to unmarshal task payload: %v", err)
+				}
+				config, ok := spec.Config.(*v1pb.Plan_Spec_ChangeDatabaseConfig)
+				if !ok {
+					return nil
+				}
+				instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+				if err != nil {
+					return status.Errorf(codes.Internal, "failed to get instance: %v", err)
+				}
+				batchConfig, err := convertToTaskBatchConfig(instance, convertPlanSpecChangeDatabaseConfigBatchConfig(config.ChangeDatabaseConfig.BatchConfig))
+				if err != nil {
+					return status.Errorf(codes.InvalidArgument, "invalid batch config, error: %v", err)
+				}
+				if batchConfig == nil {
+					// The zero value removes the batch config.
+					batchConfig = &api.DataUpdateBatchConfig{}
+				}
+				oldBatchConfig := api.DataUpdateBatchConfig{}
+				if payload.BatchConfig != nil {
+					oldBatchConfig = *payload.BatchConfig
+				}
+				if *batchConfig != oldBatchConfig {
+					taskPatch.BatchConfig = batchConfig
+					doUpdate = true
+				}
+				return nil
+			}(); err != nil {
+				return nil, err
+			}
+
 			// Sheet
 			if err := func() error {
 				switch task.Type {
-				case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync, api.TaskDatabaseDataUpdate:
+				case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync, api.TaskDatabaseSchemaUpdatePGOnlineSync, api.TaskDatabaseDataUpdate:
 					var taskPayload struct {
 						SpecID  string `json:"specId"`
 						SheetID int    `json:"sheetId"`
@@ -902,7 +981,8 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 		UID:       oldPlan.UID,
 		UpdaterID: updaterID,
 		Config: &storepb.PlanConfig{
-			Steps: convertPlanSteps(request.Plan.Steps),
+			Steps:    convertPlanSteps(newSteps),
+			Schedule: schedule,
 		},
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update plan %q: %v", request.Plan.Name, err)
@@ -917,7 +997,7 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 	}
 
 	if doUpdateSheet {
-		planCheckRuns, err := getPlanCheckRunsFromPlan(ctx, s.store, updatedPlan)
+		planCheckRuns, err := GetPlanCheckRunsFromPlan(ctx, s.store, updatedPlan)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get plan check runs for plan, error: %v", err)
 		}
@@ -926,7 +1006,7 @@ func (s *RolloutService) UpdatePlan(ctx context.Context, request *v1pb.UpdatePla
 		}
 	}
 
-	if issue != nil && doUpdateSheet {
+	if issue != nil && (doUpdateSheet || doUpdateSchedule) {
 		if err := func() error {
 			payload := &storepb.IssuePayload{}
 			if err := protojson.Unmarshal([]byte(issue.Payload), payload); err != nil {
@@ -998,9 +1078,51 @@ func diffSpecs(oldSteps []*v1pb.Plan_Step, newSteps []*v1pb.Plan_Step) ([]*v1pb.
 	return removed, added, updated
 }
 
-func validateSteps(_ []*v1pb.Plan_Step) error {
+// validatePlanSchedule validates the schedule of the plan, which only runs the data changes repeatedly.
+func (s *RolloutService) validatePlanSchedule(schedule *v1pb.Plan_Schedule, steps []*v1pb.Plan_Step) error {
+	if schedule == nil {
+		return nil
+	}
+	if !s.profile.DevelopmentUseV2Scheduler {
+		return errors.Errorf("scheduled plans require the v2 task scheduler")
+	}
+	if err := planschedule.ValidateSchedule(convertPlanSchedule(schedule)); err != nil {
+		return err
+	}
+	for _, step := range steps {
+		for _, spec := range step.Specs {
+			config := spec.GetChangeDatabaseConfig()
+			if config == nil || config.Type != v1pb.Plan_ChangeDatabaseConfig_DATA {
+				return errors.Errorf("only the data change specs can be scheduled, but spec %q is not", spec.Id)
+			}
+		}
+	}
+	return nil
+}
+
+func validateSteps(steps []*v1pb.Plan_Step) error {
 	// FIXME: impl this func
 	// targets should be unique
+	for _, step := range steps {
+		for _, spec := range step.Specs {
+			config := spec.GetChangeDatabaseConfig()
+			if config.GetBatchConfig() == nil {
+				continue
+			}
+			if config.Type != v1pb.Plan_ChangeDatabaseConfig_DATA {
+				return errors.Errorf("only the data change specs can run in batches, but spec %q is not", spec.Id)
+			}
+			if config.RollbackEnabled {
+				return errors.Errorf("spec %q cannot enable both the rollback and the batched execution", spec.Id)
+			}
+			if config.BatchConfig.ChunkSize <= 0 {
+				return errors.Errorf("the chunk size of spec %q must be positive", spec.Id)
+			}
+			if config.BatchConfig.MaxThreadsRunning < 0 || config.BatchConfig.Pause.AsDuration() < 0 || config.BatchConfig.MaxReplicaLag.AsDuration() < 0 {
+				return errors.Errorf("the batch config of spec %q cannot be negative", spec.Id)
+			}
+		}
+	}
 	return nil
 }
 
@@ -13,6 +13,7 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/runner/planschedule"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -34,6 +35,93 @@ func convertToPlan(plan *store.PlanMessage) *v1pb.Plan {
 		Title:       plan.Name,
 		Description: plan.Description,
 		Steps:       convertToPlanSteps(plan.Config.Steps),
+		Schedule:    convertToPlanSchedule(plan.Config.Schedule),
+	}
+}
+
+func convertToPlanSchedule(schedule *storepb.PlanConfig_Schedule) *v1pb.Plan_Schedule {
+	if schedule == nil {
+		return nil
+	}
+	v1Schedule := &v1pb.Plan_Schedule{
+		Cron:     schedule.Cron,
+		TimeZone: schedule.TimeZone,
+		Paused:   schedule.Paused,
+	}
+	if !schedule.Paused {
+		if next, err := planschedule.GetNextScheduleTime(schedule, time.Now()); err == nil {
+			v1Schedule.NextRunTime = timestamppb.New(next)
+		}
+	}
+	return v1Schedule
+}
+
+func convertPlanSchedule(schedule *v1pb.Plan_Schedule) *storepb.PlanConfig_Schedule {
+	if schedule == nil {
+		return nil
+	}
+	return &storepb.PlanConfig_Schedule{
+		Cron:      schedule.Cron,
+		TimeZone:  schedule.TimeZone,
+		StartTime: timestamppb.Now(),
+	}
+}
+
+func convertToPlanScheduleOccurrence(plan *store.PlanMessage, occurrence *store.PlanScheduleOccurrenceMessage, taskRuns map[int]*store.TaskRunMessage, tasks map[int]*store.TaskMessage) *v1pb.PlanScheduleOccurrence {
+	planName := fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, plan.ProjectID, common.PlanPrefix, plan.UID)
+	getTaskName := func(task *store.TaskMessage) string {
+		return fmt.Sprintf("%s%s/%s%d/%s%d/%s%d", common.ProjectNamePrefix, plan.ProjectID, common.RolloutPrefix, task.PipelineID, common.StagePrefix, task.StageID, common.TaskPrefix, task.ID)
+	}
+	v1Occurrence := &v1pb.PlanScheduleOccurrence{
+		Name:         fmt.Sprintf("%s/%s%d", planName, common.PlanScheduleOccurrencePrefix, occurrence.UID),
+		ScheduleTime: timestamppb.New(time.Unix(occurrence.ScheduleTs, 0)),
+		Status:       convertToPlanScheduleOccurrenceStatus(occurrence.Status),
+		Detail:       occurrence.Payload.Detail,
+		CreateTime:   timestamppb.New(time.Unix(occurrence.CreatedTs, 0)),
+		UpdateTime:   timestamppb.New(time.Unix(occurrence.UpdatedTs, 0)),
+	}
+	for _, uid := range occurrence.Payload.TaskRunUids {
+		taskRun, ok := taskRuns[int(uid)]
+		if !ok {
+			continue
+		}
+		task, ok := tasks[taskRun.TaskUID]
+		if !ok {
+			continue
+		}
+		v1Occurrence.TaskRuns = append(v1Occurrence.TaskRuns, fmt.Sprintf("%s/%s%d", getTaskName(task), common.TaskRunPrefix, taskRun.ID))
+	}
+	for _, rollback := range occurrence.Payload.Rollbacks {
+		task, ok := tasks[int(rollback.TaskUid)]
+		if !ok {
+			continue
+		}
+		v1Rollback := &v1pb.PlanScheduleOccurrence_Rollback{
+			Task:  getTaskName(task),
+			Error: rollback.Error,
+		}
+		if rollback.SheetUid != 0 {
+			v1Rollback.Sheet = fmt.Sprintf("%s%s/%s%d", common.ProjectNamePrefix, plan.ProjectID, common.SheetIDPrefix, rollback.SheetUid)
+		}
+		v1Occurrence.Rollbacks = append(v1Occurrence.Rollbacks, v1Rollback)
+	}
+	return v1Occurrence
+}
+
+func convertToPlanScheduleOccurrenceStatus(status store.PlanScheduleOccurrenceStatus) v1pb.PlanScheduleOccurrence_Status {
+	switch status {
+	case store.PlanScheduleOccurrenceChecking:
+		return v1pb.PlanScheduleOccurrence_CHECKING
+	case store.PlanScheduleOccurrenceRunning:
+		return v1pb.PlanScheduleOccurrence_RUNNING
+	case store.PlanScheduleOccurrenceDone:
+		return v1pb.PlanScheduleOccurrence_DONE
+	case store.PlanScheduleOccurrenceFailed:
+		return v1pb.PlanScheduleOccurrence_FAILED
+	case store.PlanScheduleOccurrenceSkipped:
+		return v1pb.PlanScheduleOccurrence_SKIPPED
+	default:
+		return v1pb.PlanScheduleOccurrence_STATUS_UNSPECIFIED
 	}
 }
 
@@ -125,10 +213,23 @@ func convertToPlanSpecChangeDatabaseConfig(config *storepb.PlanConfig_Spec_Chang
 			SchemaVersion:   c.SchemaVersion,
 			RollbackEnabled: c.RollbackEnabled,
 			RollbackDetail:  convertToPlanSpecChangeDatabaseConfigRollbackDetail(c.RollbackDetail),
+			BatchConfig:     convertToPlanSpecChangeDatabaseConfigBatchConfig(c.BatchConfig),
 		},
 	}
 }
 
+func convertToPlanSpecChangeDatabaseConfigBatchConfig(c *storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig) *v1pb.Plan_ChangeDatabaseConfig_BatchConfig {
+	if c == nil {
+		return nil
+	}
+	return &v1pb.Plan_ChangeDatabaseConfig_BatchConfig{
+		ChunkSize:         c.ChunkSize,
+		Pause:             c.Pause,
+		MaxReplicaLag:     c.MaxReplicaLag,
+		MaxThreadsRunning: c.MaxThreadsRunning,
+	}
+}
+
 func convertToPlanSpecChangeDatabaseConfigRollbackDetail(d *storepb.PlanConfig_ChangeDatabaseConfig_RollbackDetail) *v1pb.Plan_ChangeDatabaseConfig_RollbackDetail {
 	if d == nil {
 		return nil
@@ -176,6 +277,10 @@ func convertToPlanSpecRestoreDatabaseConfig(config *storepb.PlanConfig_Spec_Rest
 		v1Config.RestoreDatabaseConfig.Source = &v1pb.Plan_RestoreDatabaseConfig_PointInTime{
 			PointInTime: source.PointInTime,
 		}
+	case *storepb.PlanConfig_RestoreDatabaseConfig_Lsn:
+		v1Config.RestoreDatabaseConfig.Source = &v1pb.Plan_RestoreDatabaseConfig_Lsn{
+			Lsn: source.Lsn,
+		}
 	}
 
 	v1Config.RestoreDatabaseConfig.CreateDatabaseConfig = convertToPlanCreateDatabaseConfig(c.CreateDatabaseConfig)
@@ -252,10 +357,23 @@ func convertPlanSpecChangeDatabaseConfig(config *v1pb.Plan_Spec_ChangeDatabaseCo
 			Type:            storepb.PlanConfig_ChangeDatabaseConfig_Type(c.Type),
 			SchemaVersion:   c.SchemaVersion,
 			RollbackEnabled: c.RollbackEnabled,
+			BatchConfig:     convertPlanSpecChangeDatabaseConfigBatchConfig(c.BatchConfig),
 		},
 	}
 }
 
+func convertPlanSpecChangeDatabaseConfigBatchConfig(c *v1pb.Plan_ChangeDatabaseConfig_BatchConfig) *storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig {
+	if c == nil {
+		return nil
+	}
+	return &storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig{
+		ChunkSize:         c.ChunkSize,
+		Pause:             c.Pause,
+		MaxReplicaLag:     c.MaxReplicaLag,
+		MaxThreadsRunning: c.MaxThreadsRunning,
+	}
+}
+
 func convertPlanSpecRestoreDatabaseConfig(config *v1pb.Plan_Spec_RestoreDatabaseConfig) *storepb.PlanConfig_Spec_RestoreDatabaseConfig {
 	c := config.RestoreDatabaseConfig
 	storeConfig := &storepb.PlanConfig_Spec_RestoreDatabaseConfig{
@@ -272,6 +390,10 @@ func convertPlanSpecRestoreDatabaseConfig(config *v1pb.Plan_Spec_RestoreDatabase
 		storeConfig.RestoreDatabaseConfig.Source = &storepb.PlanConfig_RestoreDatabaseConfig_PointInTime{
 			PointInTime: source.PointInTime,
 		}
+	case *v1pb.Plan_RestoreDatabaseConfig_Lsn:
+		storeConfig.RestoreDatabaseConfig.Source = &storepb.PlanConfig_RestoreDatabaseConfig_Lsn{
+			Lsn: source.Lsn,
+		}
 	}
 	// c.CreateDatabaseConfig is defined as optional in proto
 	// so we need to test if it's nil
@@ -368,8 +490,12 @@ func convertToPlanCheckRunType(t store.PlanCheckRunType) v1pb.PlanCheckRun_Type
 		return v1pb.PlanCheckRun_DATABASE_CONNECT
 	case store.PlanCheckDatabaseGhostSync:
 		return v1pb.PlanCheckRun_DATABASE_GHOST_SYNC
+	case store.PlanCheckDatabasePGOnlineSync:
+		return v1pb.PlanCheckRun_DATABASE_PG_ONLINE_SYNC
 	case store.PlanCheckDatabasePITRMySQL:
 		return v1pb.PlanCheckRun_DATABASE_PITR_MYSQL
+	case store.PlanCheckDatabasePITRPostgres:
+		return v1pb.PlanCheckRun_DATABASE_PITR_POSTGRES
 	}
 	return v1pb.PlanCheckRun_TYPE_UNSPECIFIED
 }
@@ -540,9 +666,9 @@ func convertToTask(ctx context.Context, s *store.Store, project *store.ProjectMe
 		return convertToTaskFromDatabaseCreate(ctx, s, project, task)
 	case api.TaskDatabaseSchemaBaseline:
 		return convertToTaskFromSchemaBaseline(ctx, s, project, task)
-	case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync:
+	case api.TaskDatabaseSchemaUpdate, api.TaskDatabaseSchemaUpdateSDL, api.TaskDatabaseSchemaUpdateGhostSync, api.TaskDatabaseSchemaUpdatePGOnlineSync:
 		return convertToTaskFromSchemaUpdate(ctx, s, project, task)
-	case api.TaskDatabaseSchemaUpdateGhostCutover:
+	case api.TaskDatabaseSchemaUpdateGhostCutover, api.TaskDatabaseSchemaUpdatePGOnlineCutover:
 		return convertToTaskFromSchemaUpdateGhostCutover(ctx, s, project, task)
 	case api.TaskDatabaseDataUpdate:
 		return convertToTaskFromDataUpdate(ctx, s, project, task)
@@ -874,8 +1000,14 @@ func convertToTaskFromDatabaseRestoreRestore(ctx context.Context, s *store.Store
 		Target:         fmt.Sprintf("%s%s/%s%s", common.InstanceNamePrefix, database.InstanceID, common.DatabaseIDPrefix, database.DatabaseName),
 		Payload:        nil,
 	}
-	if (payload.BackupID == nil) == (payload.PointInTimeTs == nil) {
-		return nil, errors.Errorf("payload.BackupID and payload.PointInTimeTs cannot be both nil or both not nil")
+	setCount := 0
+	for _, set := range []bool{payload.BackupID != nil, payload.PointInTimeTs != nil, payload.PointInTimeLSN != nil} {
+		if set {
+			setCount++
+		}
+	}
+	if setCount != 1 {
+		return nil, errors.Errorf("exactly one of payload.BackupID, payload.PointInTimeTs and payload.PointInTimeLSN should be set")
 	}
 	if (payload.TargetInstanceID == nil) != (payload.DatabaseName == nil) {
 		return nil, errors.Errorf("payload.TargetInstanceID and payload.DatabaseName must be both nil or both not nil")
@@ -921,6 +1053,11 @@ func convertToTaskFromDatabaseRestoreRestore(ctx context.Context, s *store.Store
 			PointInTime: timestamppb.New(time.Unix(*payload.PointInTimeTs, 0)),
 		}
 	}
+	if payload.PointInTimeLSN != nil {
+		v1pbTaskPayload.DatabaseRestoreRestore.Source = &v1pb.Task_DatabaseRestoreRestore_Lsn{
+			Lsn: *payload.PointInTimeLSN,
+		}
+	}
 	v1pbTask.Payload = &v1pbTaskPayload
 
 	return v1pbTask, nil
@@ -995,6 +1132,10 @@ func convertToTaskType(taskType api.TaskType) v1pb.Task_Type {
 		return v1pb.Task_DATABASE_SCHEMA_UPDATE_GHOST_SYNC
 	case api.TaskDatabaseSchemaUpdateGhostCutover:
 		return v1pb.Task_DATABASE_SCHEMA_UPDATE_GHOST_CUTOVER
+	case api.TaskDatabaseSchemaUpdatePGOnlineSync:
+		return v1pb.Task_DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC
+	case api.TaskDatabaseSchemaUpdatePGOnlineCutover:
+		return v1pb.Task_DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER
 	case api.TaskDatabaseDataUpdate:
 		return v1pb.Task_DATABASE_DATA_UPDATE
 	case api.TaskDatabaseBackup:
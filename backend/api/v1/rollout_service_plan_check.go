@@ -8,12 +8,14 @@ import (
 
 	"github.com/bytebase/bytebase/backend/common"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
-func getPlanCheckRunsFromPlan(ctx context.Context, s *store.Store, plan *store.PlanMessage) ([]*store.PlanCheckRunMessage, error) {
+// GetPlanCheckRunsFromPlan returns the plan check runs to create for the plan.
+func GetPlanCheckRunsFromPlan(ctx context.Context, s *store.Store, plan *store.PlanMessage) ([]*store.PlanCheckRunMessage, error) {
 	var planCheckRuns []*store.PlanCheckRunMessage
 	for _, step := range plan.Config.Steps {
 		for _, spec := range step.Specs {
@@ -41,6 +43,40 @@ func getPlanCheckRunsFromSpec(ctx context.Context, s *store.Store, plan *store.P
 
 	case *storepb.PlanConfig_Spec_RestoreDatabaseConfig:
 		var planCheckRuns []*store.PlanCheckRunMessage
+		switch config.RestoreDatabaseConfig.Source.(type) {
+		case *storepb.PlanConfig_RestoreDatabaseConfig_PointInTime, *storepb.PlanConfig_RestoreDatabaseConfig_Lsn:
+		default:
+			return planCheckRuns, nil
+		}
+		// postgres PITR check
+		// The WAL is archived from the source instance, so we check the source instance even if restoring to a new database.
+		sourceInstanceID, sourceDatabaseName, err := common.GetInstanceDatabaseID(config.RestoreDatabaseConfig.Target)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get instance database id from target %q", config.RestoreDatabaseConfig.Target)
+		}
+		sourceInstance, err := s.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &sourceInstanceID})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get instance %q", sourceInstanceID)
+		}
+		if sourceInstance == nil {
+			return nil, errors.Errorf("instance %q not found", sourceInstanceID)
+		}
+		if sourceInstance.Engine == db.Postgres {
+			planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
+				CreatorUID: api.SystemBotID,
+				UpdaterUID: api.SystemBotID,
+				PlanUID:    plan.UID,
+				Status:     store.PlanCheckRunStatusRunning,
+				Type:       store.PlanCheckDatabasePITRPostgres,
+				Config: &storepb.PlanCheckRunConfig{
+					SheetUid:           0,
+					ChangeDatabaseType: storepb.PlanCheckRunConfig_CHANGE_DATABASE_TYPE_UNSPECIFIED,
+					InstanceUid:        int32(sourceInstance.UID),
+					DatabaseName:       sourceDatabaseName,
+				},
+			})
+			return planCheckRuns, nil
+		}
 		// mysql PITR check
 		if _, ok := config.RestoreDatabaseConfig.Source.(*storepb.PlanConfig_RestoreDatabaseConfig_PointInTime); ok {
 			if config.RestoreDatabaseConfig.CreateDatabaseConfig != nil {
@@ -298,12 +334,16 @@ func getPlanCheckRunsFromChangeDatabaseConfigForDatabase(ctx context.Context, s
 		},
 	})
 	if databaseGroupUID == nil && config.Type == storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE_GHOST {
+		checkType := store.PlanCheckDatabaseGhostSync
+		if instance.Engine == db.Postgres {
+			checkType = store.PlanCheckDatabasePGOnlineSync
+		}
 		planCheckRuns = append(planCheckRuns, &store.PlanCheckRunMessage{
 			CreatorUID: api.SystemBotID,
 			UpdaterUID: api.SystemBotID,
 			PlanUID:    plan.UID,
 			Status:     store.PlanCheckRunStatusRunning,
-			Type:       store.PlanCheckDatabaseGhostSync,
+			Type:       checkType,
 			Config: &storepb.PlanCheckRunConfig{
 				SheetUid:           int32(sheetUID),
 				ChangeDatabaseType: convertToChangeDatabaseType(config.Type),
@@ -0,0 +1,147 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/bytebase/bytebase/backend/common"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// PausePlanSchedule pauses the schedule of the plan, which stops creating new occurrences.
+func (s *RolloutService) PausePlanSchedule(ctx context.Context, request *v1pb.PausePlanScheduleRequest) (*v1pb.Plan, error) {
+	return s.updatePlanSchedulePaused(ctx, request.Name, true /* paused */)
+}
+
+// ResumePlanSchedule resumes the schedule of the plan.
+// The occurrences missed during the pause are not run.
+func (s *RolloutService) ResumePlanSchedule(ctx context.Context, request *v1pb.ResumePlanScheduleRequest) (*v1pb.Plan, error) {
+	return s.updatePlanSchedulePaused(ctx, request.Name, false /* paused */)
+}
+
+func (s *RolloutService) updatePlanSchedulePaused(ctx context.Context, name string, paused bool) (*v1pb.Plan, error) {
+	planID, err := common.GetPlanID(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{UID: &planID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get plan, error: %v", err)
+	}
+	if plan == nil {
+		return nil, status.Errorf(codes.NotFound, "plan %q not found", name)
+	}
+	schedule := plan.Config.Schedule
+	if schedule == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "plan %q has no schedule", name)
+	}
+	if schedule.Paused == paused {
+		return convertToPlan(plan), nil
+	}
+
+	schedule.Paused = paused
+	if !paused {
+		schedule.StartTime = timestamppb.Now()
+	}
+	updaterID := ctx.Value(common.PrincipalIDContextKey).(int)
+	if err := s.store.UpdatePlan(ctx, &store.UpdatePlanMessage{
+		UID:       plan.UID,
+		UpdaterID: updaterID,
+		Config:    plan.Config,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update plan %q: %v", name, err)
+	}
+	return convertToPlan(plan), nil
+}
+
+// ListPlanScheduleOccurrences lists the occurrences of the scheduled plan, the latest first.
+func (s *RolloutService) ListPlanScheduleOccurrences(ctx context.Context, request *v1pb.ListPlanScheduleOccurrencesRequest) (*v1pb.ListPlanScheduleOccurrencesResponse, error) {
+	planID, err := common.GetPlanID(request.Parent)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{UID: &planID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get plan, error: %v", err)
+	}
+	if plan == nil {
+		return nil, status.Errorf(codes.NotFound, "plan %q not found", request.Parent)
+	}
+
+	limit, offset := int(request.PageSize), 0
+	if request.PageToken != "" {
+		var pageToken storepb.PageToken
+		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		if pageToken.Limit < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "page size cannot be negative")
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	}
+	if limit <= 0 {
+		limit = 50
+	}
+	if limit > 1000 {
+		limit = 1000
+	}
+	limitPlusOne := limit + 1
+
+	occurrences, err := s.store.ListPlanScheduleOccurrences(ctx, &store.FindPlanScheduleOccurrenceMessage{
+		PlanUID: &plan.UID,
+		Limit:   &limitPlusOne,
+		Offset:  &offset,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list plan schedule occurrences, error: %v", err)
+	}
+	nextPageToken := ""
+	if len(occurrences) == limitPlusOne {
+		occurrences = occurrences[:limit]
+		if nextPageToken, err = getPageToken(limit, offset+limit); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+
+	taskRuns := map[int]*store.TaskRunMessage{}
+	var taskRunUIDs []int
+	for _, occurrence := range occurrences {
+		for _, uid := range occurrence.Payload.TaskRunUids {
+			taskRunUIDs = append(taskRunUIDs, int(uid))
+		}
+	}
+	if len(taskRunUIDs) > 0 {
+		list, err := s.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{UIDs: &taskRunUIDs})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list task runs, error: %v", err)
+		}
+		for _, taskRun := range list {
+			taskRuns[taskRun.ID] = taskRun
+		}
+	}
+	tasks := map[int]*store.TaskMessage{}
+	if plan.PipelineUID != nil {
+		list, err := s.store.ListTasks(ctx, &api.TaskFind{PipelineID: plan.PipelineUID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list tasks, error: %v", err)
+		}
+		for _, task := range list {
+			tasks[task.ID] = task
+		}
+	}
+
+	response := &v1pb.ListPlanScheduleOccurrencesResponse{
+		NextPageToken: nextPageToken,
+	}
+	for _, occurrence := range occurrences {
+		response.Occurrences = append(response.Occurrences, convertToPlanScheduleOccurrence(plan, occurrence, taskRuns, tasks))
+	}
+	return response, nil
+}
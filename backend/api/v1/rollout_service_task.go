@@ -16,6 +16,7 @@ import (
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -324,6 +325,9 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 		if err != nil {
 			return nil, nil, errors.Wrapf(err, "failed to convert sheet id %q to int", sheetIDStr)
 		}
+		if instance.Engine == db.Postgres {
+			return getTaskCreatesFromPGOnlineMigration(spec, c, instance, database, sheetID)
+		}
 		var taskCreateList []*store.TaskMessage
 		// task "sync"
 		payloadSync := api.TaskDatabaseSchemaUpdateGhostSyncPayload{
@@ -388,6 +392,11 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 			RollbackEnabled:   c.RollbackEnabled,
 			RollbackSQLStatus: api.RollbackSQLStatusPending,
 		}
+		batchConfig, err := convertToTaskBatchConfig(instance, c.BatchConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		payload.BatchConfig = batchConfig
 		if c.RollbackDetail != nil {
 			issueID, err := common.GetIssueID(c.RollbackDetail.RollbackFromIssue)
 			if err != nil {
@@ -420,6 +429,55 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseTarget(ctx context.Context, s
 	}
 }
 
+// getTaskCreatesFromPGOnlineMigration creates the PostgreSQL online migration sync and cutover tasks.
+func getTaskCreatesFromPGOnlineMigration(spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ChangeDatabaseConfig, instance *store.InstanceMessage, database *store.DatabaseMessage, sheetID int) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
+	var taskCreateList []*store.TaskMessage
+	// task "sync"
+	payloadSync := api.TaskDatabaseSchemaUpdatePGOnlineSyncPayload{
+		SpecID:        spec.Id,
+		SheetID:       sheetID,
+		SchemaVersion: c.SchemaVersion,
+		VCSPushEvent:  nil,
+	}
+	bytesSync, err := json.Marshal(payloadSync)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to marshal database schema update online migration sync payload")
+	}
+	taskCreateList = append(taskCreateList, &store.TaskMessage{
+		Name:              fmt.Sprintf("Update schema online migration sync for database %q", database.DatabaseName),
+		InstanceID:        instance.UID,
+		DatabaseID:        &database.UID,
+		Status:            api.TaskPendingApproval,
+		Type:              api.TaskDatabaseSchemaUpdatePGOnlineSync,
+		EarliestAllowedTs: spec.EarliestAllowedTime.GetSeconds(),
+		Payload:           string(bytesSync),
+	})
+
+	// task "cutover"
+	payloadCutover := api.TaskDatabaseSchemaUpdatePGOnlineCutoverPayload{
+		SpecID: spec.Id,
+	}
+	bytesCutover, err := json.Marshal(payloadCutover)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "failed to marshal database schema update online migration cutover payload")
+	}
+	taskCreateList = append(taskCreateList, &store.TaskMessage{
+		Name:              fmt.Sprintf("Update schema online migration cutover for database %q", database.DatabaseName),
+		InstanceID:        instance.UID,
+		DatabaseID:        &database.UID,
+		Status:            api.TaskPendingApproval,
+		Type:              api.TaskDatabaseSchemaUpdatePGOnlineCutover,
+		EarliestAllowedTs: spec.EarliestAllowedTime.GetSeconds(),
+		Payload:           string(bytesCutover),
+	})
+
+	// task "sync" blocks task "cutover".
+	taskIndexDAGList := []store.TaskIndexDAG{
+		{FromIndex: 0, ToIndex: 1},
+	}
+	return taskCreateList, taskIndexDAGList, nil
+}
+
 func getTaskCreatesFromChangeDatabaseConfigDatabaseGroupTarget(ctx context.Context, s *store.Store, spec *storepb.PlanConfig_Spec, c *storepb.PlanConfig_ChangeDatabaseConfig, project *store.ProjectMessage, registerEnvironmentID func(string) error) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
 	switch c.Type {
 	case storepb.PlanConfig_ChangeDatabaseConfig_MIGRATE:
@@ -575,6 +633,9 @@ func getTaskCreatesFromChangeDatabaseConfigDatabaseGroupStatements(db *store.Dat
 			creates = append(creates, taskCreate)
 
 		case storepb.PlanConfig_ChangeDatabaseConfig_DATA:
+			if c.BatchConfig != nil {
+				return nil, errors.Errorf("batched execution is not supported for the database group")
+			}
 			payload := api.TaskDatabaseDataUpdatePayload{
 				SpecID:            spec.Id,
 				SheetID:           0,
@@ -716,6 +777,15 @@ func getTaskCreatesFromRestoreDatabaseConfig(ctx context.Context, s *store.Store
 		case *storepb.PlanConfig_RestoreDatabaseConfig_PointInTime:
 			ts := source.PointInTime.GetSeconds()
 			restorePayload.PointInTimeTs = &ts
+		case *storepb.PlanConfig_RestoreDatabaseConfig_Lsn:
+			if instance.Engine != db.Postgres {
+				return nil, nil, errors.Errorf("PITR to the LSN is only supported for PostgreSQL")
+			}
+			if _, err := pg.ParseLSN(source.Lsn); err != nil {
+				return nil, nil, err
+			}
+			lsn := source.Lsn
+			restorePayload.PointInTimeLSN = &lsn
 		}
 		restorePayload.TargetInstanceID = &targetInstance.UID
 		restorePayload.DatabaseName = &c.CreateDatabaseConfig.Database
@@ -776,6 +846,15 @@ func getTaskCreatesFromRestoreDatabaseConfig(ctx context.Context, s *store.Store
 		case *storepb.PlanConfig_RestoreDatabaseConfig_PointInTime:
 			ts := source.PointInTime.GetSeconds()
 			restorePayload.PointInTimeTs = &ts
+		case *storepb.PlanConfig_RestoreDatabaseConfig_Lsn:
+			if instance.Engine != db.Postgres {
+				return nil, nil, errors.Errorf("PITR to the LSN is only supported for PostgreSQL")
+			}
+			if _, err := pg.ParseLSN(source.Lsn); err != nil {
+				return nil, nil, err
+			}
+			lsn := source.Lsn
+			restorePayload.PointInTimeLSN = &lsn
 		}
 		restorePayloadBytes, err := json.Marshal(restorePayload)
 		if err != nil {
@@ -944,6 +1023,22 @@ func getCreateDatabaseStatement(dbType db.Type, c *storepb.PlanConfig_CreateData
 	return "", errors.Errorf("unsupported database type %s", dbType)
 }
 
+// convertToTaskBatchConfig converts the batch config of the spec to the one in the data update task payload.
+func convertToTaskBatchConfig(instance *store.InstanceMessage, c *storepb.PlanConfig_ChangeDatabaseConfig_BatchConfig) (*api.DataUpdateBatchConfig, error) {
+	if c == nil {
+		return nil, nil
+	}
+	if instance.Engine != db.MySQL && instance.Engine != db.Postgres {
+		return nil, errors.Errorf("batched execution is not supported for engine %s", instance.Engine)
+	}
+	return &api.DataUpdateBatchConfig{
+		ChunkSize:         int64(c.ChunkSize),
+		PauseMs:           c.Pause.AsDuration().Milliseconds(),
+		MaxReplicaLagMs:   c.MaxReplicaLag.AsDuration().Milliseconds(),
+		MaxThreadsRunning: int64(c.MaxThreadsRunning),
+	}, nil
+}
+
 func getOrDefaultSchemaVersion(v string) string {
 	if v != "" {
 		return v
@@ -18,14 +18,17 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 
 	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/classifier"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/app/feishu"
 	"github.com/bytebase/bytebase/backend/plugin/mail"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/edit"
+	"github.com/bytebase/bytebase/backend/runner/backuprun"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -73,6 +76,8 @@ var whitelistSettings = []api.SettingName{
 	api.SettingSchemaTemplate,
 	api.SettingDataClassification,
 	api.SettingSemanticCategory,
+	api.SettingBackupVerification,
+	api.SettingSCIM,
 }
 
 //go:embed mail_templates/testmail/template.html
@@ -370,12 +375,22 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 		for _, node := range storeValue.Nodes {
 			newNode[node.Id] = node
 		}
+		oldNode := make(map[string]*storepb.ExternalApprovalSetting_Node)
 		removed := make(map[string]bool)
 		for _, node := range oldSetting.Nodes {
+			oldNode[node.Id] = node
 			if _, ok := newNode[node.Id]; !ok {
 				removed[node.Id] = true
 			}
 		}
+		// Keep the existed secret if it's not specified, because we never return the secret to the client.
+		for i, node := range externalApprovalSetting.Nodes {
+			if node.Secret == nil {
+				if old, ok := oldNode[node.Id]; ok {
+					storeValue.Nodes[i].Secret = old.Secret
+				}
+			}
+		}
 		if len(removed) > 0 {
 			externalApprovalType := api.ExternalApprovalTypeRelay
 			approvals, err := s.store.ListExternalApprovalV2(
@@ -475,6 +490,11 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 		if len(payload.Configs) > 1 {
 			return nil, status.Errorf(codes.InvalidArgument, "only support define 1 classification config for now")
 		}
+		for _, config := range payload.Configs {
+			if _, err := classifier.NewClassifier(config); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid discovery for classification config %s: %v", config.Id, err)
+			}
+		}
 		bytes, err := protojson.Marshal(payload)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
@@ -485,6 +505,12 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 		if err := convertV1PbToStorePb(request.Setting.Value.GetSemanticCategorySettingValue(), storeCategorySetting); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
 		}
+		// Keep the existed salt if it's not specified, because we never return the salt to the client.
+		oldCategorySetting, err := s.store.GetSemanticCategorySetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", apiSettingName, err)
+		}
+		fillMaskingAlgorithmSalt(request.Setting.Value.GetSemanticCategorySettingValue(), storeCategorySetting, oldCategorySetting)
 		idMap := make(map[string]any)
 		for _, category := range storeCategorySetting.Categories {
 			if !isValidUUID(category.Id) {
@@ -497,12 +523,94 @@ func (s *SettingService) SetSetting(ctx context.Context, request *v1pb.SetSettin
 				return nil, status.Errorf(codes.InvalidArgument, "duplicate category id: %s", category.Id)
 			}
 			idMap[category.Id] = any(nil)
+			for _, algorithm := range []*storepb.MaskingAlgorithm{category.FullMaskAlgorithm, category.PartialMaskAlgorithm} {
+				if algorithm == nil {
+					continue
+				}
+				if _, err := masker.NewMasker(algorithm); err != nil {
+					return nil, status.Errorf(codes.InvalidArgument, "invalid masking algorithm for category %s: %v", category.Id, err)
+				}
+			}
 		}
 		bytes, err := protojson.Marshal(storeCategorySetting)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
 		}
 		storeSettingValue = string(bytes)
+	case api.SettingBackupVerification:
+		storeBackupVerificationSetting := new(storepb.BackupVerificationSetting)
+		if err := convertV1PbToStorePb(request.Setting.Value.GetBackupVerificationSettingValue(), storeBackupVerificationSetting); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", apiSettingName, err)
+		}
+		if storeBackupVerificationSetting.Instance != "" {
+			instanceID, err := common.GetInstanceID(storeBackupVerificationSetting.Instance)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, err.Error())
+			}
+			instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get instance %q: %v", instanceID, err)
+			}
+			if instance == nil || instance.Deleted {
+				return nil, status.Errorf(codes.NotFound, "instance %q not found", instanceID)
+			}
+			if !backuprun.IsBackupVerificationSupported(instance.Engine) {
+				return nil, status.Errorf(codes.InvalidArgument, "backup verification is not supported for engine %s", instance.Engine)
+			}
+		}
+		if storeBackupVerificationSetting.SampleSize < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "sample size should not be negative")
+		}
+		if storeBackupVerificationSetting.Interval != nil && storeBackupVerificationSetting.Interval.Seconds > 0 && storeBackupVerificationSetting.Interval.AsDuration() < time.Hour {
+			return nil, status.Errorf(codes.InvalidArgument, "verification interval should be at least one hour")
+		}
+		bytes, err := protojson.Marshal(storeBackupVerificationSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
+	case api.SettingSCIM:
+		if err := s.licenseService.IsFeatureEnabled(api.FeatureSSO); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, err.Error())
+		}
+		// The SCIM token is able to provision the workspace owners.
+		role := ctx.Value(common.RoleContextKey).(api.Role)
+		if role != api.Owner {
+			return nil, status.Errorf(codes.PermissionDenied, "only workspace owner can update the SCIM setting")
+		}
+		apiValue := request.Setting.Value.GetScimSettingValue()
+		if apiValue == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "SCIM setting value must be set")
+		}
+		storeSCIMSetting := &storepb.SCIMSetting{}
+		// We will fill the token read from the store if it is not set.
+		if apiValue.Token == nil {
+			oldValue, err := s.store.GetSCIMSetting(ctx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "failed to get setting %q: %v", apiSettingName, err)
+			}
+			storeSCIMSetting.Token = oldValue.Token
+		} else {
+			if *apiValue.Token != "" && len(*apiValue.Token) < minSCIMTokenLength {
+				return nil, status.Errorf(codes.InvalidArgument, "SCIM token should have at least %d characters", minSCIMTokenLength)
+			}
+			storeSCIMSetting.Token = *apiValue.Token
+		}
+		for _, mapping := range apiValue.GroupMappings {
+			if err := s.validateSCIMGroupMapping(ctx, mapping); err != nil {
+				return nil, err
+			}
+			storeSCIMSetting.GroupMappings = append(storeSCIMSetting.GroupMappings, &storepb.SCIMSetting_GroupMapping{
+				Group:   mapping.Group,
+				Project: mapping.Project,
+				Role:    mapping.Role,
+			})
+		}
+		bytes, err := protojson.Marshal(storeSCIMSetting)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal setting for %s with error: %v", apiSettingName, err)
+		}
+		storeSettingValue = string(bytes)
 	default:
 		storeSettingValue = request.Setting.Value.GetStringValue()
 	}
@@ -704,14 +812,40 @@ func (s *SettingService) convertToSettingMessage(ctx context.Context, setting *s
 		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
 		}
-		return &v1pb.Setting{
+		return stripSensitiveData(&v1pb.Setting{
 			Name: settingName,
 			Value: &v1pb.Value{
 				Value: &v1pb.Value_SemanticCategorySettingValue{
 					SemanticCategorySettingValue: v1Value,
 				},
 			},
+		})
+	case api.SettingBackupVerification:
+		v1Value := new(v1pb.BackupVerificationSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return &v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_BackupVerificationSettingValue{
+					BackupVerificationSettingValue: v1Value,
+				},
+			},
 		}, nil
+	case api.SettingSCIM:
+		v1Value := new(v1pb.SCIMSetting)
+		if err := protojson.Unmarshal([]byte(setting.Value), v1Value); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to unmarshal setting value for %s with error: %v", setting.Name, err)
+		}
+		return stripSensitiveData(&v1pb.Setting{
+			Name: settingName,
+			Value: &v1pb.Value{
+				Value: &v1pb.Value_ScimSettingValue{
+					ScimSettingValue: v1Value,
+				},
+			},
+		})
 	default:
 		return &v1pb.Setting{
 			Name: settingName,
@@ -771,6 +905,49 @@ func validateApprovalTemplate(template *v1pb.ApprovalTemplate) error {
 	return nil
 }
 
+// minSCIMTokenLength is the minimum length of the SCIM token, which is as strong as the workspace owner credential.
+const minSCIMTokenLength = 32
+
+func (s *SettingService) validateSCIMGroupMapping(ctx context.Context, mapping *v1pb.SCIMSetting_GroupMapping) error {
+	if mapping.Group == "" {
+		return status.Errorf(codes.InvalidArgument, "SCIM group mapping must have a group")
+	}
+	roleID, err := common.GetRoleID(mapping.Role)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid role %q for SCIM group %q: %v", mapping.Role, mapping.Group, err)
+	}
+	if mapping.Project == "" {
+		switch api.Role(roleID) {
+		case api.Owner, api.DBA, api.Developer:
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid workspace role %q for SCIM group %q", mapping.Role, mapping.Group)
+		}
+		return nil
+	}
+
+	projectID, err := common.GetProjectID(mapping.Project)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	project, err := s.store.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &projectID})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get project %q: %v", projectID, err)
+	}
+	if project == nil || project.Deleted {
+		return status.Errorf(codes.NotFound, "project %q not found", projectID)
+	}
+	roles, err := s.store.ListRoles(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to list roles: %v", err)
+	}
+	for _, role := range roles {
+		if role.ResourceID == roleID {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "invalid project role %q for SCIM group %q", mapping.Role, mapping.Group)
+}
+
 func (s *SettingService) sendTestEmail(ctx context.Context, value *v1pb.SMTPMailDeliverySettingValue) error {
 	if value.Password == nil {
 		return status.Errorf(codes.InvalidArgument, "password is required when sending test email")
@@ -936,6 +1113,7 @@ func convertToExternalApprovalSettingNodes(nodes []*storepb.ExternalApprovalSett
 }
 
 func convertToExternalApprovalSettingNode(o *storepb.ExternalApprovalSetting_Node) *v1pb.ExternalApprovalSetting_Node {
+	// The secret is input only.
 	return &v1pb.ExternalApprovalSetting_Node{
 		Id:       o.Id,
 		Title:    o.Title,
@@ -993,6 +1171,7 @@ func convertExternalApprovalSettingNode(o *v1pb.ExternalApprovalSetting_Node) *s
 		Id:       o.Id,
 		Title:    o.Title,
 		Endpoint: o.Endpoint,
+		Secret:   o.GetSecret(),
 	}
 }
 
@@ -1014,7 +1193,47 @@ func stripSensitiveData(setting *v1pb.Setting) (*v1pb.Setting, error) {
 		mailDeliveryValue.SmtpMailDeliverySettingValue.Cert = nil
 		mailDeliveryValue.SmtpMailDeliverySettingValue.Key = nil
 		setting.Value.Value = mailDeliveryValue
+	case api.SettingSCIM:
+		scimValue, ok := setting.Value.Value.(*v1pb.Value_ScimSettingValue)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid setting value type: %T", setting.Value.Value)
+		}
+		scimValue.ScimSettingValue.Token = nil
+		setting.Value.Value = scimValue
+	case api.SettingSemanticCategory:
+		categoryValue, ok := setting.Value.Value.(*v1pb.Value_SemanticCategorySettingValue)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid setting value type: %T", setting.Value.Value)
+		}
+		for _, category := range categoryValue.SemanticCategorySettingValue.Categories {
+			for _, algorithm := range []*v1pb.MaskingAlgorithm{category.FullMaskAlgorithm, category.PartialMaskAlgorithm} {
+				if algorithm != nil {
+					algorithm.Salt = nil
+				}
+			}
+		}
+		setting.Value.Value = categoryValue
 	default:
 	}
 	return setting, nil
 }
+
+// fillMaskingAlgorithmSalt fills the salt of the masking algorithms not specified in the request with the salt of the same category in the old setting.
+func fillMaskingAlgorithmSalt(apiValue *v1pb.SemanticCategorySetting, storeValue, oldValue *storepb.SemanticCategorySetting) {
+	oldCategories := make(map[string]*storepb.SemanticCategorySetting_SemanticCategory)
+	for _, category := range oldValue.Categories {
+		oldCategories[category.Id] = category
+	}
+	for i, category := range apiValue.GetCategories() {
+		oldCategory, ok := oldCategories[category.Id]
+		if !ok {
+			continue
+		}
+		if category.FullMaskAlgorithm != nil && category.FullMaskAlgorithm.Salt == nil && oldCategory.FullMaskAlgorithm != nil {
+			storeValue.Categories[i].FullMaskAlgorithm.Salt = oldCategory.FullMaskAlgorithm.Salt
+		}
+		if category.PartialMaskAlgorithm != nil && category.PartialMaskAlgorithm.Salt == nil && oldCategory.PartialMaskAlgorithm != nil {
+			storeValue.Categories[i].PartialMaskAlgorithm.Salt = oldCategory.PartialMaskAlgorithm.Salt
+		}
+	}
+}
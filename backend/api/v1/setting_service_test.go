@@ -0,0 +1,79 @@
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestStripMaskingAlgorithmSalt(t *testing.T) {
+	a := require.New(t)
+	salt := "secret"
+	setting, err := stripSensitiveData(&v1pb.Setting{
+		Name: "settings/bb.workspace.semantic-category",
+		Value: &v1pb.Value{
+			Value: &v1pb.Value_SemanticCategorySettingValue{
+				SemanticCategorySettingValue: &v1pb.SemanticCategorySetting{
+					Categories: []*v1pb.SemanticCategorySetting_SemanticCategory{
+						{
+							Id:                "1",
+							FullMaskAlgorithm: &v1pb.MaskingAlgorithm{Type: v1pb.MaskingAlgorithm_HASH, Salt: &salt},
+						},
+					},
+				},
+			},
+		},
+	})
+	a.NoError(err)
+	category := setting.Value.GetSemanticCategorySettingValue().Categories[0]
+	a.Nil(category.FullMaskAlgorithm.Salt)
+	a.Nil(category.PartialMaskAlgorithm)
+}
+
+func TestFillMaskingAlgorithmSalt(t *testing.T) {
+	a := require.New(t)
+	newSalt := "new"
+	apiValue := &v1pb.SemanticCategorySetting{
+		Categories: []*v1pb.SemanticCategorySetting_SemanticCategory{
+			{
+				Id:                   "1",
+				FullMaskAlgorithm:    &v1pb.MaskingAlgorithm{Type: v1pb.MaskingAlgorithm_HASH},
+				PartialMaskAlgorithm: &v1pb.MaskingAlgorithm{Type: v1pb.MaskingAlgorithm_HASH, Salt: &newSalt},
+			},
+			{
+				Id:                "2",
+				FullMaskAlgorithm: &v1pb.MaskingAlgorithm{Type: v1pb.MaskingAlgorithm_HASH},
+			},
+		},
+	}
+	storeValue := &storepb.SemanticCategorySetting{
+		Categories: []*storepb.SemanticCategorySetting_SemanticCategory{
+			{
+				Id:                   "1",
+				FullMaskAlgorithm:    &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_HASH},
+				PartialMaskAlgorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_HASH, Salt: newSalt},
+			},
+			{
+				Id:                "2",
+				FullMaskAlgorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_HASH},
+			},
+		},
+	}
+	oldValue := &storepb.SemanticCategorySetting{
+		Categories: []*storepb.SemanticCategorySetting_SemanticCategory{
+			{
+				Id:                   "1",
+				FullMaskAlgorithm:    &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_HASH, Salt: "old-full"},
+				PartialMaskAlgorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_HASH, Salt: "old-partial"},
+			},
+		},
+	}
+
+	fillMaskingAlgorithmSalt(apiValue, storeValue, oldValue)
+	a.Equal("old-full", storeValue.Categories[0].FullMaskAlgorithm.Salt)
+	a.Equal("new", storeValue.Categories[0].PartialMaskAlgorithm.Salt)
+	a.Equal("", storeValue.Categories[1].FullMaskAlgorithm.Salt)
+}
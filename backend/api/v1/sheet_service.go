@@ -639,6 +639,8 @@ func (s *SheetService) SyncSheets(ctx context.Context, request *v1pb.SyncSheetsR
 			sheetSource = store.SheetFromGitHub
 		case vcsPlugin.Bitbucket:
 			sheetSource = store.SheetFromBitbucket
+		case vcsPlugin.Gitea:
+			sheetSource = store.SheetFromGitea
 		}
 		vscSheetType := store.SheetForSQL
 		sheetFind := &store.FindSheetMessage{
@@ -862,6 +864,8 @@ func (s *SheetService) convertToAPISheetMessage(ctx context.Context, sheet *stor
 		source = v1pb.Sheet_SOURCE_GITHUB
 	case store.SheetFromBitbucket:
 		source = v1pb.Sheet_SOURCE_BITBUCKET
+	case store.SheetFromGitea:
+		source = v1pb.Sheet_SOURCE_GITEA
 	}
 
 	tp := v1pb.Sheet_TYPE_UNSPECIFIED
@@ -932,6 +936,8 @@ func convertToStoreSheetMessage(projectUID int, databaseUID *int, creatorID int,
 		source = store.SheetFromGitHub
 	case v1pb.Sheet_SOURCE_BITBUCKET:
 		source = store.SheetFromBitbucket
+	case v1pb.Sheet_SOURCE_GITEA:
+		source = store.SheetFromGitea
 	default:
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("invalid source %q", sheet.Source))
 	}
@@ -19,7 +19,6 @@ import (
 	"github.com/lib/pq"
 	tidbast "github.com/pingcap/tidb/parser/ast"
 	"github.com/pkg/errors"
-	"github.com/xuri/excelize/v2"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -37,6 +36,7 @@ import (
 	advisorDB "github.com/bytebase/bytebase/backend/plugin/advisor/db"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
@@ -88,6 +88,52 @@ type maskingPolicyKey struct {
 	column string
 }
 
+// semanticCategoryMaskers are the maskers of the masking algorithms configured for the semantic category.
+type semanticCategoryMaskers struct {
+	full    masker.Masker
+	partial masker.Masker
+}
+
+// buildSemanticCategoryMaskers builds the maskers of the semantic categories keyed by the category ID.
+// The invalid algorithms are skipped, and the columns of them use the default full masker.
+func buildSemanticCategoryMaskers(setting *storepb.SemanticCategorySetting) map[string]semanticCategoryMaskers {
+	result := make(map[string]semanticCategoryMaskers)
+	newMasker := func(categoryID string, algorithm *storepb.MaskingAlgorithm) masker.Masker {
+		if algorithm == nil {
+			return nil
+		}
+		m, err := masker.NewMasker(algorithm)
+		if err != nil {
+			log.Warn("invalid masking algorithm of the semantic category", zap.String("category", categoryID), zap.Error(err))
+			return nil
+		}
+		return m
+	}
+	for _, category := range setting.Categories {
+		result[category.Id] = semanticCategoryMaskers{
+			full:    newMasker(category.Id, category.FullMaskAlgorithm),
+			partial: newMasker(category.Id, category.PartialMaskAlgorithm),
+		}
+	}
+	return result
+}
+
+// getColumnMasker returns the masker of the column for the masking level, and nil for the default full masker.
+func getColumnMasker(maskingData *storepb.MaskData, maskingLevel storepb.MaskingLevel, categoryMaskers map[string]semanticCategoryMaskers) masker.Masker {
+	maskers, ok := categoryMaskers[maskingData.GetSemanticCategoryId()]
+	if !ok {
+		return nil
+	}
+	switch maskingLevel {
+	case storepb.MaskingLevel_FULL:
+		return maskers.full
+	case storepb.MaskingLevel_PARTIAL:
+		return maskers.partial
+	default:
+		return nil
+	}
+}
+
 // Pretty returns pretty format SDL.
 func (*SQLService) Pretty(_ context.Context, request *v1pb.PrettyRequest) (*v1pb.PrettyResponse, error) {
 	engine := parser.EngineType(convertEngine(request.Engine))
@@ -270,25 +316,29 @@ func (s *SQLService) preAdminExecute(ctx context.Context, request *v1pb.AdminExe
 }
 
 // Export exports the SQL query result.
-func (s *SQLService) Export(ctx context.Context, request *v1pb.ExportRequest) (*v1pb.ExportResponse, error) {
+// The export content is streamed in chunks as the rows are read, so the whole export is never held in memory.
+func (s *SQLService) Export(request *v1pb.ExportRequest, server v1pb.SQLService_ExportServer) error {
+	ctx := server.Context()
 	instance, database, sensitiveSchemaInfo, activity, err := s.preExport(ctx, request)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	bytes, durationNs, exportErr := s.doExport(ctx, request, instance, database, sensitiveSchemaInfo)
-
-	if err := s.postExport(ctx, activity, durationNs, exportErr); err != nil {
-		return nil, err
+	writer := newChunkWriter(func(chunk []byte) error {
+		return server.Send(&v1pb.ExportResponse{
+			Content: chunk,
+		})
+	})
+	durationNs, exportErr := s.doExport(ctx, request, instance, database, sensitiveSchemaInfo, writer)
+	if exportErr == nil {
+		exportErr = writer.flush()
 	}
 
-	if exportErr != nil {
-		return nil, exportErr
+	if err := s.postExport(ctx, activity, durationNs, exportErr); err != nil {
+		return err
 	}
 
-	return &v1pb.ExportResponse{
-		Content: bytes,
-	}, nil
+	return exportErr
 }
 
 func (s *SQLService) postExport(ctx context.Context, activity *store.ActivityMessage, durationNs int64, queryErr error) error {
@@ -331,7 +381,7 @@ func (s *SQLService) postExport(ctx context.Context, activity *store.ActivityMes
 	return nil
 }
 
-func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *db.SensitiveSchemaInfo) ([]byte, int64, error) {
+func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *db.SensitiveSchemaInfo, w io.Writer) (int64, error) {
 	// Don't anonymize data for exporting data using admin mode.
 	if request.Admin {
 		sensitiveSchemaInfo = nil
@@ -339,7 +389,7 @@ func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest,
 
 	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database)
 	if err != nil {
-		return nil, 0, err
+		return 0, err
 	}
 	defer driver.Close(ctx)
 
@@ -348,11 +398,24 @@ func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest,
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return 0, err
 		}
 		defer conn.Close()
 	}
 
+	var resourceList []parser.SchemaResource
+	if request.Format == v1pb.ExportRequest_SQL {
+		list, err := s.extractResourceList(ctx, convertToParserEngine(instance.Engine), request.ConnectionDatabase, request.Statement, instance)
+		if err != nil {
+			return 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
+		}
+		resourceList = list
+	}
+	writer, err := newResultWriter(request.Format, instance.Engine, resourceList, w)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
 	start := time.Now().UnixNano()
 	result, err := driver.QueryConn(ctx, conn, request.Statement, &db.QueryContext{
 		Limit:               int(request.Limit),
@@ -360,73 +423,28 @@ func (s *SQLService) doExport(ctx context.Context, request *v1pb.ExportRequest,
 		CurrentDatabase:     request.ConnectionDatabase,
 		SensitiveSchemaInfo: sensitiveSchemaInfo,
 		EnableSensitive:     s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
+		// Write the rows in batches as they are read instead of holding the whole result in memory.
+		RowBatchHandler: writer.write,
 	})
 	durationNs := time.Now().UnixNano() - start
 	if err != nil {
-		return nil, durationNs, err
+		return durationNs, err
 	}
 	if len(result) != 1 {
-		return nil, durationNs, errors.Errorf("expecting 1 result, but got %d", len(result))
+		return durationNs, errors.Errorf("expecting 1 result, but got %d", len(result))
 	}
-
-	var content []byte
-	switch request.Format {
-	case v1pb.ExportRequest_CSV:
-		if content, err = s.exportCSV(result[0]); err != nil {
-			return nil, durationNs, err
-		}
-	case v1pb.ExportRequest_JSON:
-		if content, err = s.exportJSON(result[0]); err != nil {
-			return nil, durationNs, err
-		}
-	case v1pb.ExportRequest_SQL:
-		resourceList, err := s.extractResourceList(ctx, convertToParserEngine(instance.Engine), request.ConnectionDatabase, request.Statement, instance)
-		if err != nil {
-			return nil, 0, status.Errorf(codes.InvalidArgument, "failed to extract resource list: %v", err)
-		}
-		statementPrefix, err := getSQLStatementPrefix(instance.Engine, resourceList, result[0].ColumnNames)
-		if err != nil {
-			return nil, 0, err
-		}
-		if content, err = exportSQL(instance.Engine, statementPrefix, result[0]); err != nil {
-			return nil, durationNs, err
-		}
-	case v1pb.ExportRequest_XLSX:
-		if content, err = s.exportXLSX(result[0]); err != nil {
-			return nil, durationNs, err
-		}
-	default:
-		return nil, durationNs, status.Errorf(codes.InvalidArgument, "unsupported export format: %s", request.Format.String())
+	if result[0].Error != "" {
+		return durationNs, errors.New(result[0].Error)
 	}
-	return content, durationNs, nil
-}
-
-func (*SQLService) exportCSV(result *v1pb.QueryResult) ([]byte, error) {
-	var buf bytes.Buffer
-	if _, err := buf.WriteString(strings.Join(result.ColumnNames, ",")); err != nil {
-		return nil, err
+	// The result contains the rows not handled by the row batch handler, and it's written even if there are no rows
+	// so that the writer gets the columns of the empty result.
+	if err := writer.write(result[0]); err != nil {
+		return durationNs, err
 	}
-	if err := buf.WriteByte('\n'); err != nil {
-		return nil, err
+	if err := writer.close(); err != nil {
+		return durationNs, err
 	}
-	for i, row := range result.Rows {
-		for i, value := range row.Values {
-			if i != 0 {
-				if err := buf.WriteByte(','); err != nil {
-					return nil, err
-				}
-			}
-			if _, err := buf.Write(convertValueToBytesInCSV(value)); err != nil {
-				return nil, err
-			}
-		}
-		if i != len(result.Rows)-1 {
-			if err := buf.WriteByte('\n'); err != nil {
-				return nil, err
-			}
-		}
-	}
-	return buf.Bytes(), nil
+	return durationNs, nil
 }
 
 func convertValueToBytesInCSV(value *v1pb.RowValue) []byte {
@@ -639,18 +657,6 @@ func convertValueValueToBytes(value *structpb.Value) []byte {
 	}
 }
 
-func (*SQLService) exportJSON(result *v1pb.QueryResult) ([]byte, error) {
-	var results []map[string]any
-	for _, row := range result.Rows {
-		m := make(map[string]any)
-		for i, value := range row.Values {
-			m[result.ColumnNames[i]] = convertValueToStringInJSON(value)
-		}
-		results = append(results, m)
-	}
-	return json.MarshalIndent(results, "", "  ")
-}
-
 func convertValueToStringInJSON(value *v1pb.RowValue) string {
 	switch value.Kind.(type) {
 	case *v1pb.RowValue_StringValue:
@@ -687,40 +693,6 @@ const (
 	excelMaxColumn = 18278
 )
 
-func (*SQLService) exportXLSX(result *v1pb.QueryResult) ([]byte, error) {
-	f := excelize.NewFile()
-	defer f.Close()
-	index, err := f.NewSheet("Sheet1")
-	if err != nil {
-		return nil, err
-	}
-	var columnPrefixes []string
-	for i, columnName := range result.ColumnNames {
-		columnPrefix, err := getExcelColumnName(i)
-		if err != nil {
-			return nil, err
-		}
-		columnPrefixes = append(columnPrefixes, columnPrefix)
-		if err := f.SetCellValue(sheet1Name, fmt.Sprintf("%s1", columnPrefix), columnName); err != nil {
-			return nil, err
-		}
-	}
-	for i, row := range result.Rows {
-		for j, value := range row.Values {
-			columnName := fmt.Sprintf("%s%d", columnPrefixes[j], i+2)
-			if err := f.SetCellValue("Sheet1", columnName, convertValueToStringInXLSX(value)); err != nil {
-				return nil, err
-			}
-		}
-	}
-	f.SetActiveSheet(index)
-	excelBytes, err := f.WriteToBuffer()
-	if err != nil {
-		return nil, err
-	}
-	return excelBytes.Bytes(), nil
-}
-
 func getExcelColumnName(index int) (string, error) {
 	if index >= excelMaxColumn {
 		return "", errors.Errorf("index cannot be greater than %v (column ZZZ)", excelMaxColumn)
@@ -932,13 +904,14 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 	}
 
 	var results []*v1pb.QueryResult
+	var indexHitAdvices []*v1pb.Advice
 	var queryErr error
 	var durationNs int64
 	if adviceStatus != advisor.Error {
-		results, durationNs, queryErr = s.doQuery(ctx, request, instance, database, sensitiveSchemaInfo)
+		results, indexHitAdvices, durationNs, queryErr = s.doQuery(ctx, request, instance, database, sensitiveSchemaInfo)
 	}
 
-	adviceList, err = s.postQuery(ctx, request, adviceStatus, adviceList, instance, activity, durationNs, queryErr)
+	adviceList, err = s.postQuery(ctx, request, adviceStatus, adviceList, indexHitAdvices, activity, durationNs, queryErr)
 	if err != nil {
 		return nil, err
 	}
@@ -998,14 +971,9 @@ func (s *SQLService) Query(ctx context.Context, request *v1pb.QueryRequest) (*v1
 }
 
 // postQuery does the following:
-//  1. Check index hit Explain statements
+//  1. Merge the index hit advices of the query
 //  2. Update SQL query activity
-func (s *SQLService) postQuery(ctx context.Context, _ *v1pb.QueryRequest, adviceStatus advisor.Status, adviceList []*v1pb.Advice, _ *store.InstanceMessage, activity *store.ActivityMessage, durationNs int64, queryErr error) ([]*v1pb.Advice, error) {
-	indexHitAdvices, err := s.checkIndexHit()
-	if err != nil {
-		return nil, err
-	}
-
+func (s *SQLService) postQuery(ctx context.Context, _ *v1pb.QueryRequest, adviceStatus advisor.Status, adviceList []*v1pb.Advice, indexHitAdvices []*v1pb.Advice, activity *store.ActivityMessage, durationNs int64, queryErr error) ([]*v1pb.Advice, error) {
 	var finalAdviceList []*v1pb.Advice
 	newLevel := activity.Level
 	if len(indexHitAdvices) == 0 {
@@ -1055,15 +1023,49 @@ func (s *SQLService) postQuery(ctx context.Context, _ *v1pb.QueryRequest, advice
 	return finalAdviceList, nil
 }
 
-func (*SQLService) checkIndexHit() ([]*v1pb.Advice, error) {
-	// TODO(rebelice): implement checkIndexHit
-	return nil, nil
+// checkIndexHit explains the query on the connection of the query and warns about the full scans of large tables.
+// The query has been executed, so it doesn't fail if the query cannot be explained.
+func (s *SQLService) checkIndexHit(ctx context.Context, conn *sql.Conn, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage) ([]*v1pb.Advice, error) {
+	switch instance.Engine {
+	case db.MySQL, db.MariaDB, db.Postgres:
+	default:
+		return nil, nil
+	}
+	if conn == nil || database == nil {
+		return nil, nil
+	}
+	if blocks, err := extractQueryBlocks(instance.Engine, request.Statement); err != nil || len(blocks) == 0 {
+		return nil, nil
+	}
+	schema, err := s.store.GetDBSchema(ctx, database.UID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Failed to get database schema: %v", err)
+	}
+	if schema == nil {
+		return nil, nil
+	}
+
+	fullScans, err := findFullTableScans(ctx, instance.Engine, conn, schema.Metadata, request.Statement)
+	if err != nil {
+		log.Debug("Failed to explain statement to check index hit", zap.String("statement", request.Statement), zap.Error(err))
+		return nil, nil
+	}
+	var advices []*v1pb.Advice
+	for _, scan := range fullScans {
+		advices = append(advices, &v1pb.Advice{
+			Status:  v1pb.Advice_WARNING,
+			Code:    int32(advisor.NotUseIndex),
+			Title:   "Full table scan",
+			Content: fmt.Sprintf("The query scans all rows of table %q with about %d rows, consider filtering by indexed columns or adding an index", scan.table, scan.rowCount),
+		})
+	}
+	return advices, nil
 }
 
-func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *db.SensitiveSchemaInfo) ([]*v1pb.QueryResult, int64, error) {
+func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, instance *store.InstanceMessage, database *store.DatabaseMessage, sensitiveSchemaInfo *db.SensitiveSchemaInfo) ([]*v1pb.QueryResult, []*v1pb.Advice, int64, error) {
 	driver, err := s.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 	defer driver.Close(ctx)
 
@@ -1072,7 +1074,7 @@ func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, in
 	if sqlDB != nil {
 		conn, err = sqlDB.Conn(ctx)
 		if err != nil {
-			return nil, 0, err
+			return nil, nil, 0, err
 		}
 		defer conn.Close()
 	}
@@ -1092,17 +1094,27 @@ func (s *SQLService) doQuery(ctx context.Context, request *v1pb.QueryRequest, in
 		SensitiveSchemaInfo: sensitiveSchemaInfo,
 		EnableSensitive:     s.licenseService.IsFeatureEnabledForInstance(api.FeatureSensitiveData, instance) == nil,
 	})
+	durationNs := time.Now().UnixNano() - start
 	select {
 	case <-ctx.Done():
 		// canceled or timed out
-		return nil, time.Now().UnixNano() - start, errors.Errorf("timeout reached: %v", timeout)
+		return nil, nil, durationNs, errors.Errorf("timeout reached: %v", timeout)
 	default:
 		// So the select will not block
 	}
+	if err != nil {
+		return nil, nil, durationNs, err
+	}
 
 	sanitizeResults(results)
 
-	return results, time.Now().UnixNano() - start, err
+	// Reuse the connection of the query to check the index hit, it shares the timeout of the query.
+	indexHitAdvices, err := s.checkIndexHit(ctx, conn, request, instance, database)
+	if err != nil {
+		return nil, nil, durationNs, err
+	}
+
+	return results, indexHitAdvices, durationNs, nil
 }
 
 // sanitizeResults sanitizes the strings in the results by replacing all the invalid UTF-8 characters with its hexadecimal representation.
@@ -1353,6 +1365,12 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 		return nil, errors.Wrapf(err, "failed to find classification setting")
 	}
 
+	semanticCategorySetting, err := s.store.GetSemanticCategorySetting(ctx)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to find semantic category setting")
+	}
+	categoryMaskers := buildSemanticCategoryMaskers(semanticCategorySetting)
+
 	maskingRulePolicy, err := s.store.GetMaskingRulePolicy(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to find masking rule policy")
@@ -1487,10 +1505,15 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 						if sensitive {
 							isEmpty = false
 						}
+						var columnMasker masker.Masker
+						if sensitive {
+							columnMasker = getColumnMasker(maskingPolicyMap[maskingPolicyKey{schema: schema.Name, table: table.Name, column: column.Name}], maskingLevel, categoryMaskers)
+						}
 						tableSchema.ColumnList = append(tableSchema.ColumnList, db.ColumnInfo{
 							Name:         column.Name,
 							Sensitive:    sensitive,
 							MaskingLevel: maskingLevel,
+							Masker:       columnMasker,
 						})
 					}
 					schemaSchema.TableList = append(schemaSchema.TableList, tableSchema)
@@ -1524,10 +1547,15 @@ func (s *SQLService) getSensitiveSchemaInfo(ctx context.Context, instance *store
 					if sensitive {
 						isEmpty = false
 					}
+					var columnMasker masker.Masker
+					if sensitive {
+						columnMasker = getColumnMasker(maskingPolicyMap[maskingPolicyKey{schema: schema.Name, table: table.Name, column: column.Name}], maskingLevel, categoryMaskers)
+					}
 					tableSchema.ColumnList = append(tableSchema.ColumnList, db.ColumnInfo{
 						Name:         column.Name,
 						Sensitive:    sensitive,
 						MaskingLevel: maskingLevel,
+						Masker:       columnMasker,
 					})
 				}
 				schemaSchema.TableList = append(schemaSchema.TableList, tableSchema)
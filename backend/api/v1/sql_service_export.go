@@ -0,0 +1,658 @@
+package v1
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/compress"
+	"github.com/apache/arrow/go/v12/parquet/file"
+	"github.com/apache/arrow/go/v12/parquet/schema"
+	"github.com/pkg/errors"
+	"github.com/xuri/excelize/v2"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// parquetRowGroupSize is the maximum number of rows in a Parquet row group.
+	parquetRowGroupSize = 100000
+	// exportChunkSize is the size of the export content sent in each response of the export stream.
+	exportChunkSize = 1024 * 1024
+)
+
+// chunkWriter buffers the export content and sends it in chunks of exportChunkSize bytes.
+type chunkWriter struct {
+	buf  []byte
+	send func(chunk []byte) error
+}
+
+func newChunkWriter(send func(chunk []byte) error) *chunkWriter {
+	return &chunkWriter{send: send}
+}
+
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		if c.buf == nil {
+			c.buf = make([]byte, 0, exportChunkSize)
+		}
+		size := min(exportChunkSize-len(c.buf), len(p))
+		c.buf = append(c.buf, p[:size]...)
+		p = p[size:]
+		if len(c.buf) == exportChunkSize {
+			if err := c.flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// flush sends the buffered content if there is any.
+func (c *chunkWriter) flush() error {
+	if len(c.buf) == 0 {
+		return nil
+	}
+	if err := c.send(c.buf); err != nil {
+		return err
+	}
+	// The chunk may be retained by the sender, so a new buffer is allocated.
+	c.buf = nil
+	return nil
+}
+
+// resultWriter writes the query result to the output in row batches, so that the whole result doesn't have to be held in memory.
+// The column names and types of the batches are the same as the first batch.
+type resultWriter interface {
+	// write writes a batch of rows of the query result.
+	write(batch *v1pb.QueryResult) error
+	// close writes the remaining content such as the footer, it must be called after the last batch is written.
+	close() error
+}
+
+// newResultWriter returns the result writer of the export format.
+// The resource list is used to build the INSERT statements for the SQL format.
+func newResultWriter(format v1pb.ExportRequest_Format, engine db.Type, resourceList []parser.SchemaResource, w io.Writer) (resultWriter, error) {
+	switch format {
+	case v1pb.ExportRequest_CSV:
+		return &csvResultWriter{w: bufio.NewWriter(w)}, nil
+	case v1pb.ExportRequest_JSON:
+		return &jsonResultWriter{w: bufio.NewWriter(w)}, nil
+	case v1pb.ExportRequest_SQL:
+		return &sqlResultWriter{w: bufio.NewWriter(w), engine: engine, resourceList: resourceList}, nil
+	case v1pb.ExportRequest_XLSX:
+		return &xlsxResultWriter{w: w}, nil
+	case v1pb.ExportRequest_PARQUET:
+		return &parquetResultWriter{w: w}, nil
+	case v1pb.ExportRequest_NDJSON:
+		return &ndjsonResultWriter{w: bufio.NewWriter(w)}, nil
+	default:
+		return nil, errors.Errorf("unsupported export format: %s", format.String())
+	}
+}
+
+// checkColumnCount checks that the batch has the same columns as the first batch.
+func checkColumnCount(columnNames []string, batch *v1pb.QueryResult) error {
+	if len(batch.ColumnNames) != len(columnNames) {
+		return errors.Errorf("expecting %d columns in the result, but got %d", len(columnNames), len(batch.ColumnNames))
+	}
+	return nil
+}
+
+type csvResultWriter struct {
+	w           *bufio.Writer
+	columnNames []string
+}
+
+func (c *csvResultWriter) write(batch *v1pb.QueryResult) error {
+	if c.columnNames == nil {
+		c.columnNames = batch.ColumnNames
+		if _, err := c.w.WriteString(strings.Join(batch.ColumnNames, ",")); err != nil {
+			return err
+		}
+	}
+	if err := checkColumnCount(c.columnNames, batch); err != nil {
+		return err
+	}
+	for _, row := range batch.Rows {
+		if err := c.w.WriteByte('\n'); err != nil {
+			return err
+		}
+		for i, value := range row.Values {
+			if i != 0 {
+				if err := c.w.WriteByte(','); err != nil {
+					return err
+				}
+			}
+			if _, err := c.w.Write(convertValueToBytesInCSV(value)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *csvResultWriter) close() error {
+	return c.w.Flush()
+}
+
+type jsonResultWriter struct {
+	w           *bufio.Writer
+	columnNames []string
+	rowCount    int
+}
+
+func (j *jsonResultWriter) write(batch *v1pb.QueryResult) error {
+	if j.columnNames == nil {
+		j.columnNames = batch.ColumnNames
+	}
+	if err := checkColumnCount(j.columnNames, batch); err != nil {
+		return err
+	}
+	for _, row := range batch.Rows {
+		m := make(map[string]any)
+		for i, value := range row.Values {
+			m[batch.ColumnNames[i]] = convertValueToStringInJSON(value)
+		}
+		content, err := json.MarshalIndent(m, "  ", "  ")
+		if err != nil {
+			return err
+		}
+		separator := ",\n  "
+		if j.rowCount == 0 {
+			separator = "[\n  "
+		}
+		if _, err := j.w.WriteString(separator); err != nil {
+			return err
+		}
+		if _, err := j.w.Write(content); err != nil {
+			return err
+		}
+		j.rowCount++
+	}
+	return nil
+}
+
+func (j *jsonResultWriter) close() error {
+	end := "\n]"
+	if j.rowCount == 0 {
+		end = "[]"
+	}
+	if _, err := j.w.WriteString(end); err != nil {
+		return err
+	}
+	return j.w.Flush()
+}
+
+type sqlResultWriter struct {
+	w            *bufio.Writer
+	engine       db.Type
+	resourceList []parser.SchemaResource
+	columnNames  []string
+	prefix       string
+	rowCount     int
+}
+
+func (s *sqlResultWriter) write(batch *v1pb.QueryResult) error {
+	if s.columnNames == nil {
+		prefix, err := getSQLStatementPrefix(s.engine, s.resourceList, batch.ColumnNames)
+		if err != nil {
+			return err
+		}
+		s.columnNames = batch.ColumnNames
+		s.prefix = prefix
+	}
+	if err := checkColumnCount(s.columnNames, batch); err != nil {
+		return err
+	}
+	if len(batch.Rows) == 0 {
+		return nil
+	}
+	content, err := exportSQL(s.engine, s.prefix, batch)
+	if err != nil {
+		return err
+	}
+	if s.rowCount > 0 {
+		if err := s.w.WriteByte('\n'); err != nil {
+			return err
+		}
+	}
+	if _, err := s.w.Write(content); err != nil {
+		return err
+	}
+	s.rowCount += len(batch.Rows)
+	return nil
+}
+
+func (s *sqlResultWriter) close() error {
+	return s.w.Flush()
+}
+
+// xlsxResultWriter writes the rows to the sheet with the stream writer, which stores the rows in a temporary file if the sheet is large.
+type xlsxResultWriter struct {
+	w            io.Writer
+	file         *excelize.File
+	streamWriter *excelize.StreamWriter
+	columnNames  []string
+	rowCount     int
+}
+
+func (x *xlsxResultWriter) write(batch *v1pb.QueryResult) error {
+	if x.file == nil {
+		if len(batch.ColumnNames) > 0 {
+			if _, err := getExcelColumnName(len(batch.ColumnNames) - 1); err != nil {
+				return err
+			}
+		}
+		x.file = excelize.NewFile()
+		streamWriter, err := x.file.NewStreamWriter(sheet1Name)
+		if err != nil {
+			return err
+		}
+		x.streamWriter = streamWriter
+		x.columnNames = batch.ColumnNames
+		var header []any
+		for _, columnName := range batch.ColumnNames {
+			header = append(header, columnName)
+		}
+		if err := x.streamWriter.SetRow("A1", header); err != nil {
+			return err
+		}
+	}
+	if err := checkColumnCount(x.columnNames, batch); err != nil {
+		return err
+	}
+	for _, row := range batch.Rows {
+		var values []any
+		for _, value := range row.Values {
+			values = append(values, convertValueToStringInXLSX(value))
+		}
+		// The first row is the header.
+		x.rowCount++
+		if err := x.streamWriter.SetRow(fmt.Sprintf("A%d", x.rowCount+1), values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (x *xlsxResultWriter) close() error {
+	if x.file == nil {
+		return nil
+	}
+	defer x.file.Close()
+	if err := x.streamWriter.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.w)
+}
+
+// ndjsonResultWriter writes each row as a JSON object in a line, the values are typed by the column types.
+type ndjsonResultWriter struct {
+	w           *bufio.Writer
+	columnNames []string
+	columnTypes []exportColumnType
+}
+
+func (n *ndjsonResultWriter) write(batch *v1pb.QueryResult) error {
+	if n.columnNames == nil {
+		n.columnNames = batch.ColumnNames
+		n.columnTypes = getExportColumnTypes(batch)
+	}
+	if err := checkColumnCount(n.columnNames, batch); err != nil {
+		return err
+	}
+	var keys [][]byte
+	for _, columnName := range n.columnNames {
+		key, err := json.Marshal(columnName)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	for _, row := range batch.Rows {
+		if err := n.w.WriteByte('{'); err != nil {
+			return err
+		}
+		for i, value := range row.Values {
+			if i != 0 {
+				if err := n.w.WriteByte(','); err != nil {
+					return err
+				}
+			}
+			v, err := convertRowValue(value, n.columnTypes[i])
+			if err != nil {
+				return errors.Wrapf(err, "failed to convert the value of column %q", n.columnNames[i])
+			}
+			if intValue, ok := v.(int64); ok {
+				// Encode the integers as numbers without losing precision.
+				v = json.Number(strconv.FormatInt(intValue, 10))
+			}
+			content, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			if _, err := n.w.Write(keys[i]); err != nil {
+				return err
+			}
+			if err := n.w.WriteByte(':'); err != nil {
+				return err
+			}
+			if _, err := n.w.Write(content); err != nil {
+				return err
+			}
+		}
+		if _, err := n.w.WriteString("}\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *ndjsonResultWriter) close() error {
+	return n.w.Flush()
+}
+
+// parquetResultWriter writes the rows to the Parquet file, each column is typed by the column type of the result.
+type parquetResultWriter struct {
+	w           io.Writer
+	writer      *file.Writer
+	rowGroup    file.BufferedRowGroupWriter
+	columnNames []string
+	columnTypes []exportColumnType
+	// rowGroupRowCount is the number of rows written to the current row group.
+	rowGroupRowCount int
+}
+
+func (p *parquetResultWriter) write(batch *v1pb.QueryResult) error {
+	if p.writer == nil {
+		p.columnNames = batch.ColumnNames
+		p.columnTypes = getExportColumnTypes(batch)
+		root, err := getParquetSchema(p.columnNames, p.columnTypes)
+		if err != nil {
+			return err
+		}
+		props := parquet.NewWriterProperties(
+			parquet.WithCompression(compress.Codecs.Snappy),
+			parquet.WithCreatedBy("Bytebase"),
+		)
+		p.writer = file.NewParquetWriter(p.w, root, file.WithWriterProps(props))
+	}
+	if err := checkColumnCount(p.columnNames, batch); err != nil {
+		return err
+	}
+	if len(batch.Rows) == 0 {
+		return nil
+	}
+	if p.rowGroup == nil || p.rowGroupRowCount >= parquetRowGroupSize {
+		// Appending a new row group closes the previous one.
+		p.rowGroup = p.writer.AppendBufferedRowGroup()
+		p.rowGroupRowCount = 0
+	}
+	for i, columnType := range p.columnTypes {
+		columnWriter, err := p.rowGroup.Column(i)
+		if err != nil {
+			return err
+		}
+		if err := writeParquetColumn(columnWriter, columnType, batch.Rows, i); err != nil {
+			return errors.Wrapf(err, "failed to write column %q", p.columnNames[i])
+		}
+	}
+	p.rowGroupRowCount += len(batch.Rows)
+	return nil
+}
+
+func (p *parquetResultWriter) close() error {
+	if p.writer == nil {
+		return nil
+	}
+	return p.writer.Close()
+}
+
+func getParquetSchema(columnNames []string, columnTypes []exportColumnType) (*schema.GroupNode, error) {
+	var fields schema.FieldList
+	// The field names must be unique in the group, the duplicate column names are suffixed with the index.
+	nameCount := make(map[string]int)
+	for i, columnName := range columnNames {
+		name := columnName
+		if count := nameCount[columnName]; count > 0 {
+			name = fmt.Sprintf("%s_%d", columnName, count)
+		}
+		nameCount[columnName]++
+
+		var node schema.Node
+		var err error
+		switch columnTypes[i] {
+		case exportColumnTypeInt:
+			node, err = schema.NewPrimitiveNodeLogical(name, parquet.Repetitions.Optional, schema.NewIntLogicalType(64, true), parquet.Types.Int64, -1, -1)
+		case exportColumnTypeFloat:
+			node = schema.NewFloat64Node(name, parquet.Repetitions.Optional, -1)
+		case exportColumnTypeBool:
+			node = schema.NewBooleanNode(name, parquet.Repetitions.Optional, -1)
+		case exportColumnTypeBytes:
+			node = schema.NewByteArrayNode(name, parquet.Repetitions.Optional, -1)
+		default:
+			node, err = schema.NewPrimitiveNodeLogical(name, parquet.Repetitions.Optional, schema.StringLogicalType{}, parquet.Types.ByteArray, -1, -1)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create the schema of column %q", columnName)
+		}
+		fields = append(fields, node)
+	}
+	return schema.NewGroupNode("schema", parquet.Repetitions.Required, fields, -1)
+}
+
+// writeParquetColumn writes the values of the column at index of the rows. The definition level is 0 for NULL values and 1 otherwise.
+func writeParquetColumn(columnWriter file.ColumnChunkWriter, columnType exportColumnType, rows []*v1pb.QueryRow, index int) error {
+	defLevels := make([]int16, len(rows))
+	var int64Values []int64
+	var float64Values []float64
+	var boolValues []bool
+	var byteArrayValues []parquet.ByteArray
+	for i, row := range rows {
+		v, err := convertRowValue(row.Values[index], columnType)
+		if err != nil {
+			return err
+		}
+		if v == nil {
+			continue
+		}
+		defLevels[i] = 1
+		switch v := v.(type) {
+		case int64:
+			int64Values = append(int64Values, v)
+		case float64:
+			float64Values = append(float64Values, v)
+		case bool:
+			boolValues = append(boolValues, v)
+		case []byte:
+			byteArrayValues = append(byteArrayValues, v)
+		case string:
+			byteArrayValues = append(byteArrayValues, parquet.ByteArray(v))
+		}
+	}
+
+	var err error
+	switch w := columnWriter.(type) {
+	case *file.Int64ColumnChunkWriter:
+		_, err = w.WriteBatch(int64Values, defLevels, nil)
+	case *file.Float64ColumnChunkWriter:
+		_, err = w.WriteBatch(float64Values, defLevels, nil)
+	case *file.BooleanColumnChunkWriter:
+		_, err = w.WriteBatch(boolValues, defLevels, nil)
+	case *file.ByteArrayColumnChunkWriter:
+		_, err = w.WriteBatch(byteArrayValues, defLevels, nil)
+	default:
+		err = errors.Errorf("unexpected column writer %T", columnWriter)
+	}
+	return err
+}
+
+// exportColumnType is the type of the column in the typed export formats, such as Parquet and NDJSON.
+type exportColumnType int
+
+const (
+	exportColumnTypeString exportColumnType = iota
+	exportColumnTypeInt
+	exportColumnTypeFloat
+	exportColumnTypeBool
+	exportColumnTypeBytes
+)
+
+var (
+	exportIntTypes = map[string]bool{
+		"INT": true, "INTEGER": true, "INT1": true, "INT2": true, "INT4": true, "INT8": true,
+		"TINYINT": true, "SMALLINT": true, "MEDIUMINT": true, "BIGINT": true,
+		"SERIAL": true, "SMALLSERIAL": true, "BIGSERIAL": true,
+		"UNSIGNED TINYINT": true, "UNSIGNED SMALLINT": true, "UNSIGNED MEDIUMINT": true, "UNSIGNED INT": true,
+		"INT16": true, "INT32": true, "INT64": true, "UINT8": true, "UINT16": true, "UINT32": true,
+	}
+	exportFloatTypes = map[string]bool{
+		"FLOAT": true, "FLOAT4": true, "FLOAT8": true, "FLOAT32": true, "FLOAT64": true,
+		"REAL": true, "DOUBLE": true, "DOUBLE PRECISION": true, "BINARY_FLOAT": true, "BINARY_DOUBLE": true,
+	}
+	exportBoolTypes = map[string]bool{
+		"BOOL": true, "BOOLEAN": true,
+	}
+	exportBytesTypes = map[string]bool{
+		"BYTEA": true, "BLOB": true, "TINYBLOB": true, "MEDIUMBLOB": true, "LONGBLOB": true,
+		"BINARY": true, "VARBINARY": true, "IMAGE": true, "RAW": true, "LONG RAW": true, "BYTES": true,
+		"BIT": true, "VARBIT": true,
+	}
+)
+
+// getExportColumnTypes returns the export column types of the result.
+// The masked columns are strings because the masked values are not of the column type.
+// DECIMAL, NUMERIC and UNSIGNED BIGINT are exported as strings to avoid losing precision.
+func getExportColumnTypes(result *v1pb.QueryResult) []exportColumnType {
+	var columnTypes []exportColumnType
+	for i := range result.ColumnNames {
+		if i < len(result.Masked) && result.Masked[i] {
+			columnTypes = append(columnTypes, exportColumnTypeString)
+			continue
+		}
+		var typeName string
+		if i < len(result.ColumnTypeNames) {
+			typeName = normalizeExportColumnTypeName(result.ColumnTypeNames[i])
+		}
+		switch {
+		case exportIntTypes[typeName]:
+			columnTypes = append(columnTypes, exportColumnTypeInt)
+		case exportFloatTypes[typeName]:
+			columnTypes = append(columnTypes, exportColumnTypeFloat)
+		case exportBoolTypes[typeName]:
+			columnTypes = append(columnTypes, exportColumnTypeBool)
+		case exportBytesTypes[typeName]:
+			columnTypes = append(columnTypes, exportColumnTypeBytes)
+		default:
+			columnTypes = append(columnTypes, exportColumnTypeString)
+		}
+	}
+	return columnTypes
+}
+
+// normalizeExportColumnTypeName normalizes the column type name, e.g. "Nullable(Int32)" to "INT32" and "varchar(255)" to "VARCHAR".
+func normalizeExportColumnTypeName(typeName string) string {
+	typeName = strings.ToUpper(strings.TrimSpace(typeName))
+	for _, wrapper := range []string{"NULLABLE(", "LOWCARDINALITY("} {
+		if strings.HasPrefix(typeName, wrapper) && strings.HasSuffix(typeName, ")") {
+			typeName = typeName[len(wrapper) : len(typeName)-1]
+		}
+	}
+	if i := strings.Index(typeName, "("); i >= 0 {
+		typeName = typeName[:i]
+	}
+	return strings.TrimSpace(typeName)
+}
+
+// convertRowValue converts the row value to the Go value of the export column type, which is one of
+// int64, float64, bool, []byte and string. It returns nil for NULL.
+func convertRowValue(value *v1pb.RowValue, columnType exportColumnType) (any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	if _, ok := value.Kind.(*v1pb.RowValue_NullValue); ok {
+		return nil, nil
+	}
+	if v, ok := value.Kind.(*v1pb.RowValue_ValueValue); ok {
+		// This is used by ClickHouse and Spanner only.
+		if _, ok := v.ValueValue.GetKind().(*structpb.Value_NullValue); ok {
+			return nil, nil
+		}
+	}
+
+	switch columnType {
+	case exportColumnTypeInt:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_Int32Value:
+			return int64(v.Int32Value), nil
+		case *v1pb.RowValue_Int64Value:
+			return v.Int64Value, nil
+		case *v1pb.RowValue_Uint32Value:
+			return int64(v.Uint32Value), nil
+		case *v1pb.RowValue_Uint64Value:
+			if v.Uint64Value > math.MaxInt64 {
+				return nil, errors.Errorf("integer %d overflows int64", v.Uint64Value)
+			}
+			return int64(v.Uint64Value), nil
+		case *v1pb.RowValue_ValueValue:
+			if n, ok := v.ValueValue.GetKind().(*structpb.Value_NumberValue); ok && n.NumberValue == math.Trunc(n.NumberValue) {
+				return int64(n.NumberValue), nil
+			}
+		}
+		return strconv.ParseInt(getRowValueString(value), 10, 64)
+	case exportColumnTypeFloat:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_FloatValue:
+			return float64(v.FloatValue), nil
+		case *v1pb.RowValue_DoubleValue:
+			return v.DoubleValue, nil
+		case *v1pb.RowValue_ValueValue:
+			if n, ok := v.ValueValue.GetKind().(*structpb.Value_NumberValue); ok {
+				return n.NumberValue, nil
+			}
+		}
+		return strconv.ParseFloat(getRowValueString(value), 64)
+	case exportColumnTypeBool:
+		switch v := value.Kind.(type) {
+		case *v1pb.RowValue_BoolValue:
+			return v.BoolValue, nil
+		case *v1pb.RowValue_ValueValue:
+			if b, ok := v.ValueValue.GetKind().(*structpb.Value_BoolValue); ok {
+				return b.BoolValue, nil
+			}
+		}
+		return strconv.ParseBool(getRowValueString(value))
+	case exportColumnTypeBytes:
+		if v, ok := value.Kind.(*v1pb.RowValue_BytesValue); ok {
+			return v.BytesValue, nil
+		}
+		return []byte(getRowValueString(value)), nil
+	default:
+		if v, ok := value.Kind.(*v1pb.RowValue_BytesValue); ok {
+			// Keep the bytes in the string columns readable.
+			return base64.StdEncoding.EncodeToString(v.BytesValue), nil
+		}
+		return getRowValueString(value), nil
+	}
+}
+
+// getRowValueString returns the string representation of the non-NULL row value.
+func getRowValueString(value *v1pb.RowValue) string {
+	if v, ok := value.Kind.(*v1pb.RowValue_ValueValue); ok {
+		if s, ok := v.ValueValue.GetKind().(*structpb.Value_StringValue); ok {
+			return s.StringValue
+		}
+	}
+	return convertValueToStringInJSON(value)
+}
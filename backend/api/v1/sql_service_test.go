@@ -1,10 +1,14 @@
 package v1
 
 import (
+	"bytes"
 	"testing"
 
+	"github.com/apache/arrow/go/v12/parquet"
+	"github.com/apache/arrow/go/v12/parquet/file"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
 	"google.golang.org/genproto/googleapis/type/expr"
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -445,3 +449,186 @@ func TestEvalMaskingLevelOfColumn(t *testing.T) {
 		a.Equal(tc.want, result, tc.description)
 	}
 }
+
+func TestResultWriter(t *testing.T) {
+	batches := []*v1pb.QueryResult{
+		{
+			ColumnNames:     []string{"id", "name", "score"},
+			ColumnTypeNames: []string{"INT", "VARCHAR", "DOUBLE"},
+			Rows: []*v1pb.QueryRow{
+				{
+					Values: []*v1pb.RowValue{
+						{Kind: &v1pb.RowValue_Int64Value{Int64Value: 1}},
+						{Kind: &v1pb.RowValue_StringValue{StringValue: "a\"b"}},
+						{Kind: &v1pb.RowValue_StringValue{StringValue: "1.5"}},
+					},
+				},
+			},
+		},
+		{
+			ColumnNames:     []string{"id", "name", "score"},
+			ColumnTypeNames: []string{"INT", "VARCHAR", "DOUBLE"},
+			Rows: []*v1pb.QueryRow{
+				{
+					Values: []*v1pb.RowValue{
+						{Kind: &v1pb.RowValue_Int64Value{Int64Value: 9007199254740993}},
+						{Kind: &v1pb.RowValue_NullValue{}},
+						{Kind: &v1pb.RowValue_NullValue{}},
+					},
+				},
+			},
+		},
+		// The final result without rows.
+		{
+			ColumnNames:     []string{"id", "name", "score"},
+			ColumnTypeNames: []string{"INT", "VARCHAR", "DOUBLE"},
+		},
+	}
+	tests := []struct {
+		format v1pb.ExportRequest_Format
+		want   string
+	}{
+		{
+			format: v1pb.ExportRequest_CSV,
+			want:   "id,name,score\n1,\"a\"\"b\",\"1.5\"\n9007199254740993,,",
+		},
+		{
+			format: v1pb.ExportRequest_JSON,
+			want:   "[\n  {\n    \"id\": \"1\",\n    \"name\": \"a\\\"b\",\n    \"score\": \"1.5\"\n  },\n  {\n    \"id\": \"9007199254740993\",\n    \"name\": \"null\",\n    \"score\": \"null\"\n  }\n]",
+		},
+		{
+			format: v1pb.ExportRequest_NDJSON,
+			want:   "{\"id\":1,\"name\":\"a\\\"b\",\"score\":1.5}\n{\"id\":9007199254740993,\"name\":null,\"score\":null}\n",
+		},
+	}
+	a := require.New(t)
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		writer, err := newResultWriter(test.format, db.MySQL, nil, &buf)
+		a.NoError(err)
+		for _, batch := range batches {
+			a.NoError(writer.write(batch))
+		}
+		a.NoError(writer.close())
+		a.Equal(test.want, buf.String(), test.format.String())
+	}
+}
+
+func TestParquetResultWriter(t *testing.T) {
+	a := require.New(t)
+
+	var buf bytes.Buffer
+	writer, err := newResultWriter(v1pb.ExportRequest_PARQUET, db.MySQL, nil, &buf)
+	a.NoError(err)
+	a.NoError(writer.write(&v1pb.QueryResult{
+		ColumnNames:     []string{"id", "email"},
+		ColumnTypeNames: []string{"BIGINT", "VARCHAR"},
+		Masked:          []bool{false, true},
+		Rows: []*v1pb.QueryRow{
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "42"}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				},
+			},
+			{
+				Values: []*v1pb.RowValue{
+					{Kind: &v1pb.RowValue_NullValue{}},
+					{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+				},
+			},
+		},
+	}))
+	a.NoError(writer.close())
+
+	reader, err := file.NewParquetReader(bytes.NewReader(buf.Bytes()))
+	a.NoError(err)
+	defer reader.Close()
+	a.Equal(int64(2), reader.NumRows())
+	a.Equal(parquet.Types.Int64, reader.MetaData().Schema.Column(0).PhysicalType())
+	a.Equal(parquet.Types.ByteArray, reader.MetaData().Schema.Column(1).PhysicalType())
+
+	columnReader, err := reader.RowGroup(0).Column(0)
+	a.NoError(err)
+	values := make([]int64, 2)
+	defLevels := make([]int16, 2)
+	total, valuesRead, err := columnReader.(*file.Int64ColumnChunkReader).ReadBatch(2, values, defLevels, nil)
+	a.NoError(err)
+	a.Equal(int64(2), total)
+	a.Equal(1, valuesRead)
+	a.Equal(int64(42), values[0])
+	a.Equal([]int16{1, 0}, defLevels)
+}
+
+func TestChunkWriter(t *testing.T) {
+	a := require.New(t)
+
+	var chunks [][]byte
+	writer := newChunkWriter(func(chunk []byte) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	content := bytes.Repeat([]byte("a"), exportChunkSize*2+10)
+	n, err := writer.Write(content[:10])
+	a.NoError(err)
+	a.Equal(10, n)
+	// Nothing is sent before a chunk is full.
+	a.Empty(chunks)
+	n, err = writer.Write(content[10:])
+	a.NoError(err)
+	a.Equal(exportChunkSize*2, n)
+	a.Len(chunks, 2)
+	a.NoError(writer.flush())
+	a.Len(chunks, 3)
+	a.Len(chunks[0], exportChunkSize)
+	a.Len(chunks[1], exportChunkSize)
+	a.Len(chunks[2], 10)
+	a.Equal(content, bytes.Join(chunks, nil))
+	// Flushing an empty buffer sends nothing.
+	a.NoError(writer.flush())
+	a.Len(chunks, 3)
+}
+
+func TestGetExportColumnTypes(t *testing.T) {
+	a := require.New(t)
+
+	got := getExportColumnTypes(&v1pb.QueryResult{
+		ColumnNames:     []string{"a", "b", "c", "d", "e", "f", "g"},
+		ColumnTypeNames: []string{"Nullable(Int32)", "DECIMAL", "FLOAT8", "BOOL", "BYTEA", "UNSIGNED BIGINT", "INT"},
+		Masked:          []bool{false, false, false, false, false, false, true},
+	})
+	a.Equal([]exportColumnType{
+		exportColumnTypeInt,
+		exportColumnTypeString,
+		exportColumnTypeFloat,
+		exportColumnTypeBool,
+		exportColumnTypeBytes,
+		exportColumnTypeString,
+		exportColumnTypeString,
+	}, got)
+}
+
+func TestXLSXResultWriter(t *testing.T) {
+	a := require.New(t)
+
+	var buf bytes.Buffer
+	writer, err := newResultWriter(v1pb.ExportRequest_XLSX, db.MySQL, nil, &buf)
+	a.NoError(err)
+	for _, name := range []string{"alice", "bob"} {
+		a.NoError(writer.write(&v1pb.QueryResult{
+			ColumnNames: []string{"name"},
+			Rows: []*v1pb.QueryRow{
+				{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_StringValue{StringValue: name}}}},
+			},
+		}))
+	}
+	a.NoError(writer.close())
+
+	f, err := excelize.OpenReader(&buf)
+	a.NoError(err)
+	defer f.Close()
+	rows, err := f.GetRows(sheet1Name)
+	a.NoError(err)
+	a.Equal([][]string{{"name"}, {"alice"}, {"bob"}}, rows)
+}
@@ -0,0 +1,155 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/xo/dburl"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/differ"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
+
+	// Register mysql differ driver.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/differ/mysql"
+	// Register postgres differ driver.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/differ/pg"
+	// Register mysql transform driver.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/transform/mysql"
+)
+
+func newDiffCmd() *cobra.Command {
+	var (
+		from   string
+		to     string
+		engine string
+	)
+	diffCmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Prints the DDL to migrate the schema of --from to the schema of --to.",
+		// Diff failures are mostly about the schemas, the usage doesn't help there.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			ctx := context.Background()
+			dbType, err := getDiffEngine(engine, from, to)
+			if err != nil {
+				return err
+			}
+			fromSchema, err := loadSchema(ctx, dbType, from)
+			if err != nil {
+				return err
+			}
+			toSchema, err := loadSchema(ctx, dbType, to)
+			if err != nil {
+				return err
+			}
+			diff, err := differ.SchemaDiff(convertToParserEngine(dbType), fromSchema, toSchema, false /* ignoreCaseSensitive */)
+			if err != nil {
+				return errors.Wrap(err, "failed to compute schema diff")
+			}
+			_, err = fmt.Fprint(cmd.OutOrStdout(), diff)
+			return err
+		},
+	}
+
+	diffCmd.Flags().StringVar(&from, "from", "", "Source schema. Either a DSN or a SQL file.")
+	diffCmd.Flags().StringVar(&to, "to", "", "Target schema. Either a DSN or a SQL file.")
+	diffCmd.Flags().StringVar(&engine, "engine", "", engineUsage+" Inferred from the DSN if unspecified.")
+	if err := diffCmd.MarkFlagRequired("from"); err != nil {
+		panic(err)
+	}
+	if err := diffCmd.MarkFlagRequired("to"); err != nil {
+		panic(err)
+	}
+	return diffCmd
+}
+
+// isDSN reports whether the schema source is a database connection string rather than a file.
+func isDSN(source string) bool {
+	return strings.Contains(source, "://")
+}
+
+// getDiffEngine returns the engine from the flag, or from the first DSN among the schema sources.
+func getDiffEngine(engine string, sources ...string) (db.Type, error) {
+	if engine != "" {
+		return parseEngine(engine)
+	}
+	for _, source := range sources {
+		if !isDSN(source) {
+			continue
+		}
+		u, err := dburl.Parse(source)
+		if err != nil {
+			return "", errors.Wrap(err, "failed to parse dsn")
+		}
+		return parseEngine(u.Driver)
+	}
+	return "", errors.New("--engine is required when both --from and --to are files")
+}
+
+// loadSchema reads the schema from a SQL file, or dumps it from the database if the source is a DSN.
+func loadSchema(ctx context.Context, dbType db.Type, source string) (string, error) {
+	schema, err := readSchema(ctx, dbType, source)
+	if err != nil {
+		return "", err
+	}
+	if convertToParserEngine(dbType) == parser.MySQL {
+		// The MySQL differ expects the SDL format rather than the raw dump, and the files are
+		// transformed the same way so that both sides of the diff are in the same format.
+		if schema, err = transform.SchemaTransform(parser.MySQL, schema); err != nil {
+			return "", errors.Wrap(err, "failed to transform schema to SDL format")
+		}
+	}
+	return schema, nil
+}
+
+// readSchema returns the content of the schema file, or the schema dump of the database if the source is a DSN.
+func readSchema(ctx context.Context, dbType db.Type, source string) (string, error) {
+	if !isDSN(source) {
+		content, err := os.ReadFile(source)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to read schema file %q", source)
+		}
+		return string(content), nil
+	}
+
+	u, err := dburl.Parse(source)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse dsn")
+	}
+	driverType, err := parseEngine(u.Driver)
+	if err != nil {
+		return "", err
+	}
+	if convertToParserEngine(driverType) != convertToParserEngine(dbType) {
+		return "", errors.Errorf("dsn engine %q doesn't match engine %q", driverType, dbType)
+	}
+	driver, err := open(ctx, u)
+	if err != nil {
+		return "", err
+	}
+	defer driver.Close(ctx)
+
+	var buf bytes.Buffer
+	if _, err := driver.Dump(ctx, &buf, true /* schemaOnly */); err != nil {
+		return "", errors.Wrap(err, "failed to dump schema")
+	}
+	return buf.String(), nil
+}
+
+func convertToParserEngine(dbType db.Type) parser.EngineType {
+	switch dbType {
+	case db.Postgres:
+		return parser.Postgres
+	case db.TiDB:
+		return parser.TiDB
+	default:
+		return parser.MySQL
+	}
+}
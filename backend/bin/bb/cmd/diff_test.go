@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/db"
+)
+
+func TestLoadSchemaFile(t *testing.T) {
+	a := require.New(t)
+	file := filepath.Join(t.TempDir(), "schema.sql")
+	// The schema file is in the dump format, with the session variables and the indexes defined in the table.
+	a.NoError(os.WriteFile(file, []byte("SET character_set_client = utf8mb4;\nCREATE TABLE t (a INT, INDEX idx_a (a));"), 0600))
+
+	// MySQL schema files are transformed to the SDL format like the dumps.
+	schema, err := loadSchema(context.Background(), db.MySQL, file)
+	a.NoError(err)
+	a.NotContains(schema, "SET character_set_client")
+	a.Contains(schema, "CREATE INDEX `idx_a` ON `t`")
+
+	// Other engines use the schema file as is.
+	content := "CREATE TABLE t (a INT);"
+	a.NoError(os.WriteFile(file, []byte(content), 0600))
+	schema, err = loadSchema(context.Background(), db.Postgres, file)
+	a.NoError(err)
+	a.Equal(content, schema)
+}
@@ -0,0 +1,284 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	"github.com/bytebase/bytebase/backend/plugin/advisor/catalog"
+	advisorDB "github.com/bytebase/bytebase/backend/plugin/advisor/db"
+
+	// Register mysql advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/mysql"
+	// Register postgresql advisor.
+	_ "github.com/bytebase/bytebase/backend/plugin/advisor/pg"
+)
+
+const (
+	lintFormatText  = "text"
+	lintFormatJSON  = "json"
+	lintFormatSARIF = "sarif"
+)
+
+func newLintCmd() *cobra.Command {
+	var (
+		fileList      []string
+		config        string
+		engine        string
+		format        string
+		failOnWarning bool
+	)
+	lintCmd := &cobra.Command{
+		Use:   "lint [file...]",
+		Short: "Checks SQL files against the SQL review rules without a Bytebase server.",
+		// Lint failures are reported through the output, the usage doesn't help there.
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// Positional files are accepted as well, which is how pre-commit passes the staged files.
+			fileList = append(fileList, args...)
+			if len(fileList) == 0 {
+				return errors.New("no SQL file to check, specify the files by --file or arguments")
+			}
+			engineType, err := parseEngine(engine)
+			if err != nil {
+				return err
+			}
+			dbType, err := advisorDB.ConvertToAdvisorDBType(string(engineType))
+			if err != nil {
+				return err
+			}
+			ruleList, err := loadSQLReviewRules(config)
+			if err != nil {
+				return err
+			}
+			resultList, err := lintFiles(context.Background(), dbType, ruleList, fileList)
+			if err != nil {
+				return err
+			}
+			if err := writeLintResults(cmd.OutOrStdout(), format, resultList); err != nil {
+				return err
+			}
+
+			var errorCount, warningCount int
+			for _, result := range resultList {
+				switch result.Status {
+				case advisor.Error:
+					errorCount++
+				case advisor.Warn:
+					warningCount++
+				}
+			}
+			if errorCount > 0 || (failOnWarning && warningCount > 0) {
+				return errors.Errorf("found %d error(s) and %d warning(s)", errorCount, warningCount)
+			}
+			return nil
+		},
+	}
+
+	lintCmd.Flags().StringSliceVarP(&fileList, "file", "f", []string{}, "SQL file to check.")
+	lintCmd.Flags().StringVar(&config, "config", "", "SQL review config in the format of sql-review.*.yaml. Use the bb.sql-review.prod template if unspecified.")
+	lintCmd.Flags().StringVar(&engine, "engine", "mysql", engineUsage)
+	lintCmd.Flags().StringVar(&format, "format", lintFormatText, "Output format, one of text, json and sarif.")
+	lintCmd.Flags().BoolVar(&failOnWarning, "fail-on-warning", false, "Exit with non-zero status on warnings as well as errors.")
+	return lintCmd
+}
+
+// lintResult is a SQL review advice found in a file.
+type lintResult struct {
+	File string `json:"file"`
+	advisor.Advice
+}
+
+// emptyCatalog is the catalog without any database metadata, because lint runs without a database.
+type emptyCatalog struct {
+	finder *catalog.Finder
+}
+
+// GetFinder implements the catalog.Catalog interface.
+func (c *emptyCatalog) GetFinder() *catalog.Finder {
+	return c.finder
+}
+
+func loadSQLReviewRules(config string) ([]*advisor.SQLReviewRule, error) {
+	if config == "" {
+		return advisor.MergeSQLReviewRules(&advisor.SQLReviewConfigOverride{Template: "bb.sql-review.prod"})
+	}
+	content, err := os.ReadFile(config)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read config file %q", config)
+	}
+	ruleList, err := advisor.UnmarshalSQLReviewRules(content)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load config file %q", config)
+	}
+	return ruleList, nil
+}
+
+func lintFiles(ctx context.Context, dbType advisorDB.Type, ruleList []*advisor.SQLReviewRule, fileList []string) ([]*lintResult, error) {
+	var resultList []*lintResult
+	for _, file := range fileList {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read file %q", file)
+		}
+		// Each file is checked on its own, so the catalog must not carry the objects created by the previous files.
+		adviceList, err := advisor.SQLReviewCheck(string(content), ruleList, advisor.SQLReviewCheckContext{
+			DbType: dbType,
+			Catalog: &emptyCatalog{
+				finder: catalog.NewEmptyFinder(&catalog.FinderContext{CheckIntegrity: false, EngineType: dbType}),
+			},
+			Context: ctx,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to check file %q", file)
+		}
+		for _, advice := range adviceList {
+			if advice.Status == advisor.Success {
+				continue
+			}
+			resultList = append(resultList, &lintResult{File: file, Advice: advice})
+		}
+	}
+	return resultList, nil
+}
+
+func writeLintResults(out io.Writer, format string, resultList []*lintResult) error {
+	switch format {
+	case lintFormatText:
+		for _, result := range resultList {
+			if _, err := fmt.Fprintf(out, "%s:%d:%d: %s %s: %s\n", result.File, result.Line, result.Column, result.Status, result.Title, result.Content); err != nil {
+				return err
+			}
+		}
+		return nil
+	case lintFormatJSON:
+		if resultList == nil {
+			resultList = []*lintResult{}
+		}
+		return writeJSON(out, resultList)
+	case lintFormatSARIF:
+		return writeJSON(out, convertToSARIF(resultList))
+	default:
+		return errors.Errorf("output format %q not supported; supported formats: text, json, sarif", format)
+	}
+}
+
+func writeJSON(out io.Writer, v any) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// sarifLog is the subset of SARIF 2.1.0 used to report the lint results to code scanning tools.
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Version        string      `json:"version"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           sarifRegion           `json:"region"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+func convertToSARIF(resultList []*lintResult) *sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{
+			Driver: sarifDriver{
+				Name:           "bb",
+				InformationURI: "https://www.bytebase.com",
+				Version:        version,
+				Rules:          []sarifRule{},
+			},
+		},
+		Results: []sarifResult{},
+	}
+	ruleSet := make(map[string]bool)
+	for _, result := range resultList {
+		// The title of a SQL review advice is the rule type.
+		if !ruleSet[result.Title] {
+			ruleSet[result.Title] = true
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: result.Title})
+		}
+		level := "note"
+		switch result.Status {
+		case advisor.Error:
+			level = "error"
+		case advisor.Warn:
+			level = "warning"
+		}
+		// SARIF lines are 1-based, while some advices don't carry a line.
+		line := result.Line
+		if line < 1 {
+			line = 1
+		}
+		run.Results = append(run.Results, sarifResult{
+			RuleID:  result.Title,
+			Level:   level,
+			Message: sarifMessage{Text: result.Content},
+			Locations: []sarifLocation{
+				{
+					PhysicalLocation: sarifPhysicalLocation{
+						ArtifactLocation: sarifArtifactLocation{URI: result.File},
+						Region:           sarifRegion{StartLine: line, StartColumn: result.Column},
+					},
+				},
+			},
+		})
+	}
+	return &sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Runs:    []sarifRun{run},
+	}
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor"
+	advisorDB "github.com/bytebase/bytebase/backend/plugin/advisor/db"
+)
+
+func TestLintFiles(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "migration.sql")
+	require.NoError(t, os.WriteFile(file, []byte("CREATE TABLE t (a INT);\nDELETE FROM t;"), 0600))
+
+	ruleList, err := advisor.UnmarshalSQLReviewRules([]byte(`
+ruleList:
+  - type: table.require-pk
+    level: ERROR
+  - type: statement.where.require
+    level: WARNING
+`))
+	require.NoError(t, err)
+
+	resultList, err := lintFiles(context.Background(), advisorDB.MySQL, ruleList, []string{file})
+	require.NoError(t, err)
+	require.Len(t, resultList, 2)
+	require.Equal(t, advisor.Error, resultList[0].Status)
+	require.Equal(t, string(advisor.SchemaRuleTableRequirePK), resultList[0].Title)
+	require.Equal(t, 1, resultList[0].Line)
+	require.Equal(t, advisor.Warn, resultList[1].Status)
+	require.Equal(t, string(advisor.SchemaRuleStatementRequireWhere), resultList[1].Title)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeLintResults(&buf, lintFormatSARIF, resultList))
+	log := &sarifLog{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), log))
+	require.Len(t, log.Runs, 1)
+	require.Len(t, log.Runs[0].Tool.Driver.Rules, 2)
+	require.Len(t, log.Runs[0].Results, 2)
+	require.Equal(t, "error", log.Runs[0].Results[0].Level)
+	require.Equal(t, "warning", log.Runs[0].Results[1].Level)
+	require.Equal(t, file, log.Runs[0].Results[1].Locations[0].PhysicalLocation.ArtifactLocation.URI)
+	require.Equal(t, resultList[1].Line, log.Runs[0].Results[1].Locations[0].PhysicalLocation.Region.StartLine)
+
+	buf.Reset()
+	require.NoError(t, writeLintResults(&buf, lintFormatJSON, nil))
+	require.Equal(t, "[]\n", buf.String())
+	require.Error(t, writeLintResults(&buf, "xml", nil))
+}
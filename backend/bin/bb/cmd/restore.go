@@ -8,12 +8,15 @@ import (
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/xo/dburl"
+
+	"github.com/bytebase/bytebase/backend/plugin/storage/backupfile"
 )
 
 func newRestoreCmd() *cobra.Command {
 	var (
-		dsn  string
-		file string
+		dsn               string
+		file              string
+		encryptionKeyFile string
 	)
 	restoreCmd := &cobra.Command{
 		Use:   "restore",
@@ -23,11 +26,18 @@ func newRestoreCmd() *cobra.Command {
 			if err != nil {
 				return errors.Wrap(err, "failed to parse dsn")
 			}
-			return restoreDatabase(context.Background(), u, file)
+			var keyWrapper backupfile.KeyWrapper
+			if encryptionKeyFile != "" {
+				if keyWrapper, err = backupfile.LoadWorkspaceKeyFile(encryptionKeyFile); err != nil {
+					return err
+				}
+			}
+			return restoreDatabase(context.Background(), u, file, keyWrapper)
 		},
 	}
 	restoreCmd.Flags().StringVar(&dsn, "dsn", "", dsnUsage)
 	restoreCmd.Flags().StringVar(&file, "file", "", "File to store the dump.")
+	restoreCmd.Flags().StringVar(&encryptionKeyFile, "encryption-key-file", "", "File of the backup encryption key, required to restore the backups encrypted by the Bytebase server.")
 	if err := restoreCmd.MarkFlagRequired("file"); err != nil {
 		panic(err)
 	}
@@ -36,12 +46,18 @@ func newRestoreCmd() *cobra.Command {
 }
 
 // restoreDatabase restores the schema of a database instance.
-func restoreDatabase(ctx context.Context, u *dburl.URL, file string) error {
+// The backup files compressed or encrypted by the Bytebase server are decoded transparently.
+func restoreDatabase(ctx context.Context, u *dburl.URL, file string, keyWrapper backupfile.KeyWrapper) error {
 	f, err := os.Open(file)
 	if err != nil {
 		return errors.Wrapf(err, "failed to open file %q", file)
 	}
 	defer f.Close()
+	content, err := backupfile.NewReader(ctx, f, keyWrapper)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read backup file %q", file)
+	}
+	defer content.Close()
 
 	db, err := open(ctx, u)
 	if err != nil {
@@ -49,7 +65,7 @@ func restoreDatabase(ctx context.Context, u *dburl.URL, file string) error {
 	}
 	defer db.Close(ctx)
 
-	if err := db.Restore(ctx, f); err != nil {
+	if err := db.Restore(ctx, content); err != nil {
 		return errors.Wrapf(err, "failed to restore from backup file %q", file)
 	}
 	return nil
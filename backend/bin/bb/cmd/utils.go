@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/xo/dburl"
@@ -11,6 +12,8 @@ import (
 	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
 	"github.com/bytebase/bytebase/backend/resources/postgres"
 
+	// install mongodb driver.
+	_ "github.com/bytebase/bytebase/backend/plugin/db/mongodb"
 	// install mysql driver.
 	_ "github.com/bytebase/bytebase/backend/plugin/db/mysql"
 	// register pg driver.
@@ -18,6 +21,16 @@ import (
 	// install pg driver.
 )
 
+func init() {
+	// dburl doesn't recognize the mongodb scheme by default.
+	dburl.Register(dburl.Scheme{
+		Driver:    "mongodb",
+		Generator: dburl.GenFromURL("mongodb://localhost:27017/"),
+		Transport: dburl.TransportTCP,
+		Aliases:   []string{"mongo"},
+	})
+}
+
 func getDatabase(u *dburl.URL) string {
 	if u.Path == "" {
 		return ""
@@ -25,6 +38,24 @@ func getDatabase(u *dburl.URL) string {
 	return u.Path[1:]
 }
 
+// parseEngine converts the engine name used by the CLI flags and DSN drivers to the database type.
+func parseEngine(engine string) (db.Type, error) {
+	switch strings.ToLower(engine) {
+	case "mysql":
+		return db.MySQL, nil
+	case "mariadb":
+		return db.MariaDB, nil
+	case "tidb":
+		return db.TiDB, nil
+	case "oceanbase":
+		return db.OceanBase, nil
+	case "postgres", "postgresql", "pg":
+		return db.Postgres, nil
+	default:
+		return "", errors.Errorf("engine %q not supported; supported engines: mysql, mariadb, tidb, oceanbase, postgres", engine)
+	}
+}
+
 func open(ctx context.Context, u *dburl.URL) (db.Driver, error) {
 	var dbType db.Type
 	var dbBinDir string
@@ -44,8 +75,11 @@ func open(ctx context.Context, u *dburl.URL) (db.Driver, error) {
 			return nil, errors.Wrapf(err, "cannot install postgres in directory %q", resourceDir)
 		}
 		dbBinDir = dir
+	case "mongodb":
+		// MongoDB dump and restore are implemented natively and don't require any binaries.
+		dbType = db.MongoDB
 	default:
-		return nil, errors.Errorf("database type %q not supported; supported types: mysql, pg", u.Driver)
+		return nil, errors.Errorf("database type %q not supported; supported types: mysql, pg, mongodb", u.Driver)
 	}
 	passwd, _ := u.User.Password()
 	driver, err := db.Open(
@@ -60,6 +94,8 @@ func open(ctx context.Context, u *dburl.URL) (db.Driver, error) {
 			Username: u.User.Username(),
 			Password: passwd,
 			Database: getDatabase(u),
+			// authSource is only used by MongoDB.
+			AuthenticationDatabase: u.Query().Get("authSource"),
 			TLSConfig: db.TLSConfig{
 				SslCA:   u.Query().Get("ssl-ca"),
 				SslCert: u.Query().Get("ssl-cert"),
@@ -11,8 +11,10 @@ import (
 
 func getBaseProfile(dataDir string) config.Profile {
 	backupStorageBackend := api.BackupStorageBackendLocal
-	if flags.backupBucket != "" {
-		backupStorageBackend = api.BackupStorageBackendS3
+	backupBucket := ""
+	if backend, bucket, ok := parseBackupBucket(flags.backupBucket); ok {
+		backupStorageBackend = backend
+		backupBucket = bucket
 	}
 
 	sampleDatabasePort := 0
@@ -26,6 +28,8 @@ func getBaseProfile(dataDir string) config.Profile {
 		GrpcPort:                  flags.port + 1, // Using flags.port + 1 as our gRPC server port.
 		DatastorePort:             flags.port + 2, // Using flags.port + 2 as our datastore port.
 		SampleDatabasePort:        sampleDatabasePort,
+		SQLProxyMySQLPort:         flags.sqlProxyMySQLPort,
+		SQLProxyPostgresPort:      flags.sqlProxyPostgresPort,
 		Readonly:                  flags.readonly,
 		SaaS:                      flags.saas,
 		DataDir:                   dataDir,
@@ -37,8 +41,9 @@ func getBaseProfile(dataDir string) config.Profile {
 		PgURL:                     flags.pgURL,
 		BackupStorageBackend:      backupStorageBackend,
 		BackupRegion:              flags.backupRegion,
-		BackupBucket:              flags.backupBucket,
+		BackupBucket:              backupBucket,
 		BackupCredentialFile:      flags.backupCredential,
+		BackupEncryptionKeyFile:   flags.backupEncryptionKeyFile,
 		FeishuAPIURL:              feishu.APIPath,
 		LastActiveTs:              time.Now().Unix(),
 		DevelopmentUseV2Scheduler: flags.developmentUseV2Scheduler,
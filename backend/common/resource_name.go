@@ -30,9 +30,11 @@ const (
 	TaskRunPrefix                = "taskRuns/"
 	PlanPrefix                   = "plans/"
 	PlanCheckRunPrefix           = "planCheckRuns/"
+	PlanScheduleOccurrencePrefix = "occurrences/"
 	RolePrefix                   = "roles/"
 	SecretNamePrefix             = "secrets/"
 	WebhookIDPrefix              = "webhooks/"
+	WebhookDeliveryPrefix        = "deliveries/"
 	SheetIDPrefix                = "sheets/"
 	DatabaseGroupNamePrefix      = "databaseGroups/"
 	SchemaGroupNamePrefix        = "schemaGroups/"
@@ -86,6 +88,15 @@ func GetProjectIDWebhookID(name string) (string, string, error) {
 	return tokens[0], tokens[1], nil
 }
 
+// GetProjectIDWebhookIDDeliveryID returns the project ID, webhook ID and delivery ID from a resource name.
+func GetProjectIDWebhookIDDeliveryID(name string) (string, string, string, error) {
+	tokens, err := GetNameParentTokens(name, ProjectNamePrefix, WebhookIDPrefix, WebhookDeliveryPrefix)
+	if err != nil {
+		return "", "", "", err
+	}
+	return tokens[0], tokens[1], tokens[2], nil
+}
+
 // GetUIDFromName returns the UID from a resource name.
 func GetUIDFromName(name, prefix string) (int, error) {
 	tokens, err := GetNameParentTokens(name, prefix)
@@ -14,7 +14,6 @@ import (
 	"github.com/nyaruka/phonenumbers"
 	"google.golang.org/protobuf/encoding/protojson"
 
-	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/webhook"
@@ -122,8 +121,8 @@ func (m *Manager) BatchCreateActivitiesForRunTasks(ctx context.Context, tasks []
 		CreatorName:  user.Name,
 		CreatorEmail: user.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
+	// Queue the deliveries instead of calling the external webhook endpoints, which could block the web serving thread.
+	m.createWebhookDeliveries(ctx, &webhookCtx, webhookList)
 
 	return nil
 }
@@ -207,8 +206,8 @@ func (m *Manager) BatchCreateActivitiesForSkipTasks(ctx context.Context, tasks [
 		CreatorName:  user.Name,
 		CreatorEmail: user.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
+	// Queue the deliveries instead of calling the external webhook endpoints, which could block the web serving thread.
+	m.createWebhookDeliveries(ctx, &webhookCtx, webhookList)
 
 	return nil
 }
@@ -292,8 +291,8 @@ func (m *Manager) BatchCreateActivitiesForCancelTaskRuns(ctx context.Context, ta
 		CreatorName:  user.Name,
 		CreatorEmail: user.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
+	// Queue the deliveries instead of calling the external webhook endpoints, which could block the web serving thread.
+	m.createWebhookDeliveries(ctx, &webhookCtx, webhookList)
 
 	return nil
 }
@@ -376,8 +375,8 @@ func (m *Manager) BatchCreateTaskStatusUpdateApprovalActivity(ctx context.Contex
 		CreatorName:  user.Name,
 		CreatorEmail: user.Email,
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, &webhookCtx, webhookList)
+	// Queue the deliveries instead of calling the external webhook endpoints, which could block the web serving thread.
+	m.createWebhookDeliveries(ctx, &webhookCtx, webhookList)
 
 	return nil
 }
@@ -428,31 +427,36 @@ func (m *Manager) CreateActivity(ctx context.Context, create *store.ActivityMess
 			zap.Error(err))
 		return activity, nil
 	}
-	// Call external webhook endpoint in Go routine to avoid blocking web serving thread.
-	go postWebhookList(ctx, webhookCtx, webhookList)
+	// Queue the deliveries instead of calling the external webhook endpoints, which could block the web serving thread.
+	m.createWebhookDeliveries(ctx, webhookCtx, webhookList)
 
 	return activity, nil
 }
 
-func postWebhookList(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
+// createWebhookDeliveries queues the webhook deliveries of the event, which are posted by the webhook delivery runner.
+func (m *Manager) createWebhookDeliveries(ctx context.Context, webhookCtx *webhook.Context, webhookList []*store.ProjectWebhookMessage) {
+	webhookCtx.CreatedTs = time.Now().Unix()
+	payload, err := json.Marshal(webhookCtx)
+	if err != nil {
+		log.Warn("Failed to marshal webhook context",
+			zap.String("activity type", webhookCtx.ActivityType),
+			zap.String("title", webhookCtx.Title),
+			zap.Error(err))
+		return
+	}
+	var creates []*store.WebhookDeliveryMessage
 	for _, hook := range webhookList {
-		webhookCtx := *webhookCtx
-		webhookCtx.URL = hook.URL
-		webhookCtx.CreatedTs = time.Now().Unix()
-		go func(webhookCtx *webhook.Context, hook *store.ProjectWebhookMessage) {
-			if err := common.Retry(ctx, func() error {
-				return webhook.Post(hook.Type, *webhookCtx)
-			}); err != nil {
-				// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning
-				log.Warn("Failed to post webhook event on activity",
-					zap.String("webhook type", hook.Type),
-					zap.String("webhook name", hook.Title),
-					zap.String("activity type", webhookCtx.ActivityType),
-					zap.String("title", webhookCtx.Title),
-					zap.Error(err))
-				return
-			}
-		}(&webhookCtx, hook)
+		creates = append(creates, &store.WebhookDeliveryMessage{
+			WebhookUID:   hook.ID,
+			ActivityType: webhookCtx.ActivityType,
+			Payload:      string(payload),
+		})
+	}
+	if _, err := m.store.CreateWebhookDeliveries(ctx, creates...); err != nil {
+		log.Warn("Failed to create webhook deliveries",
+			zap.String("activity type", webhookCtx.ActivityType),
+			zap.String("title", webhookCtx.Title),
+			zap.Error(err))
 	}
 }
 
@@ -0,0 +1,149 @@
+// Package classifier detects the classifications of the columns by their names and sampled values.
+package classifier
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	defaultSampleSize = 100
+	maxSampleSize     = 10000
+	defaultMatchRatio = 0.8
+)
+
+// Classifier detects the classifications of the columns with the discovery of a data classification config.
+type Classifier struct {
+	mode       storepb.DataClassificationSetting_DataClassificationConfig_Discovery_Mode
+	sampleSize int
+	matchRatio float64
+	detectors  []*detector
+}
+
+type detector struct {
+	classificationID  string
+	columnNamePattern *regexp.Regexp
+	// match is nil for the detectors matching the column names only.
+	match func(value string) bool
+}
+
+// NewClassifier creates the classifier with the discovery of the data classification config.
+// It returns nil if the discovery is disabled.
+func NewClassifier(config *storepb.DataClassificationSetting_DataClassificationConfig) (*Classifier, error) {
+	discovery := config.GetDiscovery()
+	if discovery == nil || discovery.Mode == storepb.DataClassificationSetting_DataClassificationConfig_Discovery_MODE_UNSPECIFIED {
+		return nil, nil
+	}
+	if discovery.SampleSize < 0 || discovery.SampleSize > maxSampleSize {
+		return nil, errors.Errorf("sample size must be between 0 and %d", maxSampleSize)
+	}
+	if discovery.MatchRatio < 0 || discovery.MatchRatio > 1 {
+		return nil, errors.Errorf("match ratio must be between 0 and 1")
+	}
+	classifier := &Classifier{
+		mode:       discovery.Mode,
+		sampleSize: int(discovery.SampleSize),
+		matchRatio: discovery.MatchRatio,
+	}
+	if classifier.sampleSize == 0 {
+		classifier.sampleSize = defaultSampleSize
+	}
+	if classifier.matchRatio == 0 {
+		classifier.matchRatio = defaultMatchRatio
+	}
+	for i, d := range discovery.Detectors {
+		detector, err := newDetector(config, d)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid detector #%d", i+1)
+		}
+		classifier.detectors = append(classifier.detectors, detector)
+	}
+	return classifier, nil
+}
+
+func newDetector(config *storepb.DataClassificationSetting_DataClassificationConfig, d *storepb.DataClassificationSetting_DataClassificationConfig_Detector) (*detector, error) {
+	if _, ok := config.Classification[d.ClassificationId]; !ok {
+		return nil, errors.Errorf("classification %q not found", d.ClassificationId)
+	}
+	result := &detector{classificationID: d.ClassificationId}
+	if d.ColumnNamePattern != "" {
+		pattern, err := regexp.Compile(d.ColumnNamePattern)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid column name pattern %q", d.ColumnNamePattern)
+		}
+		result.columnNamePattern = pattern
+	}
+	switch d.Type {
+	case storepb.DataClassificationSetting_DataClassificationConfig_Detector_TYPE_UNSPECIFIED:
+		if result.columnNamePattern == nil {
+			return nil, errors.Errorf("column name pattern must be set for the detector without type")
+		}
+	case storepb.DataClassificationSetting_DataClassificationConfig_Detector_EMAIL:
+		result.match = isEmail
+	case storepb.DataClassificationSetting_DataClassificationConfig_Detector_NATIONAL_ID:
+		result.match = isNationalID
+	case storepb.DataClassificationSetting_DataClassificationConfig_Detector_CARD_NUMBER:
+		result.match = isCardNumber
+	case storepb.DataClassificationSetting_DataClassificationConfig_Detector_PHONE:
+		result.match = isPhone
+	case storepb.DataClassificationSetting_DataClassificationConfig_Detector_REGEX:
+		if d.ValuePattern == "" {
+			return nil, errors.Errorf("value pattern must be set for the REGEX detector")
+		}
+		pattern, err := regexp.Compile("^(?:" + d.ValuePattern + ")$")
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value pattern %q", d.ValuePattern)
+		}
+		result.match = pattern.MatchString
+	case storepb.DataClassificationSetting_DataClassificationConfig_Detector_DICTIONARY:
+		if len(d.Words) == 0 {
+			return nil, errors.Errorf("words must be set for the DICTIONARY detector")
+		}
+		words := make(map[string]bool)
+		for _, word := range d.Words {
+			words[strings.ToLower(strings.TrimSpace(word))] = true
+		}
+		result.match = func(value string) bool {
+			return words[strings.ToLower(value)]
+		}
+	default:
+		return nil, errors.Errorf("unsupported detector type %s", d.Type)
+	}
+	return result, nil
+}
+
+// Mode returns the discovery mode.
+func (c *Classifier) Mode() storepb.DataClassificationSetting_DataClassificationConfig_Discovery_Mode {
+	return c.mode
+}
+
+// SampleSize returns the number of the rows sampled from each table.
+func (c *Classifier) SampleSize() int {
+	return c.sampleSize
+}
+
+// Classify returns the classification ID of the column detected by its name and the sampled non-NULL values, or "" if no detector matches.
+func (c *Classifier) Classify(columnName string, values []string) string {
+	for _, d := range c.detectors {
+		if d.columnNamePattern != nil && d.columnNamePattern.MatchString(columnName) {
+			return d.classificationID
+		}
+		if d.match == nil || len(values) == 0 {
+			continue
+		}
+		matched := 0
+		for _, value := range values {
+			if d.match(strings.TrimSpace(value)) {
+				matched++
+			}
+		}
+		if float64(matched) >= c.matchRatio*float64(len(values)) {
+			return d.classificationID
+		}
+	}
+	return ""
+}
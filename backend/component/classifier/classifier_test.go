@@ -0,0 +1,136 @@
+package classifier
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestDetectors(t *testing.T) {
+	tests := []struct {
+		match func(string) bool
+		value string
+		want  bool
+	}{
+		{isEmail, "john.doe@example.com", true},
+		{isEmail, "john.doe+tag@mail.example.co.uk", true},
+		{isEmail, "john.doe@localhost", false},
+		{isEmail, "not an email", false},
+		{isNationalID, "11010519491231002X", true},
+		{isNationalID, "11010519491231002x", true},
+		{isNationalID, "440524188001010014", true},
+		// Invalid check digit.
+		{isNationalID, "110105194912310021", false},
+		{isNationalID, "123-45-6789", true},
+		{isNationalID, "666-45-6789", false},
+		{isNationalID, "123456789", false},
+		{isCardNumber, "4111111111111111", true},
+		{isCardNumber, "4111 1111 1111 1111", true},
+		{isCardNumber, "5500-0000-0000-0004", true},
+		// Failed Luhn check.
+		{isCardNumber, "4111111111111112", false},
+		{isCardNumber, "411111111111", false},
+		{isPhone, "+1 (555) 123-4567", true},
+		{isPhone, "13812345678", true},
+		{isPhone, "123456", false},
+		{isPhone, "+1234567890123456", false},
+		{isPhone, "2023-05-17", false},
+		{isPhone, "555-CALL", false},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, test.match(test.value), test.value)
+	}
+}
+
+func TestClassify(t *testing.T) {
+	a := require.New(t)
+	config := &storepb.DataClassificationSetting_DataClassificationConfig{
+		Classification: map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+			"1-1":   {Id: "1-1", Title: "Email"},
+			"1-2":   {Id: "1-2", Title: "Card"},
+			"1-3":   {Id: "1-3", Title: "Status"},
+			"1-4":   {Id: "1-4", Title: "Salary"},
+			"1-5-1": {Id: "1-5-1", Title: "Employee ID"},
+		},
+		Discovery: &storepb.DataClassificationSetting_DataClassificationConfig_Discovery{
+			Mode: storepb.DataClassificationSetting_DataClassificationConfig_Discovery_PROPOSE,
+			Detectors: []*storepb.DataClassificationSetting_DataClassificationConfig_Detector{
+				{
+					Type:              storepb.DataClassificationSetting_DataClassificationConfig_Detector_EMAIL,
+					ClassificationId:  "1-1",
+					ColumnNamePattern: "(?i)e_?mail",
+				},
+				{
+					Type:             storepb.DataClassificationSetting_DataClassificationConfig_Detector_CARD_NUMBER,
+					ClassificationId: "1-2",
+				},
+				{
+					Type:             storepb.DataClassificationSetting_DataClassificationConfig_Detector_DICTIONARY,
+					ClassificationId: "1-3",
+					Words:            []string{"Active", "Inactive"},
+				},
+				{
+					ClassificationId:  "1-4",
+					ColumnNamePattern: "(?i)salary",
+				},
+				{
+					Type:             storepb.DataClassificationSetting_DataClassificationConfig_Detector_REGEX,
+					ClassificationId: "1-5-1",
+					ValuePattern:     `E\d{6}`,
+				},
+			},
+		},
+	}
+	classifier, err := NewClassifier(config)
+	a.NoError(err)
+	a.Equal(defaultSampleSize, classifier.SampleSize())
+
+	tests := []struct {
+		column string
+		values []string
+		want   string
+	}{
+		{"email", nil, "1-1"},
+		{"contact", []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com", "not an email"}, "1-1"},
+		{"contact", []string{"a@example.com", "not an email"}, ""},
+		{"payment", []string{"4111111111111111", " 5500 0000 0000 0004 "}, "1-2"},
+		{"status", []string{"active", "INACTIVE", "Active"}, "1-3"},
+		{"base_salary", []string{"100"}, "1-4"},
+		{"employee", []string{"E000001", "E000002"}, "1-5-1"},
+		// The REGEX detector matches the whole value.
+		{"employee", []string{"E0000011", "xE000002"}, ""},
+		{"name", []string{"alice", "bob"}, ""},
+	}
+	for _, test := range tests {
+		a.Equal(test.want, classifier.Classify(test.column, test.values), "%s: %v", test.column, test.values)
+	}
+}
+
+func TestNewClassifierValidation(t *testing.T) {
+	a := require.New(t)
+	classification := map[string]*storepb.DataClassificationSetting_DataClassificationConfig_DataClassification{
+		"1-1": {Id: "1-1", Title: "Email"},
+	}
+
+	classifier, err := NewClassifier(&storepb.DataClassificationSetting_DataClassificationConfig{Classification: classification})
+	a.NoError(err)
+	a.Nil(classifier)
+
+	for _, discovery := range []*storepb.DataClassificationSetting_DataClassificationConfig_Discovery{
+		{SampleSize: -1},
+		{MatchRatio: 1.5},
+		{Detectors: []*storepb.DataClassificationSetting_DataClassificationConfig_Detector{{Type: storepb.DataClassificationSetting_DataClassificationConfig_Detector_EMAIL, ClassificationId: "9-9"}}},
+		{Detectors: []*storepb.DataClassificationSetting_DataClassificationConfig_Detector{{ClassificationId: "1-1"}}},
+		{Detectors: []*storepb.DataClassificationSetting_DataClassificationConfig_Detector{{ClassificationId: "1-1", ColumnNamePattern: "("}}},
+		{Detectors: []*storepb.DataClassificationSetting_DataClassificationConfig_Detector{{Type: storepb.DataClassificationSetting_DataClassificationConfig_Detector_REGEX, ClassificationId: "1-1"}}},
+		{Detectors: []*storepb.DataClassificationSetting_DataClassificationConfig_Detector{{Type: storepb.DataClassificationSetting_DataClassificationConfig_Detector_DICTIONARY, ClassificationId: "1-1"}}},
+	} {
+		discovery.Mode = storepb.DataClassificationSetting_DataClassificationConfig_Discovery_APPLY
+		_, err := NewClassifier(&storepb.DataClassificationSetting_DataClassificationConfig{Classification: classification, Discovery: discovery})
+		a.Error(err, "discovery %v", discovery)
+	}
+}
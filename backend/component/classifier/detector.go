@@ -0,0 +1,86 @@
+package classifier
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	emailReg        = regexp.MustCompile(`^[A-Za-z0-9.!#$%&'*+/=?^_{|}~-]+@[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?(?:\.[A-Za-z0-9](?:[A-Za-z0-9-]{0,61}[A-Za-z0-9])?)+$`)
+	chinaIDReg      = regexp.MustCompile(`^[1-9]\d{5}(?:18|19|20)\d{2}(?:0[1-9]|1[0-2])(?:0[1-9]|[12]\d|3[01])\d{3}[\dXx]$`)
+	usSSNReg        = regexp.MustCompile(`^(\d{3})-(\d{2})-(\d{4})$`)
+	cardNumberReg   = regexp.MustCompile(`^\d[\d -]*\d$`)
+	phoneReg        = regexp.MustCompile(`^\+?[\d ().-]+$`)
+	dateReg         = regexp.MustCompile(`^\d{4}[-./]\d{1,2}[-./]\d{1,2}$`)
+	chinaIDWeights  = []int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+	chinaIDCheckMap = "10X98765432"
+)
+
+// isEmail returns true if the value is an email address.
+func isEmail(value string) bool {
+	return emailReg.MatchString(value)
+}
+
+// isNationalID returns true if the value is a resident identity card number of China with the valid check digit,
+// or a social security number of the US in the AAA-GG-SSSS format.
+func isNationalID(value string) bool {
+	if chinaIDReg.MatchString(value) {
+		sum := 0
+		for i, weight := range chinaIDWeights {
+			sum += int(value[i]-'0') * weight
+		}
+		return strings.ToUpper(value[17:]) == string(chinaIDCheckMap[sum%11])
+	}
+	if matches := usSSNReg.FindStringSubmatch(value); matches != nil {
+		area, group, serial := matches[1], matches[2], matches[3]
+		// The numbers in these ranges are never assigned.
+		return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+	}
+	return false
+}
+
+// isCardNumber returns true if the value is a payment card number of 13 to 19 digits passing the Luhn check.
+// The digits can be separated by spaces or dashes.
+func isCardNumber(value string) bool {
+	if !cardNumberReg.MatchString(value) {
+		return false
+	}
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(value)
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+	return luhnValid(digits)
+}
+
+// luhnValid returns true if the digits pass the Luhn check.
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// isPhone returns true if the value is a phone number of 7 to 15 digits, optionally with a leading "+" and separators.
+func isPhone(value string) bool {
+	// The dates are in the same characters as phone numbers.
+	if !phoneReg.MatchString(value) || dateReg.MatchString(value) {
+		return false
+	}
+	digits := 0
+	for _, r := range value {
+		if r >= '0' && r <= '9' {
+			digits++
+		}
+	}
+	return digits >= 7 && digits <= 15
+}
@@ -22,6 +22,10 @@ type Profile struct {
 	SampleDatabasePort int
 	// GrpcPort is the binding port for gRPC server.
 	GrpcPort int
+	// SQLProxyMySQLPort is the binding port for the MySQL SQL proxy. 0 means disabled.
+	SQLProxyMySQLPort int
+	// SQLProxyPostgresPort is the binding port for the PostgreSQL SQL proxy. 0 means disabled.
+	SQLProxyPostgresPort int
 	// PgUser is the user we use to connect to bytebase's Postgres database.
 	// The name of the database storing metadata is the same as pgUser.
 	PgUser string
@@ -51,6 +55,8 @@ type Profile struct {
 	BackupRegion         string
 	BackupBucket         string
 	BackupCredentialFile string
+	// BackupEncryptionKeyFile is the file of the base64 encoded 256-bit key encrypting the backups. Backups are not encrypted if it is empty.
+	BackupEncryptionKeyFile string
 
 	// IM integration related fields
 	// FeishuAPIURL is the URL of Feishu API server.
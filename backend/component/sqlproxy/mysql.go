@@ -0,0 +1,174 @@
+package sqlproxy
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/go-mysql-org/go-mysql/mysql"
+	"github.com/go-mysql-org/go-mysql/server"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// mysqlServerVersion is the server version reported to the MySQL clients.
+const mysqlServerVersion = "8.0.0-bytebase"
+
+var mysqlEngines = []db.Type{db.MySQL, db.TiDB, db.MariaDB, db.OceanBase}
+
+// mysqlServer is the server configuration of the MySQL proxy.
+// We use mysql_native_password, which is supported by all the MySQL clients without TLS.
+var mysqlServer = server.NewServer(mysqlServerVersion, mysql.DEFAULT_COLLATION_ID, mysql.AUTH_NATIVE_PASSWORD, nil, nil)
+
+func (p *Proxy) serveMySQL(ctx context.Context, conn net.Conn) {
+	h := &mysqlHandler{ctx: ctx, session: &session{proxy: p}, host: getClientHost(conn)}
+	c, err := server.NewCustomizedConn(conn, mysqlServer, h, h)
+	if err != nil {
+		var myErr *mysql.MyError
+		if errors.As(err, &myErr) && myErr.Code == mysql.ER_ACCESS_DENIED_ERROR {
+			p.loginThrottle.fail(h.host)
+		}
+		log.Debug("Failed to establish MySQL proxy connection", zap.String("remote", conn.RemoteAddr().String()), zap.Error(err))
+		return
+	}
+	for !c.Closed() {
+		if err := c.HandleCommand(); err != nil {
+			return
+		}
+	}
+}
+
+// mysqlHandler is both the credential provider and the command handler of a MySQL proxy connection.
+type mysqlHandler struct {
+	ctx     context.Context
+	session *session
+	// host is the client host, whose logins are throttled.
+	host string
+	// pendingDatabase is the database in the handshake, which is resolved after the user is authenticated.
+	pendingDatabase string
+}
+
+// CheckUsername implements server.CredentialProvider.
+func (h *mysqlHandler) CheckUsername(username string) (bool, error) {
+	if _, err := h.session.proxy.getUser(h.ctx, username); err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+// GetCredential implements server.CredentialProvider.
+// The handshake compares the password scrambled by the client with the one returned here.
+// The login is denied without checking the password if the client host has too many failed logins.
+func (h *mysqlHandler) GetCredential(username string) (string, bool, error) {
+	if !h.session.proxy.loginThrottle.allow(h.host) {
+		return "", false, nil
+	}
+	user, err := h.session.proxy.getUser(h.ctx, username)
+	if err != nil {
+		return "", false, nil
+	}
+	h.session.user = user
+	return GetPassword(h.session.proxy.secret, user), true, nil
+}
+
+// UseDB implements server.Handler.
+// The database is "{instance}/{database}", or a database of the current instance.
+func (h *mysqlHandler) UseDB(dbName string) error {
+	if h.session.user == nil {
+		// The handshake reads the database before authenticating the user.
+		h.pendingDatabase = dbName
+		return nil
+	}
+	if h.session.instance != nil && !strings.Contains(dbName, "/") {
+		h.session.database = dbName
+		return nil
+	}
+	instance, database, err := h.session.proxy.getInstanceAndDatabase(h.ctx, dbName, mysqlEngines...)
+	if err != nil {
+		return mysql.NewError(mysql.ER_BAD_DB_ERROR, err.Error())
+	}
+	h.session.instance, h.session.database = instance, database
+	return nil
+}
+
+// HandleQuery implements server.Handler.
+func (h *mysqlHandler) HandleQuery(query string) (*mysql.Result, error) {
+	if _, ok := getSessionStatementTag(query); ok {
+		return &mysql.Result{}, nil
+	}
+	if h.pendingDatabase != "" {
+		pendingDatabase := h.pendingDatabase
+		h.pendingDatabase = ""
+		if err := h.UseDB(pendingDatabase); err != nil {
+			return nil, err
+		}
+	}
+	if h.session.instance == nil {
+		return nil, mysql.NewError(mysql.ER_NO_DB_ERROR, "No database selected, use the database {instance}/{database}")
+	}
+	results, err := h.session.query(h.ctx, query)
+	if err != nil {
+		if isPermissionDenied(err) {
+			return nil, mysql.NewError(mysql.ER_DBACCESS_DENIED_ERROR, getErrorMessage(err))
+		}
+		return nil, mysql.NewError(mysql.ER_UNKNOWN_ERROR, getErrorMessage(err))
+	}
+	switch len(results) {
+	case 0:
+		return &mysql.Result{}, nil
+	case 1:
+	default:
+		return nil, mysql.NewError(mysql.ER_NOT_SUPPORTED_YET, "Multiple statements are not supported by the SQL proxy")
+	}
+	resultset, err := convertToMySQLResultset(results[0])
+	if err != nil {
+		return nil, mysql.NewError(mysql.ER_UNKNOWN_ERROR, err.Error())
+	}
+	return &mysql.Result{Resultset: resultset}, nil
+}
+
+// HandleFieldList implements server.Handler.
+func (*mysqlHandler) HandleFieldList(string, string) ([]*mysql.Field, error) {
+	return nil, mysql.NewError(mysql.ER_NOT_SUPPORTED_YET, "COM_FIELD_LIST is not supported by the SQL proxy")
+}
+
+// HandleStmtPrepare implements server.Handler.
+func (*mysqlHandler) HandleStmtPrepare(string) (int, int, any, error) {
+	return 0, 0, nil, mysql.NewError(mysql.ER_NOT_SUPPORTED_YET, "Prepared statements are not supported by the SQL proxy")
+}
+
+// HandleStmtExecute implements server.Handler.
+func (*mysqlHandler) HandleStmtExecute(any, string, []any) (*mysql.Result, error) {
+	return nil, mysql.NewError(mysql.ER_NOT_SUPPORTED_YET, "Prepared statements are not supported by the SQL proxy")
+}
+
+// HandleStmtClose implements server.Handler.
+func (*mysqlHandler) HandleStmtClose(any) error {
+	return nil
+}
+
+// HandleOtherCommand implements server.Handler.
+func (*mysqlHandler) HandleOtherCommand(cmd byte, _ []byte) error {
+	return mysql.NewError(mysql.ER_NOT_SUPPORTED_YET, fmt.Sprintf("Command %d is not supported by the SQL proxy", cmd))
+}
+
+func convertToMySQLResultset(result *v1pb.QueryResult) (*mysql.Resultset, error) {
+	var rows [][]any
+	for _, row := range result.Rows {
+		var values []any
+		for _, value := range row.Values {
+			if text, ok := convertRowValue(value); ok {
+				values = append(values, text)
+			} else {
+				values = append(values, nil)
+			}
+		}
+		rows = append(rows, values)
+	}
+	return mysql.BuildSimpleTextResultset(result.ColumnNames, rows)
+}
@@ -0,0 +1,461 @@
+package sqlproxy
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+
+	"github.com/jackc/pgproto3/v2"
+	"github.com/pkg/errors"
+	"github.com/xdg-go/scram"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// postgresServerVersion is the server version reported to the PostgreSQL clients.
+	postgresServerVersion = "14.0"
+	// postgresTextOID is the OID of the text type. We send all the values in the text format.
+	postgresTextOID = 25
+	// scramMechanism is the SASL mechanism to authenticate the PostgreSQL clients, the password is never sent over the connection.
+	scramMechanism = "SCRAM-SHA-256"
+	// scramIterations is the iteration count of SCRAM, which is the default of PostgreSQL.
+	scramIterations = 4096
+)
+
+var postgresEngines = []db.Type{db.Postgres, db.Redshift, db.RisingWave}
+
+// postgresConn is a PostgreSQL proxy connection.
+// It supports the simple query protocol, and the extended query protocol without parameters.
+type postgresConn struct {
+	backend *pgproto3.Backend
+	session *session
+
+	// statements are the prepared statements by name.
+	statements map[string]string
+	// portals are the bound portals by name.
+	portals map[string]*postgresPortal
+}
+
+type postgresPortal struct {
+	statement string
+	// result is set if the portal is run by Describe before Execute.
+	result *v1pb.QueryResult
+}
+
+// postgresError is the error sent to the client.
+type postgresError struct {
+	code    string
+	message string
+}
+
+func (e *postgresError) Error() string {
+	return e.message
+}
+
+func (p *Proxy) servePostgres(ctx context.Context, conn net.Conn) {
+	c := &postgresConn{
+		backend:    pgproto3.NewBackend(pgproto3.NewChunkReader(conn), conn),
+		session:    &session{proxy: p},
+		statements: make(map[string]string),
+		portals:    make(map[string]*postgresPortal),
+	}
+	if err := c.startup(ctx, conn); err != nil {
+		log.Debug("Failed to establish PostgreSQL proxy connection", zap.String("remote", conn.RemoteAddr().String()), zap.Error(err))
+		return
+	}
+	if err := c.serve(ctx); err != nil {
+		log.Debug("PostgreSQL proxy connection closed", zap.String("remote", conn.RemoteAddr().String()), zap.Error(err))
+	}
+}
+
+func (c *postgresConn) startup(ctx context.Context, conn net.Conn) error {
+	var startupMessage *pgproto3.StartupMessage
+	for startupMessage == nil {
+		msg, err := c.backend.ReceiveStartupMessage()
+		if err != nil {
+			return err
+		}
+		switch msg := msg.(type) {
+		case *pgproto3.SSLRequest, *pgproto3.GSSEncRequest:
+			// Neither SSL nor GSS encryption is supported, the client will continue without encryption.
+			if _, err := conn.Write([]byte("N")); err != nil {
+				return err
+			}
+		case *pgproto3.StartupMessage:
+			startupMessage = msg
+		default:
+			return errors.Errorf("unexpected startup message %T", msg)
+		}
+	}
+	email, database := startupMessage.Parameters["user"], startupMessage.Parameters["database"]
+
+	host := getClientHost(conn)
+	if !c.session.proxy.loginThrottle.allow(host) {
+		return c.sendFatal("28000", "too many failed login attempts, try again later")
+	}
+	user, err := c.authenticate(ctx, email)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		c.session.proxy.loginThrottle.fail(host)
+		return c.sendFatal("28P01", fmt.Sprintf("password authentication failed for user %q", email))
+	}
+	instance, databaseName, err := c.session.proxy.getInstanceAndDatabase(ctx, database, postgresEngines...)
+	if err != nil {
+		return c.sendFatal("3D000", err.Error())
+	}
+	if databaseName == "" {
+		return c.sendFatal("3D000", fmt.Sprintf("invalid database %q, the database should be {instance}/{database}", database))
+	}
+	c.session.user, c.session.instance, c.session.database = user, instance, databaseName
+
+	for _, msg := range []pgproto3.BackendMessage{
+		&pgproto3.AuthenticationOk{},
+		&pgproto3.ParameterStatus{Name: "server_version", Value: postgresServerVersion},
+		&pgproto3.ParameterStatus{Name: "server_encoding", Value: "UTF8"},
+		&pgproto3.ParameterStatus{Name: "client_encoding", Value: "UTF8"},
+		&pgproto3.ParameterStatus{Name: "DateStyle", Value: "ISO, MDY"},
+		&pgproto3.ParameterStatus{Name: "integer_datetimes", Value: "on"},
+		&pgproto3.ParameterStatus{Name: "standard_conforming_strings", Value: "on"},
+		&pgproto3.ReadyForQuery{TxStatus: 'I'},
+	} {
+		if err := c.backend.Send(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authenticate authenticates the user of the email, and returns nil if the authentication fails.
+// The error is only returned if the connection fails.
+func (c *postgresConn) authenticate(ctx context.Context, email string) (*store.UserMessage, error) {
+	user, err := c.session.proxy.getUser(ctx, email)
+	if err != nil {
+		// The exchange goes on with a random password, so that the unknown users cannot be told from the wrong passwords.
+		if _, err := c.exchangeSCRAM(randomHex(16)); err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+	ok, err := c.exchangeSCRAM(GetPassword(c.session.proxy.secret, user))
+	if err != nil || !ok {
+		return nil, err
+	}
+	return user, nil
+}
+
+// exchangeSCRAM runs the SCRAM-SHA-256 exchange with the client, and reports whether the client proves the password.
+// The password is never sent over the connection.
+func (c *postgresConn) exchangeSCRAM(password string) (bool, error) {
+	client, err := scram.SHA256.NewClient("", password, "")
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create SCRAM credentials")
+	}
+	// The salt is random for each exchange, since the password is derived rather than stored.
+	credentials := client.GetStoredCredentials(scram.KeyFactors{Salt: randomHex(16), Iters: scramIterations})
+	server, err := scram.SHA256.NewServer(func(string) (scram.StoredCredentials, error) {
+		return credentials, nil
+	})
+	if err != nil {
+		return false, errors.Wrap(err, "failed to create SCRAM server")
+	}
+	conversation := server.NewConversation()
+
+	if err := c.backend.Send(&pgproto3.AuthenticationSASL{AuthMechanisms: []string{scramMechanism}}); err != nil {
+		return false, err
+	}
+	if err := c.backend.SetAuthType(pgproto3.AuthTypeSASL); err != nil {
+		return false, err
+	}
+	msg, err := c.backend.Receive()
+	if err != nil {
+		return false, err
+	}
+	initialResponse, ok := msg.(*pgproto3.SASLInitialResponse)
+	if !ok {
+		return false, errors.Errorf("unexpected message %T, expecting SASL initial response", msg)
+	}
+	if initialResponse.AuthMechanism != scramMechanism {
+		return false, c.sendFatal("28000", fmt.Sprintf("unsupported SASL mechanism %q", initialResponse.AuthMechanism))
+	}
+	serverFirst, err := conversation.Step(string(initialResponse.Data))
+	if err != nil {
+		return false, nil
+	}
+	if err := c.backend.Send(&pgproto3.AuthenticationSASLContinue{Data: []byte(serverFirst)}); err != nil {
+		return false, err
+	}
+	if err := c.backend.SetAuthType(pgproto3.AuthTypeSASLContinue); err != nil {
+		return false, err
+	}
+	msg, err = c.backend.Receive()
+	if err != nil {
+		return false, err
+	}
+	response, ok := msg.(*pgproto3.SASLResponse)
+	if !ok {
+		return false, errors.Errorf("unexpected message %T, expecting SASL response", msg)
+	}
+	serverFinal, err := conversation.Step(string(response.Data))
+	if err != nil || !conversation.Valid() {
+		return false, nil
+	}
+	if err := c.backend.Send(&pgproto3.AuthenticationSASLFinal{Data: []byte(serverFinal)}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// randomHex returns the hex string of n random bytes.
+func randomHex(n int) string {
+	b := make([]byte, n)
+	// crypto/rand.Read never fails on the supported platforms.
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (c *postgresConn) sendFatal(code, message string) error {
+	if err := c.backend.Send(&pgproto3.ErrorResponse{Severity: "FATAL", Code: code, Message: message}); err != nil {
+		return err
+	}
+	return errors.New(message)
+}
+
+func (c *postgresConn) serve(ctx context.Context) error {
+	// skipUntilSync is set if an error occurs in the extended query protocol, and the messages are discarded until Sync.
+	skipUntilSync := false
+	for {
+		msg, err := c.backend.Receive()
+		if err != nil {
+			return err
+		}
+		if skipUntilSync {
+			if _, ok := msg.(*pgproto3.Sync); !ok {
+				continue
+			}
+		}
+
+		var msgErr error
+		switch msg := msg.(type) {
+		case *pgproto3.Terminate:
+			return nil
+		case *pgproto3.Query:
+			if err := c.handleQuery(ctx, msg.String); err != nil {
+				msgErr = err
+			}
+			if err := c.sendError(msgErr); err != nil {
+				return err
+			}
+			if err := c.backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'}); err != nil {
+				return err
+			}
+			continue
+		case *pgproto3.Parse:
+			c.statements[msg.Name] = msg.Query
+			msgErr = c.backend.Send(&pgproto3.ParseComplete{})
+		case *pgproto3.Bind:
+			msgErr = c.handleBind(msg)
+		case *pgproto3.Describe:
+			msgErr = c.handleDescribe(ctx, msg)
+		case *pgproto3.Execute:
+			msgErr = c.handleExecute(ctx, msg)
+		case *pgproto3.Close:
+			if msg.ObjectType == 'S' {
+				delete(c.statements, msg.Name)
+			} else {
+				delete(c.portals, msg.Name)
+			}
+			msgErr = c.backend.Send(&pgproto3.CloseComplete{})
+		case *pgproto3.Sync:
+			skipUntilSync = false
+			msgErr = c.backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+		case *pgproto3.Flush:
+		default:
+			msgErr = &postgresError{code: "0A000", message: fmt.Sprintf("message %T is not supported by the SQL proxy", msg)}
+		}
+		if msgErr != nil {
+			var pgErr *postgresError
+			if !errors.As(msgErr, &pgErr) {
+				// Failed to write to the client.
+				return msgErr
+			}
+			if err := c.sendError(msgErr); err != nil {
+				return err
+			}
+			skipUntilSync = true
+		}
+	}
+}
+
+// handleQuery handles the simple query, which may contain multiple statements.
+func (c *postgresConn) handleQuery(ctx context.Context, statement string) error {
+	if tag, ok := getSessionStatementTag(statement); ok {
+		return c.backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(tag)})
+	}
+	results, err := c.query(ctx, statement)
+	if err != nil {
+		return err
+	}
+	if len(results) == 0 {
+		return c.backend.Send(&pgproto3.EmptyQueryResponse{})
+	}
+	for _, result := range results {
+		if err := c.sendRowDescription(result); err != nil {
+			return err
+		}
+		if err := c.sendRows(result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *postgresConn) handleBind(msg *pgproto3.Bind) error {
+	statement, ok := c.statements[msg.PreparedStatement]
+	if !ok {
+		return &postgresError{code: "26000", message: fmt.Sprintf("prepared statement %q does not exist", msg.PreparedStatement)}
+	}
+	if len(msg.Parameters) > 0 {
+		return &postgresError{code: "0A000", message: "parameters are not supported by the SQL proxy"}
+	}
+	c.portals[msg.DestinationPortal] = &postgresPortal{statement: statement}
+	return c.backend.Send(&pgproto3.BindComplete{})
+}
+
+// handleDescribe describes the result columns, so it runs the statement and keeps the result for Execute.
+func (c *postgresConn) handleDescribe(ctx context.Context, msg *pgproto3.Describe) error {
+	portal := &postgresPortal{}
+	if msg.ObjectType == 'S' {
+		statement, ok := c.statements[msg.Name]
+		if !ok {
+			return &postgresError{code: "26000", message: fmt.Sprintf("prepared statement %q does not exist", msg.Name)}
+		}
+		if err := c.backend.Send(&pgproto3.ParameterDescription{}); err != nil {
+			return err
+		}
+		portal.statement = statement
+	} else {
+		p, ok := c.portals[msg.Name]
+		if !ok {
+			return &postgresError{code: "34000", message: fmt.Sprintf("portal %q does not exist", msg.Name)}
+		}
+		portal = p
+	}
+	if _, ok := getSessionStatementTag(portal.statement); ok {
+		return c.backend.Send(&pgproto3.NoData{})
+	}
+	result, err := c.queryOne(ctx, portal.statement)
+	if err != nil {
+		return err
+	}
+	if msg.ObjectType != 'S' {
+		portal.result = result
+	}
+	if result == nil {
+		return c.backend.Send(&pgproto3.NoData{})
+	}
+	return c.sendRowDescription(result)
+}
+
+func (c *postgresConn) handleExecute(ctx context.Context, msg *pgproto3.Execute) error {
+	portal, ok := c.portals[msg.Portal]
+	if !ok {
+		return &postgresError{code: "34000", message: fmt.Sprintf("portal %q does not exist", msg.Portal)}
+	}
+	if tag, ok := getSessionStatementTag(portal.statement); ok {
+		return c.backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(tag)})
+	}
+	result := portal.result
+	portal.result = nil
+	if result == nil {
+		r, err := c.queryOne(ctx, portal.statement)
+		if err != nil {
+			return err
+		}
+		if r == nil {
+			return c.backend.Send(&pgproto3.EmptyQueryResponse{})
+		}
+		result = r
+	}
+	return c.sendRows(result)
+}
+
+func (c *postgresConn) query(ctx context.Context, statement string) ([]*v1pb.QueryResult, error) {
+	results, err := c.session.query(ctx, statement)
+	if err != nil {
+		code := "XX000"
+		if isPermissionDenied(err) {
+			code = "42501"
+		}
+		return nil, &postgresError{code: code, message: getErrorMessage(err)}
+	}
+	return results, nil
+}
+
+// queryOne runs a single statement in the extended query protocol, and returns nil if the statement is empty.
+func (c *postgresConn) queryOne(ctx context.Context, statement string) (*v1pb.QueryResult, error) {
+	results, err := c.query(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	switch len(results) {
+	case 0:
+		return nil, nil
+	case 1:
+		return results[0], nil
+	default:
+		return nil, &postgresError{code: "42601", message: "cannot insert multiple commands into a prepared statement"}
+	}
+}
+
+func (c *postgresConn) sendRowDescription(result *v1pb.QueryResult) error {
+	var fields []pgproto3.FieldDescription
+	for _, name := range result.ColumnNames {
+		fields = append(fields, pgproto3.FieldDescription{
+			Name:         []byte(name),
+			DataTypeOID:  postgresTextOID,
+			DataTypeSize: -1,
+			TypeModifier: -1,
+			Format:       0,
+		})
+	}
+	return c.backend.Send(&pgproto3.RowDescription{Fields: fields})
+}
+
+func (c *postgresConn) sendRows(result *v1pb.QueryResult) error {
+	for _, row := range result.Rows {
+		var values [][]byte
+		for _, value := range row.Values {
+			if text, ok := convertRowValue(value); ok {
+				values = append(values, []byte(text))
+			} else {
+				values = append(values, nil)
+			}
+		}
+		if err := c.backend.Send(&pgproto3.DataRow{Values: values}); err != nil {
+			return err
+		}
+	}
+	return c.backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(fmt.Sprintf("SELECT %d", len(result.Rows)))})
+}
+
+// sendError sends the error to the client if it's not nil.
+func (c *postgresConn) sendError(err error) error {
+	if err == nil {
+		return nil
+	}
+	code := "XX000"
+	var pgErr *postgresError
+	if errors.As(err, &pgErr) {
+		code = pgErr.code
+	}
+	return c.backend.Send(&pgproto3.ErrorResponse{Severity: "ERROR", Code: code, Message: err.Error()})
+}
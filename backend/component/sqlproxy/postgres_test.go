@@ -0,0 +1,223 @@
+package sqlproxy
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/jackc/pgproto3/v2"
+	"github.com/stretchr/testify/require"
+	"github.com/xdg-go/scram"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+type fakeQuerier struct {
+	requests []*v1pb.QueryRequest
+}
+
+func (q *fakeQuerier) Query(_ context.Context, request *v1pb.QueryRequest) (*v1pb.QueryResponse, error) {
+	q.requests = append(q.requests, request)
+	if request.Statement == "SELECT * FROM salary" {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied to query salary")
+	}
+	if request.Statement == "SELECT * FROM bonus" {
+		return &v1pb.QueryResponse{
+			Results: []*v1pb.QueryResult{
+				{
+					ColumnNames: []string{"amount"},
+					Rows:        []*v1pb.QueryRow{{Values: []*v1pb.RowValue{{Kind: &v1pb.RowValue_Int32Value{Int32Value: 100}}}}},
+				},
+			},
+		}, nil
+	}
+	return &v1pb.QueryResponse{
+		AllowExport: true,
+		Results: []*v1pb.QueryResult{
+			{
+				ColumnNames: []string{"id", "email"},
+				Rows: []*v1pb.QueryRow{
+					{Values: []*v1pb.RowValue{
+						{Kind: &v1pb.RowValue_Int32Value{Int32Value: 1}},
+						{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}},
+					}},
+					{Values: []*v1pb.RowValue{
+						{Kind: &v1pb.RowValue_Int32Value{Int32Value: 2}},
+						{Kind: &v1pb.RowValue_NullValue{}},
+					}},
+				},
+			},
+		},
+	}, nil
+}
+
+func TestPostgresServe(t *testing.T) {
+	a := require.New(t)
+	querier := &fakeQuerier{}
+	// Use a TCP connection instead of net.Pipe, because the client pipelines the messages of the extended query.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	a.NoError(err)
+	defer listener.Close()
+	clientConn, err := net.Dial("tcp", listener.Addr().String())
+	a.NoError(err)
+	defer clientConn.Close()
+	serverConn, err := listener.Accept()
+	a.NoError(err)
+	c := &postgresConn{
+		backend: pgproto3.NewBackend(pgproto3.NewChunkReader(serverConn), serverConn),
+		session: &session{
+			proxy:    &Proxy{querier: querier},
+			user:     &store.UserMessage{ID: 101},
+			instance: &store.InstanceMessage{ResourceID: "prod-pg"},
+			database: "employee",
+		},
+		statements: make(map[string]string),
+		portals:    make(map[string]*postgresPortal),
+	}
+	done := make(chan error)
+	go func() {
+		done <- c.serve(context.Background())
+		serverConn.Close()
+	}()
+	frontend := pgproto3.NewFrontend(pgproto3.NewChunkReader(clientConn), clientConn)
+
+	// receiveUntilReady returns the messages until ReadyForQuery.
+	receiveUntilReady := func() []pgproto3.BackendMessage {
+		var msgs []pgproto3.BackendMessage
+		for {
+			msg, err := frontend.Receive()
+			a.NoError(err)
+			if _, ok := msg.(*pgproto3.ReadyForQuery); ok {
+				return msgs
+			}
+			// The frontend reuses the received messages, so we copy them.
+			switch msg := msg.(type) {
+			case *pgproto3.DataRow:
+				var values [][]byte
+				for _, v := range msg.Values {
+					if v == nil {
+						values = append(values, nil)
+					} else {
+						values = append(values, append([]byte{}, v...))
+					}
+				}
+				msgs = append(msgs, &pgproto3.DataRow{Values: values})
+			case *pgproto3.CommandComplete:
+				msgs = append(msgs, &pgproto3.CommandComplete{CommandTag: append([]byte{}, msg.CommandTag...)})
+			case *pgproto3.ErrorResponse:
+				msgs = append(msgs, &pgproto3.ErrorResponse{Code: msg.Code, Message: msg.Message})
+			default:
+				msgs = append(msgs, msg)
+			}
+		}
+	}
+
+	// Simple query.
+	a.NoError(frontend.Send(&pgproto3.Query{String: "SELECT id, email FROM employee"}))
+	msgs := receiveUntilReady()
+	a.Len(msgs, 4)
+	a.IsType(&pgproto3.RowDescription{}, msgs[0])
+	a.Equal(&pgproto3.DataRow{Values: [][]byte{[]byte("1"), []byte("******")}}, msgs[1])
+	a.Equal(&pgproto3.DataRow{Values: [][]byte{[]byte("2"), nil}}, msgs[2])
+	a.Equal(&pgproto3.CommandComplete{CommandTag: []byte("SELECT 2")}, msgs[3])
+	a.Equal(&v1pb.QueryRequest{Name: "instances/prod-pg", ConnectionDatabase: "employee", Statement: "SELECT id, email FROM employee", Limit: rowLimit}, querier.requests[0])
+
+	// Session statements are not sent to the database.
+	a.NoError(frontend.Send(&pgproto3.Query{String: "SET search_path TO public"}))
+	a.Equal([]pgproto3.BackendMessage{&pgproto3.CommandComplete{CommandTag: []byte("SET")}}, receiveUntilReady())
+	a.Len(querier.requests, 1)
+
+	// The permission errors are reported to the client.
+	a.NoError(frontend.Send(&pgproto3.Query{String: "SELECT * FROM salary"}))
+	a.Equal([]pgproto3.BackendMessage{&pgproto3.ErrorResponse{Code: "42501", Message: "permission denied to query salary"}}, receiveUntilReady())
+
+	// The results are not sent to the client if the user is not allowed to export them.
+	a.NoError(frontend.Send(&pgproto3.Query{String: "SELECT * FROM bonus"}))
+	msgs = receiveUntilReady()
+	a.Len(msgs, 1)
+	a.Equal("42501", msgs[0].(*pgproto3.ErrorResponse).Code)
+
+	// Extended query, which runs the statement once for Describe and Execute.
+	a.NoError(frontend.Send(&pgproto3.Parse{Query: "SELECT id, email FROM employee"}))
+	a.NoError(frontend.Send(&pgproto3.Bind{}))
+	a.NoError(frontend.Send(&pgproto3.Describe{ObjectType: 'P'}))
+	a.NoError(frontend.Send(&pgproto3.Execute{}))
+	a.NoError(frontend.Send(&pgproto3.Sync{}))
+	msgs = receiveUntilReady()
+	a.Len(msgs, 6)
+	a.IsType(&pgproto3.ParseComplete{}, msgs[0])
+	a.IsType(&pgproto3.BindComplete{}, msgs[1])
+	a.IsType(&pgproto3.RowDescription{}, msgs[2])
+	a.Equal(&pgproto3.CommandComplete{CommandTag: []byte("SELECT 2")}, msgs[5])
+	a.Len(querier.requests, 4)
+
+	// The parameters are not supported, and the messages are skipped until Sync.
+	a.NoError(frontend.Send(&pgproto3.Parse{Query: "SELECT * FROM employee WHERE id = $1"}))
+	a.NoError(frontend.Send(&pgproto3.Bind{Parameters: [][]byte{[]byte("1")}}))
+	a.NoError(frontend.Send(&pgproto3.Execute{}))
+	a.NoError(frontend.Send(&pgproto3.Sync{}))
+	msgs = receiveUntilReady()
+	a.Len(msgs, 2)
+	a.IsType(&pgproto3.ParseComplete{}, msgs[0])
+	a.Equal(&pgproto3.ErrorResponse{Code: "0A000", Message: "parameters are not supported by the SQL proxy"}, msgs[1])
+	a.Len(querier.requests, 4)
+
+	a.NoError(frontend.Send(&pgproto3.Terminate{}))
+	a.NoError(<-done)
+}
+
+func TestPostgresExchangeSCRAM(t *testing.T) {
+	for _, test := range []struct {
+		clientPassword string
+		want           bool
+	}{
+		{clientPassword: "password", want: true},
+		{clientPassword: "wrong-password", want: false},
+	} {
+		a := require.New(t)
+		serverConn, clientConn := net.Pipe()
+		c := &postgresConn{backend: pgproto3.NewBackend(pgproto3.NewChunkReader(serverConn), serverConn)}
+		type result struct {
+			ok  bool
+			err error
+		}
+		done := make(chan result)
+		go func() {
+			ok, err := c.exchangeSCRAM("password")
+			done <- result{ok: ok, err: err}
+		}()
+		frontend := pgproto3.NewFrontend(pgproto3.NewChunkReader(clientConn), clientConn)
+
+		msg, err := frontend.Receive()
+		a.NoError(err)
+		a.Equal([]string{scramMechanism}, msg.(*pgproto3.AuthenticationSASL).AuthMechanisms)
+		client, err := scram.SHA256.NewClient("", test.clientPassword, "")
+		a.NoError(err)
+		conversation := client.NewConversation()
+		clientFirst, err := conversation.Step("")
+		a.NoError(err)
+		a.NoError(frontend.Send(&pgproto3.SASLInitialResponse{AuthMechanism: scramMechanism, Data: []byte(clientFirst)}))
+		msg, err = frontend.Receive()
+		a.NoError(err)
+		clientFinal, err := conversation.Step(string(msg.(*pgproto3.AuthenticationSASLContinue).Data))
+		a.NoError(err)
+		a.NoError(frontend.Send(&pgproto3.SASLResponse{Data: []byte(clientFinal)}))
+		if test.want {
+			msg, err = frontend.Receive()
+			a.NoError(err)
+			// The client verifies the server signature as well.
+			_, err = conversation.Step(string(msg.(*pgproto3.AuthenticationSASLFinal).Data))
+			a.NoError(err)
+			a.True(conversation.Valid())
+		}
+
+		r := <-done
+		a.NoError(r.err)
+		a.Equal(test.want, r.ok, test.clientPassword)
+		serverConn.Close()
+		clientConn.Close()
+	}
+}
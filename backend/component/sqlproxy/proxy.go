@@ -0,0 +1,331 @@
+// Package sqlproxy is the read-only SQL proxy for the native MySQL and PostgreSQL clients, e.g. mysql, psql and DBeaver.
+// The statements go through the same query pipeline as the SQL editor, so that the query rights, the query policies,
+// the data masking and the query activities apply to the native clients as well. A native client can save whatever it
+// receives, so the results are only returned if the user is also allowed to export them.
+package sqlproxy
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/config"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// rowLimit is the max number of rows returned for a statement, which is also evaluated by the query and export policies.
+	rowLimit = 10000
+	// maxLoginFailures is the number of failed logins of a client host before its logins are rejected.
+	maxLoginFailures = 5
+	// loginFailureWindow is how long the failed logins of a client host are remembered since the last failure.
+	loginFailureWindow = 5 * time.Minute
+)
+
+// Querier is the query pipeline of the SQL editor, which is implemented by the SQL service.
+type Querier interface {
+	Query(ctx context.Context, request *v1pb.QueryRequest) (*v1pb.QueryResponse, error)
+}
+
+// Proxy is the SQL proxy.
+type Proxy struct {
+	store        *store.Store
+	secret       string
+	querier      Querier
+	mysqlPort    int
+	postgresPort int
+	// loginThrottle rejects the logins of the client hosts with too many failed logins against password brute force.
+	loginThrottle *loginThrottle
+}
+
+// NewProxy creates a SQL proxy.
+func NewProxy(store *store.Store, secret string, querier Querier, profile *config.Profile) *Proxy {
+	return &Proxy{
+		store:         store,
+		secret:        secret,
+		querier:       querier,
+		mysqlPort:     profile.SQLProxyMySQLPort,
+		postgresPort:  profile.SQLProxyPostgresPort,
+		loginThrottle: newLoginThrottle(),
+	}
+}
+
+// GetPassword returns the password of the user to connect the SQL proxy.
+// It's derived from the server secret and the password hash of the user, so that it changes once the user changes the password.
+func GetPassword(secret string, user *store.UserMessage) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(fmt.Sprintf("sqlproxy/%d/%s", user.ID, user.PasswordHash)))
+	return hex.EncodeToString(mac.Sum(nil)[:16])
+}
+
+// Run starts the enabled listeners, and closes them when the context is done.
+func (p *Proxy) Run(ctx context.Context, wg *sync.WaitGroup) {
+	defer wg.Done()
+
+	var listeners []net.Listener
+	for _, l := range []struct {
+		engine string
+		port   int
+		serve  func(context.Context, net.Conn)
+	}{
+		{engine: "MySQL", port: p.mysqlPort, serve: p.serveMySQL},
+		{engine: "PostgreSQL", port: p.postgresPort, serve: p.servePostgres},
+	} {
+		if l.port == 0 {
+			continue
+		}
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", l.port))
+		if err != nil {
+			log.Error("Failed to start SQL proxy", zap.String("engine", l.engine), zap.Int("port", l.port), zap.Error(err))
+			continue
+		}
+		log.Info(fmt.Sprintf("%s SQL proxy started on port %d", l.engine, l.port))
+		listeners = append(listeners, listener)
+		go accept(ctx, listener, l.serve)
+	}
+	if len(listeners) == 0 {
+		return
+	}
+
+	<-ctx.Done()
+	for _, listener := range listeners {
+		listener.Close()
+	}
+}
+
+func accept(ctx context.Context, listener net.Listener, serve func(context.Context, net.Conn)) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Error("Failed to accept SQL proxy connection", zap.Error(err))
+			}
+			return
+		}
+		go func() {
+			defer conn.Close()
+			serve(ctx, conn)
+		}()
+	}
+}
+
+func (p *Proxy) getUser(ctx context.Context, email string) (*store.UserMessage, error) {
+	email = strings.ToLower(email)
+	user, err := p.store.GetUser(ctx, &store.FindUserMessage{Email: &email})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user %q", email)
+	}
+	if user == nil || user.MemberDeleted || user.Type == api.SystemBot {
+		return nil, errors.Errorf("user %q not found", email)
+	}
+	return user, nil
+}
+
+// getInstanceAndDatabase parses the database name used by the native clients, which is "{instance}/{database}" or "{instance}",
+// and returns the instance and the database name.
+func (p *Proxy) getInstanceAndDatabase(ctx context.Context, name string, engines ...db.Type) (*store.InstanceMessage, string, error) {
+	instanceID, databaseName, err := parseDatabaseName(name)
+	if err != nil {
+		return nil, "", err
+	}
+	find := &store.FindInstanceMessage{ResourceID: &instanceID}
+	if uid, err := strconv.Atoi(instanceID); err == nil {
+		find = &store.FindInstanceMessage{UID: &uid}
+	}
+	instance, err := p.store.GetInstanceV2(ctx, find)
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "failed to get instance %q", instanceID)
+	}
+	if instance == nil {
+		return nil, "", errors.Errorf("instance %q not found", instanceID)
+	}
+	if !isEngineOf(instance.Engine, engines) {
+		return nil, "", errors.Errorf("instance %q is %s, which is not supported by this proxy", instanceID, instance.Engine)
+	}
+	return instance, databaseName, nil
+}
+
+// loginThrottle counts the failed logins of each client host, and rejects the logins of the host once the count reaches
+// maxLoginFailures, until no login fails for loginFailureWindow.
+type loginThrottle struct {
+	mu       sync.Mutex
+	failures map[string]*loginFailure
+}
+
+type loginFailure struct {
+	count int
+	// expireTime is when the failures are forgotten.
+	expireTime time.Time
+}
+
+func newLoginThrottle() *loginThrottle {
+	return &loginThrottle{failures: make(map[string]*loginFailure)}
+}
+
+// allow reports whether the client host is allowed to log in.
+func (t *loginThrottle) allow(host string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	failure, ok := t.failures[host]
+	return !ok || time.Now().After(failure.expireTime) || failure.count < maxLoginFailures
+}
+
+// fail records a failed login of the client host.
+func (t *loginThrottle) fail(host string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	for h, failure := range t.failures {
+		if now.After(failure.expireTime) {
+			delete(t.failures, h)
+		}
+	}
+	failure, ok := t.failures[host]
+	if !ok {
+		failure = &loginFailure{}
+		t.failures[host] = failure
+	}
+	failure.count++
+	failure.expireTime = now.Add(loginFailureWindow)
+}
+
+// getClientHost returns the host of the client, the port is excluded because each connection uses a different port.
+func getClientHost(conn net.Conn) string {
+	addr := conn.RemoteAddr().String()
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	return host
+}
+
+func parseDatabaseName(name string) (string, string, error) {
+	name = strings.TrimPrefix(name, common.InstanceNamePrefix)
+	instanceID, databaseName, _ := strings.Cut(name, "/")
+	databaseName = strings.TrimPrefix(databaseName, common.DatabaseIDPrefix)
+	if instanceID == "" {
+		return "", "", errors.Errorf("invalid database %q, the database should be {instance}/{database}", name)
+	}
+	return instanceID, databaseName, nil
+}
+
+func isEngineOf(engine db.Type, engines []db.Type) bool {
+	for _, e := range engines {
+		if engine == e {
+			return true
+		}
+	}
+	return false
+}
+
+// session is a client session connecting a database via the proxy.
+type session struct {
+	proxy    *Proxy
+	user     *store.UserMessage
+	instance *store.InstanceMessage
+	database string
+}
+
+// query runs the statement as the user of the session in the query pipeline of the SQL editor.
+// It returns a permission denied error instead of the results if the user is not allowed to export them.
+func (s *session) query(ctx context.Context, statement string) ([]*v1pb.QueryResult, error) {
+	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, s.user.ID)
+	response, err := s.proxy.querier.Query(ctx, &v1pb.QueryRequest{
+		Name:               fmt.Sprintf("%s%s", common.InstanceNamePrefix, s.instance.ResourceID),
+		ConnectionDatabase: s.database,
+		Statement:          statement,
+		Limit:              rowLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !response.AllowExport {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied to export the results of the statement, which is required by the SQL proxy")
+	}
+	for _, result := range response.Results {
+		if result.Error != "" {
+			return nil, errors.New(result.Error)
+		}
+	}
+	return response.Results, nil
+}
+
+// getSessionStatementTag returns the command tag if the statement only changes the session state, e.g. SET and BEGIN.
+// The proxy runs every statement on a pooled read-only connection, so it acknowledges these statements without running them.
+func getSessionStatementTag(statement string) (string, bool) {
+	fields := strings.Fields(strings.TrimSuffix(strings.TrimSpace(statement), ";"))
+	if len(fields) == 0 {
+		return "", false
+	}
+	switch keyword := strings.ToUpper(fields[0]); keyword {
+	case "SET", "RESET", "BEGIN", "COMMIT", "ROLLBACK", "END":
+		return keyword, true
+	case "START":
+		return "START TRANSACTION", true
+	case "DISCARD":
+		return "DISCARD ALL", true
+	}
+	return "", false
+}
+
+// convertRowValue returns the text representation of the value, or false if it's NULL.
+func convertRowValue(value *v1pb.RowValue) (string, bool) {
+	switch v := value.Kind.(type) {
+	case *v1pb.RowValue_NullValue:
+		return "", false
+	case *v1pb.RowValue_BoolValue:
+		return strconv.FormatBool(v.BoolValue), true
+	case *v1pb.RowValue_BytesValue:
+		return string(v.BytesValue), true
+	case *v1pb.RowValue_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'g', -1, 64), true
+	case *v1pb.RowValue_FloatValue:
+		return strconv.FormatFloat(float64(v.FloatValue), 'g', -1, 32), true
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(v.Int32Value), 10), true
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(v.Int64Value, 10), true
+	case *v1pb.RowValue_StringValue:
+		return v.StringValue, true
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(v.Uint32Value), 10), true
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(v.Uint64Value, 10), true
+	case *v1pb.RowValue_ValueValue:
+		b, err := v.ValueValue.MarshalJSON()
+		if err != nil {
+			return "", false
+		}
+		return string(b), true
+	}
+	return "", false
+}
+
+// getErrorMessage returns the message of the error to send to the client.
+func getErrorMessage(err error) string {
+	if st, ok := status.FromError(err); ok {
+		return st.Message()
+	}
+	return err.Error()
+}
+
+func isPermissionDenied(err error) bool {
+	return status.Code(err) == codes.PermissionDenied
+}
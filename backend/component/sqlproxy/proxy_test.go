@@ -0,0 +1,112 @@
+package sqlproxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/bytebase/bytebase/backend/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestParseDatabaseName(t *testing.T) {
+	tests := []struct {
+		name         string
+		wantInstance string
+		wantDatabase string
+		wantErr      bool
+	}{
+		{name: "prod-mysql/employee", wantInstance: "prod-mysql", wantDatabase: "employee"},
+		{name: "instances/prod-mysql/databases/employee", wantInstance: "prod-mysql", wantDatabase: "employee"},
+		{name: "prod-mysql", wantInstance: "prod-mysql", wantDatabase: ""},
+		{name: "101/employee", wantInstance: "101", wantDatabase: "employee"},
+		{name: "", wantErr: true},
+		{name: "/employee", wantErr: true},
+	}
+	for _, test := range tests {
+		instance, database, err := parseDatabaseName(test.name)
+		if test.wantErr {
+			require.Error(t, err, test.name)
+			continue
+		}
+		require.NoError(t, err, test.name)
+		require.Equal(t, test.wantInstance, instance, test.name)
+		require.Equal(t, test.wantDatabase, database, test.name)
+	}
+}
+
+func TestGetSessionStatementTag(t *testing.T) {
+	tests := []struct {
+		statement string
+		wantTag   string
+		wantOK    bool
+	}{
+		{statement: "SET NAMES utf8mb4", wantTag: "SET", wantOK: true},
+		{statement: "set search_path to public;", wantTag: "SET", wantOK: true},
+		{statement: "  BEGIN;", wantTag: "BEGIN", wantOK: true},
+		{statement: "START TRANSACTION READ ONLY", wantTag: "START TRANSACTION", wantOK: true},
+		{statement: "DISCARD ALL", wantTag: "DISCARD ALL", wantOK: true},
+		{statement: "SELECT 1", wantOK: false},
+		{statement: "SELECT * FROM settings", wantOK: false},
+		{statement: "", wantOK: false},
+	}
+	for _, test := range tests {
+		tag, ok := getSessionStatementTag(test.statement)
+		require.Equal(t, test.wantOK, ok, test.statement)
+		require.Equal(t, test.wantTag, tag, test.statement)
+	}
+}
+
+func TestConvertRowValue(t *testing.T) {
+	tests := []struct {
+		value    *v1pb.RowValue
+		wantText string
+		wantOK   bool
+	}{
+		{value: &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{}}, wantOK: false},
+		{value: &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: true}}, wantText: "true", wantOK: true},
+		{value: &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte("abc")}}, wantText: "abc", wantOK: true},
+		{value: &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: 1.5}}, wantText: "1.5", wantOK: true},
+		{value: &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: -42}}, wantText: "-42", wantOK: true},
+		{value: &v1pb.RowValue{Kind: &v1pb.RowValue_Uint64Value{Uint64Value: 42}}, wantText: "42", wantOK: true},
+		{value: &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}}, wantText: "******", wantOK: true},
+		{value: &v1pb.RowValue{Kind: &v1pb.RowValue_ValueValue{ValueValue: structpb.NewStringValue("a")}}, wantText: `"a"`, wantOK: true},
+	}
+	for _, test := range tests {
+		text, ok := convertRowValue(test.value)
+		require.Equal(t, test.wantOK, ok, test.value.String())
+		require.Equal(t, test.wantText, text, test.value.String())
+	}
+}
+
+func TestGetPassword(t *testing.T) {
+	a := require.New(t)
+	user := &store.UserMessage{ID: 101, PasswordHash: "hash"}
+	password := GetPassword("secret", user)
+	a.Len(password, 32)
+	a.Equal(password, GetPassword("secret", user))
+	a.NotEqual(password, GetPassword("another-secret", user))
+	a.NotEqual(password, GetPassword("secret", &store.UserMessage{ID: 102, PasswordHash: "hash"}))
+	a.NotEqual(password, GetPassword("secret", &store.UserMessage{ID: 101, PasswordHash: "new-hash"}))
+}
+
+func TestLoginThrottle(t *testing.T) {
+	a := require.New(t)
+	throttle := newLoginThrottle()
+	for i := 0; i < maxLoginFailures; i++ {
+		a.True(throttle.allow("10.0.0.1"))
+		throttle.fail("10.0.0.1")
+	}
+	a.False(throttle.allow("10.0.0.1"))
+	// The other hosts are not affected.
+	a.True(throttle.allow("10.0.0.2"))
+
+	// The failures are forgotten after the window.
+	throttle.failures["10.0.0.1"].expireTime = time.Now().Add(-time.Second)
+	a.True(throttle.allow("10.0.0.1"))
+	throttle.fail("10.0.0.2")
+	a.Len(throttle.failures, 1)
+	a.True(throttle.allow("10.0.0.1"))
+}
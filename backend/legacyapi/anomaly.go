@@ -16,6 +16,8 @@ const (
 	AnomalyDatabaseConnection AnomalyType = "bb.anomaly.database.connection"
 	// AnomalyDatabaseSchemaDrift is the anomaly type for database schema drifts.
 	AnomalyDatabaseSchemaDrift AnomalyType = "bb.anomaly.database.schema.drift"
+	// AnomalyDatabaseBackupVerificationFailed is the anomaly type for backups failing the restore verification.
+	AnomalyDatabaseBackupVerificationFailed AnomalyType = "bb.anomaly.database.backup.verification-failed"
 )
 
 // AnomalyInstanceConnectionPayload is the API message for instance connection payloads.
@@ -53,3 +55,13 @@ type AnomalyDatabaseSchemaDriftPayload struct {
 	// The actual schema dumped from the database
 	Actual string `json:"actual,omitempty"`
 }
+
+// AnomalyDatabaseBackupVerificationFailedPayload is the API message for backup verification failure payloads.
+type AnomalyDatabaseBackupVerificationFailedPayload struct {
+	// The name of the verified backup
+	BackupName string `json:"backupName,omitempty"`
+	// Time of the verification
+	VerifiedTs int64 `json:"verifiedTs,omitempty"`
+	// Verification failure detail
+	Detail string `json:"detail,omitempty"`
+}
@@ -35,11 +35,11 @@ type BackupStorageBackend string
 const (
 	// BackupStorageBackendLocal is the local storage backend for a backup.
 	BackupStorageBackendLocal BackupStorageBackend = "LOCAL"
-	// BackupStorageBackendS3 is the AWS S3 storage backend for a backup. Not used yet.
+	// BackupStorageBackendS3 is the AWS S3 storage backend for a backup.
 	BackupStorageBackendS3 BackupStorageBackend = "S3"
-	// BackupStorageBackendGCS is the Google Cloud Storage (GCS) storage backend for a backup. Not used yet.
+	// BackupStorageBackendGCS is the Google Cloud Storage (GCS) storage backend for a backup.
 	BackupStorageBackendGCS BackupStorageBackend = "GCS"
-	// BackupStorageBackendOSS is the AliCloud Object Storage Service (OSS) storage backend for a backup. Not used yet.
+	// BackupStorageBackendOSS is the AliCloud Object Storage Service (OSS) storage backend for a backup.
 	BackupStorageBackendOSS BackupStorageBackend = "OSS"
 )
 
@@ -62,4 +62,33 @@ type BackupPayload struct {
 	// It is recorded within the same transaction as the dump so that the binlog position is consistent with the dump.
 	// Please refer to https://github.com/bytebase/bytebase/blob/main/docs/design/pitr-mysql.md#full-backup for details.
 	BinlogInfo BinlogInfo `json:"binlogInfo"`
+
+	// Verification related fields
+	// Checksum is the hex encoded SHA-256 checksum of the backup content before compression and encryption, recorded when taking the backup.
+	Checksum string `json:"checksum,omitempty"`
+	// TableRowCounts is the number of rows of each table in the backup file, recorded when taking the backup.
+	// The key is the table name as it is quoted in the INSERT statements of the backup file.
+	TableRowCounts map[string]int64 `json:"tableRowCounts,omitempty"`
+	// Verification is the result of the latest restore verification of the backup.
+	Verification *BackupVerification `json:"verification,omitempty"`
+
+	// Encoding related fields
+	// Compression is the compression of the backup file, e.g. zstd. Empty means the backup file is not compressed.
+	Compression string `json:"compression,omitempty"`
+	// EncryptionKeyID is the ID of the key wrapping the data key of the backup file. Empty means the backup file is not encrypted.
+	EncryptionKeyID string `json:"encryptionKeyId,omitempty"`
+	// ContentSize is the size of the backup content before compression and encryption.
+	ContentSize int64 `json:"contentSize,omitempty"`
+}
+
+// BackupVerification is the result of restoring a backup into a scratch database and checking the restored data.
+type BackupVerification struct {
+	// VerifiedTs is the time when the backup was verified.
+	VerifiedTs int64 `json:"verifiedTs"`
+	// InstanceID is the resource ID of the instance where the backup was restored.
+	InstanceID string `json:"instanceId"`
+	// Passed is true if the backup was restored and the restored data matches the checksum and row counts.
+	Passed bool `json:"passed"`
+	// Detail is the detail of the verification.
+	Detail string `json:"detail,omitempty"`
 }
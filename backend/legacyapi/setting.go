@@ -44,6 +44,10 @@ const (
 	SettingDataClassification SettingName = "bb.workspace.data-classification"
 	// SettingSemanticCategory is the setting name for semantic category.
 	SettingSemanticCategory SettingName = "bb.workspace.semantic-category"
+	// SettingBackupVerification is the setting name for backup verification.
+	SettingBackupVerification SettingName = "bb.workspace.backup-verification"
+	// SettingSCIM is the setting name for SCIM provisioning.
+	SettingSCIM SettingName = "bb.workspace.scim"
 )
 
 // IMType is the type of IM.
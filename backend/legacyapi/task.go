@@ -45,6 +45,10 @@ const (
 	TaskDatabaseSchemaUpdateGhostSync TaskType = "bb.task.database.schema.update.ghost.sync"
 	// TaskDatabaseSchemaUpdateGhostCutover is the task type for gh-ost switching the original table and the ghost table.
 	TaskDatabaseSchemaUpdateGhostCutover TaskType = "bb.task.database.schema.update.ghost.cutover"
+	// TaskDatabaseSchemaUpdatePGOnlineSync is the task type for syncing the PostgreSQL shadow table.
+	TaskDatabaseSchemaUpdatePGOnlineSync TaskType = "bb.task.database.schema.update.pg-online.sync"
+	// TaskDatabaseSchemaUpdatePGOnlineCutover is the task type for switching the original PostgreSQL table and the shadow table.
+	TaskDatabaseSchemaUpdatePGOnlineCutover TaskType = "bb.task.database.schema.update.pg-online.cutover"
 	// TaskDatabaseDataUpdate is the task type for updating database data.
 	TaskDatabaseDataUpdate TaskType = "bb.task.database.data.update"
 	// TaskDatabaseBackup is the task type for creating database backups.
@@ -77,7 +81,7 @@ type TaskDatabasePITRRestorePayload struct {
 	// Only used when doing PITR to a new database now.
 	TargetInstanceID *int `json:"targetInstanceId,omitempty"`
 
-	// BackupID and PointInTimeTs only allow one non-nil.
+	// BackupID, PointInTimeTs and PointInTimeLSN only allow one non-nil.
 
 	// Only used when doing restore full backup only.
 	BackupID *int `json:"backupId,omitempty"`
@@ -85,6 +89,10 @@ type TaskDatabasePITRRestorePayload struct {
 	// After the PITR operations, the database will be recovered to the state at this time.
 	// Represented in UNIX timestamp in seconds.
 	PointInTimeTs *int64 `json:"pointInTimeTs,omitempty"`
+
+	// After the PITR operations, the database will be recovered to the state at this WAL location.
+	// Only used when doing PITR for PostgreSQL.
+	PointInTimeLSN *string `json:"pointInTimeLsn,omitempty"`
 }
 
 // TaskDatabasePITRCutoverPayload is the task payload for PITR cutover.
@@ -173,6 +181,26 @@ type TaskDatabaseSchemaUpdateGhostCutoverPayload struct {
 	SpecID        string `json:"specId,omitempty"`
 }
 
+// TaskDatabaseSchemaUpdatePGOnlineSyncPayload is the task payload for syncing the PostgreSQL shadow table.
+type TaskDatabaseSchemaUpdatePGOnlineSyncPayload struct {
+	// Common fields
+	Skipped       bool   `json:"skipped,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+	SpecID        string `json:"specId,omitempty"`
+
+	SheetID       int            `json:"sheetId,omitempty"`
+	SchemaVersion string         `json:"schemaVersion,omitempty"`
+	VCSPushEvent  *vcs.PushEvent `json:"pushEvent,omitempty"`
+}
+
+// TaskDatabaseSchemaUpdatePGOnlineCutoverPayload is the task payload for switching the original PostgreSQL table and the shadow table.
+type TaskDatabaseSchemaUpdatePGOnlineCutoverPayload struct {
+	// Common fields
+	Skipped       bool   `json:"skipped,omitempty"`
+	SkippedReason string `json:"skippedReason,omitempty"`
+	SpecID        string `json:"specId,omitempty"`
+}
+
 // RollbackSQLStatus is the status of a rollback SQL generation task.
 type RollbackSQLStatus string
 
@@ -226,6 +254,34 @@ type TaskDatabaseDataUpdatePayload struct {
 	RollbackFromTaskID int `json:"rollbackFromTaskId,omitempty"`
 
 	SchemaGroupName string `json:"schemaGroupName,omitempty"`
+
+	// BatchConfig is present if the statement runs in primary key ranged chunks.
+	BatchConfig *DataUpdateBatchConfig `json:"batchConfig,omitempty"`
+	// BatchProgress is the checkpoint of the batched execution, so that a failed execution resumes from it.
+	// It is cleared after all chunks are executed.
+	BatchProgress *DataUpdateBatchProgress `json:"batchProgress,omitempty"`
+}
+
+// DataUpdateBatchConfig is the config of the batched execution of a data update statement.
+type DataUpdateBatchConfig struct {
+	// ChunkSize is the number of rows in the primary key range of each chunk.
+	ChunkSize int64 `json:"chunkSize,omitempty"`
+	// PauseMs is the time in milliseconds to pause between chunks.
+	PauseMs int64 `json:"pauseMs,omitempty"`
+	// MaxReplicaLagMs pauses the execution while the replica lag exceeds it. Zero means no limit.
+	MaxReplicaLagMs int64 `json:"maxReplicaLagMs,omitempty"`
+	// MaxThreadsRunning pauses the execution while the number of running threads exceeds it. Zero means no limit.
+	MaxThreadsRunning int64 `json:"maxThreadsRunning,omitempty"`
+}
+
+// DataUpdateBatchProgress is the progress of the batched execution of a data update statement.
+type DataUpdateBatchProgress struct {
+	// LastKey is the primary key upper bound of the last executed chunk.
+	LastKey []string `json:"lastKey,omitempty"`
+	// ChunkCount is the number of executed chunks.
+	ChunkCount int64 `json:"chunkCount,omitempty"`
+	// AffectedRows is the number of rows affected by the executed chunks.
+	AffectedRows int64 `json:"affectedRows,omitempty"`
 }
 
 // TaskDatabaseBackupPayload is the task payload for database backup.
@@ -347,6 +403,9 @@ type TaskPatch struct {
 	// When RollbackEnabled is enabled, RollbackSheetID is kept till it's set to the new sheet ID by the runner.
 	RollbackSheetID *int
 	RollbackError   *string
+	// BatchConfig sets the batch config of the data update task, and the zero value removes it.
+	// The batch progress is reset as the chunks change.
+	BatchConfig *DataUpdateBatchConfig
 }
 
 // TaskStatusPatch is the API message for patching a task status.
@@ -73,8 +73,12 @@ const (
 	TaskCheckDatabaseConnect TaskCheckType = "bb.task-check.database.connect"
 	// TaskCheckGhostSync is the task check type for the gh-ost sync task.
 	TaskCheckGhostSync TaskCheckType = "bb.task-check.database.ghost.sync"
+	// TaskCheckPGOnlineSync is the task check type for the PostgreSQL online migration sync task.
+	TaskCheckPGOnlineSync TaskCheckType = "bb.task-check.database.pg-online.sync"
 	// TaskCheckPITRMySQL is the task check type for MySQL PITR.
 	TaskCheckPITRMySQL TaskCheckType = "bb.task-check.pitr.mysql"
+	// TaskCheckPITRPostgres is the task check type for PostgreSQL PITR.
+	TaskCheckPITRPostgres TaskCheckType = "bb.task-check.pitr.postgres"
 )
 
 // Namespace is the namespace for task check result.
@@ -167,7 +171,7 @@ func IsTaskCheckReportSupported(dbType db.Type) bool {
 // IsTaskCheckReportNeededForTaskType checks if the task report is needed for the task type.
 func IsTaskCheckReportNeededForTaskType(taskType TaskType) bool {
 	switch taskType {
-	case TaskDatabaseSchemaUpdate, TaskDatabaseSchemaUpdateSDL, TaskDatabaseSchemaUpdateGhostSync, TaskDatabaseDataUpdate:
+	case TaskDatabaseSchemaUpdate, TaskDatabaseSchemaUpdateSDL, TaskDatabaseSchemaUpdateGhostSync, TaskDatabaseSchemaUpdatePGOnlineSync, TaskDatabaseDataUpdate:
 		return true
 	default:
 		return false
@@ -0,0 +1,119 @@
+package metric
+
+import (
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// The operational metrics exposed on the Prometheus /metrics endpoint.
+// Unlike the collectors reporting product telemetry, they describe the health of Bytebase itself.
+var (
+	taskRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bb",
+		Name:      "task_run_duration_seconds",
+		Help:      "Duration of task runs by task type and outcome.",
+		Buckets:   []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300, 900, 3600, 10800},
+	}, []string{"type", "status"})
+	planCheckRunDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "bb",
+		Name:      "plan_check_run_duration_seconds",
+		Help:      "Duration of plan check runs, as well as the legacy task check runs, by check type and outcome.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"type", "status"})
+	backupRunTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "bb",
+		Name:      "backup_run_total",
+		Help:      "Number of database backup runs by outcome.",
+	}, []string{"instance", "database", "status"})
+	backupLastSuccess = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "bb",
+		Name:      "backup_last_success_timestamp_seconds",
+		Help:      "Unix time of the last successful backup of the database.",
+	}, []string{"instance", "database"})
+	schemaSyncLag = newSchemaSyncLagCollector()
+)
+
+const (
+	// RunStatusDone is the outcome of a run that completed.
+	RunStatusDone = "done"
+	// RunStatusFailed is the outcome of a run that failed.
+	RunStatusFailed = "failed"
+	// RunStatusCanceled is the outcome of a run canceled by the user.
+	RunStatusCanceled = "canceled"
+	// RunStatusRetry is the outcome of a run that hit a transient error and will be retried.
+	RunStatusRetry = "retry"
+)
+
+func init() {
+	prometheus.MustRegister(taskRunDuration, planCheckRunDuration, backupRunTotal, backupLastSuccess, schemaSyncLag)
+}
+
+// ObserveTaskRun records the duration and the outcome of a task run.
+func ObserveTaskRun(taskType string, status string, duration time.Duration) {
+	taskRunDuration.WithLabelValues(taskType, status).Observe(duration.Seconds())
+}
+
+// ObservePlanCheckRun records the duration and the outcome of a plan check run.
+func ObservePlanCheckRun(checkType string, status string, duration time.Duration) {
+	planCheckRunDuration.WithLabelValues(checkType, status).Observe(duration.Seconds())
+}
+
+// ObserveDatabaseBackup records the outcome of a database backup.
+func ObserveDatabaseBackup(instance string, database string, success bool) {
+	status := RunStatusDone
+	if success {
+		backupLastSuccess.WithLabelValues(instance, database).SetToCurrentTime()
+	} else {
+		status = RunStatusFailed
+	}
+	backupRunTotal.WithLabelValues(instance, database, status).Inc()
+}
+
+// ObserveInstanceSchemaSync records the successful schema sync of the instance.
+func ObserveInstanceSchemaSync(instance string) {
+	schemaSyncLag.observe(instance, time.Now())
+}
+
+// schemaSyncLagCollector exposes the time elapsed since the last successful schema sync of each instance.
+// The lag is computed at scrape time, so it keeps growing while the sync is stuck.
+type schemaSyncLagCollector struct {
+	desc *prometheus.Desc
+
+	mu       sync.Mutex
+	lastSync map[string]time.Time
+}
+
+func newSchemaSyncLagCollector() *schemaSyncLagCollector {
+	return &schemaSyncLagCollector{
+		desc: prometheus.NewDesc(
+			"bb_schema_sync_lag_seconds",
+			"Seconds since the last successful schema sync of the instance.",
+			[]string{"instance"},
+			nil,
+		),
+		lastSync: make(map[string]time.Time),
+	}
+}
+
+func (c *schemaSyncLagCollector) observe(instance string, t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.lastSync[instance] = t
+}
+
+// Describe implements the prometheus.Collector interface.
+func (c *schemaSyncLagCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+// Collect implements the prometheus.Collector interface.
+func (c *schemaSyncLagCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	for instance, t := range c.lastSync {
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, now.Sub(t).Seconds(), instance)
+	}
+}
@@ -98,6 +98,9 @@ DELETE FROM
 DELETE FROM
     environment;
 
+DELETE FROM
+    webhook_delivery;
+
 DELETE FROM
     project_webhook;
 
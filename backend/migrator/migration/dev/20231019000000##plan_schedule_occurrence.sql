@@ -0,0 +1,19 @@
+CREATE TABLE plan_schedule_occurrence (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    plan_id BIGINT NOT NULL REFERENCES plan (id),
+    schedule_ts BIGINT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('CHECKING', 'RUNNING', 'DONE', 'FAILED', 'SKIPPED')),
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_plan_schedule_occurrence_plan_id_schedule_ts ON plan_schedule_occurrence(plan_id, schedule_ts);
+
+ALTER SEQUENCE plan_schedule_occurrence_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_plan_schedule_occurrence_updated_ts
+BEFORE
+UPDATE
+    ON plan_schedule_occurrence FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
@@ -19,7 +19,7 @@ CREATE TABLE idp (
   resource_id TEXT NOT NULL,
   name TEXT NOT NULL,
   domain TEXT NOT NULL,
-  type TEXT NOT NULL CONSTRAINT idp_type_check CHECK (type IN ('OAUTH2', 'OIDC', 'LDAP')),
+  type TEXT NOT NULL CONSTRAINT idp_type_check CHECK (type IN ('OAUTH2', 'OIDC', 'LDAP', 'SAML')),
   -- config stores the corresponding configuration of the IdP, which may vary depending on the type of the IdP.
   config JSONB NOT NULL DEFAULT '{}'
 );
@@ -152,6 +152,28 @@ UPDATE
     ON member FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- scim_group stores the groups provisioned by the identity provider through SCIM.
+CREATE TABLE scim_group (
+    id SERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    -- external_id is the identifier of the group in the identity provider.
+    external_id TEXT NOT NULL DEFAULT '',
+    display_name TEXT NOT NULL,
+    -- member_ids are the principal IDs of the group members.
+    member_ids INTEGER ARRAY NOT NULL DEFAULT '{}'
+);
+
+CREATE UNIQUE INDEX idx_scim_group_unique_display_name ON scim_group(display_name);
+
+ALTER SEQUENCE scim_group_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_scim_group_updated_ts
+BEFORE
+UPDATE
+    ON scim_group FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
 -- Environment
 CREATE TABLE environment (
     id SERIAL PRIMARY KEY,
@@ -303,7 +325,9 @@ CREATE TABLE project_webhook (
     type TEXT NOT NULL CHECK (type LIKE 'bb.plugin.webhook.%'),
     name TEXT NOT NULL,
     url TEXT NOT NULL,
-    activity_list TEXT ARRAY NOT NULL
+    activity_list TEXT ARRAY NOT NULL,
+    -- secret is used to sign the payload of the custom webhook.
+    secret TEXT NOT NULL DEFAULT ''
 );
 
 CREATE INDEX idx_project_webhook_project_id ON project_webhook(project_id);
@@ -318,6 +342,32 @@ UPDATE
     ON project_webhook FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- webhook_delivery is the durable queue and the delivery history of the project webhook events.
+CREATE TABLE webhook_delivery (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    webhook_id INTEGER NOT NULL REFERENCES project_webhook (id) ON DELETE CASCADE,
+    activity_type TEXT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('PENDING', 'DONE', 'FAILED')),
+    attempt INTEGER NOT NULL DEFAULT 0,
+    next_attempt_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    last_error TEXT NOT NULL DEFAULT '',
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_webhook_delivery_webhook_id ON webhook_delivery(webhook_id);
+
+CREATE INDEX idx_webhook_delivery_status_next_attempt_ts ON webhook_delivery(status, next_attempt_ts);
+
+ALTER SEQUENCE webhook_delivery_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_webhook_delivery_updated_ts
+BEFORE
+UPDATE
+    ON webhook_delivery FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
 -- Instance
 CREATE TABLE instance (
     id SERIAL PRIMARY KEY,
@@ -734,6 +784,29 @@ UPDATE
     ON plan_check_run FOR EACH ROW
 EXECUTE FUNCTION trigger_update_updated_ts();
 
+-- plan_schedule_occurrence is the history of the occurrences of the scheduled plans.
+CREATE TABLE plan_schedule_occurrence (
+    id BIGSERIAL PRIMARY KEY,
+    created_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
+    plan_id BIGINT NOT NULL REFERENCES plan (id),
+    -- schedule_ts is the cron fire time of the occurrence.
+    schedule_ts BIGINT NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('CHECKING', 'RUNNING', 'DONE', 'FAILED', 'SKIPPED')),
+    -- payload saves the task runs and the generated rollbacks of the occurrence.
+    payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_plan_schedule_occurrence_plan_id_schedule_ts ON plan_schedule_occurrence(plan_id, schedule_ts);
+
+ALTER SEQUENCE plan_schedule_occurrence_id_seq RESTART WITH 101;
+
+CREATE TRIGGER update_plan_schedule_occurrence_updated_ts
+BEFORE
+UPDATE
+    ON plan_schedule_occurrence FOR EACH ROW
+EXECUTE FUNCTION trigger_update_updated_ts();
+
 -- Plan related END
 -----------------------
 -- issue
@@ -923,7 +996,7 @@ CREATE TABLE vcs (
     updater_id INTEGER NOT NULL REFERENCES principal (id),
     updated_ts BIGINT NOT NULL DEFAULT extract(epoch from now()),
     name TEXT NOT NULL,
-    type TEXT NOT NULL CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS')),
+    type TEXT NOT NULL CHECK (type IN ('GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS', 'GITEA')),
     instance_url TEXT NOT NULL CHECK ((instance_url LIKE 'http://%' OR instance_url LIKE 'https://%') AND instance_url = rtrim(instance_url, '/')),
     api_url TEXT NOT NULL CHECK ((api_url LIKE 'http://%' OR api_url LIKE 'https://%') AND api_url = rtrim(api_url, '/')),
     application_id TEXT NOT NULL,
@@ -1136,7 +1209,7 @@ CREATE TABLE sheet (
     name TEXT NOT NULL,
     statement TEXT NOT NULL,
     visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'PROJECT', 'PUBLIC')) DEFAULT 'PRIVATE',
-    source TEXT NOT NULL CONSTRAINT sheet_source_check CHECK (source IN ('BYTEBASE', 'GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS', 'BYTEBASE_ARTIFACT')) DEFAULT 'BYTEBASE',
+    source TEXT NOT NULL CONSTRAINT sheet_source_check CHECK (source IN ('BYTEBASE', 'GITLAB', 'GITHUB', 'BITBUCKET', 'AZURE_DEVOPS', 'GITEA', 'BYTEBASE_ARTIFACT')) DEFAULT 'BYTEBASE',
     type TEXT NOT NULL CHECK (type IN ('SQL')) DEFAULT 'SQL',
     payload JSONB NOT NULL DEFAULT '{}'
 );
@@ -6,6 +6,8 @@ import (
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor/db"
 )
 
 //go:embed config/sql-review.sample.yaml
@@ -30,6 +32,7 @@ type SQLReviewTemplateData struct {
 type SQLReviewRuleData struct {
 	Type    SQLReviewRuleType  `yaml:"type"`
 	Level   SQLReviewRuleLevel `yaml:"level,omitempty"`
+	Engine  db.Type            `yaml:"engine,omitempty"`
 	Comment string             `yaml:"comment"`
 	Payload map[string]any     `yaml:"payload"`
 }
@@ -71,6 +74,42 @@ func MergeSQLReviewRules(override *SQLReviewConfigOverride) ([]*SQLReviewRule, e
 	return res, nil
 }
 
+// UnmarshalSQLReviewRules parses a SQL review config in the YAML format of the built-in templates.
+// The config either lists the full rules like a template, or extends a built-in template by its id
+// like sql-review.override.yaml.
+func UnmarshalSQLReviewRules(data []byte) ([]*SQLReviewRule, error) {
+	config := &struct {
+		SQLReviewTemplateData `yaml:",inline"`
+		Template              SQLReviewTemplateID `yaml:"template"`
+	}{}
+	if err := yaml.Unmarshal(data, config); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal SQL review config")
+	}
+
+	if config.Template != "" {
+		return MergeSQLReviewRules(&SQLReviewConfigOverride{
+			Template: config.Template,
+			RuleList: config.RuleList,
+		})
+	}
+
+	var res []*SQLReviewRule
+	for _, ruleData := range config.RuleList {
+		rule, err := mergeRule(ruleData, nil)
+		if err != nil {
+			return nil, err
+		}
+		if rule.Level == "" {
+			rule.Level = SchemaRuleLevelError
+		}
+		if err := rule.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "invalid rule %q", rule.Type)
+		}
+		res = append(res, rule)
+	}
+	return res, nil
+}
+
 func parseSQLReviewTemplateList() ([]*SQLReviewTemplateData, error) {
 	sampleTemplate := &SQLReviewTemplateData{}
 	prodTemplate := &SQLReviewTemplateData{}
@@ -126,6 +165,7 @@ func mergeRule(source *SQLReviewRuleData, override *SQLReviewRuleData) (*SQLRevi
 	return &SQLReviewRule{
 		Type:    source.Type,
 		Level:   level,
+		Engine:  source.Engine,
 		Comment: comment,
 		Payload: string(str),
 	}, nil
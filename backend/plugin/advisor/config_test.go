@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
+
+	"github.com/bytebase/bytebase/backend/plugin/advisor/db"
 )
 
 var mockConfigOverrideYAMLStr = `
@@ -77,3 +79,44 @@ func TestConfigOverride(t *testing.T) {
 		}
 	}
 }
+
+func TestUnmarshalSQLReviewRules(t *testing.T) {
+	ruleList, err := UnmarshalSQLReviewRules([]byte(mockConfigOverrideYAMLStr))
+	require.NoError(t, err)
+	override := &SQLReviewConfigOverride{}
+	require.NoError(t, yaml.Unmarshal([]byte(mockConfigOverrideYAMLStr), override))
+	want, err := MergeSQLReviewRules(override)
+	require.NoError(t, err)
+	assert.Equal(t, want, ruleList)
+
+	ruleList, err = UnmarshalSQLReviewRules([]byte(`
+id: custom
+ruleList:
+  - type: table.require-pk
+  - type: naming.table
+    level: WARNING
+    engine: POSTGRES
+    payload:
+      format: "^[a-z]+$"
+      maxLength: 32
+`))
+	require.NoError(t, err)
+	require.Len(t, ruleList, 2)
+	assert.Equal(t, SchemaRuleTableRequirePK, ruleList[0].Type)
+	assert.Equal(t, SchemaRuleLevelError, ruleList[0].Level)
+	assert.Equal(t, SchemaRuleTableNaming, ruleList[1].Type)
+	assert.Equal(t, SchemaRuleLevelWarning, ruleList[1].Level)
+	assert.Equal(t, db.Postgres, ruleList[1].Engine)
+	assert.JSONEq(t, `{"format":"^[a-z]+$","maxLength":32}`, ruleList[1].Payload)
+
+	_, err = UnmarshalSQLReviewRules([]byte(`
+ruleList:
+  - type: naming.table
+    payload:
+      format: "("
+`))
+	require.Error(t, err)
+
+	_, err = UnmarshalSQLReviewRules([]byte(`template: bb.sql-review.unknown`))
+	require.Error(t, err)
+}
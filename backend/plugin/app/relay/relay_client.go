@@ -1,17 +1,40 @@
 // Package relay is the client sending requests to relay server for external approvals.
+// The protocol between Bytebase and the relay server is documented in README.md.
 package relay
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
+const (
+	// ProtocolVersion is the version of the external approval protocol.
+	ProtocolVersion = "v1"
+
+	// VersionHeader is the header carrying the protocol version.
+	VersionHeader = "X-Bytebase-Relay-Version"
+	// TimestampHeader is the header carrying the unix timestamp in seconds when the request is signed.
+	TimestampHeader = "X-Bytebase-Timestamp"
+	// SignatureHeader is the header carrying the HMAC-SHA256 signature of the request.
+	SignatureHeader = "X-Bytebase-Signature-256"
+
+	// signatureTolerance is the max allowed clock skew between the signer and the verifier.
+	signatureTolerance = 5 * time.Minute
+	// maxResponseSize is the max size of the response body from the relay server.
+	maxResponseSize = 1 << 20
+)
+
 // Client is the client for relay.
 type Client struct {
 	client *http.Client
@@ -26,6 +49,15 @@ func NewClient() *Client {
 	}
 }
 
+// Node is the relay server of an external approval node.
+type Node struct {
+	// Endpoint is the base URL of the relay server, e.g. "http://hello:1234".
+	Endpoint string
+	// Secret is the shared secret to sign the requests and the responses. They are not signed if it's empty,
+	// and the callbacks from the relay server are refused.
+	Secret string
+}
+
 // CreatePayload is the message to create external approval.
 type CreatePayload struct {
 	IssueID     string    `json:"issueId"`
@@ -42,39 +74,31 @@ type CreatePayload struct {
 type Status string
 
 const (
+	// StatusPending means that the external approval is waiting for the decision.
+	StatusPending Status = "PENDING"
 	// StatusApproved means that the external approval is approved.
 	StatusApproved Status = "APPROVED"
 	// StatusRejected means that the external approval is rejected.
 	StatusRejected Status = "REJECTED"
+	// StatusCanceled means that the external approval is canceled.
+	StatusCanceled Status = "CANCELED"
 )
 
 // ResponsePayload is the response message to for external approval.
+// It's also the body of the callback from the relay server to Bytebase.
 type ResponsePayload struct {
 	ID     string `json:"id"`
 	Status Status `json:"status"`
 }
 
 // Create sends a message to create external approval.
-func (c *Client) Create(relayEndpoint string, payload *CreatePayload) (string, error) {
-	out, err := json.Marshal(&payload)
-	if err != nil {
-		return "", err
-	}
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/approval", relayEndpoint), bytes.NewBuffer(out))
-	if err != nil {
-		return "", err
-	}
-	resp, err := c.client.Do(req)
-	if err != nil {
-		return "", err
-	}
-	if resp.StatusCode != http.StatusOK {
-		return "", errors.Errorf("failed to create external approval with status %v", resp.Status)
-	}
+func (c *Client) Create(node *Node, payload *CreatePayload) (string, error) {
 	responseBody := &ResponsePayload{}
-	err = json.NewDecoder(resp.Body).Decode(responseBody)
-	if err != nil {
-		return "", err
+	if err := c.do(node, http.MethodPost, "/approval", payload, responseBody); err != nil {
+		return "", errors.Wrapf(err, "failed to create external approval")
+	}
+	if responseBody.ID == "" {
+		return "", errors.Errorf("failed to create external approval, empty id in the response")
 	}
 	return responseBody.ID, nil
 }
@@ -83,45 +107,128 @@ func (c *Client) Create(relayEndpoint string, payload *CreatePayload) (string, e
 type UpdatePayload struct {
 	Title     string `json:"title"`
 	Statement string `json:"statement"`
+	// Status is set to CANCELED when Bytebase cancels the external approval.
+	Status Status `json:"status,omitempty"`
 }
 
 // UpdateApproval sends a message to update the external approval.
-func (c *Client) UpdateApproval(relayEndpoint string, id string, payload *UpdatePayload) error {
-	out, err := json.Marshal(&payload)
-	if err != nil {
-		return err
+func (c *Client) UpdateApproval(node *Node, id string, payload *UpdatePayload) error {
+	if err := c.do(node, http.MethodPatch, fmt.Sprintf("/approval/%s", id), payload, nil); err != nil {
+		return errors.Wrapf(err, "failed to update external approval %s", id)
+	}
+	return nil
+}
+
+// CancelApproval cancels the external approval, e.g. the issue is closed or the statement is changed.
+func (c *Client) CancelApproval(node *Node, id string) error {
+	return c.UpdateApproval(node, id, &UpdatePayload{Status: StatusCanceled})
+}
+
+// GetApproval gets the approval of an external approval.
+func (c *Client) GetApproval(node *Node, id string) (*ResponsePayload, error) {
+	responseBody := &ResponsePayload{}
+	if err := c.do(node, http.MethodGet, fmt.Sprintf("/approval/%s", id), nil, responseBody); err != nil {
+		return nil, errors.Wrapf(err, "failed to get external approval %s", id)
+	}
+	return responseBody, nil
+}
+
+func (c *Client) do(node *Node, method, path string, payload, responseBody any) error {
+	var body []byte
+	if payload != nil {
+		out, err := json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+		body = out
 	}
-	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/approval/%s", relayEndpoint, id), bytes.NewBuffer(out))
+	req, err := http.NewRequest(method, strings.TrimSuffix(node.Endpoint, "/")+path, bytes.NewReader(body))
 	if err != nil {
 		return err
 	}
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	SignHeader(req.Header, node.Secret, time.Now(), method, req.URL.RequestURI(), body)
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return err
 	}
+	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return errors.Errorf("failed to create external approval with status %v", resp.Status)
+		return errors.Errorf("unexpected response status %v", resp.Status)
 	}
-	return nil
+	out, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return errors.Wrapf(err, "failed to read response body")
+	}
+	// The response is signed with the method and the request URI of the request, so that it cannot be forged
+	// or replayed for another approval by whoever sits between Bytebase and the relay server.
+	if node.Secret != "" {
+		if err := VerifyHeader(resp.Header, node.Secret, time.Now(), method, req.URL.RequestURI(), out); err != nil {
+			return errors.Wrapf(err, "failed to verify response")
+		}
+	}
+	if responseBody == nil {
+		return nil
+	}
+	return json.Unmarshal(out, responseBody)
 }
 
-// GetApproval gets the approval of an external approval.
-func (c *Client) GetApproval(relayEndpoint string, id string) (*ResponsePayload, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/approval/%s", relayEndpoint, id), strings.NewReader(""))
-	if err != nil {
-		return nil, err
+// SignHeader sets the version, timestamp and signature headers of a request, or of the response to a request,
+// with the method and the request URI of the request.
+// The signature is not set if the secret is empty.
+func SignHeader(header http.Header, secret string, now time.Time, method, path string, body []byte) {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	header.Set(VersionHeader, ProtocolVersion)
+	header.Set(TimestampHeader, timestamp)
+	if secret != "" {
+		header.Set(SignatureHeader, Sign(secret, timestamp, method, path, body))
 	}
-	resp, err := c.client.Do(req)
+}
+
+// Sign returns the signature of the request or the response signed at timestamp,
+// in the form of "sha256=<hex HMAC-SHA256 of `timestamp.method.path.body`>".
+// The method and the path are signed, so that a signed request cannot be replayed against another approval.
+func Sign(secret, timestamp, method, path string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	for _, s := range []string{timestamp, method, path} {
+		_, _ = mac.Write([]byte(s))
+		_, _ = mac.Write([]byte("."))
+	}
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyHeader verifies the signature of a request or a response signed by SignHeader.
+// The secret is required, a message cannot be verified without it.
+func VerifyHeader(header http.Header, secret string, now time.Time, method, path string, body []byte) error {
+	if secret == "" {
+		return errors.New("the secret is required to verify the signature")
+	}
+	timestamp := header.Get(TimestampHeader)
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
 	if err != nil {
-		return nil, err
+		return errors.Errorf("invalid %s header %q", TimestampHeader, timestamp)
 	}
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.Errorf("failed to get external approval status with status %v", resp.Status)
+	if skew := now.Sub(time.Unix(ts, 0)); skew > signatureTolerance || skew < -signatureTolerance {
+		return errors.Errorf("timestamp %s is out of the tolerance %v", timestamp, signatureTolerance)
 	}
-	responseBody := &ResponsePayload{}
-	err = json.NewDecoder(resp.Body).Decode(responseBody)
+	if !hmac.Equal([]byte(header.Get(SignatureHeader)), []byte(Sign(secret, timestamp, method, path, body))) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+// ReadVerifiedBody reads the body of the request and verifies its signature.
+func ReadVerifiedBody(r *http.Request, secret string) ([]byte, error) {
+	body, err := io.ReadAll(r.Body)
 	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read request body")
+	}
+	if err := VerifyHeader(r.Header, secret, time.Now(), r.Method, r.URL.RequestURI(), body); err != nil {
 		return nil, err
 	}
-	return responseBody, nil
+	return body, nil
 }
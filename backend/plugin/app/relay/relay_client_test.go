@@ -0,0 +1,162 @@
+package relay_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/app/relay"
+	"github.com/bytebase/bytebase/backend/plugin/app/relay/relaytest"
+)
+
+func TestClient(t *testing.T) {
+	a := require.New(t)
+	server := relaytest.NewServer("my-secret")
+	defer server.Close()
+	client := relay.NewClient()
+	node := &relay.Node{Endpoint: server.URL, Secret: "my-secret"}
+
+	id, err := client.Create(node, &relay.CreatePayload{IssueID: "101", Title: "Add column"})
+	a.NoError(err)
+	a.Equal("Add column", server.GetApproval(id).Payload.Title)
+
+	resp, err := client.GetApproval(node, id)
+	a.NoError(err)
+	a.Equal(relay.StatusPending, resp.Status)
+
+	a.NoError(server.Approve(id))
+	resp, err = client.GetApproval(node, id)
+	a.NoError(err)
+	a.Equal(relay.StatusApproved, resp.Status)
+
+	// Canceling a decided approval is a no-op.
+	a.NoError(client.CancelApproval(node, id))
+	a.Equal(relay.StatusApproved, server.GetApproval(id).Status)
+
+	id, err = client.Create(node, &relay.CreatePayload{IssueID: "102"})
+	a.NoError(err)
+	a.NoError(client.CancelApproval(node, id))
+	a.Equal(relay.StatusCanceled, server.GetApproval(id).Status)
+	a.Error(server.Reject(id))
+
+	// The requests with a wrong secret are refused.
+	_, err = client.Create(&relay.Node{Endpoint: server.URL, Secret: "wrong-secret"}, &relay.CreatePayload{IssueID: "103"})
+	a.Error(err)
+	_, err = client.Create(&relay.Node{Endpoint: server.URL}, &relay.CreatePayload{IssueID: "103"})
+	a.Error(err)
+}
+
+func TestClientVerifiesResponse(t *testing.T) {
+	body := []byte(`{"id":"1","status":"APPROVED"}`)
+	tests := []struct {
+		name    string
+		sign    func(header http.Header, r *http.Request)
+		wantErr bool
+	}{
+		{
+			name: "signed",
+			sign: func(header http.Header, r *http.Request) {
+				relay.SignHeader(header, "my-secret", time.Now(), r.Method, r.URL.RequestURI(), body)
+			},
+		},
+		{
+			name:    "unsigned",
+			sign:    func(http.Header, *http.Request) {},
+			wantErr: true,
+		},
+		{
+			name: "wrong secret",
+			sign: func(header http.Header, r *http.Request) {
+				relay.SignHeader(header, "other-secret", time.Now(), r.Method, r.URL.RequestURI(), body)
+			},
+			wantErr: true,
+		},
+		{
+			name: "replayed from another approval",
+			sign: func(header http.Header, r *http.Request) {
+				relay.SignHeader(header, "my-secret", time.Now(), r.Method, "/approval/2", body)
+			},
+			wantErr: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				test.sign(w.Header(), r)
+				_, _ = w.Write(body)
+			}))
+			defer server.Close()
+
+			resp, err := relay.NewClient().GetApproval(&relay.Node{Endpoint: server.URL, Secret: "my-secret"}, "1")
+			if test.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, relay.StatusApproved, resp.Status)
+		})
+	}
+}
+
+func TestCallback(t *testing.T) {
+	a := require.New(t)
+	server := relaytest.NewServer("my-secret")
+	defer server.Close()
+
+	var got relay.ResponsePayload
+	callback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := relay.ReadVerifiedBody(r, "my-secret")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+			return
+		}
+		a.NoError(json.Unmarshal(body, &got))
+	}))
+	defer callback.Close()
+	server.CallbackURL = callback.URL
+
+	id, err := relay.NewClient().Create(&relay.Node{Endpoint: server.URL, Secret: "my-secret"}, &relay.CreatePayload{IssueID: "101"})
+	a.NoError(err)
+	a.NoError(server.Reject(id))
+	a.Equal(relay.ResponsePayload{ID: id, Status: relay.StatusRejected}, got)
+}
+
+func TestVerifyHeader(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	body := []byte(`{"status":"CANCELED"}`)
+	signed := http.Header{}
+	relay.SignHeader(signed, "my-secret", now, http.MethodPatch, "/approval/1", body)
+
+	tests := []struct {
+		name    string
+		secret  string
+		now     time.Time
+		method  string
+		path    string
+		body    []byte
+		wantErr bool
+	}{
+		{name: "valid", secret: "my-secret", now: now, method: http.MethodPatch, path: "/approval/1", body: body},
+		{name: "clock skew within tolerance", secret: "my-secret", now: now.Add(4 * time.Minute), method: http.MethodPatch, path: "/approval/1", body: body},
+		{name: "no secret", secret: "", now: now, method: http.MethodPatch, path: "/approval/1", body: body, wantErr: true},
+		{name: "wrong secret", secret: "other-secret", now: now, method: http.MethodPatch, path: "/approval/1", body: body, wantErr: true},
+		{name: "tampered body", secret: "my-secret", now: now, method: http.MethodPatch, path: "/approval/1", body: []byte(`{"status":"PENDING"}`), wantErr: true},
+		{name: "replayed", secret: "my-secret", now: now.Add(10 * time.Minute), method: http.MethodPatch, path: "/approval/1", body: body, wantErr: true},
+		{name: "replayed against another approval", secret: "my-secret", now: now, method: http.MethodPatch, path: "/approval/2", body: body, wantErr: true},
+		{name: "replayed with another method", secret: "my-secret", now: now, method: http.MethodPost, path: "/approval/1", body: body, wantErr: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := relay.VerifyHeader(signed, test.secret, test.now, test.method, test.path, test.body)
+			if test.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+// Package relaytest is the reference mock relay server implementing the external approval protocol, used in tests.
+package relaytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/app/relay"
+)
+
+// Approval is an external approval stored in the mock relay server.
+type Approval struct {
+	ID      string
+	Status  relay.Status
+	Payload relay.CreatePayload
+}
+
+// Server is the mock relay server.
+type Server struct {
+	// URL is the endpoint of the server, which is used as the endpoint of the external approval node.
+	URL string
+	// CallbackURL is the Bytebase callback URL. If it's set, the server notifies Bytebase when an approval is decided.
+	CallbackURL string
+
+	secret string
+	server *httptest.Server
+
+	mu        sync.Mutex
+	nextID    int
+	approvals map[string]*Approval
+}
+
+// NewServer starts a mock relay server verifying the requests with the secret.
+func NewServer(secret string) *Server {
+	s := &Server{
+		secret:    secret,
+		nextID:    1,
+		approvals: make(map[string]*Approval),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.handle))
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// GetApproval returns a copy of the approval, or nil if it's not found.
+func (s *Server) GetApproval(id string) *Approval {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	approval, ok := s.approvals[id]
+	if !ok {
+		return nil
+	}
+	clone := *approval
+	return &clone
+}
+
+// Approve approves the approval as an approver in the external system.
+func (s *Server) Approve(id string) error {
+	return s.decide(id, relay.StatusApproved)
+}
+
+// Reject rejects the approval as an approver in the external system.
+func (s *Server) Reject(id string) error {
+	return s.decide(id, relay.StatusRejected)
+}
+
+func (s *Server) decide(id string, status relay.Status) error {
+	s.mu.Lock()
+	approval, ok := s.approvals[id]
+	if !ok {
+		s.mu.Unlock()
+		return errors.Errorf("approval %s not found", id)
+	}
+	if approval.Status != relay.StatusPending {
+		s.mu.Unlock()
+		return errors.Errorf("approval %s is already %s", id, approval.Status)
+	}
+	approval.Status = status
+	s.mu.Unlock()
+
+	if s.CallbackURL == "" {
+		return nil
+	}
+	body, err := json.Marshal(&relay.ResponsePayload{ID: id, Status: status})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, s.CallbackURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	relay.SignHeader(req.Header, s.secret, time.Now(), req.Method, req.URL.RequestURI(), body)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "failed to call back")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("failed to call back with status %v", resp.Status)
+	}
+	return nil
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, err := relay.ReadVerifiedBody(r, s.secret)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	if v := r.Header.Get(relay.VersionHeader); v != relay.ProtocolVersion {
+		http.Error(w, "unsupported protocol version "+v, http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/approval":
+		var payload relay.CreatePayload
+		if err := json.Unmarshal(body, &payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		approval := &Approval{
+			ID:      strconv.Itoa(s.nextID),
+			Status:  relay.StatusPending,
+			Payload: payload,
+		}
+		s.nextID++
+		s.approvals[approval.ID] = approval
+		s.writeApproval(w, r, approval)
+	case strings.HasPrefix(r.URL.Path, "/approval/"):
+		approval, ok := s.approvals[strings.TrimPrefix(r.URL.Path, "/approval/")]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			s.writeApproval(w, r, approval)
+		case http.MethodPatch:
+			var payload relay.UpdatePayload
+			if err := json.Unmarshal(body, &payload); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if payload.Status == relay.StatusCanceled && approval.Status == relay.StatusPending {
+				approval.Status = relay.StatusCanceled
+			}
+			s.writeApproval(w, r, approval)
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// writeApproval writes the approval as the response signed with the method and the request URI of the request.
+func (s *Server) writeApproval(w http.ResponseWriter, r *http.Request, approval *Approval) {
+	body, err := json.Marshal(&relay.ResponsePayload{ID: approval.ID, Status: approval.Status})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	relay.SignHeader(w.Header(), s.secret, time.Now(), r.Method, r.URL.RequestURI(), body)
+	_, _ = w.Write(body)
+}
@@ -15,6 +15,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	"github.com/bytebase/bytebase/backend/plugin/vcs"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
@@ -469,6 +470,11 @@ type QueryContext struct {
 	CurrentDatabase string
 	// ShareDB is for Redshift.
 	ShareDB bool
+
+	// RowBatchHandler receives the rows of the query result in batches as they are read, so that large results
+	// don't have to be held in memory. The handled rows are not included in the returned result.
+	// It's only supported by the drivers querying via database/sql, the other drivers return all rows in the result.
+	RowBatchHandler func(batch *v1pb.QueryResult) error
 }
 
 // DatabaseRoleMessage is the API message for database role.
@@ -481,6 +487,17 @@ type DatabaseRoleMessage struct {
 	ValidUntil *string
 	// The role attribute.
 	Attribute *string
+	// MemberOf is the list of roles or groups granted to the role.
+	// For MSSQL, it's the server roles and the database roles in "database.role" format.
+	// For Redshift, it's the user groups.
+	// For MongoDB, it's the granted roles in "database.role" format.
+	// For Spanner, it's the granted database roles.
+	MemberOf []string
+	// Database is the database that the role belongs to.
+	// For MSSQL, it's the default database of the login.
+	// For MongoDB, it's the authentication database of the user or the database that the role is defined in.
+	// For Spanner, it's the database that the database role is defined in.
+	Database string
 }
 
 // DatabaseRoleUpsertMessage is the API message for upserting a database role.
@@ -655,6 +672,8 @@ type ColumnInfo struct {
 	// TODO(zp): retire Sensitive boolean flag.
 	Sensitive    bool
 	MaskingLevel storepb.MaskingLevel
+	// Masker is the masker of the column for its masking level. The default full masker is used if it is nil.
+	Masker masker.Masker
 }
 
 // SensitiveField is the struct about SELECT fields.
@@ -663,4 +682,6 @@ type SensitiveField struct {
 	// TODO(zp): retire Sensitive boolean flag.
 	Sensitive    bool
 	MaskingLevel storepb.MaskingLevel
+	// Masker is the masker of the field if it selects a column directly. The default full masker is used if it is nil.
+	Masker masker.Masker
 }
@@ -0,0 +1,435 @@
+package mongodb
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+)
+
+// The MongoDB dump is a stream of BSON documents, similar to the archive format of mongodump:
+//
+//  1. An archive header, which contains the metadata of all collections and views, including their options
+//     (validators, capped, collation, view pipelines, etc.) and index specifications.
+//  2. For each collection, a namespace document followed by the raw BSON documents of the collection,
+//     then a terminator of four 0xFF bytes. A BSON document never starts with a negative length,
+//     so the terminator cannot be confused with a document.
+const (
+	archiveMagic   = "bytebase-mongodb-archive"
+	archiveVersion = 1
+
+	collectionTypeCollection = "collection"
+	collectionTypeView       = "view"
+	collectionTypeTimeseries = "timeseries"
+
+	// restoreBatchSize is the maximum number of documents inserted in one batch when restoring.
+	restoreBatchSize = 1000
+	// restoreBatchBytes is the maximum total size of documents inserted in one batch when restoring.
+	restoreBatchBytes = 8 * 1024 * 1024
+)
+
+var archiveTerminator = []byte{0xFF, 0xFF, 0xFF, 0xFF}
+
+// archiveHeader is the first document in the archive.
+type archiveHeader struct {
+	Magic       string               `bson:"magic"`
+	Version     int                  `bson:"version"`
+	Database    string               `bson:"database"`
+	Collections []*archiveCollection `bson:"collections"`
+}
+
+// archiveCollection is the metadata of a collection or a view in the archive.
+type archiveCollection struct {
+	Name string `bson:"name"`
+	// Type is one of "collection", "view" and "timeseries".
+	Type string `bson:"type"`
+	// Options is the options field of the listCollections command result, which can be passed to the create command.
+	Options bson.Raw `bson:"options,omitempty"`
+	// Indexes are the index specifications of the listIndexes command result.
+	Indexes []bson.Raw `bson:"indexes,omitempty"`
+}
+
+// archiveNamespace is the document preceding the documents of a collection in the archive.
+type archiveNamespace struct {
+	Collection string `bson:"collection"`
+}
+
+// collectionSpecification is the subset of the listCollections command result.
+type collectionSpecification struct {
+	Name    string   `bson:"name"`
+	Type    string   `bson:"type"`
+	Options bson.Raw `bson:"options"`
+}
+
+func (c *archiveCollection) hasData() bool {
+	return c.Type == collectionTypeCollection || c.Type == collectionTypeTimeseries
+}
+
+// Dump dumps the database in the archive format.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	// The schema only dump is stored as text for schema drift detection and database creation,
+	// which doesn't apply to the schemaless MongoDB. The collections are synced via metadata instead.
+	if schemaOnly {
+		return "", nil
+	}
+	if driver.databaseName == "" {
+		return "", errors.Errorf("database name is required to dump MongoDB")
+	}
+	database := driver.client.Database(driver.databaseName)
+	collections, err := listArchiveCollections(ctx, database)
+	if err != nil {
+		return "", err
+	}
+
+	w := bufio.NewWriter(out)
+	header := &archiveHeader{
+		Magic:       archiveMagic,
+		Version:     archiveVersion,
+		Database:    driver.databaseName,
+		Collections: collections,
+	}
+	if err := writeArchiveDocument(w, header); err != nil {
+		return "", errors.Wrap(err, "failed to write archive header")
+	}
+	for _, collection := range collections {
+		if !collection.hasData() {
+			continue
+		}
+		if err := dumpCollectionData(ctx, w, database.Collection(collection.Name)); err != nil {
+			return "", errors.Wrapf(err, "failed to dump collection %q", collection.Name)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return "", errors.Wrap(err, "failed to flush dump")
+	}
+	return "", nil
+}
+
+// listArchiveCollections lists the metadata of the collections and views in the database, ordered by name.
+func listArchiveCollections(ctx context.Context, database *mongo.Database) ([]*archiveCollection, error) {
+	cursor, err := database.ListCollections(ctx, bson.D{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list collections")
+	}
+	defer cursor.Close(ctx)
+	var collections []*archiveCollection
+	for cursor.Next(ctx) {
+		var spec collectionSpecification
+		if err := cursor.Decode(&spec); err != nil {
+			return nil, errors.Wrap(err, "failed to decode collection specification")
+		}
+		if systemCollection[spec.Name] || strings.HasPrefix(spec.Name, "system.") {
+			continue
+		}
+		collection := &archiveCollection{
+			Name:    spec.Name,
+			Type:    spec.Type,
+			Options: spec.Options,
+		}
+		if collection.hasData() {
+			indexes, err := listIndexSpecifications(ctx, database.Collection(spec.Name))
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list indexes of collection %q", spec.Name)
+			}
+			collection.Indexes = indexes
+		}
+		collections = append(collections, collection)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, errors.Wrap(err, "failed to iterate collections")
+	}
+	sort.Slice(collections, func(i, j int) bool {
+		return collections[i].Name < collections[j].Name
+	})
+	return collections, nil
+}
+
+// listIndexSpecifications returns the index specifications of a collection except the default _id index.
+func listIndexSpecifications(ctx context.Context, collection *mongo.Collection) ([]bson.Raw, error) {
+	cursor, err := collection.Indexes().List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var indexes []bson.Raw
+	for cursor.Next(ctx) {
+		if name, ok := cursor.Current.Lookup("name").StringValueOK(); ok && name == "_id_" {
+			continue
+		}
+		indexes = append(indexes, append(bson.Raw{}, cursor.Current...))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return indexes, nil
+}
+
+func dumpCollectionData(ctx context.Context, w io.Writer, collection *mongo.Collection) error {
+	if err := writeArchiveDocument(w, &archiveNamespace{Collection: collection.Name()}); err != nil {
+		return err
+	}
+	cursor, err := collection.Find(ctx, bson.D{})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+	for cursor.Next(ctx) {
+		if _, err := w.Write(cursor.Current); err != nil {
+			return err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	_, err = w.Write(archiveTerminator)
+	return err
+}
+
+// Restore restores the backup read from src into the database of the driver.
+func (driver *Driver) Restore(ctx context.Context, src io.Reader) error {
+	if driver.databaseName == "" {
+		return errors.Errorf("database name is required to restore MongoDB")
+	}
+	r := bufio.NewReader(src)
+	header, err := readArchiveHeader(r)
+	if err != nil {
+		return err
+	}
+	database := driver.client.Database(driver.databaseName)
+	collectionMap := make(map[string]*archiveCollection)
+	for _, collection := range header.Collections {
+		collectionMap[collection.Name] = collection
+	}
+
+	// Create collections before views because views may be defined on the collections.
+	for _, collection := range sortCollectionsForCreation(header.Collections) {
+		if err := createCollection(ctx, database, collection); err != nil {
+			return err
+		}
+	}
+
+	for {
+		raw, terminator, err := readArchiveRecord(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return errors.Wrap(err, "failed to read archive")
+		}
+		if terminator {
+			return errors.Errorf("unexpected terminator in archive")
+		}
+		var namespace archiveNamespace
+		if err := bson.Unmarshal(raw, &namespace); err != nil {
+			return errors.Wrap(err, "failed to decode namespace in archive")
+		}
+		collection, ok := collectionMap[namespace.Collection]
+		if !ok || !collection.hasData() {
+			return errors.Errorf("collection %q is not defined in the archive header", namespace.Collection)
+		}
+		count, err := restoreCollectionData(ctx, r, database.Collection(collection.Name))
+		if err != nil {
+			return errors.Wrapf(err, "failed to restore documents of collection %q", collection.Name)
+		}
+		log.Debug("Restored MongoDB collection", zap.String("database", driver.databaseName), zap.String("collection", collection.Name), zap.Int64("documents", count))
+	}
+
+	// Build indexes after the documents are inserted, which is much faster than maintaining them on insertion.
+	for _, collection := range header.Collections {
+		if err := createIndexes(ctx, database, collection); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readArchiveHeader(r io.Reader) (*archiveHeader, error) {
+	raw, terminator, err := readArchiveRecord(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read archive header")
+	}
+	if terminator {
+		return nil, errors.Errorf("invalid archive header")
+	}
+	var header archiveHeader
+	if err := bson.Unmarshal(raw, &header); err != nil {
+		return nil, errors.Wrap(err, "failed to decode archive header")
+	}
+	if header.Magic != archiveMagic {
+		return nil, errors.Errorf("invalid MongoDB archive, expecting magic %q but got %q", archiveMagic, header.Magic)
+	}
+	if header.Version > archiveVersion {
+		return nil, errors.Errorf("unsupported MongoDB archive version %d, the latest supported version is %d", header.Version, archiveVersion)
+	}
+	return &header, nil
+}
+
+// sortCollectionsForCreation returns collections first, then views in an order
+// such that a view is always created after the view it is defined on.
+func sortCollectionsForCreation(collections []*archiveCollection) []*archiveCollection {
+	var result []*archiveCollection
+	viewMap := make(map[string]*archiveCollection)
+	var viewNames []string
+	for _, collection := range collections {
+		if collection.Type == collectionTypeView {
+			viewMap[collection.Name] = collection
+			viewNames = append(viewNames, collection.Name)
+			continue
+		}
+		result = append(result, collection)
+	}
+	sort.Strings(viewNames)
+
+	visited := make(map[string]bool)
+	var visit func(name string)
+	visit = func(name string) {
+		view, ok := viewMap[name]
+		if !ok || visited[name] {
+			return
+		}
+		visited[name] = true
+		if viewOn, ok := view.Options.Lookup("viewOn").StringValueOK(); ok {
+			visit(viewOn)
+		}
+		result = append(result, view)
+	}
+	for _, name := range viewNames {
+		visit(name)
+	}
+	return result
+}
+
+func createCollection(ctx context.Context, database *mongo.Database, collection *archiveCollection) error {
+	command := bson.D{{Key: "create", Value: collection.Name}}
+	if len(collection.Options) > 0 {
+		elements, err := collection.Options.Elements()
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode options of collection %q", collection.Name)
+		}
+		for _, element := range elements {
+			command = append(command, bson.E{Key: element.Key(), Value: element.Value()})
+		}
+	}
+	if err := database.RunCommand(ctx, command).Err(); err != nil {
+		return errors.Wrapf(err, "failed to create %s %q", collection.Type, collection.Name)
+	}
+	return nil
+}
+
+func createIndexes(ctx context.Context, database *mongo.Database, collection *archiveCollection) error {
+	if len(collection.Indexes) == 0 {
+		return nil
+	}
+	var indexes bson.A
+	for _, index := range collection.Indexes {
+		elements, err := index.Elements()
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode index of collection %q", collection.Name)
+		}
+		var spec bson.D
+		for _, element := range elements {
+			// The index version and namespace are determined by the server.
+			if element.Key() == "v" || element.Key() == "ns" {
+				continue
+			}
+			spec = append(spec, bson.E{Key: element.Key(), Value: element.Value()})
+		}
+		indexes = append(indexes, spec)
+	}
+	command := bson.D{
+		{Key: "createIndexes", Value: collection.Name},
+		{Key: "indexes", Value: indexes},
+	}
+	if err := database.RunCommand(ctx, command).Err(); err != nil {
+		return errors.Wrapf(err, "failed to create indexes of collection %q", collection.Name)
+	}
+	return nil
+}
+
+func restoreCollectionData(ctx context.Context, r io.Reader, collection *mongo.Collection) (int64, error) {
+	var count int64
+	var batch []any
+	batchBytes := 0
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := collection.InsertMany(ctx, batch); err != nil {
+			return err
+		}
+		count += int64(len(batch))
+		batch = nil
+		batchBytes = 0
+		return nil
+	}
+	for {
+		raw, terminator, err := readArchiveRecord(r)
+		if err == io.EOF {
+			return count, errors.Errorf("unexpected end of archive, missing terminator")
+		}
+		if err != nil {
+			return count, err
+		}
+		if terminator {
+			break
+		}
+		batch = append(batch, raw)
+		batchBytes += len(raw)
+		if len(batch) >= restoreBatchSize || batchBytes >= restoreBatchBytes {
+			if err := flush(); err != nil {
+				return count, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+func writeArchiveDocument(w io.Writer, v any) error {
+	raw, err := bson.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(raw)
+	return err
+}
+
+// readArchiveRecord reads a BSON document or a terminator from the archive.
+// It returns io.EOF if the archive ends before a new record.
+func readArchiveRecord(r io.Reader) (bson.Raw, bool, error) {
+	var lengthBytes [4]byte
+	if _, err := io.ReadFull(r, lengthBytes[:]); err != nil {
+		if err == io.EOF {
+			return nil, false, io.EOF
+		}
+		return nil, false, errors.Wrap(err, "failed to read document length")
+	}
+	length := int32(binary.LittleEndian.Uint32(lengthBytes[:]))
+	if length == -1 {
+		return nil, true, nil
+	}
+	// The minimal BSON document is an empty document of 5 bytes.
+	if length < 5 {
+		return nil, false, errors.Errorf("invalid document length %d", length)
+	}
+	raw := make([]byte, length)
+	copy(raw, lengthBytes[:])
+	if _, err := io.ReadFull(r, raw[4:]); err != nil {
+		return nil, false, errors.Wrap(err, "failed to read document")
+	}
+	if err := bson.Raw(raw).Validate(); err != nil {
+		return nil, false, errors.Wrap(err, "invalid document")
+	}
+	return raw, false, nil
+}
@@ -0,0 +1,101 @@
+package mongodb
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestArchiveRecord(t *testing.T) {
+	a := require.New(t)
+
+	var buf bytes.Buffer
+	header := &archiveHeader{
+		Magic:    archiveMagic,
+		Version:  archiveVersion,
+		Database: "sampleDB",
+		Collections: []*archiveCollection{
+			{Name: "users", Type: collectionTypeCollection},
+		},
+	}
+	a.NoError(writeArchiveDocument(&buf, header))
+	a.NoError(writeArchiveDocument(&buf, &archiveNamespace{Collection: "users"}))
+	a.NoError(writeArchiveDocument(&buf, bson.D{{Key: "name", Value: "danny"}}))
+	_, err := buf.Write(archiveTerminator)
+	a.NoError(err)
+
+	got, err := readArchiveHeader(&buf)
+	a.NoError(err)
+	a.Equal("sampleDB", got.Database)
+	a.Len(got.Collections, 1)
+	a.Equal("users", got.Collections[0].Name)
+
+	raw, terminator, err := readArchiveRecord(&buf)
+	a.NoError(err)
+	a.False(terminator)
+	a.Equal("users", raw.Lookup("collection").StringValue())
+
+	raw, terminator, err = readArchiveRecord(&buf)
+	a.NoError(err)
+	a.False(terminator)
+	a.Equal("danny", raw.Lookup("name").StringValue())
+
+	_, terminator, err = readArchiveRecord(&buf)
+	a.NoError(err)
+	a.True(terminator)
+
+	_, _, err = readArchiveRecord(&buf)
+	a.Equal(io.EOF, err)
+}
+
+func TestReadArchiveHeaderInvalid(t *testing.T) {
+	tests := []struct {
+		header any
+	}{
+		{
+			header: bson.D{{Key: "magic", Value: "mongodump"}},
+		},
+		{
+			header: &archiveHeader{Magic: archiveMagic, Version: archiveVersion + 1},
+		},
+	}
+
+	a := require.New(t)
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		a.NoError(writeArchiveDocument(&buf, tt.header))
+		_, err := readArchiveHeader(&buf)
+		a.Error(err)
+	}
+
+	// Truncated document.
+	_, _, err := readArchiveRecord(bytes.NewReader([]byte{0x10, 0x00, 0x00, 0x00, 0x01}))
+	a.Error(err)
+}
+
+func TestSortCollectionsForCreation(t *testing.T) {
+	newView := func(name, viewOn string) *archiveCollection {
+		options, err := bson.Marshal(bson.D{{Key: "viewOn", Value: viewOn}, {Key: "pipeline", Value: bson.A{}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &archiveCollection{Name: name, Type: collectionTypeView, Options: options}
+	}
+	collections := []*archiveCollection{
+		newView("a_view", "b_view"),
+		newView("b_view", "users"),
+		{Name: "users", Type: collectionTypeCollection},
+		newView("c_view", "users"),
+		{Name: "metrics", Type: collectionTypeTimeseries},
+	}
+
+	a := require.New(t)
+	var got []string
+	for _, collection := range sortCollectionsForCreation(collections) {
+		got = append(got, collection.Name)
+	}
+	a.Equal([]string{"users", "metrics", "b_view", "a_view", "c_view"}, got)
+}
@@ -3,10 +3,13 @@ package mongodb
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
 
+	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -17,29 +20,228 @@ const (
 	bytebaseDefaultDatabase = "bytebase"
 )
 
+// The role of MongoDB is either a user or a custom role, named in "database.name" format, e.g. "admin.alice".
+// A user is created if the password is set, otherwise a custom role is created.
+// The attribute of the role is the JSON array of the granted roles, e.g. `[{"role": "readWrite", "db": "app"}]`.
+
+// RolesInfo is the subset of the mongodb command rolesInfo.
+type RolesInfo struct {
+	Roles []CustomRole `bson:"roles"`
+}
+
+// CustomRole is the subset of the `roles` field in the `RolesInfo`.
+type CustomRole struct {
+	ID       string `json:"_id" bson:"_id"`
+	RoleName string `json:"role" bson:"role"`
+	DB       string `json:"db" bson:"db"`
+	Roles    []Role `json:"roles" bson:"roles"`
+}
+
 // CreateRole creates the role.
-func (*Driver) CreateRole(_ context.Context, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("create role for MongoDB is not implemented yet")
+func (driver *Driver) CreateRole(ctx context.Context, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	databaseName, name := splitRoleName(upsert.Name)
+	roles, err := parseRoles(upsert.Attribute)
+	if err != nil {
+		return nil, err
+	}
+
+	var command bson.D
+	if upsert.Password != nil {
+		command = bson.D{
+			{Key: "createUser", Value: name},
+			{Key: "pwd", Value: *upsert.Password},
+			{Key: "roles", Value: roles},
+		}
+	} else {
+		command = bson.D{
+			{Key: "createRole", Value: name},
+			{Key: "privileges", Value: bson.A{}},
+			{Key: "roles", Value: roles},
+		}
+	}
+	if err := driver.client.Database(databaseName).RunCommand(ctx, command).Err(); err != nil {
+		return nil, errors.Wrapf(err, "failed to create role %q", upsert.Name)
+	}
+
+	return driver.FindRole(ctx, upsert.Name)
 }
 
 // UpdateRole updates the role.
-func (*Driver) UpdateRole(_ context.Context, _ string, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("update role for MongoDB is not implemented yet")
+func (driver *Driver) UpdateRole(ctx context.Context, roleName string, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	if roleName != upsert.Name {
+		return nil, errors.Errorf("MongoDB does not support renaming the role %q", roleName)
+	}
+	_, isUser, err := driver.findRoleImpl(ctx, roleName)
+	if err != nil {
+		return nil, err
+	}
+	databaseName, name := splitRoleName(roleName)
+
+	var command bson.D
+	if isUser {
+		command = bson.D{{Key: "updateUser", Value: name}}
+		if upsert.Password != nil {
+			command = append(command, bson.E{Key: "pwd", Value: *upsert.Password})
+		}
+	} else {
+		if upsert.Password != nil {
+			return nil, errors.Errorf("cannot set the password for the MongoDB role %q", roleName)
+		}
+		command = bson.D{{Key: "updateRole", Value: name}}
+	}
+	if upsert.Attribute != nil {
+		roles, err := parseRoles(upsert.Attribute)
+		if err != nil {
+			return nil, err
+		}
+		command = append(command, bson.E{Key: "roles", Value: roles})
+	}
+	if len(command) > 1 {
+		if err := driver.client.Database(databaseName).RunCommand(ctx, command).Err(); err != nil {
+			return nil, errors.Wrapf(err, "failed to update role %q", roleName)
+		}
+	}
+
+	return driver.FindRole(ctx, roleName)
 }
 
 // FindRole finds the role by name.
-func (*Driver) FindRole(_ context.Context, _ string) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("find role for MongoDB is not implemented yet")
+func (driver *Driver) FindRole(ctx context.Context, roleName string) (*db.DatabaseRoleMessage, error) {
+	role, _, err := driver.findRoleImpl(ctx, roleName)
+	return role, err
+}
+
+// findRoleImpl finds the user or the custom role by name, and returns true if it's a user.
+func (driver *Driver) findRoleImpl(ctx context.Context, roleName string) (*db.DatabaseRoleMessage, bool, error) {
+	databaseName, name := splitRoleName(roleName)
+	database := driver.client.Database(databaseName)
+
+	var usersInfo UsersInfo
+	if err := database.RunCommand(ctx, bson.D{{Key: "usersInfo", Value: name}}).Decode(&usersInfo); err != nil {
+		return nil, false, errors.Wrap(err, "cannot run usersInfo command")
+	}
+	if len(usersInfo.Users) > 0 {
+		role, err := convertToRole(usersInfo.Users[0].ID, usersInfo.Users[0].DB, usersInfo.Users[0].Roles)
+		return role, true, err
+	}
+
+	var rolesInfo RolesInfo
+	if err := database.RunCommand(ctx, bson.D{{Key: "rolesInfo", Value: name}}).Decode(&rolesInfo); err != nil {
+		return nil, false, errors.Wrap(err, "cannot run rolesInfo command")
+	}
+	if len(rolesInfo.Roles) > 0 {
+		role, err := convertToRole(rolesInfo.Roles[0].ID, rolesInfo.Roles[0].DB, rolesInfo.Roles[0].Roles)
+		return role, false, err
+	}
+
+	return nil, false, common.Errorf(common.NotFound, fmt.Sprintf("cannot find the role %s", roleName))
 }
 
 // ListRole lists the role.
-func (*Driver) ListRole(_ context.Context) ([]*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("list role for MongoDB is not implemented yet")
+func (driver *Driver) ListRole(ctx context.Context) ([]*db.DatabaseRoleMessage, error) {
+	var usersInfo UsersInfo
+	if err := driver.client.Database(bytebaseDefaultDatabase).RunCommand(ctx, bson.D{{
+		Key:   "usersInfo",
+		Value: bson.D{{Key: "forAllDBs", Value: true}},
+	}}).Decode(&usersInfo); err != nil {
+		return nil, errors.Wrap(err, "cannot run usersInfo command")
+	}
+	var result []*db.DatabaseRoleMessage
+	for _, user := range usersInfo.Users {
+		role, err := convertToRole(user.ID, user.DB, user.Roles)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, role)
+	}
+
+	// The custom roles are defined in each database, and the built-in roles are excluded by default.
+	databaseNames, err := driver.client.ListDatabaseNames(ctx, bson.M{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list database names")
+	}
+	for _, databaseName := range databaseNames {
+		var rolesInfo RolesInfo
+		if err := driver.client.Database(databaseName).RunCommand(ctx, bson.D{{Key: "rolesInfo", Value: 1}}).Decode(&rolesInfo); err != nil {
+			return nil, errors.Wrapf(err, "cannot run rolesInfo command in database %q", databaseName)
+		}
+		for _, customRole := range rolesInfo.Roles {
+			role, err := convertToRole(customRole.ID, customRole.DB, customRole.Roles)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, role)
+		}
+	}
+	return result, nil
 }
 
 // DeleteRole deletes the role by name.
-func (*Driver) DeleteRole(_ context.Context, _ string) error {
-	return errors.Errorf("delete role for MongoDB is not implemented yet")
+func (driver *Driver) DeleteRole(ctx context.Context, roleName string) error {
+	_, isUser, err := driver.findRoleImpl(ctx, roleName)
+	if err != nil {
+		if common.ErrorCode(err) == common.NotFound {
+			return nil
+		}
+		return err
+	}
+	databaseName, name := splitRoleName(roleName)
+	command := bson.D{{Key: "dropRole", Value: name}}
+	if isUser {
+		command = bson.D{{Key: "dropUser", Value: name}}
+	}
+	if err := driver.client.Database(databaseName).RunCommand(ctx, command).Err(); err != nil {
+		return errors.Wrapf(err, "failed to delete role %q", roleName)
+	}
+	return nil
+}
+
+func convertToRole(name, databaseName string, roles []Role) (*db.DatabaseRoleMessage, error) {
+	if roles == nil {
+		roles = []Role{}
+	}
+	bs, err := json.Marshal(roles)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot marshal roles")
+	}
+	attribute := string(bs)
+	role := &db.DatabaseRoleMessage{
+		Name:      name,
+		Attribute: &attribute,
+		Database:  databaseName,
+	}
+	for _, r := range roles {
+		role.MemberOf = append(role.MemberOf, fmt.Sprintf("%s.%s", r.DB, r.RoleName))
+	}
+	return role, nil
+}
+
+// splitRoleName splits the role name into the database name and the user or role name.
+// The database name is "admin" if it's not specified.
+func splitRoleName(roleName string) (string, string) {
+	if databaseName, name, ok := strings.Cut(roleName, "."); ok {
+		return databaseName, name
+	}
+	return "admin", roleName
+}
+
+// parseRoles parses the JSON array of the granted roles.
+func parseRoles(attribute *string) (bson.A, error) {
+	roles := bson.A{}
+	if attribute == nil || strings.TrimSpace(*attribute) == "" {
+		return roles, nil
+	}
+	var roleList []Role
+	if err := json.Unmarshal([]byte(*attribute), &roleList); err != nil {
+		return nil, errors.Wrapf(err, "invalid role attribute %q, it should be the JSON array of roles such as [{\"role\": \"readWrite\", \"db\": \"app\"}]", *attribute)
+	}
+	for _, role := range roleList {
+		if role.RoleName == "" || role.DB == "" {
+			return nil, errors.Errorf("invalid role attribute %q, both role and db are required", *attribute)
+		}
+		roles = append(roles, bson.D{{Key: "role", Value: role.RoleName}, {Key: "db", Value: role.DB}})
+	}
+	return roles, nil
 }
 
 // getUserList returns the list of users.
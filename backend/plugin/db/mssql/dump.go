@@ -2,12 +2,92 @@ package mssql
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"io"
+	"strings"
+
+	"github.com/pkg/errors"
 )
 
-// Dump dumps the database.
-func (*Driver) Dump(_ context.Context, _ io.Writer, _ bool) (string, error) {
-	// TODO(d): implement it.
+// dumpSchema is the schema objects of a database read from the system catalog.
+type dumpSchema struct {
+	schemas   []string
+	sequences []*dumpSequence
+	tables    []*dumpTable
+	// modules are the definitions of the views, functions, procedures and triggers in the creation order.
+	modules []string
+}
+
+type dumpSequence struct {
+	schema      string
+	name        string
+	dataType    string
+	startValue  string
+	increment   string
+	minValue    string
+	maxValue    string
+	isCycling   bool
+	isCached    bool
+	cacheSize   sql.NullInt64
+	userDefined bool
+}
+
+type dumpTable struct {
+	id          int
+	schema      string
+	name        string
+	columns     []*dumpColumn
+	constraints []string
+	foreignKeys []string
+	indexes     []string
+}
+
+type dumpColumn struct {
+	name        string
+	dataType    string
+	nullable    bool
+	collation   sql.NullString
+	identity    string
+	computed    sql.NullString
+	persisted   bool
+	defaultName sql.NullString
+	defaultExpr sql.NullString
+}
+
+type dumpIndexColumn struct {
+	name       string
+	descending bool
+	included   bool
+}
+
+type dumpIndexKey struct {
+	objectID int
+	indexID  int
+}
+
+// Dump dumps the schema of the database as T-SQL statements, which can be parsed by the SQL Server schema differ.
+// Dumping the data is not supported.
+func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	if !schemaOnly {
+		return "", errors.New("dumping the data of SQL Server databases is not supported")
+	}
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer txn.Rollback()
+
+	schema, err := getDumpSchema(ctx, txn)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to dump schema of database %q", driver.databaseName)
+	}
+	if err := txn.Commit(); err != nil {
+		return "", err
+	}
+	if err := writeDumpSchema(out, schema); err != nil {
+		return "", err
+	}
 	return "", nil
 }
 
@@ -16,3 +96,646 @@ func (*Driver) Restore(_ context.Context, _ io.Reader) (err error) {
 	// TODO(d): implement it.
 	return nil
 }
+
+func getDumpSchema(ctx context.Context, txn *sql.Tx) (*dumpSchema, error) {
+	schemaNames, err := getSchemas(txn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get schemas")
+	}
+	schema := &dumpSchema{}
+	for _, schemaName := range schemaNames {
+		// The dbo schema exists in every database.
+		if schemaName != "dbo" {
+			schema.schemas = append(schema.schemas, schemaName)
+		}
+	}
+	if schema.sequences, err = getDumpSequences(ctx, txn); err != nil {
+		return nil, errors.Wrap(err, "failed to get sequences")
+	}
+	if schema.tables, err = getDumpTables(ctx, txn); err != nil {
+		return nil, errors.Wrap(err, "failed to get tables")
+	}
+	if schema.modules, err = getDumpModules(ctx, txn); err != nil {
+		return nil, errors.Wrap(err, "failed to get views, functions, procedures and triggers")
+	}
+	return schema, nil
+}
+
+func getDumpSequences(ctx context.Context, txn *sql.Tx) ([]*dumpSequence, error) {
+	query := `
+		SELECT
+			SCHEMA_NAME(s.schema_id),
+			s.name,
+			t.name,
+			t.is_user_defined,
+			CAST(s.start_value AS NVARCHAR(64)),
+			CAST(s.increment AS NVARCHAR(64)),
+			CAST(s.minimum_value AS NVARCHAR(64)),
+			CAST(s.maximum_value AS NVARCHAR(64)),
+			s.is_cycling,
+			s.is_cached,
+			s.cache_size
+		FROM sys.sequences s
+		INNER JOIN sys.types t ON s.user_type_id = t.user_type_id
+		ORDER BY SCHEMA_NAME(s.schema_id), s.name`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sequences []*dumpSequence
+	for rows.Next() {
+		sequence := &dumpSequence{}
+		if err := rows.Scan(
+			&sequence.schema,
+			&sequence.name,
+			&sequence.dataType,
+			&sequence.userDefined,
+			&sequence.startValue,
+			&sequence.increment,
+			&sequence.minValue,
+			&sequence.maxValue,
+			&sequence.isCycling,
+			&sequence.isCached,
+			&sequence.cacheSize,
+		); err != nil {
+			return nil, err
+		}
+		sequences = append(sequences, sequence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return sequences, nil
+}
+
+func getDumpTables(ctx context.Context, txn *sql.Tx) ([]*dumpTable, error) {
+	query := `
+		SELECT t.object_id, SCHEMA_NAME(t.schema_id), t.name
+		FROM sys.tables t
+		WHERE t.is_ms_shipped = 0
+		ORDER BY SCHEMA_NAME(t.schema_id), t.name`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tables []*dumpTable
+	tableMap := make(map[int]*dumpTable)
+	for rows.Next() {
+		table := &dumpTable{}
+		if err := rows.Scan(&table.id, &table.schema, &table.name); err != nil {
+			return nil, err
+		}
+		tables = append(tables, table)
+		tableMap[table.id] = table
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if err := getDumpColumns(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to get columns")
+	}
+	indexColumnMap, err := getDumpIndexColumns(ctx, txn)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get index columns")
+	}
+	if err := getDumpKeyConstraints(ctx, txn, tableMap, indexColumnMap); err != nil {
+		return nil, errors.Wrap(err, "failed to get primary keys and unique constraints")
+	}
+	if err := getDumpCheckConstraints(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to get check constraints")
+	}
+	if err := getDumpForeignKeys(ctx, txn, tableMap); err != nil {
+		return nil, errors.Wrap(err, "failed to get foreign keys")
+	}
+	if err := getDumpIndexes(ctx, txn, tableMap, indexColumnMap); err != nil {
+		return nil, errors.Wrap(err, "failed to get indexes")
+	}
+	return tables, nil
+}
+
+func getDumpColumns(ctx context.Context, txn *sql.Tx, tableMap map[int]*dumpTable) error {
+	// The collation is only dumped if it is different from the database collation.
+	query := `
+		SELECT
+			c.object_id,
+			c.name,
+			SCHEMA_NAME(t.schema_id),
+			t.name,
+			t.is_user_defined,
+			c.max_length,
+			c.precision,
+			c.scale,
+			c.is_nullable,
+			CASE WHEN c.collation_name <> CAST(DATABASEPROPERTYEX(DB_NAME(), 'Collation') AS NVARCHAR(128)) THEN c.collation_name END,
+			CAST(ic.seed_value AS NVARCHAR(64)),
+			CAST(ic.increment_value AS NVARCHAR(64)),
+			cc.definition,
+			ISNULL(cc.is_persisted, 0),
+			dc.name,
+			dc.definition
+		FROM sys.columns c
+		INNER JOIN sys.tables tb ON c.object_id = tb.object_id
+		INNER JOIN sys.types t ON c.user_type_id = t.user_type_id
+		LEFT JOIN sys.identity_columns ic ON c.object_id = ic.object_id AND c.column_id = ic.column_id
+		LEFT JOIN sys.computed_columns cc ON c.object_id = cc.object_id AND c.column_id = cc.column_id
+		LEFT JOIN sys.default_constraints dc ON c.default_object_id = dc.object_id
+		WHERE tb.is_ms_shipped = 0
+		ORDER BY c.object_id, c.column_id`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		column := &dumpColumn{}
+		var objectID, maxLength, precision, scale int
+		var typeSchema, typeName string
+		var userDefined bool
+		var seed, increment sql.NullString
+		if err := rows.Scan(
+			&objectID,
+			&column.name,
+			&typeSchema,
+			&typeName,
+			&userDefined,
+			&maxLength,
+			&precision,
+			&scale,
+			&column.nullable,
+			&column.collation,
+			&seed,
+			&increment,
+			&column.computed,
+			&column.persisted,
+			&column.defaultName,
+			&column.defaultExpr,
+		); err != nil {
+			return err
+		}
+		table, ok := tableMap[objectID]
+		if !ok {
+			continue
+		}
+		if userDefined {
+			column.dataType = fmt.Sprintf("%s.%s", quoteIdentifier(typeSchema), quoteIdentifier(typeName))
+		} else {
+			column.dataType = getColumnDataType(typeName, maxLength, precision, scale)
+		}
+		if seed.Valid && increment.Valid {
+			column.identity = fmt.Sprintf("IDENTITY(%s, %s)", seed.String, increment.String)
+		}
+		table.columns = append(table.columns, column)
+	}
+	return rows.Err()
+}
+
+// getDumpIndexColumns returns the key columns followed by the included columns of the indexes.
+func getDumpIndexColumns(ctx context.Context, txn *sql.Tx) (map[dumpIndexKey][]*dumpIndexColumn, error) {
+	query := `
+		SELECT ic.object_id, ic.index_id, c.name, ic.is_descending_key, ic.is_included_column
+		FROM sys.index_columns ic
+		INNER JOIN sys.columns c ON ic.object_id = c.object_id AND ic.column_id = c.column_id
+		INNER JOIN sys.tables t ON ic.object_id = t.object_id
+		WHERE t.is_ms_shipped = 0
+		ORDER BY ic.object_id, ic.index_id, ic.is_included_column, ic.key_ordinal, ic.index_column_id`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	indexColumnMap := make(map[dumpIndexKey][]*dumpIndexColumn)
+	for rows.Next() {
+		var key dumpIndexKey
+		column := &dumpIndexColumn{}
+		if err := rows.Scan(&key.objectID, &key.indexID, &column.name, &column.descending, &column.included); err != nil {
+			return nil, err
+		}
+		indexColumnMap[key] = append(indexColumnMap[key], column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return indexColumnMap, nil
+}
+
+func getDumpKeyConstraints(ctx context.Context, txn *sql.Tx, tableMap map[int]*dumpTable, indexColumnMap map[dumpIndexKey][]*dumpIndexColumn) error {
+	query := `
+		SELECT kc.parent_object_id, kc.unique_index_id, kc.name, kc.type, i.type_desc
+		FROM sys.key_constraints kc
+		INNER JOIN sys.indexes i ON kc.parent_object_id = i.object_id AND kc.unique_index_id = i.index_id
+		ORDER BY kc.parent_object_id, kc.type, kc.name`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key dumpIndexKey
+		var name, tp, indexType string
+		if err := rows.Scan(&key.objectID, &key.indexID, &name, &tp, &indexType); err != nil {
+			return err
+		}
+		table, ok := tableMap[key.objectID]
+		if !ok {
+			continue
+		}
+		keyword := "UNIQUE"
+		if strings.TrimSpace(tp) == "PK" {
+			keyword = "PRIMARY KEY"
+		}
+		table.constraints = append(table.constraints, fmt.Sprintf("CONSTRAINT %s %s %s (%s)", quoteIdentifier(name), keyword, indexType, getIndexKeyColumns(indexColumnMap[key])))
+	}
+	return rows.Err()
+}
+
+func getDumpCheckConstraints(ctx context.Context, txn *sql.Tx, tableMap map[int]*dumpTable) error {
+	query := `
+		SELECT parent_object_id, name, definition
+		FROM sys.check_constraints
+		WHERE is_ms_shipped = 0
+		ORDER BY parent_object_id, name`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var objectID int
+		var name, definition string
+		if err := rows.Scan(&objectID, &name, &definition); err != nil {
+			return err
+		}
+		table, ok := tableMap[objectID]
+		if !ok {
+			continue
+		}
+		// The definition is enclosed in parentheses by SQL Server.
+		table.constraints = append(table.constraints, fmt.Sprintf("CONSTRAINT %s CHECK %s", quoteIdentifier(name), definition))
+	}
+	return rows.Err()
+}
+
+func getDumpForeignKeys(ctx context.Context, txn *sql.Tx, tableMap map[int]*dumpTable) error {
+	query := `
+		SELECT
+			fk.parent_object_id,
+			fk.name,
+			c.name,
+			SCHEMA_NAME(rt.schema_id),
+			rt.name,
+			rc.name,
+			fk.delete_referential_action_desc,
+			fk.update_referential_action_desc
+		FROM sys.foreign_keys fk
+		INNER JOIN sys.foreign_key_columns fkc ON fk.object_id = fkc.constraint_object_id
+		INNER JOIN sys.columns c ON fkc.parent_object_id = c.object_id AND fkc.parent_column_id = c.column_id
+		INNER JOIN sys.tables rt ON fkc.referenced_object_id = rt.object_id
+		INNER JOIN sys.columns rc ON fkc.referenced_object_id = rc.object_id AND fkc.referenced_column_id = rc.column_id
+		WHERE fk.is_ms_shipped = 0
+		ORDER BY fk.parent_object_id, fk.name, fkc.constraint_column_id`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	type foreignKey struct {
+		table             *dumpTable
+		name              string
+		columns           []string
+		referencedTable   string
+		referencedColumns []string
+		onDelete          string
+		onUpdate          string
+	}
+	var foreignKeys []*foreignKey
+	for rows.Next() {
+		var objectID int
+		var name, column, referencedSchema, referencedTable, referencedColumn, onDelete, onUpdate string
+		if err := rows.Scan(&objectID, &name, &column, &referencedSchema, &referencedTable, &referencedColumn, &onDelete, &onUpdate); err != nil {
+			return err
+		}
+		table, ok := tableMap[objectID]
+		if !ok {
+			continue
+		}
+		if len(foreignKeys) == 0 || foreignKeys[len(foreignKeys)-1].table != table || foreignKeys[len(foreignKeys)-1].name != name {
+			foreignKeys = append(foreignKeys, &foreignKey{
+				table:           table,
+				name:            name,
+				referencedTable: fmt.Sprintf("%s.%s", quoteIdentifier(referencedSchema), quoteIdentifier(referencedTable)),
+				onDelete:        onDelete,
+				onUpdate:        onUpdate,
+			})
+		}
+		fk := foreignKeys[len(foreignKeys)-1]
+		fk.columns = append(fk.columns, quoteIdentifier(column))
+		fk.referencedColumns = append(fk.referencedColumns, quoteIdentifier(referencedColumn))
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, fk := range foreignKeys {
+		var buf strings.Builder
+		_, _ = fmt.Fprintf(&buf, "CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (%s)", quoteIdentifier(fk.name), strings.Join(fk.columns, ", "), fk.referencedTable, strings.Join(fk.referencedColumns, ", "))
+		if fk.onDelete != "NO_ACTION" {
+			_, _ = fmt.Fprintf(&buf, " ON DELETE %s", strings.ReplaceAll(fk.onDelete, "_", " "))
+		}
+		if fk.onUpdate != "NO_ACTION" {
+			_, _ = fmt.Fprintf(&buf, " ON UPDATE %s", strings.ReplaceAll(fk.onUpdate, "_", " "))
+		}
+		fk.table.foreignKeys = append(fk.table.foreignKeys, buf.String())
+	}
+	return nil
+}
+
+func getDumpIndexes(ctx context.Context, txn *sql.Tx, tableMap map[int]*dumpTable, indexColumnMap map[dumpIndexKey][]*dumpIndexColumn) error {
+	// The indexes of the primary keys and unique constraints are dumped with the constraints.
+	query := `
+		SELECT i.object_id, i.index_id, i.name, i.is_unique, i.type_desc, i.filter_definition
+		FROM sys.indexes i
+		INNER JOIN sys.tables t ON i.object_id = t.object_id
+		WHERE t.is_ms_shipped = 0
+			AND i.is_primary_key = 0
+			AND i.is_unique_constraint = 0
+			AND i.is_hypothetical = 0
+			AND i.type_desc IN ('CLUSTERED', 'NONCLUSTERED', 'CLUSTERED COLUMNSTORE', 'NONCLUSTERED COLUMNSTORE')
+		ORDER BY i.object_id, i.name`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key dumpIndexKey
+		var name, indexType string
+		var unique bool
+		var filter sql.NullString
+		if err := rows.Scan(&key.objectID, &key.indexID, &name, &unique, &indexType, &filter); err != nil {
+			return err
+		}
+		table, ok := tableMap[key.objectID]
+		if !ok {
+			continue
+		}
+		table.indexes = append(table.indexes, getCreateIndexStmt(table, name, unique, indexType, filter, indexColumnMap[key]))
+	}
+	return rows.Err()
+}
+
+func getDumpModules(ctx context.Context, txn *sql.Tx) ([]string, error) {
+	// The definition is NULL for the encrypted modules.
+	query := `
+		SELECT m.definition
+		FROM sys.sql_modules m
+		INNER JOIN sys.objects o ON m.object_id = o.object_id
+		WHERE o.is_ms_shipped = 0
+			AND o.type IN ('V', 'FN', 'IF', 'TF', 'P', 'TR')
+			AND m.definition IS NOT NULL
+		ORDER BY o.create_date, o.object_id`
+	rows, err := txn.QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var modules []string
+	for rows.Next() {
+		var definition string
+		if err := rows.Scan(&definition); err != nil {
+			return nil, err
+		}
+		modules = append(modules, strings.TrimSpace(definition))
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return modules, nil
+}
+
+// writeDumpSchema writes the schema in the dependency order. The statements which must be the first statement
+// in a batch, such as CREATE SCHEMA and CREATE VIEW, are followed by GO.
+func writeDumpSchema(out io.Writer, schema *dumpSchema) error {
+	var buf strings.Builder
+	for _, schemaName := range schema.schemas {
+		_, _ = fmt.Fprintf(&buf, "CREATE SCHEMA %s;\nGO\n\n", quoteIdentifier(schemaName))
+	}
+	for _, sequence := range schema.sequences {
+		_, _ = buf.WriteString(getCreateSequenceStmt(sequence))
+		_, _ = buf.WriteString("\n\n")
+	}
+	for _, table := range schema.tables {
+		_, _ = buf.WriteString(getCreateTableStmt(table))
+		_, _ = buf.WriteString("\n\n")
+	}
+	// Add the foreign keys after all tables are created.
+	for _, table := range schema.tables {
+		for _, foreignKey := range table.foreignKeys {
+			_, _ = fmt.Fprintf(&buf, "ALTER TABLE %s.%s ADD %s;\n\n", quoteIdentifier(table.schema), quoteIdentifier(table.name), foreignKey)
+		}
+	}
+	for _, table := range schema.tables {
+		for _, index := range table.indexes {
+			_, _ = buf.WriteString(index)
+			_, _ = buf.WriteString("\n\n")
+		}
+	}
+	if len(schema.modules) > 0 {
+		_, _ = buf.WriteString("GO\n\n")
+	}
+	for _, module := range schema.modules {
+		_, _ = buf.WriteString(module)
+		_, _ = buf.WriteString("\nGO\n\n")
+	}
+	_, err := io.WriteString(out, buf.String())
+	return err
+}
+
+func getCreateSequenceStmt(sequence *dumpSequence) string {
+	dataType := sequence.dataType
+	if sequence.userDefined {
+		dataType = quoteIdentifier(dataType)
+	}
+	partList := []string{
+		fmt.Sprintf("CREATE SEQUENCE %s.%s AS %s", quoteIdentifier(sequence.schema), quoteIdentifier(sequence.name), dataType),
+		fmt.Sprintf("START WITH %s", sequence.startValue),
+		fmt.Sprintf("INCREMENT BY %s", sequence.increment),
+		fmt.Sprintf("MINVALUE %s", sequence.minValue),
+		fmt.Sprintf("MAXVALUE %s", sequence.maxValue),
+	}
+	if sequence.isCycling {
+		partList = append(partList, "CYCLE")
+	} else {
+		partList = append(partList, "NO CYCLE")
+	}
+	switch {
+	case !sequence.isCached:
+		partList = append(partList, "NO CACHE")
+	case sequence.cacheSize.Valid:
+		partList = append(partList, fmt.Sprintf("CACHE %d", sequence.cacheSize.Int64))
+	default:
+		partList = append(partList, "CACHE")
+	}
+	return strings.Join(partList, " ") + ";"
+}
+
+func getCreateTableStmt(table *dumpTable) string {
+	var itemList []string
+	for _, column := range table.columns {
+		partList := []string{quoteIdentifier(column.name)}
+		if column.computed.Valid {
+			// The definition is enclosed in parentheses by SQL Server.
+			partList = append(partList, "AS", column.computed.String)
+			if column.persisted {
+				partList = append(partList, "PERSISTED")
+			}
+			itemList = append(itemList, strings.Join(partList, " "))
+			continue
+		}
+		partList = append(partList, column.dataType)
+		if column.identity != "" {
+			partList = append(partList, column.identity)
+		}
+		if column.collation.Valid {
+			partList = append(partList, "COLLATE", column.collation.String)
+		}
+		if column.defaultExpr.Valid {
+			if column.defaultName.Valid {
+				partList = append(partList, "CONSTRAINT", quoteIdentifier(column.defaultName.String))
+			}
+			partList = append(partList, "DEFAULT", unwrapParentheses(column.defaultExpr.String))
+		}
+		if column.nullable {
+			partList = append(partList, "NULL")
+		} else {
+			partList = append(partList, "NOT NULL")
+		}
+		itemList = append(itemList, strings.Join(partList, " "))
+	}
+	itemList = append(itemList, table.constraints...)
+
+	var buf strings.Builder
+	_, _ = fmt.Fprintf(&buf, "CREATE TABLE %s.%s (\n", quoteIdentifier(table.schema), quoteIdentifier(table.name))
+	for i, item := range itemList {
+		_, _ = buf.WriteString("    ")
+		_, _ = buf.WriteString(item)
+		if i != len(itemList)-1 {
+			_, _ = buf.WriteString(",")
+		}
+		_, _ = buf.WriteString("\n")
+	}
+	_, _ = buf.WriteString(");")
+	return buf.String()
+}
+
+func getCreateIndexStmt(table *dumpTable, name string, unique bool, indexType string, filter sql.NullString, columns []*dumpIndexColumn) string {
+	tableName := fmt.Sprintf("%s.%s", quoteIdentifier(table.schema), quoteIdentifier(table.name))
+	switch indexType {
+	case "CLUSTERED COLUMNSTORE":
+		return fmt.Sprintf("CREATE CLUSTERED COLUMNSTORE INDEX %s ON %s;", quoteIdentifier(name), tableName)
+	case "NONCLUSTERED COLUMNSTORE":
+		var columnList []string
+		for _, column := range columns {
+			columnList = append(columnList, quoteIdentifier(column.name))
+		}
+		return fmt.Sprintf("CREATE NONCLUSTERED COLUMNSTORE INDEX %s ON %s (%s);", quoteIdentifier(name), tableName, strings.Join(columnList, ", "))
+	}
+
+	partList := []string{"CREATE"}
+	if unique {
+		partList = append(partList, "UNIQUE")
+	}
+	partList = append(partList, indexType, "INDEX", quoteIdentifier(name), "ON", tableName, fmt.Sprintf("(%s)", getIndexKeyColumns(columns)))
+	var includedList []string
+	for _, column := range columns {
+		if column.included {
+			includedList = append(includedList, quoteIdentifier(column.name))
+		}
+	}
+	if len(includedList) > 0 {
+		partList = append(partList, fmt.Sprintf("INCLUDE (%s)", strings.Join(includedList, ", ")))
+	}
+	if filter.Valid {
+		partList = append(partList, "WHERE", filter.String)
+	}
+	return strings.Join(partList, " ") + ";"
+}
+
+func getIndexKeyColumns(columns []*dumpIndexColumn) string {
+	var columnList []string
+	for _, column := range columns {
+		if column.included {
+			continue
+		}
+		order := "ASC"
+		if column.descending {
+			order = "DESC"
+		}
+		columnList = append(columnList, fmt.Sprintf("%s %s", quoteIdentifier(column.name), order))
+	}
+	return strings.Join(columnList, ", ")
+}
+
+// getColumnDataType returns the data type of the column with the length, precision or scale.
+// The max_length of the Unicode types is in bytes, which is twice the length in characters.
+func getColumnDataType(typeName string, maxLength, precision, scale int) string {
+	switch typeName {
+	case "char", "varchar", "binary", "varbinary":
+		if maxLength == -1 {
+			return fmt.Sprintf("%s(MAX)", typeName)
+		}
+		return fmt.Sprintf("%s(%d)", typeName, maxLength)
+	case "nchar", "nvarchar":
+		if maxLength == -1 {
+			return fmt.Sprintf("%s(MAX)", typeName)
+		}
+		return fmt.Sprintf("%s(%d)", typeName, maxLength/2)
+	case "decimal", "numeric":
+		return fmt.Sprintf("%s(%d, %d)", typeName, precision, scale)
+	case "datetime2", "datetimeoffset", "time":
+		return fmt.Sprintf("%s(%d)", typeName, scale)
+	default:
+		return typeName
+	}
+}
+
+// unwrapParentheses removes the parentheses enclosing the whole expression, which are added by SQL Server
+// when storing the default definitions, such as ((0)) and (getdate()).
+func unwrapParentheses(expr string) string {
+	for len(expr) >= 2 && expr[0] == '(' && expr[len(expr)-1] == ')' {
+		depth := 0
+		inString := false
+		enclosed := true
+		for i := 0; i < len(expr)-1; i++ {
+			switch {
+			case expr[i] == '\'':
+				inString = !inString
+			case inString:
+			case expr[i] == '(':
+				depth++
+			case expr[i] == ')':
+				depth--
+			}
+			if depth == 0 {
+				enclosed = false
+				break
+			}
+		}
+		if !enclosed {
+			return expr
+		}
+		expr = strings.TrimSpace(expr[1 : len(expr)-1])
+	}
+	return expr
+}
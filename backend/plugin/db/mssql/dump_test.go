@@ -0,0 +1,113 @@
+package mssql
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	mssqldiffer "github.com/bytebase/bytebase/backend/plugin/parser/sql/differ/mssql"
+)
+
+func TestGetColumnDataType(t *testing.T) {
+	tests := []struct {
+		typeName  string
+		maxLength int
+		precision int
+		scale     int
+		want      string
+	}{
+		{typeName: "int", maxLength: 4, precision: 10, want: "int"},
+		{typeName: "varchar", maxLength: 20, want: "varchar(20)"},
+		{typeName: "nvarchar", maxLength: 20, want: "nvarchar(10)"},
+		{typeName: "nvarchar", maxLength: -1, want: "nvarchar(MAX)"},
+		{typeName: "decimal", maxLength: 5, precision: 7, scale: 2, want: "decimal(7, 2)"},
+		{typeName: "datetime2", maxLength: 8, precision: 27, scale: 7, want: "datetime2(7)"},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, getColumnDataType(test.typeName, test.maxLength, test.precision, test.scale))
+	}
+}
+
+func TestUnwrapParentheses(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{expr: "((0))", want: "0"},
+		{expr: "(getdate())", want: "getdate()"},
+		{expr: "(N'(a)')", want: "N'(a)'"},
+		{expr: "((1)+(2))", want: "(1)+(2)"},
+		{expr: "(N')')", want: "N')'"},
+		{expr: "0", want: "0"},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		a.Equal(test.want, unwrapParentheses(test.expr))
+	}
+}
+
+func TestWriteDumpSchema(t *testing.T) {
+	a := require.New(t)
+	dept := &dumpTable{
+		schema: "dbo",
+		name:   "dept",
+		columns: []*dumpColumn{
+			{name: "deptno", dataType: "int", identity: "IDENTITY(1, 1)"},
+			{name: "dname", dataType: "nvarchar(14)", nullable: true, collation: sql.NullString{String: "Latin1_General_CI_AS", Valid: true}},
+		},
+		constraints: []string{"CONSTRAINT [pk_dept] PRIMARY KEY CLUSTERED ([deptno] ASC)"},
+	}
+	emp := &dumpTable{
+		schema: "sales",
+		name:   "emp",
+		columns: []*dumpColumn{
+			{name: "empno", dataType: "int"},
+			{name: "sal", dataType: "decimal(7, 2)", defaultName: sql.NullString{String: "df_emp_sal", Valid: true}, defaultExpr: sql.NullString{String: "((0))", Valid: true}},
+			{name: "bonus", computed: sql.NullString{String: "([sal]*(0.1))", Valid: true}, persisted: true},
+			{name: "deptno", dataType: "int", nullable: true},
+		},
+		constraints: []string{
+			"CONSTRAINT [pk_emp] PRIMARY KEY CLUSTERED ([empno] ASC)",
+			"CONSTRAINT [ck_emp_sal] CHECK ([sal]>(0))",
+		},
+		foreignKeys: []string{"CONSTRAINT [fk_deptno] FOREIGN KEY ([deptno]) REFERENCES [dbo].[dept] ([deptno]) ON DELETE CASCADE"},
+		indexes: []string{
+			getCreateIndexStmt(&dumpTable{schema: "sales", name: "emp"}, "ix_emp_deptno", false, "NONCLUSTERED", sql.NullString{String: "([deptno] IS NOT NULL)", Valid: true}, []*dumpIndexColumn{
+				{name: "deptno", descending: true},
+				{name: "sal", included: true},
+			}),
+		},
+	}
+	schema := &dumpSchema{
+		schemas: []string{"sales"},
+		sequences: []*dumpSequence{
+			{schema: "sales", name: "seq1", dataType: "bigint", startValue: "1", increment: "1", minValue: "1", maxValue: "9223372036854775807", isCached: true, cacheSize: sql.NullInt64{Int64: 20, Valid: true}},
+		},
+		tables: []*dumpTable{dept, emp},
+		modules: []string{
+			"CREATE VIEW [sales].[v_emp] AS SELECT empno, sal FROM sales.emp",
+			"CREATE PROCEDURE [sales].[p_emp] AS\nBEGIN\n  SELECT 1 AS a;\nEND",
+		},
+	}
+
+	var buf strings.Builder
+	a.NoError(writeDumpSchema(&buf, schema))
+	dump := buf.String()
+	a.Contains(dump, "CREATE NONCLUSTERED INDEX [ix_emp_deptno] ON [sales].[emp] ([deptno] DESC) INCLUDE ([sal]) WHERE ([deptno] IS NOT NULL);")
+	a.Contains(dump, "[sal] decimal(7, 2) CONSTRAINT [df_emp_sal] DEFAULT 0 NOT NULL")
+
+	// The dump is parsed by the schema differ, and there is no difference with itself.
+	differ := &mssqldiffer.SchemaDiffer{}
+	diff, err := differ.SchemaDiff(dump, dump, false)
+	a.NoError(err)
+	a.Equal("", diff)
+	diff, err = differ.SchemaDiff("", dump, false)
+	a.NoError(err)
+	a.Contains(diff, "CREATE TABLE [sales].[emp]")
+	a.Contains(diff, "CREATE VIEW [sales].[v_emp]")
+}
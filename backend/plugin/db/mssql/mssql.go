@@ -35,6 +35,7 @@ func init() {
 type Driver struct {
 	db           *sql.DB
 	databaseName string
+	instanceID   string
 }
 
 func newDriver(db.DriverConfig) db.Driver {
@@ -42,7 +43,7 @@ func newDriver(db.DriverConfig) db.Driver {
 }
 
 // Open opens a MSSQL driver.
-func (driver *Driver) Open(_ context.Context, _ db.Type, config db.ConnectionConfig, _ db.ConnectionContext) (db.Driver, error) {
+func (driver *Driver) Open(_ context.Context, _ db.Type, config db.ConnectionConfig, connCtx db.ConnectionContext) (db.Driver, error) {
 	query := url.Values{}
 	query.Add("app name", "Bytebase")
 	if config.Database != "" {
@@ -60,6 +61,7 @@ func (driver *Driver) Open(_ context.Context, _ db.Type, config db.ConnectionCon
 	}
 	driver.db = db
 	driver.databaseName = config.Database
+	driver.instanceID = connCtx.InstanceID
 	return driver, nil
 }
 
@@ -2,33 +2,368 @@ package mssql
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 
+	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
 )
 
+// The role of MSSQL is the server login. The attribute of the role is the comma separated list of the memberships,
+// the item without the database prefix is a server role, e.g. "sysadmin",
+// and the item with the database prefix is a database role, e.g. "db1.db_datareader".
+// The database user mapped to the login is created when the login is granted the database role for the first time.
+
 // CreateRole creates the role.
-func (*Driver) CreateRole(_ context.Context, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("create role for MSSQL is not implemented yet")
+func (driver *Driver) CreateRole(ctx context.Context, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	if upsert.Password == nil {
+		return nil, errors.Errorf("password is required to create the login %q", upsert.Name)
+	}
+	statement := fmt.Sprintf("CREATE LOGIN %s WITH PASSWORD = N'%s'", quoteIdentifier(upsert.Name), escapeString(*upsert.Password))
+	if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+		return nil, util.FormatErrorWithQuery(err, "CREATE LOGIN")
+	}
+
+	if upsert.Attribute != nil {
+		if err := driver.alterMembership(ctx, upsert.Name, nil, parseMemberOf(*upsert.Attribute)); err != nil {
+			return nil, err
+		}
+	}
+
+	return driver.FindRole(ctx, upsert.Name)
 }
 
 // UpdateRole updates the role.
-func (*Driver) UpdateRole(_ context.Context, _ string, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("update role for MSSQL is not implemented yet")
+func (driver *Driver) UpdateRole(ctx context.Context, roleName string, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	role, err := driver.FindRole(ctx, roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	if roleName != upsert.Name {
+		statement := fmt.Sprintf("ALTER LOGIN %s WITH NAME = %s", quoteIdentifier(roleName), quoteIdentifier(upsert.Name))
+		if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+			return nil, util.FormatErrorWithQuery(err, statement)
+		}
+	}
+	if upsert.Password != nil {
+		statement := fmt.Sprintf("ALTER LOGIN %s WITH PASSWORD = N'%s'", quoteIdentifier(upsert.Name), escapeString(*upsert.Password))
+		if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+			return nil, util.FormatErrorWithQuery(err, "ALTER LOGIN")
+		}
+	}
+	if upsert.Attribute != nil {
+		if err := driver.alterMembership(ctx, upsert.Name, role.MemberOf, parseMemberOf(*upsert.Attribute)); err != nil {
+			return nil, err
+		}
+	}
+
+	return driver.FindRole(ctx, upsert.Name)
 }
 
 // FindRole finds the role by name.
-func (*Driver) FindRole(_ context.Context, _ string) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("find role for MSSQL is not implemented yet")
+func (driver *Driver) FindRole(ctx context.Context, roleName string) (*db.DatabaseRoleMessage, error) {
+	roles, err := driver.findRoleImpl(ctx, &roleName)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, common.Errorf(common.NotFound, fmt.Sprintf("cannot find the role %s", roleName))
+	}
+	return roles[0], nil
 }
 
 // ListRole lists the role.
-func (*Driver) ListRole(_ context.Context) ([]*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("list role for MSSQL is not implemented yet")
+func (driver *Driver) ListRole(ctx context.Context) ([]*db.DatabaseRoleMessage, error) {
+	return driver.findRoleImpl(ctx, nil)
 }
 
 // DeleteRole deletes the role by name.
-func (*Driver) DeleteRole(_ context.Context, _ string) error {
-	return errors.Errorf("delete role for MSSQL is not implemented yet")
+func (driver *Driver) DeleteRole(ctx context.Context, roleName string) error {
+	role, err := driver.FindRole(ctx, roleName)
+	if err != nil {
+		if common.ErrorCode(err) == common.NotFound {
+			return nil
+		}
+		return err
+	}
+
+	// Drop the database users mapped to the login, otherwise they become orphaned users.
+	// The dbo user cannot be dropped, the ownership of the database should be transferred first.
+	databaseUsers, err := driver.getDatabaseUsers(ctx, roleName)
+	if err != nil {
+		return err
+	}
+	for database, userName := range databaseUsers {
+		if userName == "dbo" {
+			continue
+		}
+		if err := driver.execInDatabase(ctx, database, fmt.Sprintf("DROP USER %s", quoteIdentifier(userName))); err != nil {
+			return err
+		}
+	}
+
+	statement := fmt.Sprintf("DROP LOGIN %s", quoteIdentifier(role.Name))
+	if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+		return util.FormatErrorWithQuery(err, statement)
+	}
+	return nil
+}
+
+func (driver *Driver) findRoleImpl(ctx context.Context, name *string) ([]*db.DatabaseRoleMessage, error) {
+	where := ""
+	var args []any
+	if name != nil {
+		where = "AND p.name = @p1"
+		args = append(args, *name)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			p.name,
+			ISNULL(p.default_database_name, ''),
+			ISNULL(r.name, '')
+		FROM sys.server_principals p
+		LEFT JOIN sys.server_role_members m ON p.principal_id = m.member_principal_id
+		LEFT JOIN sys.server_principals r ON m.role_principal_id = r.principal_id
+		WHERE p.type IN ('S', 'U', 'G')
+			AND p.name NOT LIKE '##%%'
+			AND p.name NOT LIKE 'NT AUTHORITY\%%'
+			AND p.name NOT LIKE 'NT SERVICE\%%'
+			%s
+		ORDER BY p.name, r.name`, where)
+	rows, err := driver.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var roles []*db.DatabaseRoleMessage
+	roleMap := make(map[string]*db.DatabaseRoleMessage)
+	for rows.Next() {
+		var loginName, defaultDatabase, serverRole string
+		if err := rows.Scan(&loginName, &defaultDatabase, &serverRole); err != nil {
+			return nil, err
+		}
+		role, ok := roleMap[loginName]
+		if !ok {
+			role = &db.DatabaseRoleMessage{
+				Name:            loginName,
+				ConnectionLimit: -1,
+				Database:        defaultDatabase,
+			}
+			roleMap[loginName] = role
+			roles = append(roles, role)
+		}
+		if serverRole != "" {
+			role.MemberOf = append(role.MemberOf, serverRole)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	if len(roles) > 0 {
+		if err := driver.fillDatabaseRoles(ctx, roleMap); err != nil {
+			return nil, err
+		}
+	}
+	for _, role := range roles {
+		attribute := strings.Join(role.MemberOf, ", ")
+		role.Attribute = &attribute
+	}
+	return roles, nil
+}
+
+// fillDatabaseRoles appends the database roles of the database users mapped to the logins.
+func (driver *Driver) fillDatabaseRoles(ctx context.Context, roleMap map[string]*db.DatabaseRoleMessage) error {
+	databases, err := driver.getAccessibleDatabases(ctx)
+	if err != nil {
+		return err
+	}
+	for _, database := range databases {
+		query := fmt.Sprintf(`
+			SELECT
+				l.name,
+				r.name
+			FROM %[1]s.sys.database_role_members m
+			INNER JOIN %[1]s.sys.database_principals u ON m.member_principal_id = u.principal_id
+			INNER JOIN %[1]s.sys.database_principals r ON m.role_principal_id = r.principal_id
+			INNER JOIN sys.server_principals l ON u.sid = l.sid
+			ORDER BY l.name, r.name`, quoteIdentifier(database))
+		if err := func() error {
+			rows, err := driver.db.QueryContext(ctx, query)
+			if err != nil {
+				return util.FormatErrorWithQuery(err, query)
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var loginName, databaseRole string
+				if err := rows.Scan(&loginName, &databaseRole); err != nil {
+					return err
+				}
+				if role, ok := roleMap[loginName]; ok {
+					role.MemberOf = append(role.MemberOf, fmt.Sprintf("%s.%s", database, databaseRole))
+				}
+			}
+			return rows.Err()
+		}(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDatabaseUsers returns the database users mapped to the login, keyed by the database name.
+func (driver *Driver) getDatabaseUsers(ctx context.Context, loginName string) (map[string]string, error) {
+	databases, err := driver.getAccessibleDatabases(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]string)
+	for _, database := range databases {
+		query := fmt.Sprintf(`
+			SELECT u.name
+			FROM %s.sys.database_principals u
+			INNER JOIN sys.server_principals l ON u.sid = l.sid
+			WHERE l.name = @p1`, quoteIdentifier(database))
+		var userName string
+		if err := driver.db.QueryRowContext(ctx, query, loginName).Scan(&userName); err != nil {
+			if err == sql.ErrNoRows {
+				continue
+			}
+			return nil, util.FormatErrorWithQuery(err, query)
+		}
+		result[database] = userName
+	}
+	return result, nil
+}
+
+func (driver *Driver) getAccessibleDatabases(ctx context.Context) ([]string, error) {
+	query := `SELECT name FROM sys.databases WHERE state = 0 AND HAS_DBACCESS(name) = 1 ORDER BY name`
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+	var databases []string
+	for rows.Next() {
+		var database string
+		if err := rows.Scan(&database); err != nil {
+			return nil, err
+		}
+		databases = append(databases, database)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return databases, nil
+}
+
+// alterMembership grants and revokes the server roles and the database roles of the login.
+func (driver *Driver) alterMembership(ctx context.Context, loginName string, oldMemberOf, newMemberOf []string) error {
+	oldSet := make(map[string]bool)
+	for _, item := range oldMemberOf {
+		oldSet[item] = true
+	}
+	newSet := make(map[string]bool)
+	for _, item := range newMemberOf {
+		newSet[item] = true
+	}
+
+	databaseUsers, err := driver.getDatabaseUsers(ctx, loginName)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range oldMemberOf {
+		if newSet[item] {
+			continue
+		}
+		database, role := splitMembership(item)
+		if database == "" {
+			statement := fmt.Sprintf("ALTER SERVER ROLE %s DROP MEMBER %s", quoteIdentifier(role), quoteIdentifier(loginName))
+			if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+				return util.FormatErrorWithQuery(err, statement)
+			}
+			continue
+		}
+		userName, ok := databaseUsers[database]
+		if !ok {
+			continue
+		}
+		if err := driver.execInDatabase(ctx, database, fmt.Sprintf("ALTER ROLE %s DROP MEMBER %s", quoteIdentifier(role), quoteIdentifier(userName))); err != nil {
+			return err
+		}
+	}
+	for _, item := range newMemberOf {
+		if oldSet[item] {
+			continue
+		}
+		database, role := splitMembership(item)
+		if database == "" {
+			statement := fmt.Sprintf("ALTER SERVER ROLE %s ADD MEMBER %s", quoteIdentifier(role), quoteIdentifier(loginName))
+			if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+				return util.FormatErrorWithQuery(err, statement)
+			}
+			continue
+		}
+		userName, ok := databaseUsers[database]
+		if !ok {
+			userName = loginName
+			if err := driver.execInDatabase(ctx, database, fmt.Sprintf("CREATE USER %[1]s FOR LOGIN %[1]s", quoteIdentifier(userName))); err != nil {
+				return err
+			}
+			databaseUsers[database] = userName
+		}
+		if err := driver.execInDatabase(ctx, database, fmt.Sprintf("ALTER ROLE %s ADD MEMBER %s", quoteIdentifier(role), quoteIdentifier(userName))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// execInDatabase executes the statement in the context of the given database.
+func (driver *Driver) execInDatabase(ctx context.Context, database string, statement string) error {
+	query := fmt.Sprintf("EXEC %s.sys.sp_executesql @p1", quoteIdentifier(database))
+	if _, err := driver.db.ExecContext(ctx, query, statement); err != nil {
+		return util.FormatErrorWithQuery(err, statement)
+	}
+	return nil
+}
+
+// parseMemberOf parses the comma separated memberships, the result is sorted and deduplicated.
+func parseMemberOf(attribute string) []string {
+	set := make(map[string]bool)
+	var result []string
+	for _, item := range strings.Split(attribute, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" || set[item] {
+			continue
+		}
+		set[item] = true
+		result = append(result, item)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// splitMembership splits the membership into the database and the role, the database is empty for the server role.
+func splitMembership(item string) (string, string) {
+	if i := strings.LastIndex(item, "."); i >= 0 {
+		return item[:i], item[i+1:]
+	}
+	return "", item
+}
+
+func quoteIdentifier(name string) string {
+	return fmt.Sprintf("[%s]", strings.ReplaceAll(name, "]", "]]"))
+}
+
+func escapeString(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
 }
@@ -6,9 +6,12 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -16,6 +19,14 @@ import (
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
+var systemDatabases = map[string]bool{
+	"master":  true,
+	"model":   true,
+	"msdb":    true,
+	"tempdb":  true,
+	"rdscore": true,
+}
+
 // SyncInstance syncs the instance.
 func (driver *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error) {
 	var version, fullVersion string
@@ -325,12 +336,261 @@ func getViews(txn *sql.Tx) (map[string][]*storepb.ViewMetadata, error) {
 	return viewMap, nil
 }
 
-// SyncSlowQuery syncs the slow query.
-func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
-	return nil, errors.Errorf("not implemented")
+// slowQuerySnapshots keeps the plans of each instance last read from sys.dm_exec_query_stats, keyed by the instance ID.
+// The counters in sys.dm_exec_query_stats are accumulated since the plan is cached, and can't be reset without
+// clearing the plan cache, so the executions of each day are the difference from the counters read before that day.
+// The snapshots are kept in memory, so after Bytebase restarts, the plans cached before the day of their last
+// execution are only counted from the next read.
+var slowQuerySnapshots = struct {
+	sync.Mutex
+	instances map[string]map[string]*planSnapshot
+}{instances: make(map[string]map[string]*planSnapshot)}
+
+// planStatistics is the statistics of a statement in a cached plan.
+type planStatistics struct {
+	databaseName      string
+	queryHash         string
+	statement         string
+	creationTime      time.Time
+	lastExecutionTime time.Time
+	counters          planCounters
+	maxElapsedTime    int64
+	maxRows           int64
+	maxLogicalReads   int64
+}
+
+// planCounters is the counters of a statement in a cached plan. The elapsed time is in microseconds.
+type planCounters struct {
+	count             int64
+	totalElapsedTime  int64
+	totalRows         int64
+	totalLogicalReads int64
+}
+
+func (c planCounters) sub(o planCounters) planCounters {
+	return planCounters{
+		count:             c.count - o.count,
+		totalElapsedTime:  c.totalElapsedTime - o.totalElapsedTime,
+		totalRows:         c.totalRows - o.totalRows,
+		totalLogicalReads: c.totalLogicalReads - o.totalLogicalReads,
+	}
+}
+
+// planSnapshot is the last read statistics of a statement in a cached plan.
+type planSnapshot struct {
+	plan *planStatistics
+	// logDate is the date of the last execution of the plan.
+	logDate time.Time
+	// baseline is the counters read before logDate.
+	baseline planCounters
+	// previousLogDate and previous are the date and the counters of the executions before logDate,
+	// so that the day before logDate can be synced again.
+	previousLogDate           time.Time
+	previousLastExecutionTime time.Time
+	previous                  planCounters
+}
+
+// SyncSlowQuery syncs the slow query from sys.dm_exec_query_stats.
+// The executions of a cached plan are attributed to the day of their last execution when the plan is read.
+// The maximum values are accumulated since the plan is cached because they can't be subtracted.
+func (driver *Driver) SyncSlowQuery(ctx context.Context, logDateTs time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	// The elapsed time is in microseconds, and the times are in the local time of the server.
+	query := `
+		SELECT
+			DB_NAME(CONVERT(INT, pa.value)) AS database_name,
+			CONVERT(VARCHAR(130), qs.plan_handle, 1) AS plan_handle,
+			qs.statement_start_offset,
+			CONVERT(VARCHAR(18), qs.query_hash, 1) AS query_hash,
+			SUBSTRING(st.text, (qs.statement_start_offset / 2) + 1, ((CASE qs.statement_end_offset WHEN -1 THEN DATALENGTH(st.text) ELSE qs.statement_end_offset END - qs.statement_start_offset) / 2) + 1) AS statement_text,
+			qs.execution_count,
+			qs.total_elapsed_time,
+			qs.max_elapsed_time,
+			qs.total_rows,
+			qs.max_rows,
+			qs.total_logical_reads,
+			qs.max_logical_reads,
+			DATEADD(MINUTE, DATEDIFF(MINUTE, GETDATE(), GETUTCDATE()), qs.creation_time) AS creation_time,
+			DATEADD(MINUTE, DATEDIFF(MINUTE, GETDATE(), GETUTCDATE()), qs.last_execution_time) AS last_execution_time
+		FROM sys.dm_exec_query_stats qs
+		CROSS APPLY sys.dm_exec_sql_text(qs.sql_handle) st
+		CROSS APPLY sys.dm_exec_plan_attributes(qs.plan_handle) pa
+		WHERE pa.attribute = 'dbid' AND qs.max_elapsed_time >= 1000000`
+
+	rows, err := driver.db.QueryContext(ctx, query)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	plans := make(map[string]*planStatistics)
+	for rows.Next() {
+		var databaseName sql.NullString
+		var planHandle string
+		var statementStartOffset int64
+		plan := &planStatistics{}
+		if err := rows.Scan(
+			&databaseName,
+			&planHandle,
+			&statementStartOffset,
+			&plan.queryHash,
+			&plan.statement,
+			&plan.counters.count,
+			&plan.counters.totalElapsedTime,
+			&plan.maxElapsedTime,
+			&plan.counters.totalRows,
+			&plan.maxRows,
+			&plan.counters.totalLogicalReads,
+			&plan.maxLogicalReads,
+			&plan.creationTime,
+			&plan.lastExecutionTime,
+		); err != nil {
+			return nil, err
+		}
+		if !databaseName.Valid {
+			continue
+		}
+		if systemDatabases[databaseName.String] {
+			continue
+		}
+		plan.databaseName = databaseName.String
+		plans[fmt.Sprintf("%s:%d", planHandle, statementStartOffset)] = plan
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	slowQuerySnapshots.Lock()
+	defer slowQuerySnapshots.Unlock()
+	snapshots := updatePlanSnapshots(slowQuerySnapshots.instances[driver.instanceID], plans)
+	slowQuerySnapshots.instances[driver.instanceID] = snapshots
+	return analyzeSlowQuery(snapshots, logDateTs), nil
+}
+
+// updatePlanSnapshots returns the snapshots of the plans read now. The snapshots of the plans no longer cached are dropped.
+func updatePlanSnapshots(snapshots map[string]*planSnapshot, plans map[string]*planStatistics) map[string]*planSnapshot {
+	result := make(map[string]*planSnapshot)
+	for key, plan := range plans {
+		logDate := plan.lastExecutionTime.UTC().Truncate(24 * time.Hour)
+		snapshot, ok := snapshots[key]
+		switch {
+		case !ok:
+			snapshot = &planSnapshot{logDate: logDate}
+			if plan.creationTime.Before(logDate) {
+				// The executions before the plan is read first can't be split by day, so they are not counted.
+				snapshot.baseline = plan.counters
+			}
+		case !snapshot.plan.creationTime.Equal(plan.creationTime):
+			// The plan is recompiled and its counters start over, so the executions of the old plan are carried over.
+			executions := snapshot.plan.counters.sub(snapshot.baseline)
+			if snapshot.logDate.Equal(logDate) {
+				snapshot = &planSnapshot{
+					logDate:                   logDate,
+					baseline:                  planCounters{}.sub(executions),
+					previousLogDate:           snapshot.previousLogDate,
+					previousLastExecutionTime: snapshot.previousLastExecutionTime,
+					previous:                  snapshot.previous,
+				}
+			} else {
+				snapshot = &planSnapshot{
+					logDate:                   logDate,
+					previousLogDate:           snapshot.logDate,
+					previousLastExecutionTime: snapshot.plan.lastExecutionTime,
+					previous:                  executions,
+				}
+			}
+		case snapshot.logDate.Before(logDate):
+			snapshot.previousLogDate = snapshot.logDate
+			snapshot.previousLastExecutionTime = snapshot.plan.lastExecutionTime
+			snapshot.previous = snapshot.plan.counters.sub(snapshot.baseline)
+			snapshot.baseline = snapshot.plan.counters
+			snapshot.logDate = logDate
+		}
+		snapshot.plan = plan
+		result[key] = snapshot
+	}
+	return result
+}
+
+// analyzeSlowQuery groups the executions of the plans on the log date by the query hash for each database.
+func analyzeSlowQuery(snapshots map[string]*planSnapshot, logDateTs time.Time) map[string]*storepb.SlowQueryStatistics {
+	logDate := logDateTs.UTC().Truncate(24 * time.Hour)
+	logMap := make(map[string]map[string]*storepb.SlowQueryStatisticsItem)
+	for _, snapshot := range snapshots {
+		var counters planCounters
+		var lastExecutionTime time.Time
+		switch {
+		case snapshot.logDate.Equal(logDate):
+			counters, lastExecutionTime = snapshot.plan.counters.sub(snapshot.baseline), snapshot.plan.lastExecutionTime
+		case snapshot.previousLogDate.Equal(logDate):
+			counters, lastExecutionTime = snapshot.previous, snapshot.previousLastExecutionTime
+		default:
+			continue
+		}
+		if counters.count <= 0 {
+			continue
+		}
+
+		plan := snapshot.plan
+		databaseLog, ok := logMap[plan.databaseName]
+		if !ok {
+			databaseLog = make(map[string]*storepb.SlowQueryStatisticsItem)
+			logMap[plan.databaseName] = databaseLog
+		}
+		item, ok := databaseLog[plan.queryHash]
+		if !ok {
+			fingerprint := strings.TrimSpace(plan.statement)
+			if len(fingerprint) > db.SlowQueryMaxLen {
+				fingerprint = fingerprint[:db.SlowQueryMaxLen]
+			}
+			databaseLog[plan.queryHash] = &storepb.SlowQueryStatisticsItem{
+				SqlFingerprint:      fingerprint,
+				Count:               counters.count,
+				LatestLogTime:       timestamppb.New(lastExecutionTime),
+				TotalQueryTime:      durationpb.New(time.Duration(counters.totalElapsedTime) * time.Microsecond),
+				MaximumQueryTime:    durationpb.New(time.Duration(plan.maxElapsedTime) * time.Microsecond),
+				TotalRowsSent:       counters.totalRows,
+				MaximumRowsSent:     plan.maxRows,
+				TotalRowsExamined:   counters.totalLogicalReads,
+				MaximumRowsExamined: plan.maxLogicalReads,
+			}
+			continue
+		}
+		item.Count += counters.count
+		if item.LatestLogTime.AsTime().Before(lastExecutionTime) {
+			item.LatestLogTime = timestamppb.New(lastExecutionTime)
+		}
+		item.TotalQueryTime = durationpb.New(item.TotalQueryTime.AsDuration() + time.Duration(counters.totalElapsedTime)*time.Microsecond)
+		if item.MaximumQueryTime.AsDuration() < time.Duration(plan.maxElapsedTime)*time.Microsecond {
+			item.MaximumQueryTime = durationpb.New(time.Duration(plan.maxElapsedTime) * time.Microsecond)
+		}
+		item.TotalRowsSent += counters.totalRows
+		if item.MaximumRowsSent < plan.maxRows {
+			item.MaximumRowsSent = plan.maxRows
+		}
+		item.TotalRowsExamined += counters.totalLogicalReads
+		if item.MaximumRowsExamined < plan.maxLogicalReads {
+			item.MaximumRowsExamined = plan.maxLogicalReads
+		}
+	}
+
+	result := make(map[string]*storepb.SlowQueryStatistics)
+	for databaseName, databaseLog := range logMap {
+		statistics := &storepb.SlowQueryStatistics{}
+		for _, item := range databaseLog {
+			statistics.Items = append(statistics.Items, item)
+		}
+		result[databaseName] = statistics
+	}
+	return result
 }
 
 // CheckSlowQueryLogEnabled checks if slow query log is enabled.
-func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
-	return errors.Errorf("not implemented")
+// SQL Server always collects the query statistics, so we only check if the user has the permission to read them.
+func (driver *Driver) CheckSlowQueryLogEnabled(ctx context.Context) error {
+	query := `SELECT TOP 1 1 FROM sys.dm_exec_query_stats`
+	var unused int
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&unused); err != nil && err != sql.ErrNoRows {
+		return errors.Wrap(util.FormatErrorWithQuery(err, query), "the VIEW SERVER STATE permission is required to read the query statistics")
+	}
+	return nil
 }
@@ -0,0 +1,165 @@
+package mssql
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+type slowQueryItem struct {
+	fingerprint    string
+	count          int64
+	totalQueryTime time.Duration
+	maxQueryTime   time.Duration
+	totalRows      int64
+}
+
+func convertSlowQueryStatistics(result map[string]*storepb.SlowQueryStatistics) map[string][]slowQueryItem {
+	items := make(map[string][]slowQueryItem)
+	for databaseName, statistics := range result {
+		for _, item := range statistics.Items {
+			items[databaseName] = append(items[databaseName], slowQueryItem{
+				fingerprint:    item.SqlFingerprint,
+				count:          item.Count,
+				totalQueryTime: item.TotalQueryTime.AsDuration(),
+				maxQueryTime:   item.MaximumQueryTime.AsDuration(),
+				totalRows:      item.TotalRowsSent,
+			})
+		}
+		sort.Slice(items[databaseName], func(i, j int) bool {
+			return items[databaseName][i].fingerprint < items[databaseName][j].fingerprint
+		})
+	}
+	return items
+}
+
+func TestAnalyzeSlowQuery(t *testing.T) {
+	day := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	newSnapshot := func(databaseName, queryHash, statement string, count, elapsedTime, maxElapsedTime int64) *planSnapshot {
+		return &planSnapshot{
+			logDate: day,
+			plan: &planStatistics{
+				databaseName:      databaseName,
+				queryHash:         queryHash,
+				statement:         statement,
+				lastExecutionTime: day.Add(time.Hour),
+				counters:          planCounters{count: count, totalElapsedTime: elapsedTime, totalRows: count},
+				maxElapsedTime:    maxElapsedTime,
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		snapshots map[string]*planSnapshot
+		want      map[string][]slowQueryItem
+	}{
+		{
+			name: "the plans of the same query hash are grouped",
+			snapshots: map[string]*planSnapshot{
+				"p1:0": newSnapshot("db1", "0x01", " SELECT * FROM t WHERE id = @p1 ", 2, 3000000, 2000000),
+				"p2:0": newSnapshot("db1", "0x01", "SELECT * FROM t WHERE id = @p1", 3, 6000000, 4000000),
+				"p3:0": newSnapshot("db1", "0x02", "UPDATE t SET a = 1", 1, 1000000, 1000000),
+			},
+			want: map[string][]slowQueryItem{
+				"db1": {
+					{fingerprint: "SELECT * FROM t WHERE id = @p1", count: 5, totalQueryTime: 9 * time.Second, maxQueryTime: 4 * time.Second, totalRows: 5},
+					{fingerprint: "UPDATE t SET a = 1", count: 1, totalQueryTime: time.Second, maxQueryTime: time.Second, totalRows: 1},
+				},
+			},
+		},
+		{
+			name: "the same query hash is grouped by database",
+			snapshots: map[string]*planSnapshot{
+				"p1:0": newSnapshot("db1", "0x01", "SELECT 1", 1, 1000000, 1000000),
+				"p2:0": newSnapshot("db2", "0x01", "SELECT 1", 2, 4000000, 2000000),
+			},
+			want: map[string][]slowQueryItem{
+				"db1": {{fingerprint: "SELECT 1", count: 1, totalQueryTime: time.Second, maxQueryTime: time.Second, totalRows: 1}},
+				"db2": {{fingerprint: "SELECT 1", count: 2, totalQueryTime: 4 * time.Second, maxQueryTime: 2 * time.Second, totalRows: 2}},
+			},
+		},
+		{
+			name: "the plans executed on another day or not executed since the baseline are excluded",
+			snapshots: map[string]*planSnapshot{
+				"p1:0": {
+					logDate: day.AddDate(0, 0, 1),
+					plan:    &planStatistics{databaseName: "db1", queryHash: "0x01", statement: "SELECT 1", counters: planCounters{count: 1}},
+				},
+				"p2:0": {
+					logDate:  day,
+					baseline: planCounters{count: 1},
+					plan:     &planStatistics{databaseName: "db1", queryHash: "0x02", statement: "SELECT 2", counters: planCounters{count: 1}},
+				},
+			},
+			want: map[string][]slowQueryItem{},
+		},
+	}
+
+	for _, test := range tests {
+		got := analyzeSlowQuery(test.snapshots, day.Add(12*time.Hour))
+		require.Equal(t, test.want, convertSlowQueryStatistics(got), test.name)
+	}
+}
+
+func TestUpdatePlanSnapshots(t *testing.T) {
+	day1 := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.AddDate(0, 0, 1)
+	newPlan := func(creationTime, lastExecutionTime time.Time, count int64) map[string]*planStatistics {
+		return map[string]*planStatistics{
+			"p1:0": {
+				databaseName:      "db1",
+				queryHash:         "0x01",
+				statement:         "SELECT 1",
+				creationTime:      creationTime,
+				lastExecutionTime: lastExecutionTime,
+				counters:          planCounters{count: count, totalElapsedTime: count * 1000000},
+				maxElapsedTime:    1000000,
+			},
+		}
+	}
+	countOn := func(snapshots map[string]*planSnapshot, logDate time.Time) int64 {
+		var count int64
+		for _, statistics := range analyzeSlowQuery(snapshots, logDate) {
+			for _, item := range statistics.Items {
+				count += item.Count
+			}
+		}
+		return count
+	}
+
+	// The plan cached on the day is counted since it's cached.
+	snapshots := updatePlanSnapshots(nil, newPlan(day1.Add(time.Hour), day1.Add(2*time.Hour), 3))
+	require.Equal(t, int64(3), countOn(snapshots, day1))
+
+	// The executions on the same day are counted from the same baseline.
+	snapshots = updatePlanSnapshots(snapshots, newPlan(day1.Add(time.Hour), day1.Add(3*time.Hour), 5))
+	require.Equal(t, int64(5), countOn(snapshots, day1))
+
+	// The executions on the next day are not counted again, and the day before is kept.
+	snapshots = updatePlanSnapshots(snapshots, newPlan(day1.Add(time.Hour), day2.Add(time.Hour), 7))
+	require.Equal(t, int64(5), countOn(snapshots, day1))
+	require.Equal(t, int64(2), countOn(snapshots, day2))
+
+	// The recompiled plan is counted since it's cached, and the executions of the old plan are carried over.
+	snapshots = updatePlanSnapshots(snapshots, newPlan(day2.Add(2*time.Hour), day2.Add(3*time.Hour), 1))
+	require.Equal(t, int64(5), countOn(snapshots, day1))
+	require.Equal(t, int64(3), countOn(snapshots, day2))
+	snapshots = updatePlanSnapshots(snapshots, newPlan(day2.AddDate(0, 0, 1), day2.AddDate(0, 0, 1).Add(time.Hour), 1))
+	require.Equal(t, int64(3), countOn(snapshots, day2))
+	require.Equal(t, int64(1), countOn(snapshots, day2.AddDate(0, 0, 1)))
+
+	// The plan cached before the day of its last execution is only counted from the next read.
+	snapshots = updatePlanSnapshots(nil, newPlan(day1.Add(time.Hour), day2.Add(time.Hour), 7))
+	require.Equal(t, int64(0), countOn(snapshots, day2))
+	snapshots = updatePlanSnapshots(snapshots, newPlan(day1.Add(time.Hour), day2.Add(2*time.Hour), 9))
+	require.Equal(t, int64(2), countOn(snapshots, day2))
+
+	// The snapshots of the plans no longer cached are dropped.
+	snapshots = updatePlanSnapshots(snapshots, nil)
+	require.Empty(t, snapshots)
+}
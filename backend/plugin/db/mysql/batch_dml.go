@@ -0,0 +1,405 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb/parser"
+	"github.com/pingcap/tidb/parser/ast"
+	"github.com/pingcap/tidb/parser/format"
+	"github.com/pingcap/tidb/parser/opcode"
+	"github.com/pkg/errors"
+)
+
+// BatchDML executes a single-table UPDATE or DELETE statement in primary key ranged chunks,
+// so that each chunk only locks the rows in its own range.
+type BatchDML struct {
+	stmt  ast.StmtNode
+	where ast.ExprNode
+	// setColumns are the columns set by the UPDATE statement.
+	setColumns []string
+
+	schemaName string
+	tableName  string
+	// qualifier is the alias of the table in the statement, or the table name.
+	qualifier  string
+	primaryKey []string
+	// chunkStatements are the statements of the chunks indexed by whether the chunk has the lower and the upper bounds.
+	chunkStatements [2][2]string
+}
+
+// NewBatchDML creates a batch DML for the statement.
+// The statement must be a single UPDATE or DELETE statement on a single table without ORDER BY and LIMIT.
+func NewBatchDML(statement string) (*BatchDML, error) {
+	nodes, _, err := parser.New().Parse(statement, "", "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	if len(nodes) != 1 {
+		return nil, errors.Errorf("batched execution requires exactly one UPDATE or DELETE statement, but got %d statements", len(nodes))
+	}
+
+	b := &BatchDML{stmt: nodes[0]}
+	var tableRefs *ast.TableRefsClause
+	switch node := nodes[0].(type) {
+	case *ast.UpdateStmt:
+		if node.MultipleTable || node.Order != nil || node.Limit != nil || node.With != nil {
+			return nil, errors.Errorf("batched execution does not support the UPDATE statement with multiple tables, ORDER BY, LIMIT or WITH")
+		}
+		tableRefs, b.where = node.TableRefs, node.Where
+		for _, assignment := range node.List {
+			b.setColumns = append(b.setColumns, assignment.Column.Name.O)
+		}
+		if err := checkIdempotentAssignments(node.List); err != nil {
+			return nil, err
+		}
+	case *ast.DeleteStmt:
+		if node.IsMultiTable || node.Order != nil || node.Limit != nil || node.With != nil {
+			return nil, errors.Errorf("batched execution does not support the DELETE statement with multiple tables, ORDER BY, LIMIT or WITH")
+		}
+		tableRefs, b.where = node.TableRefs, node.Where
+	default:
+		return nil, errors.Errorf("batched execution only supports the UPDATE and DELETE statements")
+	}
+
+	if tableRefs == nil || tableRefs.TableRefs == nil || tableRefs.TableRefs.Right != nil {
+		return nil, errors.Errorf("batched execution requires the statement on a single table")
+	}
+	source, ok := tableRefs.TableRefs.Left.(*ast.TableSource)
+	if !ok {
+		return nil, errors.Errorf("batched execution requires the statement on a single table")
+	}
+	table, ok := source.Source.(*ast.TableName)
+	if !ok {
+		return nil, errors.Errorf("batched execution requires the statement on a table")
+	}
+	b.schemaName, b.tableName = table.Schema.O, table.Name.O
+	b.qualifier = table.Name.O
+	if source.AsName.O != "" {
+		b.qualifier = source.AsName.O
+	}
+	return b, nil
+}
+
+// TableName returns the name of the table changed by the statement.
+func (b *BatchDML) TableName() string {
+	return b.tableName
+}
+
+// Prepare loads the primary key of the table and builds the statements of the chunks.
+func (b *BatchDML) Prepare(ctx context.Context, conn *sql.Conn) error {
+	query := `
+		SELECT COLUMN_NAME
+		FROM information_schema.KEY_COLUMN_USAGE
+		WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND TABLE_NAME = ? AND CONSTRAINT_NAME = 'PRIMARY'
+		ORDER BY ORDINAL_POSITION`
+	rows, err := conn.QueryContext(ctx, query, b.schemaName, b.tableName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the primary key of table %q", b.tableName)
+	}
+	defer rows.Close()
+	var primaryKey []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return err
+		}
+		primaryKey = append(primaryKey, column)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(primaryKey) == 0 {
+		return errors.Errorf("batched execution requires table %q to have a primary key", b.tableName)
+	}
+	b.primaryKey = primaryKey
+	if err := b.checkSetColumns(); err != nil {
+		return err
+	}
+
+	for _, hasLower := range []bool{false, true} {
+		for _, hasUpper := range []bool{false, true} {
+			statement, err := b.buildChunkStatement(hasLower, hasUpper)
+			if err != nil {
+				return err
+			}
+			b.chunkStatements[boolIndex(hasLower)][boolIndex(hasUpper)] = statement
+		}
+	}
+	return nil
+}
+
+// checkSetColumns rejects updating the primary key, because a row moved to a later chunk would be updated again.
+func (b *BatchDML) checkSetColumns() error {
+	for _, column := range b.setColumns {
+		for _, key := range b.primaryKey {
+			// Column names are case-insensitive in MySQL.
+			if strings.EqualFold(column, key) {
+				return errors.Errorf("batched execution does not support updating the primary key column %q of table %q", column, b.tableName)
+			}
+		}
+	}
+	return nil
+}
+
+// checkIdempotentAssignments rejects the SET expressions depending on the assigned columns or on subqueries.
+// The progress is saved after the chunk is committed, so a chunk is executed again if the task resumes in between,
+// and executing the statement twice on a row must have the same effect as executing it once.
+func checkIdempotentAssignments(assignments []*ast.Assignment) error {
+	assigned := make(map[string]bool)
+	for _, assignment := range assignments {
+		assigned[assignment.Column.Name.L] = true
+	}
+	for _, assignment := range assignments {
+		checker := &assignmentChecker{assigned: assigned}
+		assignment.Expr.Accept(checker)
+		if checker.subquery {
+			return errors.Errorf("batched execution does not support subqueries in SET, because a chunk may be executed again when the task resumes")
+		}
+		if checker.column != "" {
+			return errors.Errorf("batched execution does not support SET %q depending on the assigned column %q, because a chunk may be executed again when the task resumes", assignment.Column.Name.O, checker.column)
+		}
+	}
+	return nil
+}
+
+// assignmentChecker finds the subqueries and the references to the assigned columns in an expression.
+type assignmentChecker struct {
+	assigned map[string]bool
+
+	subquery bool
+	column   string
+}
+
+// Enter implements the ast.Visitor interface.
+func (c *assignmentChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch node := in.(type) {
+	case *ast.SubqueryExpr:
+		c.subquery = true
+		return in, true
+	case *ast.ColumnNameExpr:
+		if c.assigned[node.Name.Name.L] {
+			c.column = node.Name.Name.O
+		}
+	}
+	return in, false
+}
+
+// Leave implements the ast.Visitor interface.
+func (*assignmentChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// EstimateRows returns the estimated number of rows of the table, or zero if it is unknown.
+func (b *BatchDML) EstimateRows(ctx context.Context, conn *sql.Conn) (int64, error) {
+	var rows sql.NullInt64
+	query := `
+		SELECT TABLE_ROWS
+		FROM information_schema.TABLES
+		WHERE TABLE_SCHEMA = COALESCE(NULLIF(?, ''), DATABASE()) AND TABLE_NAME = ?`
+	if err := conn.QueryRowContext(ctx, query, b.schemaName, b.tableName).Scan(&rows); err != nil {
+		if err == sql.ErrNoRows {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "failed to estimate the rows of table %q", b.tableName)
+	}
+	return rows.Int64, nil
+}
+
+// NextBoundary returns the primary key of the last row in the chunk of chunkSize rows after lowerKey.
+// It returns nil if the rest of the table has no more than chunkSize rows.
+// A nil lowerKey starts from the beginning of the table.
+func (b *BatchDML) NextBoundary(ctx context.Context, conn *sql.Conn, lowerKey []string, chunkSize int64) ([]string, error) {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "SELECT %s FROM %s", strings.Join(b.quotedColumns(""), ", "), b.table())
+	if lowerKey != nil {
+		fmt.Fprintf(&buf, " WHERE %s > %s", b.keyTuple(""), placeholderTuple(len(b.primaryKey)))
+	}
+	fmt.Fprintf(&buf, " ORDER BY %s LIMIT 1 OFFSET %d", strings.Join(b.quotedColumns(""), ", "), chunkSize-1)
+
+	var args []any
+	for _, v := range lowerKey {
+		args = append(args, v)
+	}
+	upperKey := make([]sql.NullString, len(b.primaryKey))
+	dest := make([]any, len(upperKey))
+	for i := range upperKey {
+		dest[i] = &upperKey[i]
+	}
+	if err := conn.QueryRowContext(ctx, buf.String(), args...).Scan(dest...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get the next chunk boundary of table %q", b.tableName)
+	}
+	var result []string
+	for _, v := range upperKey {
+		result = append(result, v.String)
+	}
+	return result, nil
+}
+
+// ExecuteChunk executes the statement on the rows with the primary key in (lowerKey, upperKey], and returns the affected rows.
+// A nil bound means the chunk is unbounded on that side.
+func (b *BatchDML) ExecuteChunk(ctx context.Context, conn *sql.Conn, lowerKey, upperKey []string) (int64, error) {
+	statement := b.chunkStatements[boolIndex(lowerKey != nil)][boolIndex(upperKey != nil)]
+	var args []any
+	for _, v := range lowerKey {
+		args = append(args, v)
+	}
+	for _, v := range upperKey {
+		args = append(args, v)
+	}
+	result, err := conn.ExecContext(ctx, statement, args...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// ThreadsRunning returns the number of the running threads of the server.
+func (*BatchDML) ThreadsRunning(ctx context.Context, conn *sql.Conn) (int64, error) {
+	var name, value string
+	if err := conn.QueryRowContext(ctx, "SHOW GLOBAL STATUS LIKE 'Threads_running'").Scan(&name, &value); err != nil {
+		return 0, errors.Wrapf(err, "failed to get Threads_running")
+	}
+	threads, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid Threads_running %q", value)
+	}
+	return threads, nil
+}
+
+// GetReplicaLag returns the replication lag of the replica connected by db.
+// It returns false if the server is not a replica.
+func GetReplicaLag(ctx context.Context, db *sql.DB) (time.Duration, bool, error) {
+	// SHOW REPLICA STATUS is introduced in MySQL 8.0.22 and MariaDB 10.5.1, and SHOW SLAVE STATUS is removed in MySQL 8.4.
+	rows, err := db.QueryContext(ctx, "SHOW REPLICA STATUS")
+	if err != nil {
+		rows, err = db.QueryContext(ctx, "SHOW SLAVE STATUS")
+		if err != nil {
+			return 0, false, errors.Wrapf(err, "failed to get the replica status")
+		}
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return 0, false, err
+	}
+	if !rows.Next() {
+		return 0, false, rows.Err()
+	}
+	values := make([]sql.NullString, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return 0, false, err
+	}
+	for i, column := range columns {
+		if column != "Seconds_Behind_Source" && column != "Seconds_Behind_Master" {
+			continue
+		}
+		if !values[i].Valid {
+			return 0, true, errors.Errorf("the replication of the replica is not running")
+		}
+		seconds, err := strconv.ParseInt(values[i].String, 10, 64)
+		if err != nil {
+			return 0, true, errors.Wrapf(err, "invalid replica lag %q", values[i].String)
+		}
+		return time.Duration(seconds) * time.Second, true, nil
+	}
+	return 0, false, errors.Errorf("replica lag not found in the replica status")
+}
+
+func (b *BatchDML) buildChunkStatement(hasLower, hasUpper bool) (string, error) {
+	var predicates []string
+	if hasLower {
+		predicates = append(predicates, fmt.Sprintf("%s > %s", b.keyTuple(b.qualifier), placeholderTuple(len(b.primaryKey))))
+	}
+	if hasUpper {
+		predicates = append(predicates, fmt.Sprintf("%s <= %s", b.keyTuple(b.qualifier), placeholderTuple(len(b.primaryKey))))
+	}
+	where := b.where
+	if len(predicates) > 0 {
+		// Parse the range predicate in a SELECT statement to get its expression node.
+		nodes, _, err := parser.New().Parse(fmt.Sprintf("SELECT 1 FROM %s WHERE %s", b.table(), strings.Join(predicates, " AND ")), "", "")
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to parse the chunk range predicate")
+		}
+		predicate := nodes[0].(*ast.SelectStmt).Where
+		if where == nil {
+			where = predicate
+		} else {
+			where = &ast.BinaryOperationExpr{
+				Op: opcode.LogicAnd,
+				L:  &ast.ParenthesesExpr{Expr: where},
+				R:  predicate,
+			}
+		}
+	}
+
+	switch node := b.stmt.(type) {
+	case *ast.UpdateStmt:
+		node.Where = where
+		defer func() { node.Where = b.where }()
+	case *ast.DeleteStmt:
+		node.Where = where
+		defer func() { node.Where = b.where }()
+	}
+	var buf strings.Builder
+	if err := b.stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &buf)); err != nil {
+		return "", errors.Wrapf(err, "failed to restore the chunk statement")
+	}
+	return buf.String(), nil
+}
+
+func (b *BatchDML) table() string {
+	if b.schemaName == "" {
+		return quoteIdentifier(b.tableName)
+	}
+	return fmt.Sprintf("%s.%s", quoteIdentifier(b.schemaName), quoteIdentifier(b.tableName))
+}
+
+func (b *BatchDML) quotedColumns(qualifier string) []string {
+	var columns []string
+	for _, column := range b.primaryKey {
+		if qualifier == "" {
+			columns = append(columns, quoteIdentifier(column))
+		} else {
+			columns = append(columns, fmt.Sprintf("%s.%s", quoteIdentifier(qualifier), quoteIdentifier(column)))
+		}
+	}
+	return columns
+}
+
+func (b *BatchDML) keyTuple(qualifier string) string {
+	columns := b.quotedColumns(qualifier)
+	if len(columns) == 1 {
+		return columns[0]
+	}
+	return fmt.Sprintf("(%s)", strings.Join(columns, ", "))
+}
+
+func placeholderTuple(n int) string {
+	if n == 1 {
+		return "?"
+	}
+	return fmt.Sprintf("(%s)", strings.TrimSuffix(strings.Repeat("?, ", n), ", "))
+}
+
+func quoteIdentifier(name string) string {
+	return fmt.Sprintf("`%s`", strings.ReplaceAll(name, "`", "``"))
+}
+
+func boolIndex(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,113 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBatchDML(t *testing.T) {
+	tests := []struct {
+		statement string
+		err       bool
+	}{
+		{statement: "UPDATE t SET a = 1 WHERE b > 2", err: false},
+		{statement: "DELETE FROM db.t WHERE b > 2", err: false},
+		{statement: "UPDATE t AS x SET x.a = 1", err: false},
+		{statement: "UPDATE t SET a = 1; DELETE FROM t", err: true},
+		{statement: "UPDATE t SET a = 1 ORDER BY b LIMIT 10", err: true},
+		{statement: "DELETE FROM t LIMIT 10", err: true},
+		{statement: "UPDATE t1, t2 SET t1.a = t2.a WHERE t1.id = t2.id", err: true},
+		{statement: "DELETE t1 FROM t1 JOIN t2 ON t1.id = t2.id", err: true},
+		{statement: "INSERT INTO t VALUES (1)", err: true},
+		{statement: "UPDATE t SET a = LOWER(b), c = NOW()", err: false},
+		{statement: "UPDATE t SET a = a + 1", err: true},
+		{statement: "UPDATE t AS x SET x.a = b, x.b = IFNULL(x.A, 0)", err: true},
+		{statement: "UPDATE t SET a = (SELECT MAX(a) FROM t2)", err: true},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := NewBatchDML(test.statement)
+		if test.err {
+			a.Error(err, test.statement)
+		} else {
+			a.NoError(err, test.statement)
+		}
+	}
+}
+
+func TestBatchDMLCheckSetColumns(t *testing.T) {
+	tests := []struct {
+		statement string
+		wantErr   bool
+	}{
+		{statement: "UPDATE t SET a = 1"},
+		{statement: "UPDATE t SET id = 1000", wantErr: true},
+		{statement: "UPDATE t AS x SET x.ID = 1", wantErr: true},
+		{statement: "DELETE FROM t"},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		b, err := NewBatchDML(test.statement)
+		a.NoError(err, test.statement)
+		b.primaryKey = []string{"id"}
+		if test.wantErr {
+			a.Error(b.checkSetColumns(), test.statement)
+		} else {
+			a.NoError(b.checkSetColumns(), test.statement)
+		}
+	}
+}
+
+func TestBatchDMLChunkStatement(t *testing.T) {
+	tests := []struct {
+		statement  string
+		primaryKey []string
+		want       [2][2]string
+	}{
+		{
+			statement:  "UPDATE t SET a = 1 WHERE b > 2 OR c = 3",
+			primaryKey: []string{"id"},
+			want: [2][2]string{
+				{
+					"UPDATE `t` SET `a`=1 WHERE `b`>2 OR `c`=3",
+					"UPDATE `t` SET `a`=1 WHERE (`b`>2 OR `c`=3) AND `t`.`id`<=?",
+				},
+				{
+					"UPDATE `t` SET `a`=1 WHERE (`b`>2 OR `c`=3) AND `t`.`id`>?",
+					"UPDATE `t` SET `a`=1 WHERE (`b`>2 OR `c`=3) AND `t`.`id`>? AND `t`.`id`<=?",
+				},
+			},
+		},
+		{
+			statement:  "DELETE FROM db.t AS x",
+			primaryKey: []string{"a", "b"},
+			want: [2][2]string{
+				{
+					"DELETE FROM `db`.`t` AS `x`",
+					"DELETE FROM `db`.`t` AS `x` WHERE ROW(`x`.`a`,`x`.`b`)<=ROW(?,?)",
+				},
+				{
+					"DELETE FROM `db`.`t` AS `x` WHERE ROW(`x`.`a`,`x`.`b`)>ROW(?,?)",
+					"DELETE FROM `db`.`t` AS `x` WHERE ROW(`x`.`a`,`x`.`b`)>ROW(?,?) AND ROW(`x`.`a`,`x`.`b`)<=ROW(?,?)",
+				},
+			},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		b, err := NewBatchDML(test.statement)
+		a.NoError(err)
+		b.primaryKey = test.primaryKey
+		for _, hasLower := range []bool{false, true} {
+			for _, hasUpper := range []bool{false, true} {
+				got, err := b.buildChunkStatement(hasLower, hasUpper)
+				a.NoError(err)
+				a.Equal(test.want[boolIndex(hasLower)][boolIndex(hasUpper)], got)
+			}
+		}
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
@@ -511,11 +513,227 @@ func getViews(txn *sql.Tx, schemaName string) (map[string][]*storepb.ViewMetadat
 }
 
 // SyncSlowQuery syncs the slow query.
-func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
-	return nil, errors.Errorf("not implemented")
+// It reads the per-snapshot statistics from AWR if the Diagnostics Pack is enabled, otherwise it falls back to V$SQL.
+// The statistics in V$SQL are accumulated since the cursor is loaded, so the statements are attributed to the day of their last execution.
+func (driver *Driver) SyncSlowQuery(ctx context.Context, logDateTs time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	awrEnabled, err := driver.isAWREnabled(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The CDB root and the non-CDB are named after V$DATABASE in the database list.
+	databaseName := `CASE WHEN c.name IS NULL OR c.name = 'CDB$ROOT' THEN d.name ELSE c.name END`
+	if driver.schemaTenantMode {
+		databaseName = `s.parsing_schema_name`
+	}
+	// Oracle does not record the maximum elapsed time of a statement, so the highest average elapsed time is used instead.
+	// The elapsed time is in microseconds.
+	var source string
+	if awrEnabled {
+		source = fmt.Sprintf(`
+			SELECT
+				%s AS database_name,
+				s.sql_id,
+				TO_CHAR(s.force_matching_signature) AS force_matching_signature,
+				(SELECT DBMS_LOB.SUBSTR(t.sql_text, 1000, 1) FROM dba_hist_sqltext t WHERE t.sql_id = s.sql_id AND t.dbid = s.dbid AND ROWNUM = 1) AS sql_text,
+				s.executions_delta AS executions,
+				s.elapsed_time_delta AS elapsed_time,
+				s.rows_processed_delta AS rows_processed,
+				s.buffer_gets_delta AS buffer_gets,
+				SYS_EXTRACT_UTC(FROM_TZ(CAST(sn.end_interval_time AS TIMESTAMP), TO_CHAR(SYSTIMESTAMP, 'TZH:TZM'))) AS log_time
+			FROM dba_hist_sqlstat s
+			JOIN dba_hist_snapshot sn ON s.snap_id = sn.snap_id AND s.dbid = sn.dbid AND s.instance_number = sn.instance_number
+			LEFT JOIN v$containers c ON s.con_id = c.con_id
+			CROSS JOIN v$database d
+			WHERE s.executions_delta > 0 AND s.parsing_schema_name NOT IN (%s)`, databaseName, systemSchema)
+	} else {
+		source = fmt.Sprintf(`
+			SELECT
+				%s AS database_name,
+				s.sql_id,
+				TO_CHAR(s.force_matching_signature) AS force_matching_signature,
+				s.sql_text,
+				s.executions,
+				s.elapsed_time,
+				s.rows_processed,
+				s.buffer_gets,
+				SYS_EXTRACT_UTC(FROM_TZ(CAST(s.last_active_time AS TIMESTAMP), TO_CHAR(SYSTIMESTAMP, 'TZH:TZM'))) AS log_time
+			FROM v$sql s
+			LEFT JOIN v$containers c ON s.con_id = c.con_id
+			CROSS JOIN v$database d
+			WHERE s.executions > 0 AND s.parsing_schema_name NOT IN (%s)`, databaseName, systemSchema)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			database_name,
+			sql_id,
+			force_matching_signature,
+			MIN(sql_text),
+			SUM(executions),
+			SUM(elapsed_time),
+			ROUND(MAX(elapsed_time / executions)),
+			SUM(rows_processed),
+			ROUND(MAX(rows_processed / executions)),
+			SUM(buffer_gets),
+			ROUND(MAX(buffer_gets / executions)),
+			MAX(log_time)
+		FROM (%s)
+		WHERE elapsed_time / executions >= 1000000
+			AND log_time >= TO_TIMESTAMP(:1, 'YYYY-MM-DD')
+			AND log_time < TO_TIMESTAMP(:2, 'YYYY-MM-DD')
+		GROUP BY database_name, sql_id, force_matching_signature`, source)
+
+	rows, err := driver.db.QueryContext(ctx, query, logDateTs.Format("2006-01-02"), logDateTs.AddDate(0, 0, 1).Format("2006-01-02"))
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var slowQueries []*slowQuery
+	for rows.Next() {
+		q := &slowQuery{}
+		var sqlText sql.NullString
+		if err := rows.Scan(
+			&q.databaseName,
+			&q.sqlID,
+			&q.forceMatchingSignature,
+			&sqlText,
+			&q.executions,
+			&q.elapsedTime,
+			&q.maxElapsedTime,
+			&q.rowsProcessed,
+			&q.maxRowsProcessed,
+			&q.bufferGets,
+			&q.maxBufferGets,
+			&q.logTime,
+		); err != nil {
+			return nil, err
+		}
+		q.sqlText = sqlText.String
+		slowQueries = append(slowQueries, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return analyzeSlowQuery(slowQueries), nil
+}
+
+// slowQuery is the statistics of a statement on the log date. The elapsed time is in microseconds.
+type slowQuery struct {
+	databaseName           string
+	sqlID                  string
+	forceMatchingSignature string
+	sqlText                string
+	executions             int64
+	elapsedTime            int64
+	maxElapsedTime         int64
+	rowsProcessed          int64
+	maxRowsProcessed       int64
+	bufferGets             int64
+	maxBufferGets          int64
+	logTime                time.Time
+}
+
+// fingerprintKey returns the key to group the statements. The statements only differing in the literals share
+// the same force matching signature, which is 0 if the statement can't be parameterized, e.g. PL/SQL blocks.
+func (q *slowQuery) fingerprintKey() string {
+	if q.forceMatchingSignature == "" || q.forceMatchingSignature == "0" {
+		return q.sqlID
+	}
+	return q.forceMatchingSignature
+}
+
+// analyzeSlowQuery groups the statements by the fingerprint key for each database.
+// The fingerprint is the least SQL text in the group, so it's stable across the syncs.
+func analyzeSlowQuery(slowQueries []*slowQuery) map[string]*storepb.SlowQueryStatistics {
+	type group struct {
+		sqlText string
+		item    *storepb.SlowQueryStatisticsItem
+	}
+	logMap := make(map[string]map[string]*group)
+	for _, q := range slowQueries {
+		sqlText := strings.TrimSpace(q.sqlText)
+		if sqlText == "" {
+			sqlText = q.sqlID
+		}
+		databaseLog, ok := logMap[q.databaseName]
+		if !ok {
+			databaseLog = make(map[string]*group)
+			logMap[q.databaseName] = databaseLog
+		}
+		g, ok := databaseLog[q.fingerprintKey()]
+		if !ok {
+			databaseLog[q.fingerprintKey()] = &group{
+				sqlText: sqlText,
+				item: &storepb.SlowQueryStatisticsItem{
+					Count:               q.executions,
+					LatestLogTime:       timestamppb.New(q.logTime),
+					TotalQueryTime:      durationpb.New(time.Duration(q.elapsedTime) * time.Microsecond),
+					MaximumQueryTime:    durationpb.New(time.Duration(q.maxElapsedTime) * time.Microsecond),
+					TotalRowsSent:       q.rowsProcessed,
+					MaximumRowsSent:     q.maxRowsProcessed,
+					TotalRowsExamined:   q.bufferGets,
+					MaximumRowsExamined: q.maxBufferGets,
+				},
+			}
+			continue
+		}
+		if sqlText < g.sqlText {
+			g.sqlText = sqlText
+		}
+		item := g.item
+		item.Count += q.executions
+		if item.LatestLogTime.AsTime().Before(q.logTime) {
+			item.LatestLogTime = timestamppb.New(q.logTime)
+		}
+		item.TotalQueryTime = durationpb.New(item.TotalQueryTime.AsDuration() + time.Duration(q.elapsedTime)*time.Microsecond)
+		if item.MaximumQueryTime.AsDuration() < time.Duration(q.maxElapsedTime)*time.Microsecond {
+			item.MaximumQueryTime = durationpb.New(time.Duration(q.maxElapsedTime) * time.Microsecond)
+		}
+		item.TotalRowsSent += q.rowsProcessed
+		if item.MaximumRowsSent < q.maxRowsProcessed {
+			item.MaximumRowsSent = q.maxRowsProcessed
+		}
+		item.TotalRowsExamined += q.bufferGets
+		if item.MaximumRowsExamined < q.maxBufferGets {
+			item.MaximumRowsExamined = q.maxBufferGets
+		}
+	}
+
+	result := make(map[string]*storepb.SlowQueryStatistics)
+	for databaseName, databaseLog := range logMap {
+		statistics := &storepb.SlowQueryStatistics{}
+		for _, g := range databaseLog {
+			g.item.SqlFingerprint = g.sqlText
+			if len(g.item.SqlFingerprint) > db.SlowQueryMaxLen {
+				g.item.SqlFingerprint = g.item.SqlFingerprint[:db.SlowQueryMaxLen]
+			}
+			statistics.Items = append(statistics.Items, g.item)
+		}
+		result[databaseName] = statistics
+	}
+	return result
+}
+
+func (driver *Driver) isAWREnabled(ctx context.Context) (bool, error) {
+	query := `SELECT value FROM v$parameter WHERE name = 'control_management_pack_access'`
+	var value sql.NullString
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&value); err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, util.FormatErrorWithQuery(err, query)
+	}
+	return strings.Contains(strings.ToUpper(value.String), "DIAGNOSTIC"), nil
 }
 
 // CheckSlowQueryLogEnabled checks if slow query log is enabled.
-func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
-	return errors.Errorf("not implemented")
+// Oracle always collects the cursor statistics, so we only check if the user has the privilege to read them.
+func (driver *Driver) CheckSlowQueryLogEnabled(ctx context.Context) error {
+	query := `SELECT COUNT(*) FROM v$sql s LEFT JOIN v$containers c ON s.con_id = c.con_id WHERE ROWNUM = 1`
+	var count int
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return errors.Wrap(util.FormatErrorWithQuery(err, query), "the SELECT privilege on V$SQL and V$CONTAINERS is required to read the cursor statistics")
+	}
+	return nil
 }
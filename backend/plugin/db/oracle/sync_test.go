@@ -0,0 +1,86 @@
+package oracle
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeSlowQuery(t *testing.T) {
+	logTime := time.Date(2023, 8, 1, 1, 0, 0, 0, time.UTC)
+	type item struct {
+		fingerprint    string
+		count          int64
+		totalQueryTime time.Duration
+		maxQueryTime   time.Duration
+		latestLogTime  time.Time
+	}
+	tests := []struct {
+		name        string
+		slowQueries []*slowQuery
+		want        map[string][]item
+	}{
+		{
+			name: "the statements with the same force matching signature are grouped",
+			slowQueries: []*slowQuery{
+				{databaseName: "ORCL", sqlID: "a1", forceMatchingSignature: "123", sqlText: "SELECT * FROM t WHERE id = 2", executions: 1, elapsedTime: 2000000, maxElapsedTime: 2000000, logTime: logTime},
+				{databaseName: "ORCL", sqlID: "a2", forceMatchingSignature: "123", sqlText: "SELECT * FROM t WHERE id = 1 ", executions: 2, elapsedTime: 3000000, maxElapsedTime: 1500000, logTime: logTime.Add(time.Hour)},
+				{databaseName: "ORCL", sqlID: "a3", forceMatchingSignature: "456", sqlText: "SELECT * FROM s", executions: 1, elapsedTime: 1000000, maxElapsedTime: 1000000, logTime: logTime},
+			},
+			want: map[string][]item{
+				"ORCL": {
+					{fingerprint: "SELECT * FROM s", count: 1, totalQueryTime: time.Second, maxQueryTime: time.Second, latestLogTime: logTime},
+					{fingerprint: "SELECT * FROM t WHERE id = 1", count: 3, totalQueryTime: 5 * time.Second, maxQueryTime: 2 * time.Second, latestLogTime: logTime.Add(time.Hour)},
+				},
+			},
+		},
+		{
+			name: "the statements without force matching signature are grouped by the SQL ID",
+			slowQueries: []*slowQuery{
+				{databaseName: "ORCL", sqlID: "b1", forceMatchingSignature: "0", sqlText: "BEGIN p(1); END;", executions: 1, elapsedTime: 1000000, maxElapsedTime: 1000000, logTime: logTime},
+				{databaseName: "ORCL", sqlID: "b1", forceMatchingSignature: "0", sqlText: "BEGIN p(1); END;", executions: 1, elapsedTime: 3000000, maxElapsedTime: 3000000, logTime: logTime},
+				{databaseName: "ORCL", sqlID: "b2", forceMatchingSignature: "0", sqlText: "BEGIN p(2); END;", executions: 1, elapsedTime: 1000000, maxElapsedTime: 1000000, logTime: logTime},
+				{databaseName: "ORCL", sqlID: "b3", sqlText: "", executions: 1, elapsedTime: 1000000, maxElapsedTime: 1000000, logTime: logTime},
+			},
+			want: map[string][]item{
+				"ORCL": {
+					{fingerprint: "BEGIN p(1); END;", count: 2, totalQueryTime: 4 * time.Second, maxQueryTime: 3 * time.Second, latestLogTime: logTime},
+					{fingerprint: "BEGIN p(2); END;", count: 1, totalQueryTime: time.Second, maxQueryTime: time.Second, latestLogTime: logTime},
+					{fingerprint: "b3", count: 1, totalQueryTime: time.Second, maxQueryTime: time.Second, latestLogTime: logTime},
+				},
+			},
+		},
+		{
+			name: "the same statement is grouped by database",
+			slowQueries: []*slowQuery{
+				{databaseName: "PDB1", sqlID: "c1", forceMatchingSignature: "789", sqlText: "SELECT 1 FROM dual", executions: 1, elapsedTime: 1000000, maxElapsedTime: 1000000, logTime: logTime},
+				{databaseName: "PDB2", sqlID: "c1", forceMatchingSignature: "789", sqlText: "SELECT 1 FROM dual", executions: 2, elapsedTime: 2000000, maxElapsedTime: 1000000, logTime: logTime},
+			},
+			want: map[string][]item{
+				"PDB1": {{fingerprint: "SELECT 1 FROM dual", count: 1, totalQueryTime: time.Second, maxQueryTime: time.Second, latestLogTime: logTime}},
+				"PDB2": {{fingerprint: "SELECT 1 FROM dual", count: 2, totalQueryTime: 2 * time.Second, maxQueryTime: time.Second, latestLogTime: logTime}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got := make(map[string][]item)
+		for databaseName, statistics := range analyzeSlowQuery(test.slowQueries) {
+			for _, i := range statistics.Items {
+				got[databaseName] = append(got[databaseName], item{
+					fingerprint:    i.SqlFingerprint,
+					count:          i.Count,
+					totalQueryTime: i.TotalQueryTime.AsDuration(),
+					maxQueryTime:   i.MaximumQueryTime.AsDuration(),
+					latestLogTime:  i.LatestLogTime.AsTime(),
+				})
+			}
+			sort.Slice(got[databaseName], func(i, j int) bool {
+				return got[databaseName][i].fingerprint < got[databaseName][j].fingerprint
+			})
+		}
+		require.Equal(t, test.want, got, test.name)
+	}
+}
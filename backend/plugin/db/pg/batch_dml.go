@@ -0,0 +1,409 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// BatchDML executes a single-table UPDATE or DELETE statement in primary key ranged chunks,
+// so that each chunk only locks the rows in its own range.
+type BatchDML struct {
+	tree     *pgquery.ParseResult
+	relation *pgquery.RangeVar
+	where    *pgquery.Node
+	// setColumns are the columns set by the UPDATE statement.
+	setColumns []string
+
+	// schemaName is empty until Prepare resolves the unqualified table by the search path.
+	schemaName string
+	tableName  string
+	// qualifier is the alias of the table in the statement, or the table name.
+	qualifier string
+	owner     string
+	// primaryKey and primaryKeyTypes are the primary key columns and their types.
+	primaryKey      []string
+	primaryKeyTypes []string
+	// chunkStatements are the statements of the chunks indexed by whether the chunk has the lower and the upper bounds.
+	chunkStatements [2][2]string
+}
+
+// NewBatchDML creates a batch DML for the statement.
+// The statement must be a single UPDATE or DELETE statement on a single table without FROM, USING and WITH.
+func NewBatchDML(statement string) (*BatchDML, error) {
+	tree, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	if len(tree.Stmts) != 1 {
+		return nil, errors.Errorf("batched execution requires exactly one UPDATE or DELETE statement, but got %d statements", len(tree.Stmts))
+	}
+
+	b := &BatchDML{tree: tree}
+	var relation *pgquery.RangeVar
+	switch {
+	case tree.Stmts[0].GetStmt().GetUpdateStmt() != nil:
+		update := tree.Stmts[0].GetStmt().GetUpdateStmt()
+		if len(update.FromClause) > 0 || update.WithClause != nil {
+			return nil, errors.Errorf("batched execution does not support the UPDATE statement with FROM or WITH")
+		}
+		relation, b.where = update.Relation, update.WhereClause
+		for _, target := range update.TargetList {
+			b.setColumns = append(b.setColumns, target.GetResTarget().GetName())
+		}
+		if err := checkIdempotentAssignments(update.TargetList, b.setColumns); err != nil {
+			return nil, err
+		}
+	case tree.Stmts[0].GetStmt().GetDeleteStmt() != nil:
+		del := tree.Stmts[0].GetStmt().GetDeleteStmt()
+		if len(del.UsingClause) > 0 || del.WithClause != nil {
+			return nil, errors.Errorf("batched execution does not support the DELETE statement with USING or WITH")
+		}
+		relation, b.where = del.Relation, del.WhereClause
+	default:
+		return nil, errors.Errorf("batched execution only supports the UPDATE and DELETE statements")
+	}
+	if relation == nil {
+		return nil, errors.Errorf("batched execution requires the statement on a table")
+	}
+
+	b.relation = relation
+	b.schemaName, b.tableName = relation.Schemaname, relation.Relname
+	b.qualifier = relation.Relname
+	if relation.Alias != nil && relation.Alias.Aliasname != "" {
+		b.qualifier = relation.Alias.Aliasname
+	}
+	return b, nil
+}
+
+// TableName returns the name of the table changed by the statement.
+func (b *BatchDML) TableName() string {
+	return b.tableName
+}
+
+// Prepare loads the database owner and the primary key of the table, and builds the statements of the chunks.
+// An unqualified table is resolved by the search path of the owner, which the chunks are executed as.
+func (b *BatchDML) Prepare(ctx context.Context, conn *sql.Conn) error {
+	if err := conn.QueryRowContext(ctx, `SELECT u.rolname FROM pg_roles AS u JOIN pg_database AS d ON (d.datdba = u.oid) WHERE d.datname = current_database()`).Scan(&b.owner); err != nil {
+		return errors.Wrapf(err, "failed to get the owner of the current database")
+	}
+	if b.schemaName == "" {
+		schemaName, err := resolveTableSchema(ctx, conn, b.tableName)
+		if err != nil {
+			return err
+		}
+		b.setSchema(schemaName)
+	}
+
+	query := `
+		SELECT a.attname, format_type(a.atttypid, a.atttypmod)
+		FROM pg_index i
+		JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		WHERE i.indrelid = $1::regclass AND i.indisprimary
+		ORDER BY array_position(i.indkey::int2[], a.attnum)`
+	rows, err := conn.QueryContext(ctx, query, b.table())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the primary key of table %q.%q", b.schemaName, b.tableName)
+	}
+	defer rows.Close()
+	var primaryKey, primaryKeyTypes []string
+	for rows.Next() {
+		var column, columnType string
+		if err := rows.Scan(&column, &columnType); err != nil {
+			return err
+		}
+		primaryKey = append(primaryKey, column)
+		primaryKeyTypes = append(primaryKeyTypes, columnType)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if len(primaryKey) == 0 {
+		return errors.Errorf("batched execution requires table %q.%q to have a primary key", b.schemaName, b.tableName)
+	}
+	b.primaryKey, b.primaryKeyTypes = primaryKey, primaryKeyTypes
+	if err := b.checkSetColumns(); err != nil {
+		return err
+	}
+
+	for _, hasLower := range []bool{false, true} {
+		for _, hasUpper := range []bool{false, true} {
+			statement, err := b.buildChunkStatement(hasLower, hasUpper)
+			if err != nil {
+				return err
+			}
+			b.chunkStatements[boolIndex(hasLower)][boolIndex(hasUpper)] = statement
+		}
+	}
+	return nil
+}
+
+// checkSetColumns rejects updating the primary key, because a row moved to a later chunk would be updated again.
+func (b *BatchDML) checkSetColumns() error {
+	for _, column := range b.setColumns {
+		for _, key := range b.primaryKey {
+			if column == key {
+				return errors.Errorf("batched execution does not support updating the primary key column %q of table %q.%q", column, b.schemaName, b.tableName)
+			}
+		}
+	}
+	return nil
+}
+
+// checkIdempotentAssignments rejects the SET expressions depending on the assigned columns or on subqueries.
+// The progress is saved after the chunk is committed, so a chunk is executed again if the task resumes in between,
+// and executing the statement twice on a row must have the same effect as executing it once.
+func checkIdempotentAssignments(targets []*pgquery.Node, setColumns []string) error {
+	assigned := make(map[string]bool)
+	for _, column := range setColumns {
+		assigned[column] = true
+	}
+	for _, target := range targets {
+		var err error
+		walkNodes(target.GetResTarget().GetVal().ProtoReflect(), func(node *pgquery.Node) bool {
+			if node.GetSubLink() != nil {
+				err = errors.Errorf("batched execution does not support subqueries in SET, because a chunk may be executed again when the task resumes")
+				return false
+			}
+			if fields := node.GetColumnRef().GetFields(); len(fields) > 0 {
+				if column := fields[len(fields)-1].GetString_().GetSval(); assigned[column] {
+					err = errors.Errorf("batched execution does not support SET %q depending on the assigned column %q, because a chunk may be executed again when the task resumes", target.GetResTarget().GetName(), column)
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkNodes calls visit on the nodes in the message recursively until visit returns false.
+// It returns false if the walk is stopped.
+func walkNodes(m protoreflect.Message, visit func(*pgquery.Node) bool) bool {
+	if !m.IsValid() {
+		return true
+	}
+	if node, ok := m.Interface().(*pgquery.Node); ok && !visit(node) {
+		return false
+	}
+	next := true
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Message() == nil || fd.IsMap():
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && next; i++ {
+				next = walkNodes(list.Get(i).Message(), visit)
+			}
+		default:
+			next = walkNodes(v.Message(), visit)
+		}
+		return next
+	})
+	return next
+}
+
+// setSchema qualifies the table in the statement, so that the chunks don't depend on the search path.
+func (b *BatchDML) setSchema(schemaName string) {
+	b.schemaName = schemaName
+	b.relation.Schemaname = schemaName
+}
+
+// EstimateRows returns the estimated number of rows of the table, or zero if it is unknown.
+func (b *BatchDML) EstimateRows(ctx context.Context, conn *sql.Conn) (int64, error) {
+	var rows float64
+	if err := conn.QueryRowContext(ctx, `SELECT reltuples FROM pg_class WHERE oid = $1::regclass`, b.table()).Scan(&rows); err != nil {
+		return 0, errors.Wrapf(err, "failed to estimate the rows of table %q.%q", b.schemaName, b.tableName)
+	}
+	// reltuples is -1 if the table has never been vacuumed or analyzed.
+	if rows <= 0 {
+		return 0, nil
+	}
+	return int64(rows), nil
+}
+
+// NextBoundary returns the primary key of the last row in the chunk of chunkSize rows after lowerKey.
+// It returns nil if the rest of the table has no more than chunkSize rows.
+// A nil lowerKey starts from the beginning of the table.
+func (b *BatchDML) NextBoundary(ctx context.Context, conn *sql.Conn, lowerKey []string, chunkSize int64) ([]string, error) {
+	var columns []string
+	for _, column := range b.quotedColumns("") {
+		columns = append(columns, column+"::text")
+	}
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "SELECT %s FROM %s", strings.Join(columns, ", "), b.table())
+	if lowerKey != nil {
+		fmt.Fprintf(&buf, " WHERE %s > %s", b.keyTuple(""), b.placeholderTuple(1))
+	}
+	fmt.Fprintf(&buf, " ORDER BY %s LIMIT 1 OFFSET %d", strings.Join(b.quotedColumns(""), ", "), chunkSize-1)
+
+	var args []any
+	for _, v := range lowerKey {
+		args = append(args, v)
+	}
+	upperKey := make([]sql.NullString, len(b.primaryKey))
+	dest := make([]any, len(upperKey))
+	for i := range upperKey {
+		dest[i] = &upperKey[i]
+	}
+	if err := conn.QueryRowContext(ctx, buf.String(), args...).Scan(dest...); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get the next chunk boundary of table %q.%q", b.schemaName, b.tableName)
+	}
+	var result []string
+	for _, v := range upperKey {
+		result = append(result, v.String)
+	}
+	return result, nil
+}
+
+// ExecuteChunk executes the statement on the rows with the primary key in (lowerKey, upperKey], and returns the affected rows.
+// A nil bound means the chunk is unbounded on that side.
+// Each chunk runs in its own transaction as the database owner.
+func (b *BatchDML) ExecuteChunk(ctx context.Context, conn *sql.Conn, lowerKey, upperKey []string) (int64, error) {
+	statement := b.chunkStatements[boolIndex(lowerKey != nil)][boolIndex(upperKey != nil)]
+	var args []any
+	for _, v := range lowerKey {
+		args = append(args, v)
+	}
+	for _, v := range upperKey {
+		args = append(args, v)
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL ROLE %s", pgx.Identifier{b.owner}.Sanitize())); err != nil {
+		return 0, err
+	}
+	result, err := tx.ExecContext(ctx, statement, args...)
+	if err != nil {
+		return 0, err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return rowsAffected, nil
+}
+
+// ThreadsRunning returns the number of the active backends other than the current one.
+func (*BatchDML) ThreadsRunning(ctx context.Context, conn *sql.Conn) (int64, error) {
+	var count int64
+	if err := conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM pg_stat_activity WHERE state = 'active' AND pid <> pg_backend_pid()`).Scan(&count); err != nil {
+		return 0, errors.Wrapf(err, "failed to count the active backends")
+	}
+	return count, nil
+}
+
+// ReplicaLag returns the maximum replay lag of the standbys streaming from the primary.
+func ReplicaLag(ctx context.Context, conn *sql.Conn) (time.Duration, error) {
+	var seconds float64
+	if err := conn.QueryRowContext(ctx, `SELECT COALESCE(EXTRACT(EPOCH FROM MAX(replay_lag)), 0) FROM pg_stat_replication`).Scan(&seconds); err != nil {
+		return 0, errors.Wrapf(err, "failed to get the replica lag")
+	}
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+func (b *BatchDML) buildChunkStatement(hasLower, hasUpper bool) (string, error) {
+	var predicates []string
+	if hasLower {
+		predicates = append(predicates, fmt.Sprintf("%s > %s", b.keyTuple(b.qualifier), b.placeholderTuple(1)))
+	}
+	if hasUpper {
+		start := 1
+		if hasLower {
+			start += len(b.primaryKey)
+		}
+		predicates = append(predicates, fmt.Sprintf("%s <= %s", b.keyTuple(b.qualifier), b.placeholderTuple(start)))
+	}
+	where := b.where
+	if len(predicates) > 0 {
+		// Parse the range predicate in a SELECT statement to get its expression node.
+		tree, err := pgquery.Parse(fmt.Sprintf("SELECT 1 WHERE %s", strings.Join(predicates, " AND ")))
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to parse the chunk range predicate")
+		}
+		predicate := tree.Stmts[0].GetStmt().GetSelectStmt().GetWhereClause()
+		if where == nil {
+			where = predicate
+		} else {
+			where = pgquery.MakeBoolExprNode(pgquery.BoolExprType_AND_EXPR, []*pgquery.Node{where, predicate}, 0)
+		}
+	}
+
+	stmt := b.tree.Stmts[0].GetStmt()
+	if update := stmt.GetUpdateStmt(); update != nil {
+		update.WhereClause = where
+		defer func() { update.WhereClause = b.where }()
+	} else {
+		del := stmt.GetDeleteStmt()
+		del.WhereClause = where
+		defer func() { del.WhereClause = b.where }()
+	}
+	statement, err := pgquery.Deparse(b.tree)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to deparse the chunk statement")
+	}
+	return statement, nil
+}
+
+func (b *BatchDML) table() string {
+	return pgx.Identifier{b.schemaName, b.tableName}.Sanitize()
+}
+
+func (b *BatchDML) quotedColumns(qualifier string) []string {
+	var columns []string
+	for _, column := range b.primaryKey {
+		if qualifier == "" {
+			columns = append(columns, pgx.Identifier{column}.Sanitize())
+		} else {
+			columns = append(columns, pgx.Identifier{qualifier, column}.Sanitize())
+		}
+	}
+	return columns
+}
+
+func (b *BatchDML) keyTuple(qualifier string) string {
+	columns := b.quotedColumns(qualifier)
+	if len(columns) == 1 {
+		return columns[0]
+	}
+	return fmt.Sprintf("(%s)", strings.Join(columns, ", "))
+}
+
+// placeholderTuple returns the parameter tuple of the primary key starting at $start, with the parameters casted to the column types.
+func (b *BatchDML) placeholderTuple(start int) string {
+	var placeholders []string
+	for i, columnType := range b.primaryKeyTypes {
+		placeholders = append(placeholders, fmt.Sprintf("$%d::%s", start+i, columnType))
+	}
+	if len(placeholders) == 1 {
+		return placeholders[0]
+	}
+	return fmt.Sprintf("(%s)", strings.Join(placeholders, ", "))
+}
+
+func boolIndex(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
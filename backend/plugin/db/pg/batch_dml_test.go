@@ -0,0 +1,134 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBatchDML(t *testing.T) {
+	tests := []struct {
+		statement string
+		wantErr   bool
+	}{
+		{statement: `UPDATE orders SET status = 'done' WHERE created_at < now()`},
+		{statement: `DELETE FROM "Sales"."Orders" AS o WHERE o.amount = 0`},
+		{statement: `UPDATE orders SET status = 'done'; DELETE FROM orders`, wantErr: true},
+		{statement: `UPDATE orders SET status = c.status FROM customers c WHERE c.id = orders.customer_id`, wantErr: true},
+		{statement: `DELETE FROM orders USING customers c WHERE c.id = orders.customer_id`, wantErr: true},
+		{statement: `WITH x AS (SELECT 1) DELETE FROM orders`, wantErr: true},
+		{statement: `INSERT INTO orders VALUES (1)`, wantErr: true},
+		{statement: `UPDATE orders SET status = lower(code), updated_at = now()`},
+		{statement: `UPDATE orders SET amount = amount + 1`, wantErr: true},
+		{statement: `UPDATE orders AS o SET a = b, b = coalesce(o.a, 0)`, wantErr: true},
+		{statement: `UPDATE orders SET amount = (SELECT max(amount) FROM orders)`, wantErr: true},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := NewBatchDML(test.statement)
+		if test.wantErr {
+			a.Error(err, test.statement)
+		} else {
+			a.NoError(err, test.statement)
+		}
+	}
+}
+
+func TestBatchDMLCheckSetColumns(t *testing.T) {
+	tests := []struct {
+		statement string
+		wantErr   bool
+	}{
+		{statement: `UPDATE orders SET status = 'done'`},
+		{statement: `UPDATE orders SET id = 1000`, wantErr: true},
+		{statement: `UPDATE orders AS o SET tenant_id = 2, status = 'moved'`, wantErr: true},
+		{statement: `DELETE FROM orders`},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		b, err := NewBatchDML(test.statement)
+		a.NoError(err, test.statement)
+		b.primaryKey = []string{"tenant_id", "id"}
+		if test.wantErr {
+			a.Error(b.checkSetColumns(), test.statement)
+		} else {
+			a.NoError(b.checkSetColumns(), test.statement)
+		}
+	}
+}
+
+func TestBatchDMLChunkStatement(t *testing.T) {
+	tests := []struct {
+		statement       string
+		schemaName      string
+		primaryKey      []string
+		primaryKeyTypes []string
+		want            [2][2]string
+	}{
+		{
+			statement:       `UPDATE orders SET status = 'done' WHERE amount > 2 OR note IS NULL`,
+			primaryKey:      []string{"id"},
+			primaryKeyTypes: []string{"bigint"},
+			want: [2][2]string{
+				{
+					`UPDATE orders SET status = 'done' WHERE amount > 2 OR note IS NULL`,
+					`UPDATE orders SET status = 'done' WHERE (amount > 2 OR note IS NULL) AND orders.id <= $1::bigint`,
+				},
+				{
+					`UPDATE orders SET status = 'done' WHERE (amount > 2 OR note IS NULL) AND orders.id > $1::bigint`,
+					`UPDATE orders SET status = 'done' WHERE (amount > 2 OR note IS NULL) AND (orders.id > $1::bigint AND orders.id <= $2::bigint)`,
+				},
+			},
+		},
+		{
+			statement:       `DELETE FROM "Sales"."Orders" AS o`,
+			primaryKey:      []string{"Region", "id"},
+			primaryKeyTypes: []string{"character varying(10)", "integer"},
+			want: [2][2]string{
+				{
+					`DELETE FROM "Sales"."Orders" o`,
+					`DELETE FROM "Sales"."Orders" o WHERE (o."Region", o.id) <= ($1::varchar(10), $2::int)`,
+				},
+				{
+					`DELETE FROM "Sales"."Orders" o WHERE (o."Region", o.id) > ($1::varchar(10), $2::int)`,
+					`DELETE FROM "Sales"."Orders" o WHERE (o."Region", o.id) > ($1::varchar(10), $2::int) AND (o."Region", o.id) <= ($3::varchar(10), $4::int)`,
+				},
+			},
+		},
+		{
+			statement:       `DELETE FROM orders WHERE amount = 0`,
+			schemaName:      "sales",
+			primaryKey:      []string{"id"},
+			primaryKeyTypes: []string{"bigint"},
+			want: [2][2]string{
+				{
+					`DELETE FROM sales.orders WHERE amount = 0`,
+					`DELETE FROM sales.orders WHERE amount = 0 AND orders.id <= $1::bigint`,
+				},
+				{
+					`DELETE FROM sales.orders WHERE amount = 0 AND orders.id > $1::bigint`,
+					`DELETE FROM sales.orders WHERE amount = 0 AND (orders.id > $1::bigint AND orders.id <= $2::bigint)`,
+				},
+			},
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		b, err := NewBatchDML(test.statement)
+		a.NoError(err)
+		if test.schemaName != "" {
+			b.setSchema(test.schemaName)
+		}
+		b.primaryKey, b.primaryKeyTypes = test.primaryKey, test.primaryKeyTypes
+		for _, hasLower := range []bool{false, true} {
+			for _, hasUpper := range []bool{false, true} {
+				got, err := b.buildChunkStatement(hasLower, hasUpper)
+				a.NoError(err)
+				a.Equal(test.want[boolIndex(hasLower)][boolIndex(hasUpper)], got)
+			}
+		}
+	}
+}
@@ -60,28 +60,6 @@ func (driver *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool)
 
 func (driver *Driver) dumpOneDatabaseWithPgDump(ctx context.Context, database string, out io.Writer, schemaOnly bool) error {
 	var args []string
-	args = append(args, fmt.Sprintf("--username=%s", driver.config.Username))
-	if driver.config.Password == "" {
-		args = append(args, "--no-password")
-	}
-	if driver.sshClient == nil {
-		args = append(args, fmt.Sprintf("--host=%s", driver.config.Host))
-		args = append(args, fmt.Sprintf("--port=%s", driver.config.Port))
-	} else {
-		localPort := <-util.PortFIFO
-		defer func() {
-			util.PortFIFO <- localPort
-		}()
-		args = append(args, fmt.Sprintf("--host=%s", "localhost"))
-		args = append(args, fmt.Sprintf("--port=%d", localPort))
-		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", localPort))
-		if err != nil {
-			return err
-		}
-		defer listener.Close()
-		databaseAddress := fmt.Sprintf("%s:%s", driver.config.Host, driver.config.Port)
-		go util.ProxyConnection(driver.sshClient, listener, databaseAddress)
-	}
 	if schemaOnly {
 		args = append(args, "--schema-only")
 	}
@@ -93,22 +71,11 @@ func (driver *Driver) dumpOneDatabaseWithPgDump(ctx context.Context, database st
 	args = append(args, "--no-privileges")
 	args = append(args, database)
 
-	pgDumpPath := filepath.Join(driver.dbBinDir, "pg_dump")
-	cmd := exec.CommandContext(ctx, pgDumpPath, args...)
-	// Unlike MySQL, PostgreSQL does not support specifying commands in commands, we can do this by means of environment variables.
-	if driver.config.Password != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("PGPASSWORD=%s", driver.config.Password))
-	}
-	if driver.config.TLSConfig.SslCert != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLCERT=%s", driver.config.TLSConfig.SslCert))
-	}
-	if driver.config.TLSConfig.SslCA != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLROOTCERT=%s", driver.config.TLSConfig.SslCA))
-	}
-	if driver.config.TLSConfig.SslKey != "" {
-		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLKEY=%s", driver.config.TLSConfig.SslKey))
+	cmd, closeTunnel, err := driver.newUtilityCommand(ctx, "pg_dump", args...)
+	if err != nil {
+		return err
 	}
-	cmd.Env = append(cmd.Env, "OPENSSL_CONF=/etc/ssl/")
+	defer closeTunnel()
 	outPipe, err := cmd.StdoutPipe()
 	if err != nil {
 		return err
@@ -247,3 +214,50 @@ func (driver *Driver) Restore(ctx context.Context, sc io.Reader) error {
 
 	return txn.Commit()
 }
+
+// newUtilityCommand creates the command of the PostgreSQL utility connecting to the instance, e.g. pg_dump.
+// The returned function closes the SSH tunnel of the command, and it should be called after the command finishes.
+func (driver *Driver) newUtilityCommand(ctx context.Context, name string, args ...string) (*exec.Cmd, func(), error) {
+	var connectionArgs []string
+	connectionArgs = append(connectionArgs, fmt.Sprintf("--username=%s", driver.config.Username))
+	if driver.config.Password == "" {
+		connectionArgs = append(connectionArgs, "--no-password")
+	}
+	closeTunnel := func() {}
+	if driver.sshClient == nil {
+		connectionArgs = append(connectionArgs, fmt.Sprintf("--host=%s", driver.config.Host))
+		connectionArgs = append(connectionArgs, fmt.Sprintf("--port=%s", driver.config.Port))
+	} else {
+		localPort := <-util.PortFIFO
+		connectionArgs = append(connectionArgs, fmt.Sprintf("--host=%s", "localhost"))
+		connectionArgs = append(connectionArgs, fmt.Sprintf("--port=%d", localPort))
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%d", localPort))
+		if err != nil {
+			util.PortFIFO <- localPort
+			return nil, nil, err
+		}
+		closeTunnel = func() {
+			listener.Close()
+			util.PortFIFO <- localPort
+		}
+		databaseAddress := fmt.Sprintf("%s:%s", driver.config.Host, driver.config.Port)
+		go util.ProxyConnection(driver.sshClient, listener, databaseAddress)
+	}
+
+	cmd := exec.CommandContext(ctx, filepath.Join(driver.dbBinDir, name), append(connectionArgs, args...)...)
+	// Unlike MySQL, PostgreSQL does not support specifying commands in commands, we can do this by means of environment variables.
+	if driver.config.Password != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGPASSWORD=%s", driver.config.Password))
+	}
+	if driver.config.TLSConfig.SslCert != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLCERT=%s", driver.config.TLSConfig.SslCert))
+	}
+	if driver.config.TLSConfig.SslCA != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLROOTCERT=%s", driver.config.TLSConfig.SslCA))
+	}
+	if driver.config.TLSConfig.SslKey != "" {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PGSSLKEY=%s", driver.config.TLSConfig.SslKey))
+	}
+	cmd.Env = append(cmd.Env, "OPENSSL_CONF=/etc/ssl/")
+	return cmd, closeTunnel, nil
+}
@@ -0,0 +1,735 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jackc/pgx/v4"
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/pkg/errors"
+)
+
+const (
+	// onlineMigrationChunkSize is the number of rows copied into the shadow table per statement.
+	onlineMigrationChunkSize = 1000
+	// onlineMigrationCheckSampleSize is the number of rows copied into the shadow table during the dry run.
+	onlineMigrationCheckSampleSize = 100
+	// onlineMigrationLockTimeout is the lock timeout for statements locking the original table.
+	onlineMigrationLockTimeout = "3s"
+	// onlineMigrationCutoverRetry is the number of attempts to acquire the locks for the cutover.
+	onlineMigrationCutoverRetry = 5
+	// maxIdentifierLength is the maximum length in bytes of PostgreSQL identifiers.
+	maxIdentifierLength = 63
+)
+
+// onlineMigrationQueryer is the common interface of sql.DB and sql.Tx.
+type onlineMigrationQueryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// OnlineMigration changes the schema of a table without blocking writes.
+// The statement is applied to a shadow table created like the original table,
+// rows are copied in chunks while a trigger replays concurrent changes,
+// and the two tables are swapped by renaming at cutover.
+// The original table is kept as "_<table>_del" after the cutover.
+type OnlineMigration struct {
+	tree     *pgquery.ParseResult
+	relation *pgquery.RangeVar
+
+	// schemaName is empty until the unqualified table is resolved by the search path.
+	schemaName string
+	tableName  string
+	// shadowStatement is the ALTER TABLE statement rewritten against the shadow table.
+	shadowStatement string
+}
+
+// NewOnlineMigration creates an online migration for the statement.
+// The statement must be a single ALTER TABLE statement.
+func NewOnlineMigration(statement string) (*OnlineMigration, error) {
+	tree, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	if len(tree.Stmts) != 1 {
+		return nil, errors.Errorf("online migration requires exactly one ALTER TABLE statement, but got %d statements", len(tree.Stmts))
+	}
+	alter := tree.Stmts[0].GetStmt().GetAlterTableStmt()
+	if alter == nil || alter.Objtype != pgquery.ObjectType_OBJECT_TABLE || alter.Relation == nil {
+		return nil, errors.Errorf("online migration only supports the ALTER TABLE statement")
+	}
+	for _, cmd := range alter.Cmds {
+		switch cmd.GetAlterTableCmd().GetSubtype() {
+		case pgquery.AlterTableType_AT_AddInherit,
+			pgquery.AlterTableType_AT_DropInherit,
+			pgquery.AlterTableType_AT_AttachPartition,
+			pgquery.AlterTableType_AT_DetachPartition,
+			pgquery.AlterTableType_AT_ChangeOwner:
+			return nil, errors.Errorf("online migration does not support changing the owner, inheritance or partitions of the table")
+		}
+	}
+
+	m := &OnlineMigration{
+		tree:      tree,
+		relation:  alter.Relation,
+		tableName: alter.Relation.Relname,
+	}
+	if alter.Relation.Schemaname != "" {
+		if err := m.setSchema(alter.Relation.Schemaname); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// resolveSchema resolves the unqualified table by the search path of the database owner, which the statement is executed as.
+func (m *OnlineMigration) resolveSchema(ctx context.Context, db *sql.DB) error {
+	if m.schemaName != "" {
+		return nil
+	}
+	schemaName, err := resolveTableSchema(ctx, db, m.tableName)
+	if err != nil {
+		return err
+	}
+	return m.setSchema(schemaName)
+}
+
+// setSchema sets the schema of the migrated table, and rewrites the statement against the shadow table in the schema.
+func (m *OnlineMigration) setSchema(schemaName string) error {
+	m.relation.Schemaname = schemaName
+	m.relation.Relname = m.ShadowTableName()
+	shadowStatement, err := pgquery.Deparse(m.tree)
+	if err != nil {
+		return errors.Wrapf(err, "failed to deparse statement")
+	}
+	m.schemaName = schemaName
+	m.shadowStatement = shadowStatement
+	return nil
+}
+
+// SchemaName returns the schema name of the migrated table.
+// It is empty for an unqualified table until the table is resolved by the search path.
+func (m *OnlineMigration) SchemaName() string {
+	return m.schemaName
+}
+
+// TableName returns the name of the migrated table.
+func (m *OnlineMigration) TableName() string {
+	return m.tableName
+}
+
+// ShadowTableName returns the name of the shadow table.
+func (m *OnlineMigration) ShadowTableName() string {
+	return fmt.Sprintf("_%s_gho", m.tableName)
+}
+
+// OldTableName returns the name of the original table after the cutover.
+func (m *OnlineMigration) OldTableName() string {
+	return fmt.Sprintf("_%s_del", m.tableName)
+}
+
+func (m *OnlineMigration) syncName() string {
+	return fmt.Sprintf("_%s_sync", m.tableName)
+}
+
+func (m *OnlineMigration) table() string {
+	return pgx.Identifier{m.schemaName, m.tableName}.Sanitize()
+}
+
+func (m *OnlineMigration) shadowTable() string {
+	return pgx.Identifier{m.schemaName, m.ShadowTableName()}.Sanitize()
+}
+
+func (m *OnlineMigration) syncFunction() string {
+	return pgx.Identifier{m.schemaName, m.syncName()}.Sanitize()
+}
+
+// Check validates the prerequisites of the online migration.
+// An unqualified table is resolved by the search path of the database owner, the same as the other steps.
+// It applies the statement to the shadow table and copies sample rows in a transaction which is always rolled back.
+func (m *OnlineMigration) Check(ctx context.Context, db *sql.DB) error {
+	if err := m.resolveSchema(ctx, db); err != nil {
+		return err
+	}
+	for _, name := range []string{m.ShadowTableName(), m.OldTableName(), m.syncName() + "_truncate"} {
+		if len(name) > maxIdentifierLength {
+			return errors.Errorf("table name %q is too long, the name %q exceeds %d bytes", m.tableName, name, maxIdentifierLength)
+		}
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var relkind string
+	var rowSecurity bool
+	if err := tx.QueryRowContext(ctx, `SELECT relkind, relrowsecurity FROM pg_class WHERE oid = to_regclass($1)`, m.table()).Scan(&relkind, &rowSecurity); err != nil {
+		if err == sql.ErrNoRows {
+			return errors.Errorf("table %q.%q does not exist", m.schemaName, m.tableName)
+		}
+		return err
+	}
+	if relkind != "r" {
+		return errors.Errorf("%q.%q is not an ordinary table, partitioned tables are not supported", m.schemaName, m.tableName)
+	}
+	if rowSecurity {
+		return errors.Errorf("table %q.%q has row level security enabled", m.schemaName, m.tableName)
+	}
+	for _, name := range []string{m.ShadowTableName(), m.OldTableName()} {
+		var exists bool
+		if err := tx.QueryRowContext(ctx, `SELECT to_regclass($1) IS NOT NULL`, pgx.Identifier{m.schemaName, name}.Sanitize()).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return errors.Errorf("table %q.%q already exists", m.schemaName, name)
+		}
+	}
+
+	checks := []struct {
+		query   string
+		message string
+	}{
+		{
+			query:   `SELECT inhrelid::regclass::text FROM pg_inherits WHERE inhrelid = $1::regclass OR inhparent = $1::regclass LIMIT 1`,
+			message: "table %q.%q is in an inheritance hierarchy with %s",
+		},
+		{
+			query:   `SELECT conname FROM pg_constraint WHERE contype = 'f' AND (conrelid = $1::regclass OR confrelid = $1::regclass) LIMIT 1`,
+			message: "table %q.%q is referenced by or references other tables with foreign key %q",
+		},
+		{
+			query: `
+				SELECT DISTINCT r.ev_class::regclass::text
+				FROM pg_depend d
+				JOIN pg_rewrite r ON r.oid = d.objid
+				WHERE d.classid = 'pg_rewrite'::regclass AND d.refobjid = $1::regclass AND r.ev_class <> $1::regclass
+				LIMIT 1`,
+			message: "table %q.%q is used by view %s",
+		},
+		{
+			query:   `SELECT tgname FROM pg_trigger WHERE tgrelid = $1::regclass AND NOT tgisinternal LIMIT 1`,
+			message: "table %q.%q has trigger %q",
+		},
+	}
+	for _, check := range checks {
+		var name string
+		if err := tx.QueryRowContext(ctx, check.query, m.table()).Scan(&name); err != nil {
+			if err == sql.ErrNoRows {
+				continue
+			}
+			return err
+		}
+		return errors.Errorf(check.message, m.schemaName, m.tableName, name)
+	}
+
+	columns, err := m.prepare(ctx, tx)
+	if err != nil {
+		return err
+	}
+	columnList := strings.Join(columns, ", ")
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO %s (%s) OVERRIDING SYSTEM VALUE SELECT %s FROM %s LIMIT %d`, m.shadowTable(), columnList, columnList, m.table(), onlineMigrationCheckSampleSize)); err != nil {
+		return errors.Wrapf(err, "failed to copy rows into the shadow table")
+	}
+	return nil
+}
+
+// Prepare creates the shadow table with the new schema and the trigger replaying changes of the original table.
+// Leftovers of the previous attempts are dropped first.
+func (m *OnlineMigration) Prepare(ctx context.Context, db *sql.DB) error {
+	if err := m.resolveSchema(ctx, db); err != nil {
+		return err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := m.prepare(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (m *OnlineMigration) prepare(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`SET LOCAL lock_timeout = '%s'`, onlineMigrationLockTimeout)); err != nil {
+		return nil, err
+	}
+	if err := m.cleanup(ctx, tx); err != nil {
+		return nil, err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING ALL)`, m.shadowTable(), m.table())); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the shadow table")
+	}
+	if _, err := tx.ExecContext(ctx, m.shadowStatement); err != nil {
+		return nil, errors.Wrapf(err, "failed to alter the shadow table")
+	}
+
+	primaryKey, _, err := getPrimaryKey(ctx, tx, m.table())
+	if err != nil {
+		return nil, err
+	}
+	if len(primaryKey) == 0 {
+		return nil, errors.Errorf("table %q.%q has no primary key", m.schemaName, m.tableName)
+	}
+	shadowPrimaryKey, _, err := getPrimaryKey(ctx, tx, m.shadowTable())
+	if err != nil {
+		return nil, err
+	}
+	if strings.Join(primaryKey, ",") != strings.Join(shadowPrimaryKey, ",") {
+		return nil, errors.Errorf("online migration does not support changing the primary key of table %q.%q", m.schemaName, m.tableName)
+	}
+	columns, err := m.getCopyColumns(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.ExecContext(ctx, m.syncFunctionStatement(columns, primaryKey)); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the sync function")
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON %s FOR EACH ROW EXECUTE PROCEDURE %s()`,
+		pgx.Identifier{m.syncName()}.Sanitize(), m.table(), m.syncFunction())); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the sync trigger")
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`CREATE TRIGGER %s AFTER TRUNCATE ON %s FOR EACH STATEMENT EXECUTE PROCEDURE %s()`,
+		pgx.Identifier{m.syncName() + "_truncate"}.Sanitize(), m.table(), m.syncFunction())); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the sync trigger")
+	}
+	return columns, nil
+}
+
+// syncFunctionStatement returns the statement creating the trigger function which replays the changes on the shadow table.
+func (m *OnlineMigration) syncFunctionStatement(columns []string, primaryKey []string) string {
+	var oldKey, newValues, updates []string
+	for _, column := range primaryKey {
+		oldKey = append(oldKey, "OLD."+column)
+	}
+	isKey := make(map[string]bool)
+	for _, column := range primaryKey {
+		isKey[column] = true
+	}
+	for _, column := range columns {
+		newValues = append(newValues, "NEW."+column)
+		if !isKey[column] {
+			updates = append(updates, fmt.Sprintf("%s = EXCLUDED.%s", column, column))
+		}
+	}
+	onConflict := "DO NOTHING"
+	if len(updates) > 0 {
+		onConflict = "DO UPDATE SET " + strings.Join(updates, ", ")
+	}
+
+	return fmt.Sprintf(`CREATE FUNCTION %s() RETURNS trigger LANGUAGE plpgsql AS $bb_sync$
+BEGIN
+	IF TG_OP = 'TRUNCATE' THEN
+		TRUNCATE %s;
+		RETURN NULL;
+	END IF;
+	IF TG_OP = 'UPDATE' OR TG_OP = 'DELETE' THEN
+		DELETE FROM %s WHERE (%s) = (%s);
+	END IF;
+	IF TG_OP = 'INSERT' OR TG_OP = 'UPDATE' THEN
+		INSERT INTO %s (%s) OVERRIDING SYSTEM VALUE VALUES (%s) ON CONFLICT (%s) %s;
+	END IF;
+	RETURN NULL;
+END;
+$bb_sync$`,
+		m.syncFunction(),
+		m.shadowTable(),
+		m.shadowTable(), strings.Join(primaryKey, ", "), strings.Join(oldKey, ", "),
+		m.shadowTable(), strings.Join(columns, ", "), strings.Join(newValues, ", "), strings.Join(primaryKey, ", "), onConflict,
+	)
+}
+
+// EstimateRowCount returns the estimated number of rows in the original table.
+func (m *OnlineMigration) EstimateRowCount(ctx context.Context, db *sql.DB) (int64, error) {
+	if err := m.resolveSchema(ctx, db); err != nil {
+		return 0, err
+	}
+	var count int64
+	if err := db.QueryRowContext(ctx, `SELECT GREATEST(reltuples::bigint, 0) FROM pg_class WHERE oid = $1::regclass`, m.table()).Scan(&count); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// Backfill copies the rows of the original table into the shadow table in chunks ordered by the primary key.
+// Rows already replayed by the trigger are skipped. The progress is called with the number of copied rows after each chunk.
+// The rows of a chunk are locked with FOR KEY SHARE until they are copied, so a concurrent DELETE waits for the chunk
+// and its trigger removes the copied row, instead of the copy resurrecting a row deleted after the chunk is read.
+func (m *OnlineMigration) Backfill(ctx context.Context, db *sql.DB, progress func(copied int64)) error {
+	if err := m.resolveSchema(ctx, db); err != nil {
+		return err
+	}
+	primaryKey, primaryKeyTypes, err := getPrimaryKey(ctx, db, m.table())
+	if err != nil {
+		return err
+	}
+	if len(primaryKey) == 0 {
+		return errors.Errorf("table %q.%q has no primary key", m.schemaName, m.tableName)
+	}
+	columns, err := m.getCopyColumns(ctx, db)
+	if err != nil {
+		return err
+	}
+	columnList := strings.Join(columns, ", ")
+	keyList := strings.Join(primaryKey, ", ")
+	var lastKeyList, params []string
+	for i, column := range primaryKey {
+		lastKeyList = append(lastKeyList, column+"::text")
+		params = append(params, fmt.Sprintf("$%d::%s", i+1, primaryKeyTypes[i]))
+	}
+	chunkQuery := func(where string) string {
+		return fmt.Sprintf(`
+			WITH chunk AS (
+				SELECT %s FROM %s %s ORDER BY %s LIMIT %d FOR KEY SHARE
+			), copied AS (
+				INSERT INTO %s (%s) OVERRIDING SYSTEM VALUE SELECT %s FROM chunk ON CONFLICT (%s) DO NOTHING
+			)
+			SELECT (SELECT count(*) FROM chunk), %s FROM chunk ORDER BY %s DESC LIMIT 1`,
+			columnList, m.table(), where, keyList, onlineMigrationChunkSize,
+			m.shadowTable(), columnList, columnList, keyList,
+			strings.Join(lastKeyList, ", "), strings.Join(descending(primaryKey), ", "),
+		)
+	}
+	firstQuery := chunkQuery("")
+	nextQuery := chunkQuery(fmt.Sprintf("WHERE (%s) > (%s)", keyList, strings.Join(params, ", ")))
+
+	var copied int64
+	var lastKey []any
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		query := firstQuery
+		if lastKey != nil {
+			query = nextQuery
+		}
+		var count int64
+		key := make([]sql.NullString, len(primaryKey))
+		dest := []any{&count}
+		for i := range key {
+			dest = append(dest, &key[i])
+		}
+		if err := db.QueryRowContext(ctx, query, lastKey...).Scan(dest...); err != nil {
+			if err == sql.ErrNoRows {
+				return nil
+			}
+			return errors.Wrapf(err, "failed to copy rows into the shadow table")
+		}
+		copied += count
+		progress(copied)
+		if count < onlineMigrationChunkSize {
+			return nil
+		}
+		lastKey = nil
+		for _, k := range key {
+			lastKey = append(lastKey, k.String)
+		}
+	}
+}
+
+// Cutover swaps the original table and the shadow table.
+// The sequences, owner, privileges and index names of the original table are moved to the shadow table.
+func (m *OnlineMigration) Cutover(ctx context.Context, db *sql.DB) error {
+	if err := m.resolveSchema(ctx, db); err != nil {
+		return err
+	}
+	var err error
+	for i := 0; i < onlineMigrationCutoverRetry; i++ {
+		if err = m.cutover(ctx, db); err == nil {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to cut over after %d attempts", onlineMigrationCutoverRetry)
+	}
+	if _, err := db.ExecContext(ctx, fmt.Sprintf(`ANALYZE %s`, m.table())); err != nil {
+		return errors.Wrapf(err, "failed to analyze table %q.%q", m.schemaName, m.tableName)
+	}
+	return nil
+}
+
+func (m *OnlineMigration) cutover(ctx context.Context, db *sql.DB) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`SET LOCAL lock_timeout = '%s'`, onlineMigrationLockTimeout)); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`LOCK TABLE %s, %s IN ACCESS EXCLUSIVE MODE`, m.table(), m.shadowTable())); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DROP FUNCTION %s() CASCADE`, m.syncFunction())); err != nil {
+		return err
+	}
+
+	var statements []string
+	// Serial sequences are owned by the original table and would be dropped with it.
+	// Identity sequences belong to the tables, so the shadow ones continue from the original values.
+	rows, err := tx.QueryContext(ctx, `
+		SELECT s.oid::regclass::text, a.attname, d.deptype, pg_get_serial_sequence($2, a.attname)
+		FROM pg_depend d
+		JOIN pg_class s ON s.oid = d.objid AND s.relkind = 'S'
+		JOIN pg_attribute a ON a.attrelid = d.refobjid AND a.attnum = d.refobjsubid
+		WHERE d.classid = 'pg_class'::regclass AND d.refobjid = $1::regclass AND d.deptype IN ('a', 'i')`,
+		m.table(), m.shadowTable())
+	if err != nil {
+		return err
+	}
+	type sequenceDependency struct {
+		sequence       string
+		column         string
+		depType        string
+		shadowSequence sql.NullString
+	}
+	var dependencies []sequenceDependency
+	defer rows.Close()
+	for rows.Next() {
+		var dependency sequenceDependency
+		if err := rows.Scan(&dependency.sequence, &dependency.column, &dependency.depType, &dependency.shadowSequence); err != nil {
+			return err
+		}
+		dependencies = append(dependencies, dependency)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if err := rows.Close(); err != nil {
+		return err
+	}
+	for _, dependency := range dependencies {
+		if dependency.depType == "a" {
+			var exists bool
+			if err := tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM pg_attribute WHERE attrelid = $1::regclass AND attname = $2 AND NOT attisdropped)`, m.shadowTable(), dependency.column).Scan(&exists); err != nil {
+				return err
+			}
+			if exists {
+				statements = append(statements, fmt.Sprintf(`ALTER SEQUENCE %s OWNED BY %s.%s`, dependency.sequence, m.shadowTable(), pgx.Identifier{dependency.column}.Sanitize()))
+			}
+		} else if dependency.shadowSequence.Valid {
+			statements = append(statements, fmt.Sprintf(`SELECT setval(%s, last_value, is_called) FROM %s`, quoteLiteral(dependency.shadowSequence.String), dependency.sequence))
+		}
+	}
+
+	var owner string
+	if err := tx.QueryRowContext(ctx, `SELECT pg_get_userbyid(relowner) FROM pg_class WHERE oid = $1::regclass`, m.table()).Scan(&owner); err != nil {
+		return err
+	}
+	statements = append(statements, fmt.Sprintf(`ALTER TABLE %s OWNER TO %s`, m.shadowTable(), pgx.Identifier{owner}.Sanitize()))
+	grantRows, err := tx.QueryContext(ctx, `
+		SELECT CASE WHEN a.grantee = 0 THEN 'PUBLIC' ELSE quote_ident(pg_get_userbyid(a.grantee)) END, a.privilege_type, a.is_grantable
+		FROM pg_class c, aclexplode(c.relacl) a
+		WHERE c.oid = $1::regclass AND a.grantee <> c.relowner`,
+		m.table())
+	if err != nil {
+		return err
+	}
+	defer grantRows.Close()
+	for grantRows.Next() {
+		var grantee, privilege string
+		var grantable bool
+		if err := grantRows.Scan(&grantee, &privilege, &grantable); err != nil {
+			return err
+		}
+		statement := fmt.Sprintf(`GRANT %s ON TABLE %s TO %s`, privilege, m.shadowTable(), grantee)
+		if grantable {
+			statement += " WITH GRANT OPTION"
+		}
+		statements = append(statements, statement)
+	}
+	if err := grantRows.Err(); err != nil {
+		return err
+	}
+
+	renames, err := m.getIndexRenames(ctx, tx)
+	if err != nil {
+		return err
+	}
+	statements = append(statements, renames...)
+	statements = append(statements,
+		fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`, m.table(), pgx.Identifier{m.OldTableName()}.Sanitize()),
+		fmt.Sprintf(`ALTER TABLE %s RENAME TO %s`, m.shadowTable(), pgx.Identifier{m.tableName}.Sanitize()),
+	)
+	for _, statement := range statements {
+		if _, err := tx.ExecContext(ctx, statement); err != nil {
+			return errors.Wrapf(err, "failed to execute %q", statement)
+		}
+	}
+	return tx.Commit()
+}
+
+// getIndexRenames returns the statements moving the index names of the original table to the matching indexes of the shadow table.
+// Indexes are matched by their definitions, and the constraints backed by the indexes are renamed together.
+func (m *OnlineMigration) getIndexRenames(ctx context.Context, tx *sql.Tx) ([]string, error) {
+	listIndexes := func(table string) ([]string, []string, error) {
+		rows, err := tx.QueryContext(ctx, `
+			SELECT c.relname, i.indisunique::text || substring(pg_get_indexdef(i.indexrelid) FROM ' USING .*$')
+			FROM pg_index i
+			JOIN pg_class c ON c.oid = i.indexrelid
+			WHERE i.indrelid = $1::regclass
+			ORDER BY c.relname`,
+			table)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer rows.Close()
+		var names, definitions []string
+		for rows.Next() {
+			var name, definition string
+			if err := rows.Scan(&name, &definition); err != nil {
+				return nil, nil, err
+			}
+			names = append(names, name)
+			definitions = append(definitions, definition)
+		}
+		return names, definitions, rows.Err()
+	}
+	names, definitions, err := listIndexes(m.table())
+	if err != nil {
+		return nil, err
+	}
+	shadowNames, shadowDefinitions, err := listIndexes(m.shadowTable())
+	if err != nil {
+		return nil, err
+	}
+
+	var statements, shadowStatements []string
+	matched := make(map[int]bool)
+	for i, name := range names {
+		statements = append(statements, fmt.Sprintf(`ALTER INDEX %s RENAME TO %s`, pgx.Identifier{m.schemaName, name}.Sanitize(), pgx.Identifier{oldIndexName(name)}.Sanitize()))
+		for j, shadowName := range shadowNames {
+			if matched[j] || shadowDefinitions[j] != definitions[i] {
+				continue
+			}
+			matched[j] = true
+			shadowStatements = append(shadowStatements, fmt.Sprintf(`ALTER INDEX %s RENAME TO %s`, pgx.Identifier{m.schemaName, shadowName}.Sanitize(), pgx.Identifier{name}.Sanitize()))
+			break
+		}
+	}
+	return append(statements, shadowStatements...), nil
+}
+
+// Cleanup drops the shadow table and the sync trigger.
+func (m *OnlineMigration) Cleanup(ctx context.Context, db *sql.DB) error {
+	if err := m.resolveSchema(ctx, db); err != nil {
+		return err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`SET LOCAL lock_timeout = '%s'`, onlineMigrationLockTimeout)); err != nil {
+		return err
+	}
+	if err := m.cleanup(ctx, tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (m *OnlineMigration) cleanup(ctx context.Context, tx *sql.Tx) error {
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DROP FUNCTION IF EXISTS %s() CASCADE`, m.syncFunction())); err != nil {
+		return errors.Wrapf(err, "failed to drop the sync function")
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DROP TABLE IF EXISTS %s`, m.shadowTable())); err != nil {
+		return errors.Wrapf(err, "failed to drop the shadow table")
+	}
+	return nil
+}
+
+// getCopyColumns returns the quoted columns existing in both the original table and the shadow table, excluding generated columns.
+func (m *OnlineMigration) getCopyColumns(ctx context.Context, q onlineMigrationQueryer) ([]string, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT quote_ident(s.attname)
+		FROM pg_attribute s
+		JOIN pg_attribute o ON o.attrelid = $1::regclass AND o.attname = s.attname AND o.attnum > 0 AND NOT o.attisdropped
+		WHERE s.attrelid = $2::regclass AND s.attnum > 0 AND NOT s.attisdropped AND s.attgenerated = '' AND o.attgenerated = ''
+		ORDER BY s.attnum`,
+		m.table(), m.shadowTable())
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var columns []string
+	for rows.Next() {
+		var column string
+		if err := rows.Scan(&column); err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(columns) == 0 {
+		return nil, errors.Errorf("no column to copy from table %q.%q", m.schemaName, m.tableName)
+	}
+	return columns, nil
+}
+
+// getPrimaryKey returns the quoted primary key columns and their types of the table.
+func getPrimaryKey(ctx context.Context, q onlineMigrationQueryer, table string) ([]string, []string, error) {
+	rows, err := q.QueryContext(ctx, `
+		SELECT quote_ident(a.attname), format_type(a.atttypid, a.atttypmod)
+		FROM pg_index i
+		CROSS JOIN LATERAL unnest(i.indkey::int2[]) WITH ORDINALITY AS k(attnum, ord)
+		JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = k.attnum
+		WHERE i.indrelid = $1::regclass AND i.indisprimary
+		ORDER BY k.ord`,
+		table)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+	var columns, types []string
+	for rows.Next() {
+		var column, tp string
+		if err := rows.Scan(&column, &tp); err != nil {
+			return nil, nil, err
+		}
+		columns = append(columns, column)
+		types = append(types, tp)
+	}
+	return columns, types, rows.Err()
+}
+
+func descending(columns []string) []string {
+	var result []string
+	for _, column := range columns {
+		result = append(result, column+" DESC")
+	}
+	return result
+}
+
+// oldIndexName returns the name of the original index after the cutover, truncated to the identifier length limit.
+func oldIndexName(name string) string {
+	const prefix, suffix = "_", "_del"
+	limit := maxIdentifierLength - len(prefix) - len(suffix)
+	for len(name) > limit {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return prefix + name + suffix
+}
+
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
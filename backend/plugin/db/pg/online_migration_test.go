@@ -0,0 +1,104 @@
+package pg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOnlineMigration(t *testing.T) {
+	tests := []struct {
+		statement       string
+		schemaName      string
+		tableName       string
+		shadowStatement string
+		wantErr         bool
+	}{
+		{
+			statement:       `ALTER TABLE public.orders ADD COLUMN note text;`,
+			schemaName:      "public",
+			tableName:       "orders",
+			shadowStatement: `ALTER TABLE public._orders_gho ADD COLUMN note text`,
+		},
+		{
+			// The unqualified table is resolved by the search path before the migration.
+			statement: `ALTER TABLE orders ADD COLUMN note text;`,
+			tableName: "orders",
+		},
+		{
+			statement:       `ALTER TABLE "Sales"."Orders" ALTER COLUMN amount TYPE bigint, ADD CONSTRAINT uk_code UNIQUE (code)`,
+			schemaName:      "Sales",
+			tableName:       "Orders",
+			shadowStatement: `ALTER TABLE "Sales"."_Orders_gho" ALTER COLUMN amount TYPE bigint, ADD CONSTRAINT uk_code UNIQUE (code)`,
+		},
+		{
+			statement: `CREATE TABLE t (id int)`,
+			wantErr:   true,
+		},
+		{
+			statement: `ALTER TABLE t ADD COLUMN a int; ALTER TABLE t ADD COLUMN b int;`,
+			wantErr:   true,
+		},
+		{
+			statement: `ALTER INDEX idx_t SET (fillfactor = 70)`,
+			wantErr:   true,
+		},
+		{
+			statement: `ALTER TABLE t OWNER TO bb`,
+			wantErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		m, err := NewOnlineMigration(test.statement)
+		if test.wantErr {
+			require.Error(t, err, test.statement)
+			continue
+		}
+		require.NoError(t, err, test.statement)
+		require.Equal(t, test.schemaName, m.SchemaName())
+		require.Equal(t, test.tableName, m.TableName())
+		require.Equal(t, test.shadowStatement, m.shadowStatement)
+	}
+}
+
+func TestOnlineMigrationNames(t *testing.T) {
+	m, err := NewOnlineMigration(`ALTER TABLE orders ADD COLUMN note text`)
+	require.NoError(t, err)
+	require.NoError(t, m.setSchema("public"))
+	require.Equal(t, "_orders_gho", m.ShadowTableName())
+	require.Equal(t, "_orders_del", m.OldTableName())
+	require.Equal(t, `"public"."_orders_gho"`, m.shadowTable())
+	require.Equal(t, `"public"."_orders_sync"`, m.syncFunction())
+
+	require.Equal(t, "_orders_pkey_del", oldIndexName("orders_pkey"))
+	long := oldIndexName(strings.Repeat("a", 63))
+	require.Len(t, long, maxIdentifierLength)
+	require.True(t, strings.HasSuffix(long, "_del"))
+}
+
+func TestOnlineMigrationSyncFunctionStatement(t *testing.T) {
+	m, err := NewOnlineMigration(`ALTER TABLE orders ADD COLUMN note text`)
+	require.NoError(t, err)
+	require.NoError(t, m.setSchema("public"))
+
+	statement := m.syncFunctionStatement([]string{"id", "amount"}, []string{"id"})
+	require.Contains(t, statement, `CREATE FUNCTION "public"."_orders_sync"() RETURNS trigger`)
+	require.Contains(t, statement, `DELETE FROM "public"."_orders_gho" WHERE (id) = (OLD.id);`)
+	require.Contains(t, statement, `INSERT INTO "public"."_orders_gho" (id, amount) OVERRIDING SYSTEM VALUE VALUES (NEW.id, NEW.amount) ON CONFLICT (id) DO UPDATE SET amount = EXCLUDED.amount;`)
+
+	statement = m.syncFunctionStatement([]string{"a", "b"}, []string{"a", "b"})
+	require.Contains(t, statement, `ON CONFLICT (a, b) DO NOTHING;`)
+}
+
+func TestOnlineMigrationSetSchema(t *testing.T) {
+	m, err := NewOnlineMigration(`ALTER TABLE orders ADD COLUMN note text`)
+	require.NoError(t, err)
+	require.Equal(t, "", m.SchemaName())
+
+	require.NoError(t, m.setSchema("sales"))
+	require.Equal(t, "sales", m.SchemaName())
+	require.Equal(t, `ALTER TABLE sales._orders_gho ADD COLUMN note text`, m.shadowStatement)
+	require.Equal(t, `"sales"."orders"`, m.table())
+}
@@ -447,6 +447,40 @@ func (driver *Driver) GetCurrentDatabaseOwner() (string, error) {
 	return owner, nil
 }
 
+// txBeginner is the common interface of sql.DB and sql.Conn to begin transactions.
+type txBeginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// resolveTableSchema returns the schema of the first table with the name in the search path of the database owner.
+// The search path may contain "$user", so it's resolved as the owner, which executes the changes on the databases.
+func resolveTableSchema(ctx context.Context, db txBeginner, tableName string) (string, error) {
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+	var owner string
+	if err := tx.QueryRowContext(ctx, `SELECT u.rolname FROM pg_roles AS u JOIN pg_database AS d ON (d.datdba = u.oid) WHERE d.datname = current_database()`).Scan(&owner); err != nil {
+		return "", errors.Wrapf(err, "failed to get the owner of the current database")
+	}
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL ROLE %s", pgx.Identifier{owner}.Sanitize())); err != nil {
+		return "", err
+	}
+	var schemaName sql.NullString
+	if err := tx.QueryRowContext(ctx, `
+		SELECT n.nspname
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE c.oid = to_regclass($1)`, pgx.Identifier{tableName}.Sanitize()).Scan(&schemaName); err != nil && err != sql.ErrNoRows {
+		return "", errors.Wrapf(err, "failed to resolve the schema of table %q", tableName)
+	}
+	if !schemaName.Valid {
+		return "", errors.Errorf("table %q does not exist in the search path", tableName)
+	}
+	return schemaName.String, nil
+}
+
 // QueryConn queries a SQL statement in a given connection.
 func (driver *Driver) QueryConn(ctx context.Context, conn *sql.Conn, statement string, queryContext *db.QueryContext) ([]*v1pb.QueryResult, error) {
 	singleSQLs, err := parser.SplitMultiSQL(parser.Postgres, statement)
@@ -0,0 +1,334 @@
+package pg
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/resources/utils"
+)
+
+const (
+	// baseBackupLabel is the label of the base backups taken by Bytebase.
+	baseBackupLabel = "bytebase"
+	// walRemovedMessage is the error message when the WAL to stream has been removed on the server.
+	walRemovedMessage = "has already been removed"
+)
+
+var (
+	walFileNameReg         = regexp.MustCompile(`^[0-9A-F]{24}$`)
+	timelineHistoryFileReg = regexp.MustCompile(`^[0-9A-F]{8}\.history$`)
+	// ErrWALRemoved is returned by ReceiveWAL if the server has removed the WAL to stream.
+	// The WAL archive has a gap then, and we need to take a new base backup.
+	ErrWALRemoved = errors.New("the WAL to stream has been removed on the server")
+)
+
+// BaseBackupMeta is the metadata of a base backup for PITR.
+type BaseBackupMeta struct {
+	// StartWALFile is the WAL segment file when the base backup starts.
+	// Recovering the base backup replays the WAL segments since it.
+	StartWALFile string `json:"startWalFile"`
+	// StopLSN is the WAL location after the base backup finishes.
+	// The base backup can recover to any WAL location after it.
+	StopLSN string `json:"stopLsn"`
+	// StartTs is the UNIX timestamp in seconds when the base backup starts.
+	StartTs int64 `json:"startTs"`
+	// StopTs is the UNIX timestamp in seconds after the base backup finishes.
+	// The base backup can recover to any time after it.
+	StopTs int64 `json:"stopTs"`
+	// ServerVersionNum is the server_version_num of the instance.
+	ServerVersionNum int `json:"serverVersionNum"`
+	// Superuser is the bootstrap superuser of the instance, which connects to the recovered instance.
+	Superuser string `json:"superuser"`
+}
+
+// RecoveryTarget is the target to recover the base backup to.
+// Only one of Time and LSN is set.
+type RecoveryTarget struct {
+	Time time.Time
+	LSN  string
+}
+
+// IsWALFileName returns true if the file name is a WAL segment file name, e.g. 000000010000000000000002.
+func IsWALFileName(name string) bool {
+	return walFileNameReg.MatchString(name)
+}
+
+// IsTimelineHistoryFileName returns true if the file name is a timeline history file name, e.g. 00000002.history.
+func IsTimelineHistoryFileName(name string) bool {
+	return timelineHistoryFileReg.MatchString(name)
+}
+
+// ParseLSN parses the textual representation of a WAL location, e.g. 0/16B3748.
+func ParseLSN(lsn string) (uint64, error) {
+	parts := strings.Split(lsn, "/")
+	if len(parts) != 2 {
+		return 0, errors.Errorf("invalid LSN %q, the format should be {hex}/{hex}", lsn)
+	}
+	high, err := strconv.ParseUint(parts[0], 16, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid LSN %q", lsn)
+	}
+	low, err := strconv.ParseUint(parts[1], 16, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid LSN %q", lsn)
+	}
+	return high<<32 | low, nil
+}
+
+// GetDbBinDir returns the directory of the bundled PostgreSQL binaries.
+func (driver *Driver) GetDbBinDir() string {
+	return driver.dbBinDir
+}
+
+// CheckServerVersionForPITR checks if the server has the same major version as the bundled PostgreSQL, which replays the WAL for PITR.
+func (driver *Driver) CheckServerVersionForPITR(ctx context.Context) error {
+	var versionNum int
+	if err := driver.db.QueryRowContext(ctx, "SELECT current_setting('server_version_num')::int").Scan(&versionNum); err != nil {
+		return errors.Wrap(err, "failed to get the server version")
+	}
+	bundledMajorVersion, err := driver.getUtilityMajorVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if versionNum/10000 != bundledMajorVersion {
+		return errors.Errorf("PITR requires PostgreSQL %d, which is the version of the bundled PostgreSQL replaying the WAL, but the server version is %d", bundledMajorVersion, versionNum/10000)
+	}
+	return nil
+}
+
+// CheckWALArchivingForPITR checks if the server allows archiving the WAL by the streaming replication protocol.
+func (driver *Driver) CheckWALArchivingForPITR(ctx context.Context) error {
+	var walLevel string
+	var maxWALSenders, tablespaceCount int
+	var inRecovery, canReplicate, canSwitchWAL bool
+	query := `
+	SELECT
+		current_setting('wal_level'),
+		current_setting('max_wal_senders')::int,
+		pg_is_in_recovery(),
+		(SELECT rolsuper OR rolreplication FROM pg_roles WHERE rolname = current_user),
+		has_function_privilege('pg_switch_wal()', 'EXECUTE'),
+		(SELECT count(*) FROM pg_tablespace WHERE spcname NOT IN ('pg_default', 'pg_global'))`
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&walLevel, &maxWALSenders, &inRecovery, &canReplicate, &canSwitchWAL, &tablespaceCount); err != nil {
+		return errors.Wrap(err, "failed to check the WAL settings")
+	}
+	if walLevel != "replica" && walLevel != "logical" {
+		return errors.Errorf("wal_level is %q, it should be \"replica\" or \"logical\" to archive the WAL", walLevel)
+	}
+	if maxWALSenders == 0 {
+		return errors.Errorf("max_wal_senders is 0, it should be greater than 0 to archive the WAL")
+	}
+	if inRecovery {
+		return errors.Errorf("the instance is a standby, PITR requires the WAL archived from the primary")
+	}
+	if !canReplicate {
+		return errors.Errorf("user %q should be a superuser or have the REPLICATION attribute to archive the WAL", driver.config.Username)
+	}
+	if !canSwitchWAL {
+		return errors.Errorf("user %q should have the privilege to execute pg_switch_wal() to archive the latest WAL before PITR", driver.config.Username)
+	}
+	if tablespaceCount > 0 {
+		return errors.Errorf("PITR doesn't support the instance with tablespaces other than pg_default and pg_global")
+	}
+	return nil
+}
+
+// ReceiveWAL streams the WAL segments of the instance into walDir by pg_receivewal, and blocks until the streaming stops.
+// It resumes from the latest WAL segment in walDir, so walDir should keep the latest segment received.
+func (driver *Driver) ReceiveWAL(ctx context.Context, walDir string) error {
+	if err := os.MkdirAll(walDir, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "failed to create WAL directory %q", walDir)
+	}
+	cmd, closeTunnel, err := driver.newUtilityCommand(ctx, "pg_receivewal", fmt.Sprintf("--directory=%s", walDir), "--no-loop")
+	if err != nil {
+		return err
+	}
+	defer closeTunnel()
+	if err := runUtilityCommand(cmd); err != nil {
+		if strings.Contains(err.Error(), walRemovedMessage) {
+			return errors.Wrap(ErrWALRemoved, err.Error())
+		}
+		return errors.Wrap(err, "failed to receive the WAL")
+	}
+	return nil
+}
+
+// CreateBaseBackup takes a base backup of the instance into dir by pg_basebackup.
+// The base backup includes base.tar.gz of the data directory and pg_wal.tar.gz of the WAL during the backup.
+func (driver *Driver) CreateBaseBackup(ctx context.Context, dir string) (*BaseBackupMeta, error) {
+	meta := &BaseBackupMeta{
+		StartTs: time.Now().Unix(),
+	}
+	// The bootstrap superuser always has the OID 10.
+	if err := driver.db.QueryRowContext(ctx, `
+	SELECT
+		pg_walfile_name(pg_current_wal_lsn()),
+		current_setting('server_version_num')::int,
+		(SELECT rolname FROM pg_roles WHERE oid = 10)`,
+	).Scan(&meta.StartWALFile, &meta.ServerVersionNum, &meta.Superuser); err != nil {
+		return nil, errors.Wrap(err, "failed to get the WAL location before the base backup")
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, errors.Wrapf(err, "failed to clean up base backup directory %q", dir)
+	}
+	cmd, closeTunnel, err := driver.newUtilityCommand(ctx, "pg_basebackup",
+		fmt.Sprintf("--pgdata=%s", dir),
+		"--format=tar",
+		"--gzip",
+		"--wal-method=stream",
+		"--checkpoint=fast",
+		fmt.Sprintf("--label=%s", baseBackupLabel),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer closeTunnel()
+	if err := runUtilityCommand(cmd); err != nil {
+		return nil, errors.Wrap(err, "failed to take the base backup")
+	}
+
+	if err := driver.db.QueryRowContext(ctx, "SELECT pg_current_wal_lsn()::text").Scan(&meta.StopLSN); err != nil {
+		return nil, errors.Wrap(err, "failed to get the WAL location after the base backup")
+	}
+	meta.StopTs = time.Now().Unix()
+	return meta, nil
+}
+
+// SwitchWAL commits a transaction and switches to a new WAL segment, so that the WAL up to now can be archived.
+// The commit makes the recovery able to reach any target time before now.
+// It returns the name of the WAL segment file switched from.
+func (driver *Driver) SwitchWAL(ctx context.Context) (string, error) {
+	if _, err := driver.db.ExecContext(ctx, "SELECT txid_current()"); err != nil {
+		return "", errors.Wrap(err, "failed to commit a transaction")
+	}
+	var walFile string
+	if err := driver.db.QueryRowContext(ctx, "SELECT pg_walfile_name(pg_switch_wal())").Scan(&walFile); err != nil {
+		return "", errors.Wrap(err, "failed to switch the WAL")
+	}
+	return walFile, nil
+}
+
+// ExtractBaseBackup extracts the base backup taken by CreateBaseBackup into dataDir.
+func ExtractBaseBackup(baseBackupDir, dataDir string) error {
+	if err := os.RemoveAll(dataDir); err != nil {
+		return errors.Wrapf(err, "failed to clean up data directory %q", dataDir)
+	}
+	for _, item := range []struct {
+		file string
+		dir  string
+	}{
+		{file: "base.tar.gz", dir: dataDir},
+		{file: "pg_wal.tar.gz", dir: filepath.Join(dataDir, "pg_wal")},
+	} {
+		if err := func() error {
+			f, err := os.Open(filepath.Join(baseBackupDir, item.file))
+			if err != nil {
+				return errors.Wrapf(err, "failed to open %q of the base backup", item.file)
+			}
+			defer f.Close()
+			if err := utils.ExtractTarGz(f, item.dir); err != nil {
+				return errors.Wrapf(err, "failed to extract %q of the base backup", item.file)
+			}
+			return nil
+		}(); err != nil {
+			return err
+		}
+	}
+	// PostgreSQL refuses to start if the data directory is accessible by others.
+	if err := os.Chmod(dataDir, 0700); err != nil {
+		return errors.Wrapf(err, "failed to chmod data directory %q", dataDir)
+	}
+	return nil
+}
+
+// WriteRecoveryConfig configures the data directory extracted from a base backup to recover to the target by replaying the WAL in walDir.
+// It replaces the configuration of the original instance, which may not work on this host.
+func WriteRecoveryConfig(dataDir, walDir string, target RecoveryTarget) error {
+	config, err := buildRecoveryConfig(walDir, target)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dataDir, "postgresql.conf"), []byte(config), 0600); err != nil {
+		return errors.Wrap(err, "failed to write postgresql.conf")
+	}
+	// Only the local connections of the temporary instance are allowed.
+	if err := os.WriteFile(filepath.Join(dataDir, "pg_hba.conf"), []byte("local all all trust\n"), 0600); err != nil {
+		return errors.Wrap(err, "failed to write pg_hba.conf")
+	}
+	if err := os.Remove(filepath.Join(dataDir, "postgresql.auto.conf")); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to remove postgresql.auto.conf")
+	}
+	if err := os.WriteFile(filepath.Join(dataDir, "recovery.signal"), nil, 0600); err != nil {
+		return errors.Wrap(err, "failed to write recovery.signal")
+	}
+	return nil
+}
+
+func buildRecoveryConfig(walDir string, target RecoveryTarget) (string, error) {
+	settings := [][2]string{
+		{"listen_addresses", ""},
+		{"hot_standby", "off"},
+		{"archive_mode", "off"},
+		{"fsync", "off"},
+		{"shared_preload_libraries", ""},
+		{"restore_command", fmt.Sprintf(`cp "%s/%%f" "%%p"`, walDir)},
+		{"recovery_target_timeline", "latest"},
+		{"recovery_target_action", "promote"},
+	}
+	switch {
+	case target.LSN != "" && target.Time.IsZero():
+		if _, err := ParseLSN(target.LSN); err != nil {
+			return "", err
+		}
+		settings = append(settings, [2]string{"recovery_target_lsn", target.LSN})
+	case target.LSN == "" && !target.Time.IsZero():
+		settings = append(settings, [2]string{"recovery_target_time", target.Time.UTC().Format(time.RFC3339)})
+	default:
+		return "", errors.Errorf("only one of the recovery target time and LSN should be set")
+	}
+
+	var buf strings.Builder
+	buf.WriteString("# Generated by Bytebase to recover the base backup.\n")
+	for _, setting := range settings {
+		fmt.Fprintf(&buf, "%s = '%s'\n", setting[0], strings.ReplaceAll(setting[1], "'", "''"))
+	}
+	return buf.String(), nil
+}
+
+func (driver *Driver) getUtilityMajorVersion(ctx context.Context) (int, error) {
+	// The output looks like "postgres (PostgreSQL) 14.2".
+	output, err := exec.CommandContext(ctx, filepath.Join(driver.dbBinDir, "postgres"), "--version").Output()
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get the version of the bundled PostgreSQL")
+	}
+	fields := strings.Fields(string(output))
+	if len(fields) == 0 {
+		return 0, errors.Errorf("invalid version %q of the bundled PostgreSQL", string(output))
+	}
+	version := fields[len(fields)-1]
+	major, err := strconv.Atoi(strings.Split(version, ".")[0])
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid version %q of the bundled PostgreSQL", version)
+	}
+	return major, nil
+}
+
+func runUtilityCommand(cmd *exec.Cmd) error {
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return errors.Wrapf(err, "error message: %s", strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
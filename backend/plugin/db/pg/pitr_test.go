@@ -0,0 +1,66 @@
+package pg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLSN(t *testing.T) {
+	tests := []struct {
+		lsn     string
+		want    uint64
+		wantErr bool
+	}{
+		{lsn: "0/16B3748", want: 0x16B3748},
+		{lsn: "1/0", want: 1 << 32},
+		{lsn: "FFFFFFFF/FFFFFFFF", want: 0xFFFFFFFFFFFFFFFF},
+		{lsn: "16B3748", wantErr: true},
+		{lsn: "0/16B3748/0", wantErr: true},
+		{lsn: "0/XYZ", wantErr: true},
+		{lsn: "100000000/0", wantErr: true},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		got, err := ParseLSN(test.lsn)
+		if test.wantErr {
+			a.Error(err, test.lsn)
+			continue
+		}
+		a.NoError(err, test.lsn)
+		a.Equal(test.want, got, test.lsn)
+	}
+}
+
+func TestWALFileName(t *testing.T) {
+	a := require.New(t)
+	a.True(IsWALFileName("000000010000000000000002"))
+	a.False(IsWALFileName("000000010000000000000002.partial"))
+	a.False(IsWALFileName("00000002.history"))
+	a.True(IsTimelineHistoryFileName("00000002.history"))
+	a.False(IsTimelineHistoryFileName("000000010000000000000002"))
+}
+
+func TestBuildRecoveryConfig(t *testing.T) {
+	a := require.New(t)
+
+	config, err := buildRecoveryConfig("/data/pitr/1/wal", RecoveryTarget{LSN: "0/16B3748"})
+	a.NoError(err)
+	a.Contains(config, "restore_command = 'cp \"/data/pitr/1/wal/%f\" \"%p\"'\n")
+	a.Contains(config, "recovery_target_lsn = '0/16B3748'\n")
+	a.NotContains(config, "recovery_target_time =")
+
+	config, err = buildRecoveryConfig("/data/it's/wal", RecoveryTarget{Time: time.Date(2023, 6, 1, 8, 30, 0, 0, time.FixedZone("UTC+8", 8*60*60))})
+	a.NoError(err)
+	a.Contains(config, "restore_command = 'cp \"/data/it''s/wal/%f\" \"%p\"'\n")
+	a.Contains(config, "recovery_target_time = '2023-06-01T00:30:00Z'\n")
+
+	_, err = buildRecoveryConfig("/wal", RecoveryTarget{})
+	a.Error(err)
+	_, err = buildRecoveryConfig("/wal", RecoveryTarget{Time: time.Now(), LSN: "0/1"})
+	a.Error(err)
+	_, err = buildRecoveryConfig("/wal", RecoveryTarget{LSN: "invalid"})
+	a.Error(err)
+}
@@ -0,0 +1,482 @@
+package pg
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	pgquery "github.com/pganalyze/pg_query_go/v4"
+	"github.com/pkg/errors"
+)
+
+// RollbackGenerator executes the data change statements and generates the statements reverting them.
+// PostgreSQL has no binlog or undo log to read the changed rows from, so the before-images are captured in the
+// same transaction: the rows to be changed by UPDATE and DELETE are locked and read by SELECT ... FOR UPDATE before
+// the statement runs, and the primary keys of the inserted rows are returned by the INSERT statement.
+type RollbackGenerator struct {
+	changes []*rollbackChange
+	// tables are the tables changed by the statements keyed by the sanitized table name in the statements.
+	tables map[string]*rollbackTable
+
+	sizeLimit int
+	// rollbackStatements are the statements reverting the executed statements in order.
+	rollbackStatements []string
+	size               int
+	err                error
+}
+
+type rollbackChangeType int
+
+const (
+	rollbackChangeInsert rollbackChangeType = iota
+	rollbackChangeUpdate
+	rollbackChangeDelete
+)
+
+type rollbackChange struct {
+	changeType rollbackChangeType
+	table      *rollbackTable
+	// statement is the statement to execute.
+	statement string
+	// raw is the parsed statement.
+	raw *pgquery.RawStmt
+	// relation is the table in the parsed statement.
+	relation *pgquery.RangeVar
+	// qualifier is the alias of the table in the statement, or the table name.
+	qualifier string
+	inh       bool
+	where     *pgquery.Node
+	// setColumns are the columns set by the UPDATE statement.
+	setColumns []string
+}
+
+type rollbackTable struct {
+	// schemaName is empty until Prepare resolves the unqualified table by the search path.
+	schemaName string
+	tableName  string
+	// columns are the columns in order, excluding the generated columns.
+	columns     []string
+	columnTypes map[string]string
+	primaryKey  []string
+	// alwaysIdentity is true if the table has a GENERATED ALWAYS identity column.
+	alwaysIdentity bool
+}
+
+// NewRollbackGenerator parses the statement for generating the rollback statements.
+// The statement can only contain INSERT, UPDATE and DELETE statements on single tables without WITH.
+func NewRollbackGenerator(statement string, sizeLimit int) (*RollbackGenerator, error) {
+	tree, err := pgquery.Parse(statement)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse statement")
+	}
+	g := &RollbackGenerator{
+		tables:    make(map[string]*rollbackTable),
+		sizeLimit: sizeLimit,
+	}
+	for _, raw := range tree.Stmts {
+		change := &rollbackChange{raw: raw, inh: true}
+		var relation *pgquery.RangeVar
+		switch node := raw.Stmt.Node.(type) {
+		case *pgquery.Node_InsertStmt:
+			insert := node.InsertStmt
+			if insert.WithClause != nil || len(insert.ReturningList) > 0 {
+				return nil, errors.Errorf("rollback SQL does not support the INSERT statement with WITH or RETURNING")
+			}
+			if insert.OnConflictClause != nil && insert.OnConflictClause.Action == pgquery.OnConflictAction_ONCONFLICT_UPDATE {
+				return nil, errors.Errorf("rollback SQL does not support the INSERT statement with ON CONFLICT DO UPDATE")
+			}
+			change.changeType, relation = rollbackChangeInsert, insert.Relation
+		case *pgquery.Node_UpdateStmt:
+			update := node.UpdateStmt
+			if update.WithClause != nil || len(update.FromClause) > 0 {
+				return nil, errors.Errorf("rollback SQL does not support the UPDATE statement with WITH or FROM")
+			}
+			change.changeType, relation, change.where = rollbackChangeUpdate, update.Relation, update.WhereClause
+			for _, target := range update.TargetList {
+				change.setColumns = append(change.setColumns, target.GetResTarget().GetName())
+			}
+		case *pgquery.Node_DeleteStmt:
+			del := node.DeleteStmt
+			if del.WithClause != nil || len(del.UsingClause) > 0 {
+				return nil, errors.Errorf("rollback SQL does not support the DELETE statement with WITH or USING")
+			}
+			change.changeType, relation, change.where = rollbackChangeDelete, del.Relation, del.WhereClause
+		default:
+			return nil, errors.Errorf("rollback SQL only supports the INSERT, UPDATE and DELETE statements")
+		}
+
+		key := pgx.Identifier{relation.Relname}.Sanitize()
+		if relation.Schemaname != "" {
+			key = pgx.Identifier{relation.Schemaname, relation.Relname}.Sanitize()
+		}
+		table, ok := g.tables[key]
+		if !ok {
+			table = &rollbackTable{schemaName: relation.Schemaname, tableName: relation.Relname}
+			g.tables[key] = table
+		}
+		change.table = table
+		change.relation = relation
+		change.inh = relation.Inh
+		change.qualifier = relation.Relname
+		if relation.Alias != nil && relation.Alias.Aliasname != "" {
+			change.qualifier = relation.Alias.Aliasname
+		}
+		change.statement = rawStatementText(statement, raw)
+		g.changes = append(g.changes, change)
+	}
+	if len(g.changes) == 0 {
+		return nil, errors.Errorf("rollback SQL requires at least one data change statement")
+	}
+	return g, nil
+}
+
+// Prepare loads the columns and the primary keys of the changed tables, and builds the statements to execute.
+// An unqualified table is resolved by the search path of the database owner, which the statements are executed as.
+func (g *RollbackGenerator) Prepare(ctx context.Context, db *sql.DB) error {
+	for _, table := range g.tables {
+		if table.schemaName == "" {
+			schemaName, err := resolveTableSchema(ctx, db, table.tableName)
+			if err != nil {
+				return err
+			}
+			table.schemaName = schemaName
+		}
+		if err := table.load(ctx, db); err != nil {
+			return err
+		}
+	}
+	return g.buildStatements()
+}
+
+// buildStatements builds the statements to execute after the tables are loaded.
+// The tables are qualified in the statements, so that the statements change the tables whose before-images are captured.
+func (g *RollbackGenerator) buildStatements() error {
+	for _, change := range g.changes {
+		if change.relation.Schemaname == "" {
+			change.relation.Schemaname = change.table.schemaName
+			statement, err := pgquery.Deparse(&pgquery.ParseResult{Stmts: []*pgquery.RawStmt{{Stmt: change.raw.Stmt}}})
+			if err != nil {
+				return errors.Wrapf(err, "failed to deparse statement %q", change.statement)
+			}
+			change.statement = statement
+		}
+		switch change.changeType {
+		case rollbackChangeInsert:
+			statement, err := change.returningStatement()
+			if err != nil {
+				return err
+			}
+			change.statement = statement
+		case rollbackChangeUpdate:
+			for _, column := range change.setColumns {
+				for _, key := range change.table.primaryKey {
+					if column == key {
+						return errors.Errorf("rollback SQL does not support updating the primary key column %q of table %q.%q", column, change.table.schemaName, change.table.tableName)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Execute executes the statements in a transaction as the role, and captures the before-images of the changed rows.
+// It returns the number of the affected rows.
+func (g *RollbackGenerator) Execute(ctx context.Context, db *sql.DB, role string) (int64, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("SET LOCAL ROLE %s", pgx.Identifier{role}.Sanitize())); err != nil {
+		return 0, err
+	}
+
+	var totalRowsAffected int64
+	for _, change := range g.changes {
+		rowsAffected, err := g.executeChange(ctx, tx, change)
+		if err != nil {
+			return 0, err
+		}
+		totalRowsAffected += rowsAffected
+	}
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return totalRowsAffected, nil
+}
+
+// RollbackStatement returns the statements reverting the executed statements.
+// It returns an error if the rollback statements exceed the size limit.
+func (g *RollbackGenerator) RollbackStatement() (string, error) {
+	if g.err != nil {
+		return "", g.err
+	}
+	var buf strings.Builder
+	// Revert the statements in the reverse order.
+	for i := len(g.rollbackStatements) - 1; i >= 0; i-- {
+		if _, err := buf.WriteString(g.rollbackStatements[i]); err != nil {
+			return "", err
+		}
+	}
+	return buf.String(), nil
+}
+
+func (g *RollbackGenerator) executeChange(ctx context.Context, tx *sql.Tx, change *rollbackChange) (int64, error) {
+	if change.changeType == rollbackChangeInsert {
+		rows, err := tx.QueryContext(ctx, change.statement)
+		if err != nil {
+			return 0, err
+		}
+		defer rows.Close()
+		var rowsAffected int64
+		var buf strings.Builder
+		for rows.Next() {
+			values, err := scanLiterals(rows, len(change.table.primaryKey))
+			if err != nil {
+				return 0, err
+			}
+			rowsAffected++
+			if g.err == nil {
+				fmt.Fprintf(&buf, "DELETE FROM %s WHERE %s;\n", change.table.sanitizedName(), change.table.primaryKeyCondition(values))
+			}
+		}
+		if err := rows.Err(); err != nil {
+			return 0, err
+		}
+		g.appendRollbackStatement(buf.String())
+		return rowsAffected, nil
+	}
+
+	if g.err == nil {
+		query, err := change.captureQuery()
+		if err != nil {
+			return 0, err
+		}
+		rows, err := tx.QueryContext(ctx, query)
+		if err != nil {
+			return 0, errors.Wrapf(err, "failed to capture the rows changed by statement %q", change.statement)
+		}
+		var buf strings.Builder
+		for rows.Next() {
+			values, err := scanLiterals(rows, len(change.table.columns))
+			if err != nil {
+				rows.Close()
+				return 0, err
+			}
+			buf.WriteString(change.rollbackStatement(values))
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return 0, err
+		}
+		rows.Close()
+		g.appendRollbackStatement(buf.String())
+	}
+
+	result, err := tx.ExecContext(ctx, change.statement)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (g *RollbackGenerator) appendRollbackStatement(statement string) {
+	if g.err != nil || statement == "" {
+		return
+	}
+	g.size += len(statement)
+	if g.sizeLimit > 0 && g.size > g.sizeLimit {
+		// Stop capturing the rows, but the statements still run.
+		g.err = errors.Errorf("the rollback SQL exceeds %d KB", g.sizeLimit/1024)
+		g.rollbackStatements = nil
+		return
+	}
+	g.rollbackStatements = append(g.rollbackStatements, statement)
+}
+
+// returningStatement rewrites the INSERT statement to return the primary keys of the inserted rows as literals.
+func (c *rollbackChange) returningStatement() (string, error) {
+	var columns []string
+	for _, key := range c.table.primaryKey {
+		columns = append(columns, fmt.Sprintf("quote_nullable(%s)", pgx.Identifier{key}.Sanitize()))
+	}
+	tree, err := pgquery.Parse(fmt.Sprintf("SELECT %s", strings.Join(columns, ", ")))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse the returning list")
+	}
+	insert := c.raw.Stmt.GetInsertStmt()
+	insert.ReturningList = tree.Stmts[0].GetStmt().GetSelectStmt().GetTargetList()
+	defer func() { insert.ReturningList = nil }()
+	statement, err := pgquery.Deparse(&pgquery.ParseResult{Stmts: []*pgquery.RawStmt{{Stmt: c.raw.Stmt}}})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to deparse the INSERT statement")
+	}
+	return statement, nil
+}
+
+// captureQuery returns the query locking and reading the rows to be changed by the UPDATE or DELETE statement as literals.
+func (c *rollbackChange) captureQuery() (string, error) {
+	var columns []string
+	for _, column := range c.table.columns {
+		columns = append(columns, fmt.Sprintf("quote_nullable(%s)", pgx.Identifier{c.qualifier, column}.Sanitize()))
+	}
+	from := c.table.sanitizedName()
+	if !c.inh {
+		from = "ONLY " + from
+	}
+	if c.qualifier != c.table.tableName {
+		from = fmt.Sprintf("%s AS %s", from, pgx.Identifier{c.qualifier}.Sanitize())
+	}
+	tree, err := pgquery.Parse(fmt.Sprintf("SELECT %s FROM %s FOR UPDATE", strings.Join(columns, ", "), from))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse the capture query")
+	}
+	tree.Stmts[0].GetStmt().GetSelectStmt().WhereClause = c.where
+	query, err := pgquery.Deparse(tree)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to deparse the capture query")
+	}
+	return query, nil
+}
+
+// rollbackStatement returns the statement restoring the row with the literal values of the table columns.
+func (c *rollbackChange) rollbackStatement(values []string) string {
+	table := c.table
+	if c.changeType == rollbackChangeDelete {
+		var columns, literals []string
+		for i, column := range table.columns {
+			columns = append(columns, pgx.Identifier{column}.Sanitize())
+			literals = append(literals, table.literal(column, values[i]))
+		}
+		overriding := ""
+		if table.alwaysIdentity {
+			overriding = " OVERRIDING SYSTEM VALUE"
+		}
+		return fmt.Sprintf("INSERT INTO %s (%s)%s VALUES (%s);\n", table.sanitizedName(), strings.Join(columns, ", "), overriding, strings.Join(literals, ", "))
+	}
+
+	valueByColumn := make(map[string]string)
+	for i, column := range table.columns {
+		valueByColumn[column] = values[i]
+	}
+	var sets []string
+	for _, column := range c.setColumns {
+		sets = append(sets, fmt.Sprintf("%s = %s", pgx.Identifier{column}.Sanitize(), table.literal(column, valueByColumn[column])))
+	}
+	var keys []string
+	for _, key := range table.primaryKey {
+		keys = append(keys, valueByColumn[key])
+	}
+	return fmt.Sprintf("UPDATE %s SET %s WHERE %s;\n", table.sanitizedName(), strings.Join(sets, ", "), table.primaryKeyCondition(keys))
+}
+
+func (t *rollbackTable) load(ctx context.Context, db *sql.DB) error {
+	// The generated columns are skipped because they cannot be written, and attgenerated only exists since PostgreSQL 12.
+	query := `
+		SELECT
+			a.attname,
+			format_type(a.atttypid, a.atttypmod),
+			COALESCE(a.attnum = ANY(i.indkey), FALSE),
+			COALESCE(to_jsonb(a)->>'attidentity', '') = 'a'
+		FROM pg_attribute a
+		LEFT JOIN pg_index i ON i.indrelid = a.attrelid AND i.indisprimary
+		WHERE a.attrelid = $1::regclass AND a.attnum > 0 AND NOT a.attisdropped AND COALESCE(to_jsonb(a)->>'attgenerated', '') = ''
+		ORDER BY a.attnum`
+	rows, err := db.QueryContext(ctx, query, t.sanitizedName())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the columns of table %q.%q", t.schemaName, t.tableName)
+	}
+	defer rows.Close()
+	t.columnTypes = make(map[string]string)
+	for rows.Next() {
+		var column, columnType string
+		var isPrimaryKey, isAlwaysIdentity bool
+		if err := rows.Scan(&column, &columnType, &isPrimaryKey, &isAlwaysIdentity); err != nil {
+			return err
+		}
+		t.columns = append(t.columns, column)
+		t.columnTypes[column] = columnType
+		if isAlwaysIdentity {
+			t.alwaysIdentity = true
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Load the primary key separately to keep the order of the key columns.
+	keyRows, err := db.QueryContext(ctx, `
+		SELECT a.attname
+		FROM pg_index i
+		JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+		WHERE i.indrelid = $1::regclass AND i.indisprimary
+		ORDER BY array_position(i.indkey::int2[], a.attnum)`, t.sanitizedName())
+	if err != nil {
+		return errors.Wrapf(err, "failed to get the primary key of table %q.%q", t.schemaName, t.tableName)
+	}
+	defer keyRows.Close()
+	for keyRows.Next() {
+		var column string
+		if err := keyRows.Scan(&column); err != nil {
+			return err
+		}
+		t.primaryKey = append(t.primaryKey, column)
+	}
+	if err := keyRows.Err(); err != nil {
+		return err
+	}
+	if len(t.primaryKey) == 0 {
+		return errors.Errorf("rollback SQL requires table %q.%q to have a primary key", t.schemaName, t.tableName)
+	}
+	return nil
+}
+
+func (t *rollbackTable) sanitizedName() string {
+	return pgx.Identifier{t.schemaName, t.tableName}.Sanitize()
+}
+
+// literal casts the literal value returned by quote_nullable() to the column type.
+func (t *rollbackTable) literal(column, value string) string {
+	return fmt.Sprintf("%s::%s", value, t.columnTypes[column])
+}
+
+func (t *rollbackTable) primaryKeyCondition(values []string) string {
+	var conditions []string
+	for i, key := range t.primaryKey {
+		conditions = append(conditions, fmt.Sprintf("%s = %s", pgx.Identifier{key}.Sanitize(), t.literal(key, values[i])))
+	}
+	return strings.Join(conditions, " AND ")
+}
+
+func scanLiterals(rows *sql.Rows, n int) ([]string, error) {
+	values := make([]string, n)
+	dest := make([]any, n)
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// rawStatementText returns the text of the statement in the source.
+func rawStatementText(source string, raw *pgquery.RawStmt) string {
+	start := int(raw.StmtLocation)
+	if raw.StmtLen == 0 {
+		return strings.TrimSpace(source[start:])
+	}
+	return strings.TrimSpace(source[start : start+int(raw.StmtLen)])
+}
+
+// ExecuteWithRollback executes the data change statements with the generator as the database owner.
+func (driver *Driver) ExecuteWithRollback(ctx context.Context, generator *RollbackGenerator) (int64, error) {
+	owner, err := driver.GetCurrentDatabaseOwner()
+	if err != nil {
+		return 0, err
+	}
+	return generator.Execute(ctx, driver.db, owner)
+}
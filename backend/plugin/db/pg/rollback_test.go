@@ -0,0 +1,84 @@
+package pg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRollbackGenerator(t *testing.T) {
+	tests := []struct {
+		statement string
+		wantErr   bool
+	}{
+		{statement: `INSERT INTO orders (id, note) VALUES (1, 'a'); UPDATE orders SET note = 'b' WHERE id = 2; DELETE FROM orders WHERE id = 3;`},
+		{statement: `INSERT INTO orders (id) VALUES (1) ON CONFLICT DO NOTHING`},
+		{statement: `INSERT INTO orders (id) VALUES (1) ON CONFLICT (id) DO UPDATE SET note = 'a'`, wantErr: true},
+		{statement: `INSERT INTO orders (id) VALUES (1) RETURNING id`, wantErr: true},
+		{statement: `UPDATE orders SET note = c.note FROM customers c WHERE c.id = orders.customer_id`, wantErr: true},
+		{statement: `DELETE FROM orders USING customers c WHERE c.id = orders.customer_id`, wantErr: true},
+		{statement: `WITH x AS (SELECT 1) DELETE FROM orders`, wantErr: true},
+		{statement: `ALTER TABLE orders ADD COLUMN note text`, wantErr: true},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		_, err := NewRollbackGenerator(test.statement, 0)
+		if test.wantErr {
+			a.Error(err, test.statement)
+		} else {
+			a.NoError(err, test.statement)
+		}
+	}
+}
+
+func TestRollbackStatement(t *testing.T) {
+	a := require.New(t)
+	g, err := NewRollbackGenerator(`INSERT INTO orders (id, note) VALUES (1, 'a');
+UPDATE orders AS o SET note = 'b' WHERE o.amount > 10;
+DELETE FROM ONLY "Sales".orders WHERE note IS NULL;`, 0)
+	a.NoError(err)
+	a.Len(g.changes, 3)
+	for _, table := range g.tables {
+		if table.schemaName == "" {
+			// Resolved by the search path in Prepare.
+			table.schemaName = "public"
+		}
+		table.columns = []string{"id", "note", "amount"}
+		table.columnTypes = map[string]string{"id": "bigint", "note": "text", "amount": "numeric(10,2)"}
+		table.primaryKey = []string{"id"}
+	}
+
+	insert, update, del := g.changes[0], g.changes[1], g.changes[2]
+	a.Equal(`INSERT INTO orders (id, note) VALUES (1, 'a')`, insert.statement)
+	statement, err := insert.returningStatement()
+	a.NoError(err)
+	a.Equal(`INSERT INTO orders (id, note) VALUES (1, 'a') RETURNING quote_nullable(id)`, statement)
+
+	// The unqualified tables are qualified in the executed statements.
+	a.NoError(g.buildStatements())
+	a.Equal(`INSERT INTO public.orders (id, note) VALUES (1, 'a') RETURNING quote_nullable(id)`, insert.statement)
+	a.Equal(`UPDATE public.orders o SET note = 'b' WHERE o.amount > 10`, update.statement)
+	a.Equal(`DELETE FROM ONLY "Sales".orders WHERE note IS NULL`, del.statement)
+
+	query, err := update.captureQuery()
+	a.NoError(err)
+	a.Equal(`SELECT quote_nullable(o.id), quote_nullable(o.note), quote_nullable(o.amount) FROM public.orders o WHERE o.amount > 10 FOR UPDATE`, query)
+	a.Equal("UPDATE \"public\".\"orders\" SET \"note\" = 'x'::text WHERE \"id\" = '7'::bigint;\n", update.rollbackStatement([]string{"'7'", "'x'", "'12.00'"}))
+
+	query, err = del.captureQuery()
+	a.NoError(err)
+	a.Equal(`SELECT quote_nullable(orders.id), quote_nullable(orders.note), quote_nullable(orders.amount) FROM ONLY "Sales".orders WHERE note IS NULL FOR UPDATE`, query)
+	a.Equal("INSERT INTO \"Sales\".\"orders\" (\"id\", \"note\", \"amount\") VALUES ('8'::bigint, NULL::text, '1.50'::numeric(10,2));\n", del.rollbackStatement([]string{"'8'", "NULL", "'1.50'"}))
+
+	g.appendRollbackStatement("INSERT 1;\n")
+	g.appendRollbackStatement("INSERT 2;\n")
+	rollbackStatement, err := g.RollbackStatement()
+	a.NoError(err)
+	a.Equal("INSERT 2;\nINSERT 1;\n", rollbackStatement)
+
+	g.sizeLimit = 16
+	g.appendRollbackStatement("INSERT 3;\n")
+	_, err = g.RollbackStatement()
+	a.Error(err)
+}
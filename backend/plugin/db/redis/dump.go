@@ -0,0 +1,317 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+)
+
+// The Redis dump is a binary stream similar to the RDB file:
+//
+//  1. The header, which is the magic string followed by the format version byte.
+//  2. A key record for each key, which is the record type byte, the key, the TTL in milliseconds and
+//     the serialized value returned by the DUMP command. The TTL is zero if the key has no expiry.
+//  3. The EOF record type byte.
+//
+// Strings are prefixed with their uvarint encoded length, and the TTL is encoded as uvarint.
+// The serialized value carries the RDB version of the source server, so the dump can only be
+// restored to a Redis server with the same or a newer RDB version.
+const (
+	dumpMagic   = "BBREDIS"
+	dumpVersion = 1
+
+	recordTypeKey byte = 0x01
+	recordTypeEOF byte = 0xFF
+
+	// dumpScanCount is the COUNT hint of the SCAN command when dumping.
+	dumpScanCount = 1000
+	// restoreBatchSize is the maximum number of keys restored in one pipeline.
+	restoreBatchSize = 1000
+	// maxStringLength is the maximum length of a string in the dump, which is the limit of Redis strings.
+	maxStringLength = 512 * 1024 * 1024
+)
+
+// dumpRecord is a key record in the dump.
+type dumpRecord struct {
+	Key string
+	// TTL is the remaining time to live of the key, zero means the key has no expiry.
+	TTL time.Duration
+	// Value is the serialized value returned by the DUMP command.
+	Value string
+}
+
+// Dump dumps the keys of the database.
+func (d *Driver) Dump(ctx context.Context, out io.Writer, schemaOnly bool) (string, error) {
+	// Redis is schemaless, the key space is synced via metadata instead.
+	if schemaOnly {
+		return "", nil
+	}
+
+	w := bufio.NewWriter(out)
+	if err := writeDumpHeader(w); err != nil {
+		return "", errors.Wrap(err, "failed to write dump header")
+	}
+	var count int64
+	if err := d.forEachNode(ctx, func(ctx context.Context, client redis.Cmdable) error {
+		n, err := dumpNode(ctx, w, client)
+		count += n
+		return err
+	}); err != nil {
+		return "", err
+	}
+	if err := w.WriteByte(recordTypeEOF); err != nil {
+		return "", errors.Wrap(err, "failed to write dump")
+	}
+	if err := w.Flush(); err != nil {
+		return "", errors.Wrap(err, "failed to flush dump")
+	}
+	log.Debug("Dumped Redis database", zap.String("database", d.databaseName), zap.Int64("keys", count))
+	return "", nil
+}
+
+// dumpNode dumps the keys served by the client, and returns the number of dumped keys.
+func dumpNode(ctx context.Context, w *bufio.Writer, client redis.Cmdable) (int64, error) {
+	var count int64
+	var cursor uint64
+	for {
+		keys, nextCursor, err := client.Scan(ctx, cursor, "*", dumpScanCount).Result()
+		if err != nil {
+			return count, errors.Wrap(err, "failed to scan keys")
+		}
+		if len(keys) > 0 {
+			records, err := dumpKeys(ctx, client, keys)
+			if err != nil {
+				return count, err
+			}
+			for _, record := range records {
+				if err := writeDumpRecord(w, record); err != nil {
+					return count, errors.Wrap(err, "failed to write dump")
+				}
+			}
+			count += int64(len(records))
+		}
+		if nextCursor == 0 {
+			return count, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// dumpKeys dumps the keys in a pipeline, the keys removed after scanning are skipped.
+func dumpKeys(ctx context.Context, client redis.Cmdable, keys []string) ([]*dumpRecord, error) {
+	pipe := client.Pipeline()
+	dumpCmds := make([]*redis.StringCmd, len(keys))
+	ttlCmds := make([]*redis.DurationCmd, len(keys))
+	for i, key := range keys {
+		dumpCmds[i] = pipe.Dump(ctx, key)
+		ttlCmds[i] = pipe.PTTL(ctx, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errors.Wrap(err, "failed to dump keys")
+	}
+
+	var records []*dumpRecord
+	for i, key := range keys {
+		value, err := dumpCmds[i].Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to dump key %q", key)
+		}
+		ttl, err := ttlCmds[i].Result()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the TTL of key %q", key)
+		}
+		switch {
+		case ttl == -2:
+			// The key expired after being dumped.
+			continue
+		case ttl < 0:
+			ttl = 0
+		}
+		records = append(records, &dumpRecord{Key: key, TTL: ttl, Value: value})
+	}
+	return records, nil
+}
+
+// Restore restores the keys read from src into the database, the existing keys are replaced.
+func (d *Driver) Restore(ctx context.Context, src io.Reader) error {
+	r := bufio.NewReader(src)
+	if err := readDumpHeader(r); err != nil {
+		return err
+	}
+
+	var count int64
+	var batch []*dumpRecord
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := d.rdb.Pipelined(ctx, func(p redis.Pipeliner) error {
+			for _, record := range batch {
+				p.RestoreReplace(ctx, record.Key, record.TTL, record.Value)
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrap(err, "failed to restore keys")
+		}
+		count += int64(len(batch))
+		batch = nil
+		return nil
+	}
+	for {
+		record, err := readDumpRecord(r)
+		if err != nil {
+			return errors.Wrap(err, "failed to read dump")
+		}
+		if record == nil {
+			break
+		}
+		batch = append(batch, record)
+		if len(batch) >= restoreBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+	log.Debug("Restored Redis database", zap.String("database", d.databaseName), zap.Int64("keys", count))
+	return nil
+}
+
+// forEachNode calls f with the client of each master node in the cluster ordered by address,
+// or with the client of the server if the cluster is not enabled.
+func (d *Driver) forEachNode(ctx context.Context, f func(context.Context, redis.Cmdable) error) error {
+	clusterClient, ok := d.rdb.(*redis.ClusterClient)
+	if !ok {
+		return f(ctx, d.rdb)
+	}
+	var mu sync.Mutex
+	var clients []*redis.Client
+	if err := clusterClient.ForEachMaster(ctx, func(_ context.Context, client *redis.Client) error {
+		mu.Lock()
+		defer mu.Unlock()
+		clients = append(clients, client)
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "failed to list the master nodes of the cluster")
+	}
+	sort.Slice(clients, func(i, j int) bool {
+		return clients[i].Options().Addr < clients[j].Options().Addr
+	})
+	for _, client := range clients {
+		if err := f(ctx, client); err != nil {
+			return errors.Wrapf(err, "failed on node %q", client.Options().Addr)
+		}
+	}
+	return nil
+}
+
+func writeDumpHeader(w io.Writer) error {
+	if _, err := io.WriteString(w, dumpMagic); err != nil {
+		return err
+	}
+	_, err := w.Write([]byte{dumpVersion})
+	return err
+}
+
+func readDumpHeader(r io.Reader) error {
+	header := make([]byte, len(dumpMagic)+1)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return errors.Wrap(err, "failed to read dump header")
+	}
+	if magic := string(header[:len(dumpMagic)]); magic != dumpMagic {
+		return errors.Errorf("invalid Redis dump, expecting magic %q but got %q", dumpMagic, magic)
+	}
+	if version := header[len(dumpMagic)]; version > dumpVersion {
+		return errors.Errorf("unsupported Redis dump version %d, the latest supported version is %d", version, dumpVersion)
+	}
+	return nil
+}
+
+func writeDumpRecord(w *bufio.Writer, record *dumpRecord) error {
+	if err := w.WriteByte(recordTypeKey); err != nil {
+		return err
+	}
+	if err := writeString(w, record.Key); err != nil {
+		return err
+	}
+	if err := writeUvarint(w, uint64(record.TTL.Milliseconds())); err != nil {
+		return err
+	}
+	return writeString(w, record.Value)
+}
+
+// readDumpRecord reads the next key record, it returns nil at the end of the dump.
+func readDumpRecord(r *bufio.Reader) (*dumpRecord, error) {
+	recordType, err := r.ReadByte()
+	if err != nil {
+		if err == io.EOF {
+			return nil, errors.New("unexpected end of dump, missing EOF record")
+		}
+		return nil, err
+	}
+	switch recordType {
+	case recordTypeEOF:
+		return nil, nil
+	case recordTypeKey:
+	default:
+		return nil, errors.Errorf("invalid record type 0x%02x", recordType)
+	}
+	key, err := readString(r)
+	if err != nil {
+		return nil, err
+	}
+	ttl, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	value, err := readString(r)
+	if err != nil {
+		return nil, err
+	}
+	return &dumpRecord{Key: key, TTL: time.Duration(ttl) * time.Millisecond, Value: value}, nil
+}
+
+func writeUvarint(w io.Writer, v uint64) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, v)
+	_, err := w.Write(buf[:n])
+	return err
+}
+
+func writeString(w io.Writer, s string) error {
+	if err := writeUvarint(w, uint64(len(s))); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, s)
+	return err
+}
+
+func readString(r *bufio.Reader) (string, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", err
+	}
+	if n > maxStringLength {
+		return "", errors.Errorf("invalid string length %d", n)
+	}
+	buf := make([]byte, n)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return "", err
+	}
+	return string(buf), nil
+}
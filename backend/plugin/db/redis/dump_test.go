@@ -0,0 +1,78 @@
+package redis
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDumpRecord(t *testing.T) {
+	a := require.New(t)
+
+	records := []*dumpRecord{
+		{Key: "user:1001", Value: "\x00\x05alice\x0b\x00"},
+		{Key: "session:abc", TTL: 90 * time.Second, Value: "\x00\x03xyz\x0b\x00"},
+	}
+	var buf bytes.Buffer
+	w := bufio.NewWriter(&buf)
+	a.NoError(writeDumpHeader(w))
+	for _, record := range records {
+		a.NoError(writeDumpRecord(w, record))
+	}
+	a.NoError(w.WriteByte(recordTypeEOF))
+	a.NoError(w.Flush())
+
+	r := bufio.NewReader(&buf)
+	a.NoError(readDumpHeader(r))
+	for _, want := range records {
+		got, err := readDumpRecord(r)
+		a.NoError(err)
+		a.Equal(want, got)
+	}
+	got, err := readDumpRecord(r)
+	a.NoError(err)
+	a.Nil(got)
+}
+
+func TestReadDumpInvalid(t *testing.T) {
+	a := require.New(t)
+
+	a.Error(readDumpHeader(bytes.NewReader([]byte("REDIS0011"))))
+	a.Error(readDumpHeader(bytes.NewReader([]byte(dumpMagic + "\x02"))))
+
+	// The dump is truncated without the EOF record.
+	_, err := readDumpRecord(bufio.NewReader(bytes.NewReader(nil)))
+	a.Error(err)
+	_, err = readDumpRecord(bufio.NewReader(bytes.NewReader([]byte{0x02})))
+	a.Error(err)
+}
+
+func TestKeySpaceStats(t *testing.T) {
+	a := require.New(t)
+
+	stats := newKeySpaceStats()
+	stats.add("user:1001", "hash", 100)
+	stats.add("user:1002", "hash", 100)
+	stats.add("user:1001:tags", "set", 50)
+	stats.add("counter", "string", 10)
+
+	tables := stats.toTables(8)
+	a.Len(tables, 2)
+
+	a.Equal(noPrefixTableName, tables[0].Name)
+	a.Equal(int64(2), tables[0].RowCount)
+	a.Equal(int64(20), tables[0].DataSize)
+
+	a.Equal("user:*", tables[1].Name)
+	a.Equal(int64(6), tables[1].RowCount)
+	a.Equal(int64(500), tables[1].DataSize)
+	a.Len(tables[1].Columns, 2)
+	a.Equal("hash", tables[1].Columns[0].Name)
+	a.Equal("4 keys", tables[1].Columns[0].Comment)
+	a.Equal("set", tables[1].Columns[1].Name)
+	a.Equal("2 keys", tables[1].Columns[1].Comment)
+	a.Equal("Estimated from 4 sampled keys of 8 keys", tables[1].Comment)
+}
@@ -5,7 +5,6 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"io"
 	"net"
 	"strconv"
 	"strings"
@@ -171,21 +170,6 @@ func (d *Driver) Execute(ctx context.Context, statement string, createDatabase b
 	return 0, nil
 }
 
-// Dump and restore
-// Dump the database, if dbName is empty, then dump all databases.
-// Redis is schemaless, we don't support dump Redis data currently.
-func (*Driver) Dump(_ context.Context, _ io.Writer, schemaOnly bool) (string, error) {
-	if !schemaOnly {
-		return "", errors.New("redis: not supported")
-	}
-	return "", nil
-}
-
-// Restore the database from src, which is a full backup.
-func (*Driver) Restore(context.Context, io.Reader) error {
-	return errors.New("redis: not supported")
-}
-
 // QueryConn queries a SQL statement in a given connection.
 func (d *Driver) QueryConn(ctx context.Context, _ *sql.Conn, statement string, _ *db.QueryContext) ([]*v1pb.QueryResult, error) {
 	startTime := time.Now()
@@ -2,18 +2,34 @@ package redis
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
 
+	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
 // Sync schema
 
+const (
+	// keyPrefixDelimiter is the delimiter of the key prefix by convention, e.g. "user:1001".
+	keyPrefixDelimiter = ":"
+	// noPrefixTableName is the table name of the keys without prefix.
+	noPrefixTableName = "(no prefix)"
+	// syncScanCount is the COUNT hint of the SCAN command when syncing the key space.
+	syncScanCount = 1000
+	// syncMaxSampleKeys is the maximum number of keys sampled on each node when syncing the key space.
+	syncMaxSampleKeys = 100000
+)
+
 // SyncInstance syncs the instance metadata.
 func (d *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error) {
 	var instance db.InstanceMetadata
@@ -53,8 +69,172 @@ func (d *Driver) SyncInstance(ctx context.Context) (*db.InstanceMetadata, error)
 }
 
 // SyncDBSchema syncs a single database schema.
-func (d *Driver) SyncDBSchema(context.Context) (*storepb.DatabaseSchemaMetadata, error) {
-	return &storepb.DatabaseSchemaMetadata{Name: d.databaseName}, nil
+// Redis is schemaless, so the key space is presented as tables, one for each key prefix, e.g. "user:*".
+// The row count of the table is the number of keys, and the columns are the distribution of the key types.
+func (d *Driver) SyncDBSchema(ctx context.Context) (*storepb.DatabaseSchemaMetadata, error) {
+	stats := newKeySpaceStats()
+	var total int64
+	if err := d.forEachNode(ctx, func(ctx context.Context, client redis.Cmdable) error {
+		size, err := client.DBSize(ctx).Result()
+		if err != nil {
+			return errors.Wrap(err, "failed to get the number of keys")
+		}
+		total += size
+		return sampleKeySpace(ctx, client, stats)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &storepb.DatabaseSchemaMetadata{
+		Name: d.databaseName,
+		Schemas: []*storepb.SchemaMetadata{
+			{
+				Name:   "",
+				Tables: stats.toTables(total),
+			},
+		},
+	}, nil
+}
+
+// sampleKeySpace scans at most syncMaxSampleKeys keys served by the client, and collects their types and memory usage.
+func sampleKeySpace(ctx context.Context, client redis.Cmdable, stats *keySpaceStats) error {
+	// MEMORY USAGE may be disabled by cloud vendors, the memory usage is skipped in that case.
+	memoryUsageEnabled := true
+	var cursor uint64
+	for sampled := 0; sampled < syncMaxSampleKeys; {
+		keys, nextCursor, err := client.Scan(ctx, cursor, "*", syncScanCount).Result()
+		if err != nil {
+			return errors.Wrap(err, "failed to scan keys")
+		}
+		if len(keys) > syncMaxSampleKeys-sampled {
+			keys = keys[:syncMaxSampleKeys-sampled]
+		}
+		sampled += len(keys)
+
+		pipe := client.Pipeline()
+		typeCmds := make([]*redis.StatusCmd, len(keys))
+		memoryCmds := make([]*redis.IntCmd, len(keys))
+		for i, key := range keys {
+			typeCmds[i] = pipe.Type(ctx, key)
+			if memoryUsageEnabled {
+				// Use the default sampling of the aggregate values, SAMPLES 0 walks through all elements.
+				memoryCmds[i] = pipe.MemoryUsage(ctx, key)
+			}
+		}
+		// The errors are checked per command below.
+		_, _ = pipe.Exec(ctx)
+		for i, key := range keys {
+			keyType, err := typeCmds[i].Result()
+			if err != nil {
+				return errors.Wrapf(err, "failed to get the type of key %q", key)
+			}
+			// The key is removed after scanning.
+			if keyType == "none" {
+				continue
+			}
+			var memory int64
+			if memoryUsageEnabled {
+				memory, err = memoryCmds[i].Result()
+				if err != nil && err != redis.Nil {
+					log.Debug("Failed to get the memory usage of Redis keys", zap.Error(err))
+					memoryUsageEnabled = false
+					memory = 0
+				}
+			}
+			stats.add(key, keyType, memory)
+		}
+
+		if nextCursor == 0 {
+			break
+		}
+		cursor = nextCursor
+	}
+	return nil
+}
+
+// keyPrefixStats is the statistics of the keys with the same prefix.
+type keyPrefixStats struct {
+	keyCount int64
+	memory   int64
+	// typeCount is the number of keys of each type.
+	typeCount map[string]int64
+}
+
+// keySpaceStats is the statistics of the sampled keys grouped by prefix.
+type keySpaceStats struct {
+	sampled   int64
+	prefixMap map[string]*keyPrefixStats
+}
+
+func newKeySpaceStats() *keySpaceStats {
+	return &keySpaceStats{prefixMap: make(map[string]*keyPrefixStats)}
+}
+
+func (s *keySpaceStats) add(key, keyType string, memory int64) {
+	prefix := getKeyPrefix(key)
+	stats, ok := s.prefixMap[prefix]
+	if !ok {
+		stats = &keyPrefixStats{typeCount: make(map[string]int64)}
+		s.prefixMap[prefix] = stats
+	}
+	stats.keyCount++
+	stats.memory += memory
+	stats.typeCount[keyType]++
+	s.sampled++
+}
+
+// toTables converts the statistics to tables ordered by prefix.
+// The counts are scaled to the total number of keys if only part of the keys are sampled.
+func (s *keySpaceStats) toTables(total int64) []*storepb.TableMetadata {
+	scale := func(v int64) int64 {
+		if s.sampled == 0 || total <= s.sampled {
+			return v
+		}
+		return int64(float64(v) * float64(total) / float64(s.sampled))
+	}
+
+	var prefixes []string
+	for prefix := range s.prefixMap {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	var tables []*storepb.TableMetadata
+	for _, prefix := range prefixes {
+		stats := s.prefixMap[prefix]
+		var keyTypes []string
+		for keyType := range stats.typeCount {
+			keyTypes = append(keyTypes, keyType)
+		}
+		sort.Strings(keyTypes)
+		table := &storepb.TableMetadata{
+			Name:     prefix,
+			RowCount: scale(stats.keyCount),
+			DataSize: scale(stats.memory),
+		}
+		for i, keyType := range keyTypes {
+			table.Columns = append(table.Columns, &storepb.ColumnMetadata{
+				Name:     keyType,
+				Position: int32(i + 1),
+				Type:     keyType,
+				Comment:  fmt.Sprintf("%d keys", scale(stats.typeCount[keyType])),
+			})
+		}
+		if total > s.sampled {
+			table.Comment = fmt.Sprintf("Estimated from %d sampled keys of %d keys", s.sampled, total)
+		}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+// getKeyPrefix returns the prefix pattern of the key, e.g. "user:*" for "user:1001".
+func getKeyPrefix(key string) string {
+	i := strings.Index(key, keyPrefixDelimiter)
+	if i < 0 {
+		return noPrefixTableName
+	}
+	return key[:i+len(keyPrefixDelimiter)] + "*"
 }
 
 func (d *Driver) getVersion(ctx context.Context) (string, error) {
@@ -2,33 +2,342 @@ package redshift
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
-	"github.com/pkg/errors"
-
+	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
 )
 
+// The role of Redshift is the user. The attribute of the role is the user options followed by the optional group list,
+// e.g. "CREATEDB NOCREATEUSER SYSLOG ACCESS RESTRICTED IN GROUP analyst, etl".
+var inGroupRegexp = regexp.MustCompile(`(?i)\bIN\s+GROUP\b`)
+
 // CreateRole creates the role.
-func (*Driver) CreateRole(_ context.Context, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("create role for Redshift is not implemented yet")
+func (driver *Driver) CreateRole(ctx context.Context, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	options, groups := parseRoleAttribute(upsert.Attribute)
+	optionList := convertToOptionList(upsert)
+	if options != "" {
+		optionList = append([]string{options}, optionList...)
+	}
+	if len(groups) > 0 {
+		var quotedGroups []string
+		for _, group := range groups {
+			quotedGroups = append(quotedGroups, quoteIdentifier(group))
+		}
+		optionList = append(optionList, fmt.Sprintf("IN GROUP %s", strings.Join(quotedGroups, ", ")))
+	}
+	statement := fmt.Sprintf(`CREATE USER %s %s`, quoteIdentifier(upsert.Name), strings.Join(optionList, " "))
+	if _, err := txn.ExecContext(ctx, statement); err != nil {
+		return nil, util.FormatErrorWithQuery(err, "CREATE USER")
+	}
+
+	roles, err := findRoleImpl(ctx, txn, &upsert.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, common.Errorf(common.NotFound, fmt.Sprintf("cannot find the role %s", upsert.Name))
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	return roles[0], nil
 }
 
 // UpdateRole updates the role.
-func (*Driver) UpdateRole(_ context.Context, _ string, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("update role for Redshift is not implemented yet")
+func (driver *Driver) UpdateRole(ctx context.Context, roleName string, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	roles, err := findRoleImpl(ctx, txn, &roleName)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, common.Errorf(common.NotFound, fmt.Sprintf("cannot find the role %s", roleName))
+	}
+
+	if roleName != upsert.Name {
+		// Renaming a user clears its MD5 password, so the password should be set again.
+		statement := fmt.Sprintf(`ALTER USER %s RENAME TO %s`, quoteIdentifier(roleName), quoteIdentifier(upsert.Name))
+		if _, err := txn.ExecContext(ctx, statement); err != nil {
+			return nil, util.FormatErrorWithQuery(err, statement)
+		}
+	}
+
+	options, groups := parseRoleAttribute(upsert.Attribute)
+	optionList := convertToOptionList(upsert)
+	if options != "" {
+		optionList = append([]string{options}, optionList...)
+	}
+	if len(optionList) > 0 {
+		statement := fmt.Sprintf(`ALTER USER %s %s`, quoteIdentifier(upsert.Name), strings.Join(optionList, " "))
+		if _, err := txn.ExecContext(ctx, statement); err != nil {
+			return nil, util.FormatErrorWithQuery(err, "ALTER USER")
+		}
+	}
+
+	if upsert.Attribute != nil {
+		oldGroups := make(map[string]bool)
+		for _, group := range roles[0].MemberOf {
+			oldGroups[group] = true
+		}
+		newGroups := make(map[string]bool)
+		for _, group := range groups {
+			newGroups[group] = true
+		}
+		for _, group := range roles[0].MemberOf {
+			if newGroups[group] {
+				continue
+			}
+			statement := fmt.Sprintf(`ALTER GROUP %s DROP USER %s`, quoteIdentifier(group), quoteIdentifier(upsert.Name))
+			if _, err := txn.ExecContext(ctx, statement); err != nil {
+				return nil, util.FormatErrorWithQuery(err, statement)
+			}
+		}
+		for _, group := range groups {
+			if oldGroups[group] {
+				continue
+			}
+			statement := fmt.Sprintf(`ALTER GROUP %s ADD USER %s`, quoteIdentifier(group), quoteIdentifier(upsert.Name))
+			if _, err := txn.ExecContext(ctx, statement); err != nil {
+				return nil, util.FormatErrorWithQuery(err, statement)
+			}
+		}
+	}
+
+	roles, err = findRoleImpl(ctx, txn, &upsert.Name)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, common.Errorf(common.NotFound, fmt.Sprintf("cannot find the role %s", upsert.Name))
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	return roles[0], nil
 }
 
 // FindRole finds the role by name.
-func (*Driver) FindRole(_ context.Context, _ string) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("find role for Redshift is not implemented yet")
+func (driver *Driver) FindRole(ctx context.Context, roleName string) (*db.DatabaseRoleMessage, error) {
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	roles, err := findRoleImpl(ctx, txn, &roleName)
+	if err != nil {
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return nil, common.Errorf(common.NotFound, fmt.Sprintf("cannot find the role %s", roleName))
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	return roles[0], nil
 }
 
 // ListRole lists the role.
-func (*Driver) ListRole(_ context.Context) ([]*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("list role for Redshift is not implemented yet")
+func (driver *Driver) ListRole(ctx context.Context) ([]*db.DatabaseRoleMessage, error) {
+	txn, err := driver.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer txn.Rollback()
+
+	roles, err := findRoleImpl(ctx, txn, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := txn.Commit(); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
 }
 
 // DeleteRole deletes the role by name.
-func (*Driver) DeleteRole(_ context.Context, _ string) error {
-	return errors.Errorf("delete role for Redshift is not implemented yet")
+func (driver *Driver) DeleteRole(ctx context.Context, roleName string) error {
+	statement := fmt.Sprintf(`DROP USER IF EXISTS %s`, quoteIdentifier(roleName))
+	if _, err := driver.db.ExecContext(ctx, statement); err != nil {
+		return util.FormatErrorWithQuery(err, statement)
+	}
+
+	return nil
+}
+
+func findRoleImpl(ctx context.Context, txn *sql.Tx, name *string) ([]*db.DatabaseRoleMessage, error) {
+	// rdsdb is the internal superuser of Redshift.
+	where := "u.usename <> 'rdsdb'"
+	var args []any
+	if name != nil {
+		where = "u.usename = $1"
+		args = append(args, *name)
+	}
+	query := fmt.Sprintf(`
+		SELECT
+			u.usename,
+			u.usesuper,
+			u.usecreatedb,
+			u.valuntil,
+			i.useconnlimit,
+			i.syslogaccess
+		FROM pg_catalog.pg_user u
+		LEFT JOIN pg_catalog.svl_user_info i ON u.usesysid = i.usesysid
+		WHERE %s
+		ORDER BY u.usename`, where)
+	rows, err := txn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var roles []*db.DatabaseRoleMessage
+	roleMap := make(map[string]*db.DatabaseRoleMessage)
+	optionMap := make(map[string]string)
+	for rows.Next() {
+		var userName string
+		var super, createDB bool
+		var validUntil, connectionLimit, syslogAccess sql.NullString
+		if err := rows.Scan(&userName, &super, &createDB, &validUntil, &connectionLimit, &syslogAccess); err != nil {
+			return nil, err
+		}
+
+		var options []string
+		if createDB {
+			options = append(options, "CREATEDB")
+		} else {
+			options = append(options, "NOCREATEDB")
+		}
+		if super {
+			options = append(options, "CREATEUSER")
+		} else {
+			options = append(options, "NOCREATEUSER")
+		}
+		if syslogAccess.Valid && syslogAccess.String != "" {
+			options = append(options, fmt.Sprintf("SYSLOG ACCESS %s", strings.ToUpper(syslogAccess.String)))
+		}
+
+		role := &db.DatabaseRoleMessage{
+			Name:            userName,
+			ConnectionLimit: -1,
+		}
+		if connectionLimit.Valid {
+			if limit, err := strconv.ParseInt(connectionLimit.String, 10, 32); err == nil {
+				role.ConnectionLimit = int32(limit)
+			}
+		}
+		if validUntil.Valid && validUntil.String != "infinity" {
+			role.ValidUntil = &validUntil.String
+		}
+		optionMap[userName] = strings.Join(options, " ")
+		roleMap[userName] = role
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+
+	groupQuery := `
+		SELECT
+			u.usename,
+			g.groname
+		FROM pg_catalog.pg_user u, pg_catalog.pg_group g
+		WHERE u.usesysid = ANY(g.grolist)
+		ORDER BY u.usename, g.groname`
+	groupRows, err := txn.QueryContext(ctx, groupQuery)
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, groupQuery)
+	}
+	defer groupRows.Close()
+	for groupRows.Next() {
+		var userName, groupName string
+		if err := groupRows.Scan(&userName, &groupName); err != nil {
+			return nil, err
+		}
+		if role, ok := roleMap[userName]; ok {
+			role.MemberOf = append(role.MemberOf, groupName)
+		}
+	}
+	if err := groupRows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, groupQuery)
+	}
+
+	for _, role := range roles {
+		attribute := optionMap[role.Name]
+		if len(role.MemberOf) > 0 {
+			attribute = fmt.Sprintf("%s IN GROUP %s", attribute, strings.Join(role.MemberOf, ", "))
+		}
+		role.Attribute = &attribute
+	}
+	return roles, nil
+}
+
+// parseRoleAttribute splits the role attribute into the user options and the sorted group list.
+func parseRoleAttribute(attribute *string) (string, []string) {
+	if attribute == nil {
+		return "", nil
+	}
+	loc := inGroupRegexp.FindStringIndex(*attribute)
+	if loc == nil {
+		return strings.TrimSpace(*attribute), nil
+	}
+	options := strings.TrimSpace((*attribute)[:loc[0]])
+	var groups []string
+	for _, group := range strings.Split((*attribute)[loc[1]:], ",") {
+		group = strings.TrimSpace(group)
+		if group == "" {
+			continue
+		}
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	return options, groups
+}
+
+func convertToOptionList(upsert *db.DatabaseRoleUpsertMessage) []string {
+	var optionList []string
+	if v := upsert.Password; v != nil {
+		optionList = append(optionList, fmt.Sprintf("PASSWORD '%s'", strings.ReplaceAll(*v, "'", "''")))
+	}
+	if v := upsert.ValidUntil; v != nil {
+		optionList = append(optionList, fmt.Sprintf("VALID UNTIL '%s'", *v))
+	}
+	if v := upsert.ConnectionLimit; v != nil {
+		if *v < 0 {
+			optionList = append(optionList, "CONNECTION LIMIT UNLIMITED")
+		} else {
+			optionList = append(optionList, fmt.Sprintf("CONNECTION LIMIT %d", *v))
+		}
+	}
+	return optionList
+}
+
+func quoteIdentifier(name string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(name, `"`, `""`))
 }
@@ -0,0 +1,36 @@
+package redshift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRoleAttribute(t *testing.T) {
+	testCases := []struct {
+		attribute   string
+		wantOptions string
+		wantGroups  []string
+	}{
+		{
+			attribute:   "CREATEDB NOCREATEUSER",
+			wantOptions: "CREATEDB NOCREATEUSER",
+		},
+		{
+			attribute:   "NOCREATEDB SYSLOG ACCESS RESTRICTED IN GROUP etl, analyst",
+			wantOptions: "NOCREATEDB SYSLOG ACCESS RESTRICTED",
+			wantGroups:  []string{"analyst", "etl"},
+		},
+		{
+			attribute:  "in group analyst",
+			wantGroups: []string{"analyst"},
+		},
+	}
+
+	for _, tc := range testCases {
+		attribute := tc.attribute
+		options, groups := parseRoleAttribute(&attribute)
+		require.Equal(t, tc.wantOptions, options)
+		require.Equal(t, tc.wantGroups, groups)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"strings"
 	"time"
 
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/pkg/errors"
@@ -486,12 +488,161 @@ func (driver *Driver) getTableSchema(ctx context.Context, database string) (map[
 	return tableMap, viewMap, nil
 }
 
-// SyncSlowQuery syncs the slow query.
-func (*Driver) SyncSlowQuery(_ context.Context, _ time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
-	return nil, errors.Errorf("not implemented")
+// SyncSlowQuery syncs the slow query from SNOWFLAKE.ACCOUNT_USAGE.QUERY_HISTORY.
+func (driver *Driver) SyncSlowQuery(ctx context.Context, logDateTs time.Time) (map[string]*storepb.SlowQueryStatistics, error) {
+	// The queries are aggregated by the hashes, and grouped by the fingerprint key in analyzeSlowQuery.
+	// The elapsed time is in milliseconds.
+	query := `
+		SELECT
+			DATABASE_NAME,
+			QUERY_PARAMETERIZED_HASH,
+			QUERY_HASH,
+			CASE WHEN QUERY_PARAMETERIZED_HASH IS NULL AND QUERY_HASH IS NULL THEN MD5(QUERY_TEXT) END AS QUERY_TEXT_HASH,
+			MIN(QUERY_TEXT),
+			COUNT(*),
+			SUM(TOTAL_ELAPSED_TIME),
+			MAX(TOTAL_ELAPSED_TIME),
+			SUM(ROWS_PRODUCED),
+			MAX(ROWS_PRODUCED),
+			MAX(CONVERT_TIMEZONE('UTC', START_TIME))
+		FROM SNOWFLAKE.ACCOUNT_USAGE.QUERY_HISTORY
+		WHERE DATABASE_NAME IS NOT NULL
+			AND TOTAL_ELAPSED_TIME >= 1000
+			AND TO_DATE(CONVERT_TIMEZONE('UTC', START_TIME)) = TO_DATE(?)
+		GROUP BY DATABASE_NAME, QUERY_PARAMETERIZED_HASH, QUERY_HASH, QUERY_TEXT_HASH`
+
+	rows, err := driver.db.QueryContext(ctx, query, logDateTs.Format("2006-01-02"))
+	if err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	defer rows.Close()
+
+	var slowQueries []*slowQuery
+	for rows.Next() {
+		q := &slowQuery{}
+		var totalRows, maxRows sql.NullInt64
+		if err := rows.Scan(
+			&q.databaseName,
+			&q.parameterizedHash,
+			&q.queryHash,
+			&q.queryTextHash,
+			&q.queryText,
+			&q.count,
+			&q.totalElapsedTime,
+			&q.maxElapsedTime,
+			&totalRows,
+			&maxRows,
+			&q.latestStartTime,
+		); err != nil {
+			return nil, err
+		}
+		q.totalRows, q.maxRows = totalRows.Int64, maxRows.Int64
+		slowQueries = append(slowQueries, q)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, util.FormatErrorWithQuery(err, query)
+	}
+	return analyzeSlowQuery(slowQueries), nil
+}
+
+// slowQuery is the statistics of the queries with the same hashes on the log date. The elapsed time is in milliseconds.
+type slowQuery struct {
+	databaseName      string
+	parameterizedHash sql.NullString
+	queryHash         sql.NullString
+	queryTextHash     sql.NullString
+	queryText         string
+	count             int64
+	totalElapsedTime  int64
+	maxElapsedTime    int64
+	totalRows         int64
+	maxRows           int64
+	latestStartTime   time.Time
+}
+
+// fingerprintKey returns the key to group the queries. The queries only differing in the literals share the same
+// parameterized hash, which falls back to the query hash and the hash of the query text if it's not available.
+func (q *slowQuery) fingerprintKey() string {
+	switch {
+	case q.parameterizedHash.Valid:
+		return q.parameterizedHash.String
+	case q.queryHash.Valid:
+		return q.queryHash.String
+	default:
+		return q.queryTextHash.String
+	}
+}
+
+// analyzeSlowQuery groups the queries by the fingerprint key for each database.
+// The fingerprint is the least query text in the group, so it's stable across the syncs.
+func analyzeSlowQuery(slowQueries []*slowQuery) map[string]*storepb.SlowQueryStatistics {
+	type group struct {
+		queryText string
+		item      *storepb.SlowQueryStatisticsItem
+	}
+	logMap := make(map[string]map[string]*group)
+	for _, q := range slowQueries {
+		queryText := strings.TrimSpace(q.queryText)
+		databaseLog, ok := logMap[q.databaseName]
+		if !ok {
+			databaseLog = make(map[string]*group)
+			logMap[q.databaseName] = databaseLog
+		}
+		g, ok := databaseLog[q.fingerprintKey()]
+		if !ok {
+			databaseLog[q.fingerprintKey()] = &group{
+				queryText: queryText,
+				item: &storepb.SlowQueryStatisticsItem{
+					Count:            q.count,
+					LatestLogTime:    timestamppb.New(q.latestStartTime.UTC()),
+					TotalQueryTime:   durationpb.New(time.Duration(q.totalElapsedTime) * time.Millisecond),
+					MaximumQueryTime: durationpb.New(time.Duration(q.maxElapsedTime) * time.Millisecond),
+					TotalRowsSent:    q.totalRows,
+					MaximumRowsSent:  q.maxRows,
+				},
+			}
+			continue
+		}
+		if queryText < g.queryText {
+			g.queryText = queryText
+		}
+		item := g.item
+		item.Count += q.count
+		if item.LatestLogTime.AsTime().Before(q.latestStartTime) {
+			item.LatestLogTime = timestamppb.New(q.latestStartTime.UTC())
+		}
+		item.TotalQueryTime = durationpb.New(item.TotalQueryTime.AsDuration() + time.Duration(q.totalElapsedTime)*time.Millisecond)
+		if item.MaximumQueryTime.AsDuration() < time.Duration(q.maxElapsedTime)*time.Millisecond {
+			item.MaximumQueryTime = durationpb.New(time.Duration(q.maxElapsedTime) * time.Millisecond)
+		}
+		item.TotalRowsSent += q.totalRows
+		if item.MaximumRowsSent < q.maxRows {
+			item.MaximumRowsSent = q.maxRows
+		}
+	}
+
+	result := make(map[string]*storepb.SlowQueryStatistics)
+	for databaseName, databaseLog := range logMap {
+		statistics := &storepb.SlowQueryStatistics{}
+		for _, g := range databaseLog {
+			g.item.SqlFingerprint = g.queryText
+			if len(g.item.SqlFingerprint) > db.SlowQueryMaxLen {
+				g.item.SqlFingerprint = g.item.SqlFingerprint[:db.SlowQueryMaxLen]
+			}
+			statistics.Items = append(statistics.Items, g.item)
+		}
+		result[databaseName] = statistics
+	}
+	return result
 }
 
 // CheckSlowQueryLogEnabled checks if slow query log is enabled.
-func (*Driver) CheckSlowQueryLogEnabled(_ context.Context) error {
-	return errors.Errorf("not implemented")
+// Snowflake always records the query history, so we only check if the user has the privilege to read it.
+func (driver *Driver) CheckSlowQueryLogEnabled(ctx context.Context) error {
+	query := `SELECT COUNT(*) FROM (SELECT 1 FROM SNOWFLAKE.ACCOUNT_USAGE.QUERY_HISTORY LIMIT 1)`
+	var count int
+	if err := driver.db.QueryRowContext(ctx, query).Scan(&count); err != nil {
+		return errors.Wrap(util.FormatErrorWithQuery(err, query), "the IMPORTED PRIVILEGES on the SNOWFLAKE database is required to read the query history")
+	}
+	return nil
 }
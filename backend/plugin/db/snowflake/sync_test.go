@@ -0,0 +1,90 @@
+package snowflake
+
+import (
+	"database/sql"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyzeSlowQuery(t *testing.T) {
+	startTime := time.Date(2023, 8, 1, 1, 0, 0, 0, time.UTC)
+	hash := func(s string) sql.NullString {
+		return sql.NullString{String: s, Valid: true}
+	}
+	type item struct {
+		fingerprint    string
+		count          int64
+		totalQueryTime time.Duration
+		maxQueryTime   time.Duration
+		totalRows      int64
+	}
+	tests := []struct {
+		name        string
+		slowQueries []*slowQuery
+		want        map[string][]item
+	}{
+		{
+			name: "the queries with the same parameterized hash are grouped",
+			slowQueries: []*slowQuery{
+				{databaseName: "DB1", parameterizedHash: hash("p1"), queryHash: hash("q1"), queryText: "SELECT * FROM T WHERE ID = 2", count: 1, totalElapsedTime: 2000, maxElapsedTime: 2000, totalRows: 1, maxRows: 1, latestStartTime: startTime},
+				{databaseName: "DB1", parameterizedHash: hash("p1"), queryHash: hash("q2"), queryText: "SELECT * FROM T WHERE ID = 1", count: 2, totalElapsedTime: 3000, maxElapsedTime: 1500, totalRows: 2, maxRows: 1, latestStartTime: startTime},
+				{databaseName: "DB1", parameterizedHash: hash("p2"), queryHash: hash("q3"), queryText: "SELECT * FROM S", count: 1, totalElapsedTime: 1000, maxElapsedTime: 1000, latestStartTime: startTime},
+			},
+			want: map[string][]item{
+				"DB1": {
+					{fingerprint: "SELECT * FROM S", count: 1, totalQueryTime: time.Second, maxQueryTime: time.Second},
+					{fingerprint: "SELECT * FROM T WHERE ID = 1", count: 3, totalQueryTime: 5 * time.Second, maxQueryTime: 2 * time.Second, totalRows: 3},
+				},
+			},
+		},
+		{
+			name: "the queries without parameterized hash fall back to the query hash and the query text hash",
+			slowQueries: []*slowQuery{
+				{databaseName: "DB1", queryHash: hash("q1"), queryText: "CALL P(1)", count: 1, totalElapsedTime: 1000, maxElapsedTime: 1000, latestStartTime: startTime},
+				{databaseName: "DB1", queryHash: hash("q2"), queryText: "CALL P(2)", count: 1, totalElapsedTime: 1000, maxElapsedTime: 1000, latestStartTime: startTime},
+				{databaseName: "DB1", queryTextHash: hash("t1"), queryText: " SHOW TABLES ", count: 1, totalElapsedTime: 1000, maxElapsedTime: 1000, latestStartTime: startTime},
+				{databaseName: "DB1", queryTextHash: hash("t1"), queryText: "SHOW TABLES", count: 1, totalElapsedTime: 3000, maxElapsedTime: 3000, latestStartTime: startTime},
+			},
+			want: map[string][]item{
+				"DB1": {
+					{fingerprint: "CALL P(1)", count: 1, totalQueryTime: time.Second, maxQueryTime: time.Second},
+					{fingerprint: "CALL P(2)", count: 1, totalQueryTime: time.Second, maxQueryTime: time.Second},
+					{fingerprint: "SHOW TABLES", count: 2, totalQueryTime: 4 * time.Second, maxQueryTime: 3 * time.Second},
+				},
+			},
+		},
+		{
+			name: "the same query is grouped by database",
+			slowQueries: []*slowQuery{
+				{databaseName: "DB1", parameterizedHash: hash("p1"), queryText: "SELECT 1", count: 1, totalElapsedTime: 1000, maxElapsedTime: 1000, latestStartTime: startTime},
+				{databaseName: "DB2", parameterizedHash: hash("p1"), queryText: "SELECT 1", count: 2, totalElapsedTime: 2000, maxElapsedTime: 1000, latestStartTime: startTime},
+			},
+			want: map[string][]item{
+				"DB1": {{fingerprint: "SELECT 1", count: 1, totalQueryTime: time.Second, maxQueryTime: time.Second}},
+				"DB2": {{fingerprint: "SELECT 1", count: 2, totalQueryTime: 2 * time.Second, maxQueryTime: time.Second}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got := make(map[string][]item)
+		for databaseName, statistics := range analyzeSlowQuery(test.slowQueries) {
+			for _, i := range statistics.Items {
+				got[databaseName] = append(got[databaseName], item{
+					fingerprint:    i.SqlFingerprint,
+					count:          i.Count,
+					totalQueryTime: i.TotalQueryTime.AsDuration(),
+					maxQueryTime:   i.MaximumQueryTime.AsDuration(),
+					totalRows:      i.TotalRowsSent,
+				})
+			}
+			sort.Slice(got[databaseName], func(i, j int) bool {
+				return got[databaseName][i].fingerprint < got[databaseName][j].fingerprint
+			})
+		}
+		require.Equal(t, test.want, got, test.name)
+	}
+}
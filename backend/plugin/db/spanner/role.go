@@ -2,33 +2,305 @@ package spanner
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
 
+	spanner "cloud.google.com/go/spanner"
+	"cloud.google.com/go/spanner/admin/database/apiv1/databasepb"
 	"github.com/pkg/errors"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
 
+	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 )
 
+// The role of Spanner is the database role, named in "database.role" format, e.g. "db1.analyst".
+// The attribute of the role is the comma separated list of the granted database roles, e.g. "spanner_info_reader, reader".
+
 // CreateRole creates the role.
-func (*Driver) CreateRole(_ context.Context, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("create role for spanner is not implemented yet")
+func (d *Driver) CreateRole(ctx context.Context, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	databaseName, roleName, err := splitRoleName(upsert.Name)
+	if err != nil {
+		return nil, err
+	}
+	if upsert.Password != nil {
+		return nil, errors.New("Spanner database role does not support password")
+	}
+
+	stmts := []string{fmt.Sprintf("CREATE ROLE %s", roleName)}
+	if grantedRoles := parseGrantedRoles(upsert.Attribute); len(grantedRoles) > 0 {
+		stmts = append(stmts, fmt.Sprintf("GRANT ROLE %s TO ROLE %s", strings.Join(grantedRoles, ", "), roleName))
+	}
+	if err := d.updateDDL(ctx, databaseName, stmts); err != nil {
+		return nil, err
+	}
+
+	return d.FindRole(ctx, upsert.Name)
 }
 
 // UpdateRole updates the role.
-func (*Driver) UpdateRole(_ context.Context, _ string, _ *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("update role for spanner is not implemented yet")
+func (d *Driver) UpdateRole(ctx context.Context, roleName string, upsert *db.DatabaseRoleUpsertMessage) (*db.DatabaseRoleMessage, error) {
+	if roleName != upsert.Name {
+		return nil, errors.Errorf("Spanner does not support renaming the database role %q", roleName)
+	}
+	if upsert.Password != nil {
+		return nil, errors.New("Spanner database role does not support password")
+	}
+	role, err := d.FindRole(ctx, roleName)
+	if err != nil {
+		return nil, err
+	}
+	if upsert.Attribute == nil {
+		return role, nil
+	}
+	databaseName, name, err := splitRoleName(roleName)
+	if err != nil {
+		return nil, err
+	}
+
+	oldSet := make(map[string]bool)
+	for _, grantedRole := range role.MemberOf {
+		oldSet[grantedRole] = true
+	}
+	newRoles := parseGrantedRoles(upsert.Attribute)
+	newSet := make(map[string]bool)
+	for _, grantedRole := range newRoles {
+		newSet[grantedRole] = true
+	}
+	var revokeRoles, grantRoles []string
+	for _, grantedRole := range role.MemberOf {
+		if !newSet[grantedRole] {
+			revokeRoles = append(revokeRoles, grantedRole)
+		}
+	}
+	for _, grantedRole := range newRoles {
+		if !oldSet[grantedRole] {
+			grantRoles = append(grantRoles, grantedRole)
+		}
+	}
+
+	var stmts []string
+	if len(revokeRoles) > 0 {
+		stmts = append(stmts, fmt.Sprintf("REVOKE ROLE %s FROM ROLE %s", strings.Join(revokeRoles, ", "), name))
+	}
+	if len(grantRoles) > 0 {
+		stmts = append(stmts, fmt.Sprintf("GRANT ROLE %s TO ROLE %s", strings.Join(grantRoles, ", "), name))
+	}
+	if len(stmts) > 0 {
+		if err := d.updateDDL(ctx, databaseName, stmts); err != nil {
+			return nil, err
+		}
+	}
+
+	return d.FindRole(ctx, roleName)
 }
 
 // FindRole finds the role by name.
-func (*Driver) FindRole(_ context.Context, _ string) (*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("find role for spanner is not implemented yet")
+func (d *Driver) FindRole(ctx context.Context, roleName string) (*db.DatabaseRoleMessage, error) {
+	databaseName, name, err := splitRoleName(roleName)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := d.listDatabaseRoles(ctx, databaseName)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles {
+		if role.Name == roleName {
+			return role, nil
+		}
+	}
+	return nil, common.Errorf(common.NotFound, fmt.Sprintf("cannot find the role %s in database %s", name, databaseName))
 }
 
 // ListRole lists the role.
-func (*Driver) ListRole(_ context.Context) ([]*db.DatabaseRoleMessage, error) {
-	return nil, errors.Errorf("list role for spanner is not implemented yet")
+func (d *Driver) ListRole(ctx context.Context) ([]*db.DatabaseRoleMessage, error) {
+	databases, err := d.listDatabaseNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var result []*db.DatabaseRoleMessage
+	for _, databaseName := range databases {
+		roles, err := d.listDatabaseRoles(ctx, databaseName)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, roles...)
+	}
+	return result, nil
 }
 
 // DeleteRole deletes the role by name.
-func (*Driver) DeleteRole(_ context.Context, _ string) error {
-	return errors.Errorf("delete role for spanner is not implemented yet")
+func (d *Driver) DeleteRole(ctx context.Context, roleName string) error {
+	role, err := d.FindRole(ctx, roleName)
+	if err != nil {
+		if common.ErrorCode(err) == common.NotFound {
+			return nil
+		}
+		return err
+	}
+	databaseName, name, err := splitRoleName(roleName)
+	if err != nil {
+		return err
+	}
+
+	// The granted roles should be revoked before dropping the role.
+	var stmts []string
+	if len(role.MemberOf) > 0 {
+		stmts = append(stmts, fmt.Sprintf("REVOKE ROLE %s FROM ROLE %s", strings.Join(role.MemberOf, ", "), name))
+	}
+	stmts = append(stmts, fmt.Sprintf("DROP ROLE %s", name))
+	return d.updateDDL(ctx, databaseName, stmts)
+}
+
+// listDatabaseRoles lists the user-defined database roles and their granted roles in the database.
+func (d *Driver) listDatabaseRoles(ctx context.Context, databaseName string) ([]*db.DatabaseRoleMessage, error) {
+	var roles []*db.DatabaseRoleMessage
+	roleMap := make(map[string]*db.DatabaseRoleMessage)
+	iter := d.dbClient.ListDatabaseRoles(ctx, &databasepb.ListDatabaseRolesRequest{
+		Parent: getDSN(d.config.Host, databaseName),
+	})
+	for {
+		databaseRole, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list database roles in database %q", databaseName)
+		}
+		// databaseRole.Name is of the form `projects/<project>/instances/<instance>/databases/<database>/databaseRoles/<role>`.
+		name := databaseRole.Name[strings.LastIndex(databaseRole.Name, "/")+1:]
+		if isSystemRole(name) {
+			continue
+		}
+		role := &db.DatabaseRoleMessage{
+			Name:     fmt.Sprintf("%s.%s", databaseName, name),
+			Database: databaseName,
+		}
+		roleMap[name] = role
+		roles = append(roles, role)
+	}
+	if len(roles) == 0 {
+		return nil, nil
+	}
+
+	if err := d.withDatabaseClient(ctx, databaseName, func(client *spanner.Client) error {
+		iter := client.Single().Query(ctx, spanner.NewStatement(`SELECT GRANTEE, ROLE_NAME FROM INFORMATION_SCHEMA.ROLE_GRANTEES ORDER BY GRANTEE, ROLE_NAME`))
+		defer iter.Stop()
+		for {
+			row, err := iter.Next()
+			if err == iterator.Done {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			var grantee, grantedRole string
+			if err := row.Columns(&grantee, &grantedRole); err != nil {
+				return err
+			}
+			if grantedRole == "public" {
+				continue
+			}
+			if role, ok := roleMap[grantee]; ok {
+				role.MemberOf = append(role.MemberOf, grantedRole)
+			}
+		}
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list role grantees in database %q", databaseName)
+	}
+
+	for _, role := range roles {
+		attribute := strings.Join(role.MemberOf, ", ")
+		role.Attribute = &attribute
+	}
+	return roles, nil
+}
+
+func (d *Driver) listDatabaseNames(ctx context.Context) ([]string, error) {
+	var databases []string
+	iter := d.dbClient.ListDatabases(ctx, &databasepb.ListDatabasesRequest{
+		Parent: d.config.Host,
+	})
+	for {
+		database, err := iter.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		// The database roles are only supported in the GoogleSQL dialect databases.
+		if database.DatabaseDialect == databasepb.DatabaseDialect_POSTGRESQL {
+			continue
+		}
+		databaseName, err := getDatabaseFromDSN(database.Name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get database name from %s", database.Name)
+		}
+		if _, ok := excludedDatabaseList[databaseName]; ok {
+			continue
+		}
+		databases = append(databases, databaseName)
+	}
+	return databases, nil
+}
+
+// withDatabaseClient calls f with the data client of the database, the client is created if the driver doesn't connect to the database.
+func (d *Driver) withDatabaseClient(ctx context.Context, databaseName string, f func(*spanner.Client) error) error {
+	if d.client != nil && d.databaseName == databaseName {
+		return f(d.client)
+	}
+	client, err := spanner.NewClient(ctx, getDSN(d.config.Host, databaseName), option.WithCredentialsJSON([]byte(d.config.Password)))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return f(client)
+}
+
+func (d *Driver) updateDDL(ctx context.Context, databaseName string, stmts []string) error {
+	op, err := d.dbClient.UpdateDatabaseDdl(ctx, &databasepb.UpdateDatabaseDdlRequest{
+		Database:   getDSN(d.config.Host, databaseName),
+		Statements: stmts,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to execute %q", strings.Join(stmts, "; "))
+	}
+	return op.Wait(ctx)
+}
+
+// splitRoleName splits the role name into the database name and the database role name.
+func splitRoleName(roleName string) (string, string, error) {
+	databaseName, name, ok := strings.Cut(roleName, ".")
+	if !ok || databaseName == "" || name == "" {
+		return "", "", errors.Errorf("invalid role name %q, it should be in \"database.role\" format", roleName)
+	}
+	return databaseName, name, nil
+}
+
+// parseGrantedRoles parses the comma separated granted roles, the result is sorted and deduplicated.
+func parseGrantedRoles(attribute *string) []string {
+	if attribute == nil {
+		return nil
+	}
+	set := make(map[string]bool)
+	var result []string
+	for _, role := range strings.Split(*attribute, ",") {
+		role = strings.TrimSpace(role)
+		if role == "" || set[role] {
+			continue
+		}
+		set[role] = true
+		result = append(result, role)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// isSystemRole returns true for the system roles, which cannot be altered or dropped.
+func isSystemRole(name string) bool {
+	return name == "public" || strings.HasPrefix(name, "spanner_")
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/bytebase/bytebase/backend/common"
 	"github.com/bytebase/bytebase/backend/common/log"
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
 )
@@ -118,6 +119,9 @@ func ApplyMultiStatements(sc io.Reader, f func(string) error) error {
 	return nil
 }
 
+// rowBatchSize is the number of rows passed to the row batch handler of the query context at a time.
+const rowBatchSize = 1000
+
 // Query will execute a readonly / SELECT query.
 // TODO(rebelice): remove Query function and rename Query to Query after frontend is ready to use the new API.
 func Query(ctx context.Context, dbType db.Type, conn *sql.Conn, statement string, queryContext *db.QueryContext) (*v1pb.QueryResult, error) {
@@ -152,10 +156,19 @@ func Query(ctx context.Context, dbType db.Type, conn *sql.Conn, statement string
 
 	var fieldMaskInfo []bool
 	var fieldSensitiveInfo []bool
+	var fieldMaskers []masker.Masker
 	for i := range columnNames {
 		sensitive := len(fieldList) > 0 && fieldList[i].Sensitive
 		fieldSensitiveInfo = append(fieldSensitiveInfo, sensitive)
 		fieldMaskInfo = append(fieldMaskInfo, sensitive && queryContext.EnableSensitive)
+		var fieldMasker masker.Masker
+		if sensitive && queryContext.EnableSensitive {
+			fieldMasker = fieldList[i].Masker
+			if fieldMasker == nil {
+				fieldMasker = masker.NewDefaultFullMasker()
+			}
+		}
+		fieldMaskers = append(fieldMaskers, fieldMasker)
 	}
 
 	columnTypes, err := rows.ColumnTypes()
@@ -170,7 +183,19 @@ func Query(ctx context.Context, dbType db.Type, conn *sql.Conn, statement string
 		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
 	}
 
-	data, err := readRows(rows, columnTypeNames, fieldMaskInfo)
+	var batchHandler func([]*v1pb.QueryRow) error
+	if queryContext.RowBatchHandler != nil {
+		batchHandler = func(batch []*v1pb.QueryRow) error {
+			return queryContext.RowBatchHandler(&v1pb.QueryResult{
+				ColumnNames:     columnNames,
+				ColumnTypeNames: columnTypeNames,
+				Rows:            batch,
+				Masked:          fieldMaskInfo,
+				Sensitive:       fieldSensitiveInfo,
+			})
+		}
+	}
+	data, err := readRows(rows, columnTypeNames, fieldMaskers, batchHandler)
 	if err != nil {
 		return nil, err
 	}
@@ -281,7 +306,7 @@ func rowsToQueryResult(rows *sql.Rows) (*v1pb.QueryResult, error) {
 		columnTypeNames = append(columnTypeNames, strings.ToUpper(v.DatabaseTypeName()))
 	}
 
-	data, err := readRows(rows, columnTypeNames, nil)
+	data, err := readRows(rows, columnTypeNames, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -297,7 +322,10 @@ func rowsToQueryResult(rows *sql.Rows) (*v1pb.QueryResult, error) {
 	}, nil
 }
 
-func readRows(rows *sql.Rows, columnTypeNames []string, fieldMaskInfo []bool) ([]*v1pb.QueryRow, error) {
+// readRows reads the rows. If batchHandler is not nil, the rows are passed to it in batches of rowBatchSize,
+// and only the rows not handled are returned.
+// The values of the columns with non-nil fieldMaskers are masked by the maskers.
+func readRows(rows *sql.Rows, columnTypeNames []string, fieldMaskers []masker.Masker, batchHandler func([]*v1pb.QueryRow) error) ([]*v1pb.QueryRow, error) {
 	var data []*v1pb.QueryRow
 	if len(columnTypeNames) == 0 {
 		// No rows.
@@ -333,44 +361,55 @@ func readRows(rows *sql.Rows, columnTypeNames []string, fieldMaskInfo []bool) ([
 
 		var rowData v1pb.QueryRow
 		for i := range columnTypeNames {
-			if len(fieldMaskInfo) > 0 && fieldMaskInfo[i] {
-				rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "******"}})
-				continue
-			}
-			if v, ok := (scanArgs[i]).(*sql.NullBool); ok && v.Valid {
-				rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: v.Bool}})
-				continue
+			value := getRowValue(scanArgs[i], wantBytesValue[i])
+			if len(fieldMaskers) > 0 && fieldMaskers[i] != nil {
+				value = fieldMaskers[i].Mask(value)
 			}
-			if v, ok := (scanArgs[i]).(*sql.NullString); ok && v.Valid {
-				if wantBytesValue[i] {
-					rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte(v.String)}})
-				} else {
-					rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v.String}})
-				}
-				continue
-			}
-			if v, ok := (scanArgs[i]).(*sql.NullInt64); ok && v.Valid {
-				rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v.Int64}})
-				continue
-			}
-			if v, ok := (scanArgs[i]).(*sql.NullInt32); ok && v.Valid {
-				rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: v.Int32}})
-				continue
-			}
-			if v, ok := (scanArgs[i]).(*sql.NullFloat64); ok && v.Valid {
-				rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: v.Float64}})
-				continue
-			}
-			// If none of them match, set nil to its value.
-			rowData.Values = append(rowData.Values, &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{NullValue: structpb.NullValue_NULL_VALUE}})
+			rowData.Values = append(rowData.Values, value)
 		}
 
 		data = append(data, &rowData)
+		if batchHandler != nil && len(data) >= rowBatchSize {
+			if err := batchHandler(data); err != nil {
+				return nil, err
+			}
+			data = nil
+		}
+	}
+	if batchHandler != nil && len(data) > 0 {
+		if err := batchHandler(data); err != nil {
+			return nil, err
+		}
+		data = nil
 	}
 
 	return data, nil
 }
 
+// getRowValue returns the row value of the scanned column.
+func getRowValue(scanArg any, wantBytesValue bool) *v1pb.RowValue {
+	if v, ok := scanArg.(*sql.NullBool); ok && v.Valid {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_BoolValue{BoolValue: v.Bool}}
+	}
+	if v, ok := scanArg.(*sql.NullString); ok && v.Valid {
+		if wantBytesValue {
+			return &v1pb.RowValue{Kind: &v1pb.RowValue_BytesValue{BytesValue: []byte(v.String)}}
+		}
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: v.String}}
+	}
+	if v, ok := scanArg.(*sql.NullInt64); ok && v.Valid {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: v.Int64}}
+	}
+	if v, ok := scanArg.(*sql.NullInt32); ok && v.Valid {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_Int32Value{Int32Value: v.Int32}}
+	}
+	if v, ok := scanArg.(*sql.NullFloat64); ok && v.Valid {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_DoubleValue{DoubleValue: v.Float64}}
+	}
+	// If none of them match, set nil to its value.
+	return &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{NullValue: structpb.NullValue_NULL_VALUE}}
+}
+
 func getStatementWithResultLimit(stmt string, limit int) string {
 	return fmt.Sprintf("WITH result AS (%s) SELECT * FROM result LIMIT %d;", stmt, limit)
 }
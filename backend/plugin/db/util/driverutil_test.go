@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 
 	// Register pingcap parser driver.
@@ -1236,6 +1237,95 @@ func TestPostgreSQLExtractSensitiveField(t *testing.T) {
 	}
 }
 
+func TestExtractSensitiveFieldMasker(t *testing.T) {
+	const (
+		defaultDatabase = "db"
+	)
+	a := require.New(t)
+	keepMasker, err := masker.NewMasker(&storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_KEEP, PrefixLength: 1})
+	a.NoError(err)
+	hashMasker, err := masker.NewMasker(&storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_HASH, Salt: "salt"})
+	a.NoError(err)
+	schemaInfo := func(schemaName string) *db.SensitiveSchemaInfo {
+		return &db.SensitiveSchemaInfo{
+			DatabaseList: []db.DatabaseSchema{
+				{
+					Name: defaultDatabase,
+					SchemaList: []db.SchemaSchema{
+						{
+							Name: schemaName,
+							TableList: []db.TableSchema{
+								{
+									Name: "t",
+									ColumnList: []db.ColumnInfo{
+										{
+											Name:         "a",
+											MaskingLevel: storepb.MaskingLevel_FULL,
+											Masker:       hashMasker,
+										},
+										{
+											Name:         "b",
+											MaskingLevel: storepb.MaskingLevel_NONE,
+										},
+										{
+											Name:         "d",
+											MaskingLevel: storepb.MaskingLevel_PARTIAL,
+											Masker:       keepMasker,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		statement string
+		maskers   []masker.Masker
+	}{
+		{
+			// The fields computed from expressions use the default full masker.
+			statement: "SELECT a, d AS d1, concat(a, d) FROM t",
+			maskers:   []masker.Masker{hashMasker, keepMasker, nil},
+		},
+		{
+			statement: "SELECT * FROM (SELECT a, d FROM t) x",
+			maskers:   []masker.Masker{hashMasker, keepMasker},
+		},
+		{
+			statement: "WITH c AS (SELECT d, a FROM t) SELECT c.a, c.d FROM c",
+			maskers:   []masker.Masker{hashMasker, keepMasker},
+		},
+		{
+			// The stricter masking level wins.
+			statement: "SELECT d FROM t UNION SELECT a FROM t",
+			maskers:   []masker.Masker{hashMasker},
+		},
+		{
+			// The fields of the same masking level with different maskers use the default full masker.
+			statement: "SELECT d FROM t UNION SELECT concat(d, b) FROM t",
+			maskers:   []masker.Masker{nil},
+		},
+	}
+
+	for _, dbType := range []db.Type{db.MySQL, db.Postgres} {
+		schemaName := ""
+		if dbType == db.Postgres {
+			schemaName = "public"
+		}
+		for _, test := range tests {
+			res, err := extractSensitiveField(dbType, test.statement, defaultDatabase, schemaInfo(schemaName))
+			a.NoError(err, test.statement)
+			a.Equal(len(test.maskers), len(res), test.statement)
+			for i := range res {
+				a.Equal(test.maskers[i], res[i].Masker, "%s: %s", dbType, test.statement)
+			}
+		}
+	}
+}
+
 func TestPLSQLExtractSensitiveField(t *testing.T) {
 	const (
 		defaultSchema = "ROOT"
@@ -1,11 +1,13 @@
 package util
 
 import (
+	"cmp"
 	"regexp"
 
 	"github.com/pkg/errors"
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
 
@@ -22,6 +24,21 @@ type fieldInfo struct {
 	// TODO(zp): retire sensitive boolean flag.
 	sensitive    bool
 	maskingLevel storepb.MaskingLevel
+	// masker is the masker of the column the field selects directly.
+	// It is nil for the fields computed from expressions, which use the default full masker.
+	masker masker.Masker
+}
+
+// mergeFieldMasking merges the masking level and the masker of the other field selected into the same field, e.g. by the JOIN USING or the set operations.
+// The field takes the stricter masking level with its masker, and falls back to the default full masker if the two fields of the same level have different maskers.
+func mergeFieldMasking(field *fieldInfo, other fieldInfo) {
+	switch {
+	case cmp.Less[storepb.MaskingLevel](field.maskingLevel, other.maskingLevel):
+		field.maskingLevel = other.maskingLevel
+		field.masker = other.masker
+	case field.maskingLevel == other.maskingLevel && field.masker != other.masker:
+		field.masker = nil
+	}
 }
 
 type sensitiveFieldExtractor struct {
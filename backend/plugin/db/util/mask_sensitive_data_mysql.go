@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 
@@ -49,6 +50,7 @@ func (extractor *sensitiveFieldExtractor) extractMySQLSensitiveField(statement s
 		result = append(result, db.SensitiveField{
 			Name:         field.name,
 			MaskingLevel: field.maskingLevel,
+			Masker:       field.masker,
 		})
 	}
 	return result, nil
@@ -127,6 +129,7 @@ func (extractor *sensitiveFieldExtractor) extractSetOpr(node *tidbast.SetOprStmt
 			}
 			for index := 0; index < len(result); index++ {
 				result[index].maskingLevel = fieldList[index].maskingLevel
+				result[index].masker = fieldList[index].masker
 			}
 		}
 	}
@@ -204,6 +207,7 @@ func (extractor *sensitiveFieldExtractor) extractRecursiveCTE(node *tidbast.Comm
 			cteInfo.ColumnList = append(cteInfo.ColumnList, db.ColumnInfo{
 				Name:         field.name,
 				MaskingLevel: field.maskingLevel,
+				Masker:       field.masker,
 			})
 		}
 
@@ -240,6 +244,7 @@ func (extractor *sensitiveFieldExtractor) extractRecursiveCTE(node *tidbast.Comm
 				if cmp.Less[storepb.MaskingLevel](cteInfo.ColumnList[i].MaskingLevel, field.maskingLevel) {
 					changed = true
 					cteInfo.ColumnList[i].MaskingLevel = field.maskingLevel
+					cteInfo.ColumnList[i].Masker = field.masker
 				}
 			}
 
@@ -276,6 +281,7 @@ func (extractor *sensitiveFieldExtractor) extractNonRecursiveCTE(node *tidbast.C
 		result.ColumnList = append(result.ColumnList, db.ColumnInfo{
 			Name:         field.name,
 			MaskingLevel: field.maskingLevel,
+			Masker:       field.masker,
 		})
 	}
 	return result, nil
@@ -339,11 +345,19 @@ func (extractor *sensitiveFieldExtractor) extractSelect(node *tidbast.SelectStmt
 					return nil, err
 				}
 				fieldName := extractFieldName(field)
+				var fieldMasker masker.Masker
+				if columnName, ok := field.Expr.(*tidbast.ColumnNameExpr); ok {
+					// The field selecting a column directly keeps the masker of the column.
+					if sourceField, ok := extractor.findField(columnName.Name.Schema.O, columnName.Name.Table.O, columnName.Name.Name.O); ok {
+						fieldMasker = sourceField.masker
+					}
+				}
 				result = append(result, fieldInfo{
 					database:     "",
 					table:        "",
 					name:         fieldName,
 					maskingLevel: maskingLevel,
+					masker:       fieldMasker,
 				})
 			}
 		}
@@ -367,6 +381,14 @@ func extractFieldName(in *tidbast.SelectField) string {
 }
 
 func (extractor *sensitiveFieldExtractor) checkFieldMaskingLevel(databaseName string, tableName string, fieldName string) storepb.MaskingLevel {
+	if field, ok := extractor.findField(databaseName, tableName, fieldName); ok {
+		return field.maskingLevel
+	}
+	return defaultMaskingLevel
+}
+
+// findField finds the field referenced by the column name in the outer schemas and the FROM clause.
+func (extractor *sensitiveFieldExtractor) findField(databaseName string, tableName string, fieldName string) (fieldInfo, bool) {
 	// One sub-query may have multi-outer schemas and the multi-outer schemas can use the same name, such as:
 	//
 	//  select (
@@ -386,7 +408,7 @@ func (extractor *sensitiveFieldExtractor) checkFieldMaskingLevel(databaseName st
 		sameTable := (tableName == field.table || tableName == "")
 		sameField := (fieldName == field.name)
 		if sameDatabase && sameTable && sameField {
-			return field.maskingLevel
+			return field, true
 		}
 	}
 
@@ -395,11 +417,11 @@ func (extractor *sensitiveFieldExtractor) checkFieldMaskingLevel(databaseName st
 		sameTable := (tableName == field.table || tableName == "")
 		sameField := (fieldName == field.name)
 		if sameDatabase && sameTable && sameField {
-			return field.maskingLevel
+			return field, true
 		}
 	}
 
-	return defaultMaskingLevel
+	return fieldInfo{}, false
 }
 
 func (extractor *sensitiveFieldExtractor) extractColumnFromExprNode(in tidbast.ExprNode) (maskingLevel storepb.MaskingLevel, err error) {
@@ -529,6 +551,7 @@ func (extractor *sensitiveFieldExtractor) extractTableSource(node *tidbast.Table
 				table:        node.AsName.O,
 				database:     field.database,
 				maskingLevel: field.maskingLevel,
+				masker:       field.masker,
 			})
 		}
 	} else {
@@ -614,6 +637,7 @@ func (extractor *sensitiveFieldExtractor) buildTableSchemaForView(viewName strin
 			Name:         field.Name,
 			Sensitive:    field.Sensitive,
 			MaskingLevel: field.MaskingLevel,
+			Masker:       field.Masker,
 		})
 	}
 	return result, nil
@@ -672,6 +696,7 @@ func (extractor *sensitiveFieldExtractor) extractTableName(node *tidbast.TableNa
 			table:        tableSchema.Name,
 			database:     databaseName,
 			maskingLevel: column.MaskingLevel,
+			masker:       column.Masker,
 		})
 	}
 	return res, nil
@@ -709,8 +734,8 @@ func mergeJoinField(node *tidbast.Join, leftField []fieldInfo, rightField []fiel
 		// Natural Join will merge the same column name field.
 		for _, field := range leftField {
 			// Merge the sensitive attribute for the same column name field.
-			if rField, exists := rightFieldMap[strings.ToLower(field.name)]; exists && cmp.Less[storepb.MaskingLevel](field.maskingLevel, rField.maskingLevel) {
-				field.maskingLevel = rField.maskingLevel
+			if rField, exists := rightFieldMap[strings.ToLower(field.name)]; exists {
+				mergeFieldMasking(&field, rField)
 			}
 			result = append(result, field)
 		}
@@ -733,8 +758,8 @@ func mergeJoinField(node *tidbast.Join, leftField []fieldInfo, rightField []fiel
 				_, existsInUsingMap := usingMap[strings.ToLower(field.name)]
 				rField, existsInRightField := rightFieldMap[strings.ToLower(field.name)]
 				// Merge the sensitive attribute for the column name field in USING.
-				if existsInUsingMap && existsInRightField && cmp.Less[storepb.MaskingLevel](field.maskingLevel, rField.maskingLevel) {
-					field.maskingLevel = rField.maskingLevel
+				if existsInUsingMap && existsInRightField {
+					mergeFieldMasking(&field, rField)
 				}
 				result = append(result, field)
 			}
@@ -7,6 +7,7 @@ import (
 	pgquery "github.com/pganalyze/pg_query_go/v4"
 
 	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/masker"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/ast"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/engine/pg"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -63,6 +64,7 @@ func (extractor *sensitiveFieldExtractor) extractPostgreSQLSensitiveField(statem
 		result = append(result, db.SensitiveField{
 			Name:         field.name,
 			MaskingLevel: field.maskingLevel,
+			Masker:       field.masker,
 		})
 	}
 	return result, nil
@@ -112,8 +114,8 @@ func pgMergeJoinField(node *pgquery.Node_JoinExpr, leftField []fieldInfo, rightF
 		// Natural Join will merge the same column name field.
 		for _, field := range leftField {
 			// Merge the sensitive attribute for the same column name field.
-			if rField, exists := rightFieldMap[field.name]; exists && cmp.Less[storepb.MaskingLevel](field.maskingLevel, rField.maskingLevel) {
-				field.maskingLevel = rField.maskingLevel
+			if rField, exists := rightFieldMap[field.name]; exists {
+				mergeFieldMasking(&field, rField)
 			}
 			result = append(result, field)
 		}
@@ -143,8 +145,8 @@ func pgMergeJoinField(node *pgquery.Node_JoinExpr, leftField []fieldInfo, rightF
 				_, existsInUsingMap := usingMap[field.name]
 				rField, existsInRightField := rightFieldMap[field.name]
 				// Merge the sensitive attribute for the column name field in USING.
-				if existsInUsingMap && existsInRightField && cmp.Less[storepb.MaskingLevel](field.maskingLevel, rField.maskingLevel) {
-					field.maskingLevel = rField.maskingLevel
+				if existsInUsingMap && existsInRightField {
+					mergeFieldMasking(&field, rField)
 				}
 				result = append(result, field)
 			}
@@ -190,6 +192,7 @@ func (extractor *sensitiveFieldExtractor) pgExtractRangeSubselect(node *pgquery.
 				table:        aliasName,
 				name:         columnName,
 				maskingLevel: item.maskingLevel,
+				masker:       item.masker,
 			})
 		}
 		return result, nil
@@ -225,6 +228,7 @@ func (extractor *sensitiveFieldExtractor) pgExtractRangeVar(node *pgquery.Node_R
 				name:         column.Name,
 				table:        tableSchema.Name,
 				maskingLevel: column.MaskingLevel,
+				masker:       column.Masker,
 			})
 		}
 	} else {
@@ -246,6 +250,7 @@ func (extractor *sensitiveFieldExtractor) pgExtractRangeVar(node *pgquery.Node_R
 				name:         columnName,
 				table:        aliasName,
 				maskingLevel: column.MaskingLevel,
+				masker:       column.Masker,
 			})
 		}
 	}
@@ -314,6 +319,7 @@ func (extractor *sensitiveFieldExtractor) pgExtractRecursiveCTE(node *pgquery.No
 			cteInfo.ColumnList = append(cteInfo.ColumnList, db.ColumnInfo{
 				Name:         field.name,
 				MaskingLevel: field.maskingLevel,
+				Masker:       field.masker,
 			})
 		}
 
@@ -346,6 +352,7 @@ func (extractor *sensitiveFieldExtractor) pgExtractRecursiveCTE(node *pgquery.No
 					changed = true
 					cteInfo.ColumnList[i].Sensitive = true
 					cteInfo.ColumnList[i].MaskingLevel = field.maskingLevel
+					cteInfo.ColumnList[i].Masker = field.masker
 				}
 			}
 
@@ -390,6 +397,7 @@ func (extractor *sensitiveFieldExtractor) pgExtractNonRecursiveCTE(node *pgquery
 		result.ColumnList = append(result.ColumnList, db.ColumnInfo{
 			Name:         field.name,
 			MaskingLevel: field.maskingLevel,
+			Masker:       field.masker,
 		})
 	}
 
@@ -465,18 +473,14 @@ func (extractor *sensitiveFieldExtractor) pgExtractSelect(node *pgquery.Node_Sel
 		}
 		var result []fieldInfo
 		for i, field := range leftField {
-			finalLevel := defaultMaskingLevel
-			if cmp.Less[storepb.MaskingLevel](finalLevel, field.maskingLevel) {
-				finalLevel = field.maskingLevel
-			}
-			if cmp.Less[storepb.MaskingLevel](finalLevel, rightField[i].maskingLevel) {
-				finalLevel = rightField[i].maskingLevel
-			}
-			result = append(result, fieldInfo{
+			finalField := fieldInfo{
 				name:         field.name,
 				table:        field.table,
-				maskingLevel: finalLevel,
-			})
+				maskingLevel: defaultMaskingLevel,
+			}
+			mergeFieldMasking(&finalField, field)
+			mergeFieldMasking(&finalField, rightField[i])
+			result = append(result, finalField)
 		}
 		return result, nil
 	case pgquery.SetOperation_SETOP_NONE:
@@ -534,9 +538,15 @@ func (extractor *sensitiveFieldExtractor) pgExtractSelect(node *pgquery.Node_Sel
 				if resTarget.ResTarget.Name != "" {
 					columnName = resTarget.ResTarget.Name
 				}
+				// The field selecting a column directly keeps the masker of the column.
+				var fieldMasker masker.Masker
+				if sourceField, ok := extractor.pgFindField(extractSchemaTableColumnName(columnRef)); ok {
+					fieldMasker = sourceField.masker
+				}
 				result = append(result, fieldInfo{
 					name:         columnName,
 					maskingLevel: maskingLevel,
+					masker:       fieldMasker,
 				})
 			}
 		default:
@@ -716,6 +726,14 @@ func extractSchemaTableColumnName(columnName *ast.ColumnNameDef) (string, string
 }
 
 func (extractor *sensitiveFieldExtractor) pgCheckFieldMaskingLevel(schemaName string, tableName string, fieldName string) storepb.MaskingLevel {
+	if field, ok := extractor.pgFindField(schemaName, tableName, fieldName); ok {
+		return field.maskingLevel
+	}
+	return defaultMaskingLevel
+}
+
+// pgFindField finds the field referenced by the column name in the outer schemas and the FROM clause.
+func (extractor *sensitiveFieldExtractor) pgFindField(schemaName string, tableName string, fieldName string) (fieldInfo, bool) {
 	// One sub-query may have multi-outer schemas and the multi-outer schemas can use the same name, such as:
 	//
 	//  select (
@@ -735,7 +753,7 @@ func (extractor *sensitiveFieldExtractor) pgCheckFieldMaskingLevel(schemaName st
 			sameTable := (tableName == field.table || tableName == "")
 			sameField := (fieldName == field.name)
 			if sameTable && sameField {
-				return field.maskingLevel
+				return field, true
 			}
 		}
 	}
@@ -744,11 +762,11 @@ func (extractor *sensitiveFieldExtractor) pgCheckFieldMaskingLevel(schemaName st
 		sameTable := (tableName == field.table || tableName == "")
 		sameField := (fieldName == field.name)
 		if sameTable && sameField {
-			return field.maskingLevel
+			return field, true
 		}
 	}
 
-	return defaultMaskingLevel
+	return fieldInfo{}, false
 }
 
 func (extractor *sensitiveFieldExtractor) pgExtractColumnRefFromExpressionNode(in *pgquery.Node) (storepb.MaskingLevel, error) {
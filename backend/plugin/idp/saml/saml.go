@@ -0,0 +1,629 @@
+// Package saml is the plugin for SAML 2.0 Identity Provider.
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"encoding/xml"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/pkg/errors"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	protocolNamespace  = "urn:oasis:names:tc:SAML:2.0:protocol"
+	assertionNamespace = "urn:oasis:names:tc:SAML:2.0:assertion"
+	metadataNamespace  = "urn:oasis:names:tc:SAML:2.0:metadata"
+
+	postBinding             = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	nameIDFormatUnspecified = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	statusSuccess           = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	bearerConfirmation      = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	rsaSHA256SignatureAlg   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+
+	// NameIDField is the field name in the field mapping that refers to the
+	// name identifier of the assertion subject.
+	NameIDField = "NameID"
+
+	// maxClockSkew is the tolerated clock difference between Bytebase and the
+	// identity provider when checking the validity period of an assertion.
+	maxClockSkew = 3 * time.Minute
+	// authnRequestTimeout is how long the user has to sign in at the identity
+	// provider after an AuthnRequest is issued.
+	authnRequestTimeout = 10 * time.Minute
+	// maxReplayCacheSize caps the number of the pending AuthnRequests and the
+	// used assertions each kept in the replay cache.
+	maxReplayCacheSize = 10000
+)
+
+// defaultReplayCache is shared by the identity providers, which are created for
+// each request.
+//
+// The cache is kept in the memory of the process, so the pending sign-ins are
+// lost on restart and have to be started again. It's not shared between
+// replicas either: a SAML response is only accepted by the replica that issued
+// the AuthnRequest, so a deployment with multiple replicas must route the
+// sign-in callback back to the same replica, e.g. with sticky sessions.
+var defaultReplayCache = newReplayCache()
+
+// IdentityProvider represents a SAML 2.0 Identity Provider, for which Bytebase
+// acts as the service provider.
+type IdentityProvider struct {
+	config        IdentityProviderConfig
+	certificate   *x509.Certificate
+	spCertificate *x509.Certificate
+	spPrivateKey  *rsa.PrivateKey
+	replayCache   *replayCache
+}
+
+// IdentityProviderConfig is the configuration to be consumed by the SAML 2.0
+// Identity Provider.
+type IdentityProviderConfig struct {
+	// EntityID is the entity ID of the identity provider, which is the expected
+	// issuer of the SAML responses.
+	EntityID string `json:"entityId"`
+	// SSOURL is the single sign-on URL of the identity provider that the signed
+	// AuthnRequest is sent to with the HTTP-Redirect binding.
+	SSOURL string `json:"ssoUrl"`
+	// Certificate is the PEM encoded X.509 signing certificate of the identity
+	// provider.
+	Certificate string `json:"certificate"`
+	// SPEntityID is the entity ID of Bytebase as the service provider, which is
+	// the expected audience of the assertions.
+	SPEntityID string `json:"spEntityId"`
+	// ACSURL is the assertion consumer service URL of Bytebase that the identity
+	// provider posts the SAML responses to.
+	ACSURL string `json:"acsUrl"`
+	// SPCertificate is the PEM encoded X.509 certificate of the service provider.
+	SPCertificate string `json:"spCertificate"`
+	// SPPrivateKey is the PEM encoded RSA private key of the service provider
+	// used to sign the AuthnRequest.
+	SPPrivateKey string `json:"spPrivateKey"`
+	// FieldMapping is the mapping of the assertion attributes.
+	FieldMapping *storepb.FieldMapping `json:"fieldMapping"`
+}
+
+// NewIdentityProvider initializes a new SAML 2.0 Identity Provider with the
+// given configuration.
+func NewIdentityProvider(config IdentityProviderConfig) (*IdentityProvider, error) {
+	if config.FieldMapping == nil {
+		return nil, errors.Errorf("the field %q is empty but required", "fieldMapping")
+	}
+	for v, field := range map[string]string{
+		config.EntityID:                "entityId",
+		config.SSOURL:                  "ssoUrl",
+		config.Certificate:             "certificate",
+		config.SPEntityID:              "spEntityId",
+		config.ACSURL:                  "acsUrl",
+		config.SPCertificate:           "spCertificate",
+		config.SPPrivateKey:            "spPrivateKey",
+		config.FieldMapping.Identifier: "fieldMapping.identifier",
+	} {
+		if v == "" {
+			return nil, errors.Errorf("the field %q is empty but required", field)
+		}
+	}
+	if _, err := url.ParseRequestURI(config.SSOURL); err != nil {
+		return nil, errors.Errorf("invalid SSO URL %q: %v", config.SSOURL, err)
+	}
+
+	certificate, err := parseCertificate(config.Certificate)
+	if err != nil {
+		return nil, errors.Errorf("parse identity provider certificate: %v", err)
+	}
+	spCertificate, err := parseCertificate(config.SPCertificate)
+	if err != nil {
+		return nil, errors.Errorf("parse service provider certificate: %v", err)
+	}
+	spPrivateKey, err := parsePrivateKey(config.SPPrivateKey)
+	if err != nil {
+		return nil, errors.Errorf("parse service provider private key: %v", err)
+	}
+	if !spPrivateKey.PublicKey.Equal(spCertificate.PublicKey) {
+		return nil, errors.New("the service provider private key does not match the service provider certificate")
+	}
+
+	return &IdentityProvider{
+		config:        config,
+		certificate:   certificate,
+		spCertificate: spCertificate,
+		spPrivateKey:  spPrivateKey,
+		replayCache:   defaultReplayCache,
+	}, nil
+}
+
+// Metadata returns the XML metadata of Bytebase as the service provider, which
+// can be imported by the identity provider, e.g. as an ADFS relying party trust.
+func (p *IdentityProvider) Metadata() ([]byte, error) {
+	doc := etree.NewDocument()
+	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8"`)
+	entity := doc.CreateElement("md:EntityDescriptor")
+	entity.CreateAttr("xmlns:md", metadataNamespace)
+	entity.CreateAttr("xmlns:ds", dsig.Namespace)
+	entity.CreateAttr("entityID", p.config.SPEntityID)
+
+	descriptor := entity.CreateElement("md:SPSSODescriptor")
+	descriptor.CreateAttr("AuthnRequestsSigned", "true")
+	descriptor.CreateAttr("WantAssertionsSigned", "true")
+	descriptor.CreateAttr("protocolSupportEnumeration", protocolNamespace)
+
+	keyDescriptor := descriptor.CreateElement("md:KeyDescriptor")
+	keyDescriptor.CreateAttr("use", "signing")
+	keyDescriptor.CreateElement("ds:KeyInfo").
+		CreateElement("ds:X509Data").
+		CreateElement("ds:X509Certificate").
+		SetText(base64.StdEncoding.EncodeToString(p.spCertificate.Raw))
+
+	descriptor.CreateElement("md:NameIDFormat").SetText(nameIDFormatUnspecified)
+
+	acs := descriptor.CreateElement("md:AssertionConsumerService")
+	acs.CreateAttr("Binding", postBinding)
+	acs.CreateAttr("Location", p.config.ACSURL)
+	acs.CreateAttr("index", "0")
+	acs.CreateAttr("isDefault", "true")
+
+	doc.Indent(2)
+	return doc.WriteToBytes()
+}
+
+// AuthnRequestURL returns the URL of the identity provider to redirect the user
+// to, carrying a signed AuthnRequest with the HTTP-Redirect binding. The relay
+// state is posted back by the identity provider along with the SAML response.
+// The request ID is recorded so that only the response to it is accepted.
+func (p *IdentityProvider) AuthnRequestURL(relayState string) (string, error) {
+	id := make([]byte, 20)
+	if _, err := rand.Read(id); err != nil {
+		return "", errors.Errorf("generate request ID: %v", err)
+	}
+	requestID := "id-" + hex.EncodeToString(id)
+
+	doc := etree.NewDocument()
+	request := doc.CreateElement("samlp:AuthnRequest")
+	request.CreateAttr("xmlns:samlp", protocolNamespace)
+	request.CreateAttr("xmlns:saml", assertionNamespace)
+	request.CreateAttr("ID", requestID)
+	request.CreateAttr("Version", "2.0")
+	request.CreateAttr("IssueInstant", time.Now().UTC().Format(time.RFC3339))
+	request.CreateAttr("Destination", p.config.SSOURL)
+	request.CreateAttr("AssertionConsumerServiceURL", p.config.ACSURL)
+	request.CreateAttr("ProtocolBinding", postBinding)
+	request.CreateElement("saml:Issuer").SetText(p.config.SPEntityID)
+	nameIDPolicy := request.CreateElement("samlp:NameIDPolicy")
+	nameIDPolicy.CreateAttr("Format", nameIDFormatUnspecified)
+	nameIDPolicy.CreateAttr("AllowCreate", "true")
+	raw, err := doc.WriteToBytes()
+	if err != nil {
+		return "", errors.Errorf("serialize AuthnRequest: %v", err)
+	}
+
+	// The HTTP-Redirect binding requires the message to be deflated and base64
+	// encoded, and the signature to cover the query string in a fixed order.
+	var buf bytes.Buffer
+	w, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return "", errors.Errorf("create deflate writer: %v", err)
+	}
+	if _, err := w.Write(raw); err != nil {
+		return "", errors.Errorf("deflate AuthnRequest: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return "", errors.Errorf("deflate AuthnRequest: %v", err)
+	}
+	query := "SAMLRequest=" + url.QueryEscape(base64.StdEncoding.EncodeToString(buf.Bytes()))
+	if relayState != "" {
+		query += "&RelayState=" + url.QueryEscape(relayState)
+	}
+	query += "&SigAlg=" + url.QueryEscape(rsaSHA256SignatureAlg)
+	digest := sha256.Sum256([]byte(query))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.spPrivateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", errors.Errorf("sign AuthnRequest: %v", err)
+	}
+	query += "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+
+	separator := "?"
+	if strings.Contains(p.config.SSOURL, "?") {
+		separator = "&"
+	}
+	p.replayCache.addRequest(requestID, time.Now().Add(authnRequestTimeout))
+	return p.config.SSOURL + separator + query, nil
+}
+
+// UserInfo validates the base64 encoded SAML response posted to the assertion
+// consumer service, and returns the user info mapped from the assertion.
+//
+// Either the response or the assertion must be signed by the identity provider
+// certificate. Only the signed content is read to prevent XML signature
+// wrapping attacks. The assertion must answer an AuthnRequest issued by
+// AuthnRequestURL, and each request and assertion is accepted only once to
+// prevent the captured SAML responses from being replayed.
+func (p *IdentityProvider) UserInfo(samlResponse string) (*storepb.IdentityProviderUserInfo, error) {
+	raw, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(samlResponse), ""))
+	if err != nil {
+		return nil, errors.Errorf("decode SAML response: %v", err)
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(raw); err != nil {
+		return nil, errors.Errorf("parse SAML response: %v", err)
+	}
+	root := doc.Root()
+	if root == nil {
+		return nil, errors.New("empty SAML response")
+	}
+
+	validationContext := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+		Roots: []*x509.Certificate{p.certificate},
+	})
+
+	var assertionElement *etree.Element
+	responseElement, err := validationContext.Validate(root)
+	switch {
+	case err == nil:
+		// The whole response is signed, so is the assertion inside.
+		assertionElement, err = findAssertion(responseElement)
+		if err != nil {
+			return nil, err
+		}
+	case errors.Is(err, dsig.ErrMissingSignature):
+		// Only the assertion is signed, which is the ADFS default.
+		responseElement = root
+		unsignedAssertion, err := findAssertion(root)
+		if err != nil {
+			return nil, err
+		}
+		assertionElement, err = validationContext.Validate(unsignedAssertion)
+		if err != nil {
+			return nil, errors.Errorf("validate assertion signature: %v", err)
+		}
+	default:
+		return nil, errors.Errorf("validate response signature: %v", err)
+	}
+
+	var resp response
+	if err := unmarshalElement(responseElement, &resp); err != nil {
+		return nil, errors.Errorf("unmarshal SAML response: %v", err)
+	}
+	if resp.Status.StatusCode.Value != statusSuccess {
+		return nil, errors.Errorf("unsuccessful SAML response status %q: %s", resp.Status.StatusCode.Value, resp.Status.StatusMessage)
+	}
+	if resp.Destination != "" && resp.Destination != p.config.ACSURL {
+		return nil, errors.Errorf("unexpected response destination %q, expected %q", resp.Destination, p.config.ACSURL)
+	}
+	if resp.Issuer != "" && resp.Issuer != p.config.EntityID {
+		return nil, errors.Errorf("unexpected response issuer %q, expected %q", resp.Issuer, p.config.EntityID)
+	}
+
+	var a assertion
+	if err := unmarshalElement(assertionElement, &a); err != nil {
+		return nil, errors.Errorf("unmarshal assertion: %v", err)
+	}
+	if err := p.validateAssertion(&a, resp.InResponseTo); err != nil {
+		return nil, err
+	}
+
+	return p.mapUserInfo(a.attributes())
+}
+
+func (p *IdentityProvider) validateAssertion(a *assertion, responseInResponseTo string) error {
+	now := time.Now()
+	if a.ID == "" {
+		return errors.New("the assertion has no ID")
+	}
+	if a.Issuer != p.config.EntityID {
+		return errors.Errorf("unexpected assertion issuer %q, expected %q", a.Issuer, p.config.EntityID)
+	}
+
+	if a.Conditions == nil {
+		return errors.New("the assertion has no conditions")
+	}
+	if !a.Conditions.NotBefore.IsZero() && now.Add(maxClockSkew).Before(a.Conditions.NotBefore) {
+		return errors.Errorf("the assertion is not valid before %s", a.Conditions.NotBefore.Format(time.RFC3339))
+	}
+	if !a.Conditions.NotOnOrAfter.IsZero() && !now.Add(-maxClockSkew).Before(a.Conditions.NotOnOrAfter) {
+		return errors.Errorf("the assertion has expired at %s", a.Conditions.NotOnOrAfter.Format(time.RFC3339))
+	}
+	if len(a.Conditions.AudienceRestrictions) == 0 {
+		return errors.New("the assertion has no audience restriction")
+	}
+	// Each audience restriction must be satisfied on its own.
+	for _, restriction := range a.Conditions.AudienceRestrictions {
+		found := false
+		for _, audience := range restriction.Audiences {
+			if strings.TrimSpace(audience) == p.config.SPEntityID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("the assertion audiences %q do not contain %q", restriction.Audiences, p.config.SPEntityID)
+		}
+	}
+
+	// The request ID is read from the subject confirmation because the response
+	// element may not be signed.
+	var inResponseTo string
+	var confirmationNotOnOrAfter time.Time
+	bearerConfirmed := false
+	for _, confirmation := range a.Subject.SubjectConfirmations {
+		if confirmation.Method != bearerConfirmation {
+			continue
+		}
+		data := confirmation.SubjectConfirmationData
+		if data.Recipient != "" && data.Recipient != p.config.ACSURL {
+			continue
+		}
+		if !data.NotOnOrAfter.IsZero() && !now.Add(-maxClockSkew).Before(data.NotOnOrAfter) {
+			continue
+		}
+		if data.InResponseTo == "" || (responseInResponseTo != "" && data.InResponseTo != responseInResponseTo) {
+			continue
+		}
+		inResponseTo, confirmationNotOnOrAfter = data.InResponseTo, data.NotOnOrAfter
+		bearerConfirmed = true
+		break
+	}
+	if !bearerConfirmed {
+		return errors.New("the assertion has no valid bearer subject confirmation in response to a request")
+	}
+
+	// Keep the assertion ID until the assertion expires. An assertion without
+	// the expiry must answer a request issued within the request timeout.
+	expireTime := a.Conditions.NotOnOrAfter
+	if expireTime.IsZero() || (!confirmationNotOnOrAfter.IsZero() && confirmationNotOnOrAfter.After(expireTime)) {
+		expireTime = confirmationNotOnOrAfter
+	}
+	if expireTime.IsZero() {
+		expireTime = now.Add(authnRequestTimeout)
+	}
+	return p.replayCache.use(inResponseTo, a.ID, expireTime.Add(maxClockSkew))
+}
+
+func (p *IdentityProvider) mapUserInfo(attributes map[string]string) (*storepb.IdentityProviderUserInfo, error) {
+	identifier := attributes[p.config.FieldMapping.Identifier]
+	if identifier == "" {
+		return nil, errors.Errorf("the attribute %q is not found or has empty value", p.config.FieldMapping.Identifier)
+	}
+	userInfo := &storepb.IdentityProviderUserInfo{
+		Identifier: identifier,
+	}
+
+	// Best effort to map optional fields
+	if p.config.FieldMapping.DisplayName != "" {
+		userInfo.DisplayName = attributes[p.config.FieldMapping.DisplayName]
+	}
+	if userInfo.DisplayName == "" {
+		userInfo.DisplayName = userInfo.Identifier
+	}
+	if p.config.FieldMapping.Email != "" {
+		userInfo.Email = attributes[p.config.FieldMapping.Email]
+	}
+	if p.config.FieldMapping.Phone != "" {
+		userInfo.Phone = attributes[p.config.FieldMapping.Phone]
+	}
+	return userInfo, nil
+}
+
+// findAssertion returns the only assertion of the response, detached with its
+// namespace declarations so that its signature can be validated on its own.
+func findAssertion(responseElement *etree.Element) (*etree.Element, error) {
+	nsContext, err := etreeutils.NewDefaultNSContext().SubContext(responseElement)
+	if err != nil {
+		return nil, errors.Errorf("parse SAML response namespaces: %v", err)
+	}
+	if encrypted, err := etreeutils.NSFindOneChildCtx(nsContext, responseElement, assertionNamespace, "EncryptedAssertion"); err != nil {
+		return nil, errors.Errorf("find encrypted assertion: %v", err)
+	} else if encrypted != nil {
+		return nil, errors.New("encrypted assertions are not supported, please disable assertion encryption in the identity provider")
+	}
+
+	var assertions []*etree.Element
+	if err := etreeutils.NSFindChildrenIterateCtx(nsContext, responseElement, assertionNamespace, "Assertion", func(ctx etreeutils.NSContext, el *etree.Element) error {
+		detached, err := etreeutils.NSDetatch(ctx, el)
+		if err != nil {
+			return err
+		}
+		assertions = append(assertions, detached)
+		return nil
+	}); err != nil {
+		return nil, errors.Errorf("find assertion: %v", err)
+	}
+	if len(assertions) != 1 {
+		return nil, errors.Errorf("expect 1 assertion but got %d", len(assertions))
+	}
+	return assertions[0], nil
+}
+
+func unmarshalElement(el *etree.Element, v any) error {
+	doc := etree.NewDocument()
+	doc.SetRoot(el.Copy())
+	b, err := doc.WriteToBytes()
+	if err != nil {
+		return err
+	}
+	return xml.Unmarshal(b, v)
+}
+
+// parseCertificate parses the PEM encoded certificate. The base64 encoded DER
+// without the PEM header, e.g. the ADFS token-signing certificate copied from
+// its metadata, is accepted as well.
+func parseCertificate(s string) (*x509.Certificate, error) {
+	var der []byte
+	if block, _ := pem.Decode([]byte(s)); block != nil {
+		der = block.Bytes
+	} else {
+		b, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+		if err != nil {
+			return nil, errors.New("certificate is neither PEM nor base64 encoded")
+		}
+		der = b
+	}
+	return x509.ParseCertificate(der)
+}
+
+func parsePrivateKey(s string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("only RSA private key is supported")
+	}
+	return rsaKey, nil
+}
+
+type response struct {
+	Destination  string `xml:"Destination,attr"`
+	InResponseTo string `xml:"InResponseTo,attr"`
+	Issuer       string `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	Status       struct {
+		StatusCode struct {
+			Value string `xml:"Value,attr"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:protocol StatusCode"`
+		StatusMessage string `xml:"urn:oasis:names:tc:SAML:2.0:protocol StatusMessage"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:protocol Status"`
+}
+
+type assertion struct {
+	ID      string `xml:"ID,attr"`
+	Issuer  string `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	Subject struct {
+		NameID               string `xml:"urn:oasis:names:tc:SAML:2.0:assertion NameID"`
+		SubjectConfirmations []struct {
+			Method                  string `xml:"Method,attr"`
+			SubjectConfirmationData struct {
+				Recipient    string    `xml:"Recipient,attr"`
+				NotOnOrAfter time.Time `xml:"NotOnOrAfter,attr"`
+				InResponseTo string    `xml:"InResponseTo,attr"`
+			} `xml:"urn:oasis:names:tc:SAML:2.0:assertion SubjectConfirmationData"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:assertion SubjectConfirmation"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion Subject"`
+	Conditions *struct {
+		NotBefore            time.Time `xml:"NotBefore,attr"`
+		NotOnOrAfter         time.Time `xml:"NotOnOrAfter,attr"`
+		AudienceRestrictions []struct {
+			Audiences []string `xml:"urn:oasis:names:tc:SAML:2.0:assertion Audience"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:assertion AudienceRestriction"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion Conditions"`
+	AttributeStatements []struct {
+		Attributes []struct {
+			Name   string   `xml:"Name,attr"`
+			Values []string `xml:"urn:oasis:names:tc:SAML:2.0:assertion AttributeValue"`
+		} `xml:"urn:oasis:names:tc:SAML:2.0:assertion Attribute"`
+	} `xml:"urn:oasis:names:tc:SAML:2.0:assertion AttributeStatement"`
+}
+
+// attributes returns the first value of each assertion attribute keyed by the
+// attribute name, along with the subject name identifier keyed by "NameID".
+// The attribute names are looked up as is because claim types like
+// "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/upn" contain dots.
+func (a *assertion) attributes() map[string]string {
+	attributes := map[string]string{}
+	for _, statement := range a.AttributeStatements {
+		for _, attribute := range statement.Attributes {
+			if len(attribute.Values) == 0 {
+				continue
+			}
+			if _, ok := attributes[attribute.Name]; !ok {
+				attributes[attribute.Name] = strings.TrimSpace(attribute.Values[0])
+			}
+		}
+	}
+	if nameID := strings.TrimSpace(a.Subject.NameID); nameID != "" {
+		attributes[NameIDField] = nameID
+	}
+	return attributes
+}
+
+// replayCache records the issued AuthnRequest IDs and the used assertion IDs
+// until they expire. Both are capped at maxReplayCacheSize, as the requests are
+// issued to unauthenticated callers.
+type replayCache struct {
+	mu         sync.Mutex
+	requests   map[string]time.Time
+	assertions map[string]time.Time
+}
+
+func newReplayCache() *replayCache {
+	return &replayCache{
+		requests:   map[string]time.Time{},
+		assertions: map[string]time.Time{},
+	}
+}
+
+func (c *replayCache) addRequest(requestID string, expireTime time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prune(time.Now())
+	if len(c.requests) >= maxReplayCacheSize {
+		// Evict the oldest pending request, whose sign-in fails and has to be
+		// started again.
+		var oldestID string
+		var oldestExpireTime time.Time
+		for id, t := range c.requests {
+			if oldestID == "" || t.Before(oldestExpireTime) {
+				oldestID, oldestExpireTime = id, t
+			}
+		}
+		delete(c.requests, oldestID)
+	}
+	c.requests[requestID] = expireTime
+}
+
+// use consumes the request and records the assertion, and returns an error if
+// the request is unknown or the assertion has been used.
+func (c *replayCache) use(requestID, assertionID string, expireTime time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.prune(time.Now())
+	if _, ok := c.assertions[assertionID]; ok {
+		return errors.Errorf("the assertion %q has been used", assertionID)
+	}
+	if _, ok := c.requests[requestID]; !ok {
+		return errors.Errorf("the assertion is in response to an unknown or used request %q", requestID)
+	}
+	// The used assertions are never evicted before they expire, otherwise they
+	// could be replayed.
+	if len(c.assertions) >= maxReplayCacheSize {
+		return errors.New("too many sign-ins in progress, please try again later")
+	}
+	delete(c.requests, requestID)
+	c.assertions[assertionID] = expireTime
+	return nil
+}
+
+func (c *replayCache) prune(now time.Time) {
+	for id, expireTime := range c.requests {
+		if !now.Before(expireTime) {
+			delete(c.requests, id)
+		}
+	}
+	for id, expireTime := range c.assertions {
+		if !now.Before(expireTime) {
+			delete(c.assertions, id)
+		}
+	}
+}
@@ -0,0 +1,492 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	testIDPEntityID = "http://adfs.example.com/adfs/services/trust"
+	testSPEntityID  = "https://bytebase.example.com/saml/metadata/adfs"
+	testACSURL      = "https://bytebase.example.com/saml/acs/adfs"
+
+	claimUPN  = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/upn"
+	claimName = "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name"
+)
+
+type testKeyPair struct {
+	key     *rsa.PrivateKey
+	cert    *x509.Certificate
+	certPEM string
+	keyPEM  string
+}
+
+func newTestKeyPair(t *testing.T, commonName string) *testKeyPair {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &testKeyPair{
+		key:     key,
+		cert:    cert,
+		certPEM: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		keyPEM:  string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})),
+	}
+}
+
+func newTestIdentityProvider(t *testing.T, idpKeyPair, spKeyPair *testKeyPair) *IdentityProvider {
+	p, err := NewIdentityProvider(IdentityProviderConfig{
+		EntityID:      testIDPEntityID,
+		SSOURL:        "https://adfs.example.com/adfs/ls/",
+		Certificate:   idpKeyPair.certPEM,
+		SPEntityID:    testSPEntityID,
+		ACSURL:        testACSURL,
+		SPCertificate: spKeyPair.certPEM,
+		SPPrivateKey:  spKeyPair.keyPEM,
+		FieldMapping: &storepb.FieldMapping{
+			Identifier:  claimUPN,
+			DisplayName: claimName,
+			Email:       NameIDField,
+		},
+	})
+	require.NoError(t, err)
+	p.replayCache = newReplayCache()
+	return p
+}
+
+type testAssertion struct {
+	id           string
+	inResponseTo string
+	audience     string
+	recipient    string
+	notBefore    time.Time
+	notOnOrAfter time.Time
+	upn          string
+}
+
+// newTestAssertion returns an assertion in response to a new request issued by
+// the identity provider.
+func newTestAssertion(p *IdentityProvider) testAssertion {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	id := fmt.Sprintf("%x", b)
+	p.replayCache.addRequest("id-"+id, time.Now().Add(authnRequestTimeout))
+	return testAssertion{
+		id:           "_assertion-" + id,
+		inResponseTo: "id-" + id,
+		audience:     testSPEntityID,
+		recipient:    testACSURL,
+		notBefore:    time.Now().Add(-time.Minute),
+		notOnOrAfter: time.Now().Add(time.Hour),
+		upn:          "alice@example.com",
+	}
+}
+
+func (a testAssertion) element(t *testing.T) *etree.Element {
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromString(fmt.Sprintf(`<Assertion xmlns="urn:oasis:names:tc:SAML:2.0:assertion" ID="%[9]s" IssueInstant="%[3]s" Version="2.0">
+  <Issuer>%[1]s</Issuer>
+  <Subject>
+    <NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">alice.smith@example.com</NameID>
+    <SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer">
+      <SubjectConfirmationData InResponseTo="%[10]s" NotOnOrAfter="%[4]s" Recipient="%[5]s"/>
+    </SubjectConfirmation>
+  </Subject>
+  <Conditions NotBefore="%[3]s" NotOnOrAfter="%[4]s">
+    <AudienceRestriction>
+      <Audience>%[2]s</Audience>
+    </AudienceRestriction>
+  </Conditions>
+  <AttributeStatement>
+    <Attribute Name="%[6]s"><AttributeValue>%[7]s</AttributeValue></Attribute>
+    <Attribute Name="%[8]s"><AttributeValue>Alice Smith</AttributeValue></Attribute>
+  </AttributeStatement>
+</Assertion>`,
+		testIDPEntityID,
+		a.audience,
+		a.notBefore.UTC().Format(time.RFC3339),
+		a.notOnOrAfter.UTC().Format(time.RFC3339),
+		a.recipient,
+		claimUPN,
+		a.upn,
+		claimName,
+		a.id,
+		a.inResponseTo,
+	)))
+	return doc.Root()
+}
+
+func sign(t *testing.T, keyPair *testKeyPair, el *etree.Element) *etree.Element {
+	signingContext, err := dsig.NewSigningContext(keyPair.key, [][]byte{keyPair.cert.Raw})
+	require.NoError(t, err)
+	signingContext.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	signed, err := signingContext.SignEnveloped(el)
+	require.NoError(t, err)
+	return signed
+}
+
+// buildResponse wraps the assertion in a SAML response, and returns it base64
+// encoded as posted by the identity provider.
+func buildResponse(t *testing.T, assertion *etree.Element, signResponseWith *testKeyPair) string {
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromString(fmt.Sprintf(`<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" ID="_response" Version="2.0" IssueInstant="%s" Destination="%s">
+  <Issuer xmlns="urn:oasis:names:tc:SAML:2.0:assertion">%s</Issuer>
+  <samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>
+</samlp:Response>`, time.Now().UTC().Format(time.RFC3339), testACSURL, testIDPEntityID)))
+	response := doc.Root()
+	response.AddChild(assertion)
+	if signResponseWith != nil {
+		response = sign(t, signResponseWith, response)
+	}
+	doc.SetRoot(response)
+	b, err := doc.WriteToBytes()
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestNewIdentityProvider(t *testing.T) {
+	idpKeyPair := newTestKeyPair(t, "adfs.example.com")
+	spKeyPair := newTestKeyPair(t, "bytebase.example.com")
+	validConfig := func() IdentityProviderConfig {
+		return IdentityProviderConfig{
+			EntityID:      testIDPEntityID,
+			SSOURL:        "https://adfs.example.com/adfs/ls/",
+			Certificate:   idpKeyPair.certPEM,
+			SPEntityID:    testSPEntityID,
+			ACSURL:        testACSURL,
+			SPCertificate: spKeyPair.certPEM,
+			SPPrivateKey:  spKeyPair.keyPEM,
+			FieldMapping: &storepb.FieldMapping{
+				Identifier: NameIDField,
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		modify      func(*IdentityProviderConfig)
+		containsErr string
+	}{
+		{
+			name:   "valid",
+			modify: func(*IdentityProviderConfig) {},
+		},
+		{
+			name: "base64 certificate without PEM header",
+			modify: func(c *IdentityProviderConfig) {
+				c.Certificate = base64.StdEncoding.EncodeToString(idpKeyPair.cert.Raw)
+			},
+		},
+		{
+			name: "no entity ID",
+			modify: func(c *IdentityProviderConfig) {
+				c.EntityID = ""
+			},
+			containsErr: `the field "entityId" is empty but required`,
+		},
+		{
+			name: "no identifier mapping",
+			modify: func(c *IdentityProviderConfig) {
+				c.FieldMapping.Identifier = ""
+			},
+			containsErr: `the field "fieldMapping.identifier" is empty but required`,
+		},
+		{
+			name: "invalid certificate",
+			modify: func(c *IdentityProviderConfig) {
+				c.Certificate = "not a certificate"
+			},
+			containsErr: "parse identity provider certificate",
+		},
+		{
+			name: "mismatched private key",
+			modify: func(c *IdentityProviderConfig) {
+				c.SPPrivateKey = idpKeyPair.keyPEM
+			},
+			containsErr: "does not match",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := validConfig()
+			test.modify(&config)
+			_, err := NewIdentityProvider(config)
+			if test.containsErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	spKeyPair := newTestKeyPair(t, "bytebase.example.com")
+	p := newTestIdentityProvider(t, newTestKeyPair(t, "adfs.example.com"), spKeyPair)
+
+	metadata, err := p.Metadata()
+	require.NoError(t, err)
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromBytes(metadata))
+
+	root := doc.Root()
+	assert.Equal(t, testSPEntityID, root.SelectAttrValue("entityID", ""))
+	descriptor := root.SelectElement("SPSSODescriptor")
+	require.NotNil(t, descriptor)
+	assert.Equal(t, "true", descriptor.SelectAttrValue("AuthnRequestsSigned", ""))
+	assert.Equal(t, "true", descriptor.SelectAttrValue("WantAssertionsSigned", ""))
+	acs := descriptor.SelectElement("AssertionConsumerService")
+	require.NotNil(t, acs)
+	assert.Equal(t, testACSURL, acs.SelectAttrValue("Location", ""))
+	assert.Equal(t, postBinding, acs.SelectAttrValue("Binding", ""))
+	cert := descriptor.FindElement("./KeyDescriptor/KeyInfo/X509Data/X509Certificate")
+	require.NotNil(t, cert)
+	assert.Equal(t, base64.StdEncoding.EncodeToString(spKeyPair.cert.Raw), cert.Text())
+}
+
+func TestAuthnRequestURL(t *testing.T) {
+	spKeyPair := newTestKeyPair(t, "bytebase.example.com")
+	p := newTestIdentityProvider(t, newTestKeyPair(t, "adfs.example.com"), spKeyPair)
+
+	rawURL, err := p.AuthnRequestURL("/project/sample")
+	require.NoError(t, err)
+	u, err := url.Parse(rawURL)
+	require.NoError(t, err)
+	assert.Equal(t, "adfs.example.com", u.Host)
+	query := u.Query()
+	assert.Equal(t, "/project/sample", query.Get("RelayState"))
+	assert.Equal(t, rsaSHA256SignatureAlg, query.Get("SigAlg"))
+
+	// The signature covers the raw query string before the signature.
+	signed := u.RawQuery[:strings.Index(u.RawQuery, "&Signature=")]
+	signature, err := base64.StdEncoding.DecodeString(query.Get("Signature"))
+	require.NoError(t, err)
+	digest := sha256.Sum256([]byte(signed))
+	require.NoError(t, rsa.VerifyPKCS1v15(&spKeyPair.key.PublicKey, crypto.SHA256, digest[:], signature))
+
+	deflated, err := base64.StdEncoding.DecodeString(query.Get("SAMLRequest"))
+	require.NoError(t, err)
+	inflated, err := io.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	require.NoError(t, err)
+	doc := etree.NewDocument()
+	require.NoError(t, doc.ReadFromBytes(inflated))
+	request := doc.Root()
+	assert.Equal(t, "AuthnRequest", request.Tag)
+	assert.Equal(t, testACSURL, request.SelectAttrValue("AssertionConsumerServiceURL", ""))
+	assert.Equal(t, "https://adfs.example.com/adfs/ls/", request.SelectAttrValue("Destination", ""))
+	issuer := request.SelectElement("Issuer")
+	require.NotNil(t, issuer)
+	assert.Equal(t, testSPEntityID, issuer.Text())
+	assert.Contains(t, p.replayCache.requests, request.SelectAttrValue("ID", ""))
+}
+
+func TestUserInfo(t *testing.T) {
+	idpKeyPair := newTestKeyPair(t, "adfs.example.com")
+	otherKeyPair := newTestKeyPair(t, "attacker.example.com")
+	p := newTestIdentityProvider(t, idpKeyPair, newTestKeyPair(t, "bytebase.example.com"))
+
+	wantUserInfo := &storepb.IdentityProviderUserInfo{
+		Identifier:  "alice@example.com",
+		DisplayName: "Alice Smith",
+		Email:       "alice.smith@example.com",
+	}
+	tests := []struct {
+		name         string
+		samlResponse func() string
+		containsErr  string
+	}{
+		{
+			name: "signed assertion",
+			samlResponse: func() string {
+				return buildResponse(t, sign(t, idpKeyPair, newTestAssertion(p).element(t)), nil)
+			},
+		},
+		{
+			name: "signed response",
+			samlResponse: func() string {
+				return buildResponse(t, newTestAssertion(p).element(t), idpKeyPair)
+			},
+		},
+		{
+			name: "not yet valid within clock skew",
+			samlResponse: func() string {
+				a := newTestAssertion(p)
+				a.notBefore = time.Now().Add(time.Minute)
+				return buildResponse(t, sign(t, idpKeyPair, a.element(t)), nil)
+			},
+		},
+		{
+			name: "unsigned",
+			samlResponse: func() string {
+				return buildResponse(t, newTestAssertion(p).element(t), nil)
+			},
+			containsErr: "validate assertion signature",
+		},
+		{
+			name: "signed by untrusted certificate",
+			samlResponse: func() string {
+				return buildResponse(t, sign(t, otherKeyPair, newTestAssertion(p).element(t)), nil)
+			},
+			containsErr: "validate assertion signature",
+		},
+		{
+			name: "tampered after signing",
+			samlResponse: func() string {
+				signed := sign(t, idpKeyPair, newTestAssertion(p).element(t))
+				signed.FindElement("//AttributeValue").SetText("mallory@example.com")
+				return buildResponse(t, signed, nil)
+			},
+			containsErr: "validate assertion signature",
+		},
+		{
+			name: "wrong audience",
+			samlResponse: func() string {
+				a := newTestAssertion(p)
+				a.audience = "https://other.example.com"
+				return buildResponse(t, sign(t, idpKeyPair, a.element(t)), nil)
+			},
+			containsErr: "do not contain",
+		},
+		{
+			name: "wrong recipient",
+			samlResponse: func() string {
+				a := newTestAssertion(p)
+				a.recipient = "https://other.example.com/acs"
+				return buildResponse(t, sign(t, idpKeyPair, a.element(t)), nil)
+			},
+			containsErr: "no valid bearer subject confirmation",
+		},
+		{
+			name: "expired beyond clock skew",
+			samlResponse: func() string {
+				a := newTestAssertion(p)
+				a.notBefore = time.Now().Add(-time.Hour)
+				a.notOnOrAfter = time.Now().Add(-maxClockSkew - time.Minute)
+				return buildResponse(t, sign(t, idpKeyPair, a.element(t)), nil)
+			},
+			containsErr: "the assertion has expired",
+		},
+		{
+			name: "not yet valid beyond clock skew",
+			samlResponse: func() string {
+				a := newTestAssertion(p)
+				a.notBefore = time.Now().Add(maxClockSkew + time.Minute)
+				return buildResponse(t, sign(t, idpKeyPair, a.element(t)), nil)
+			},
+			containsErr: "the assertion is not valid before",
+		},
+		{
+			name: "missing identifier",
+			samlResponse: func() string {
+				a := newTestAssertion(p)
+				a.upn = ""
+				return buildResponse(t, sign(t, idpKeyPair, a.element(t)), nil)
+			},
+			containsErr: fmt.Sprintf("the attribute %q is not found or has empty value", claimUPN),
+		},
+		{
+			name: "unsolicited",
+			samlResponse: func() string {
+				a := newTestAssertion(p)
+				a.inResponseTo = ""
+				return buildResponse(t, sign(t, idpKeyPair, a.element(t)), nil)
+			},
+			containsErr: "no valid bearer subject confirmation",
+		},
+		{
+			name: "in response to unknown request",
+			samlResponse: func() string {
+				a := newTestAssertion(p)
+				a.inResponseTo = "id-unknown"
+				return buildResponse(t, sign(t, idpKeyPair, a.element(t)), nil)
+			},
+			containsErr: "unknown or used request",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			userInfo, err := p.UserInfo(test.samlResponse())
+			if test.containsErr == "" {
+				require.NoError(t, err)
+				assert.Equal(t, wantUserInfo, userInfo)
+				return
+			}
+			require.ErrorContains(t, err, test.containsErr)
+		})
+	}
+}
+
+func TestUserInfoReplay(t *testing.T) {
+	idpKeyPair := newTestKeyPair(t, "adfs.example.com")
+	p := newTestIdentityProvider(t, idpKeyPair, newTestKeyPair(t, "bytebase.example.com"))
+
+	a := newTestAssertion(p)
+	samlResponse := buildResponse(t, sign(t, idpKeyPair, a.element(t)), nil)
+	_, err := p.UserInfo(samlResponse)
+	require.NoError(t, err)
+	_, err = p.UserInfo(samlResponse)
+	require.ErrorContains(t, err, "has been used")
+
+	// The used assertion is rejected even in response to another request.
+	replayed := newTestAssertion(p)
+	replayed.id = a.id
+	_, err = p.UserInfo(buildResponse(t, sign(t, idpKeyPair, replayed.element(t)), nil))
+	require.ErrorContains(t, err, "has been used")
+}
+
+func TestReplayCachePrune(t *testing.T) {
+	c := newReplayCache()
+	c.addRequest("expired", time.Now().Add(-time.Second))
+	c.addRequest("request", time.Now().Add(time.Minute))
+	require.ErrorContains(t, c.use("expired", "assertion", time.Now().Add(time.Minute)), "unknown or used request")
+	require.NoError(t, c.use("request", "assertion", time.Now().Add(-time.Second)))
+	// The assertion is forgotten once it expires.
+	c.addRequest("another", time.Now().Add(time.Minute))
+	require.NoError(t, c.use("another", "assertion", time.Now().Add(time.Minute)))
+}
+
+func TestReplayCacheSize(t *testing.T) {
+	c := newReplayCache()
+	for i := 0; i <= maxReplayCacheSize; i++ {
+		c.addRequest(fmt.Sprintf("request-%d", i), time.Now().Add(time.Minute+time.Duration(i)*time.Millisecond))
+	}
+	require.Len(t, c.requests, maxReplayCacheSize)
+	// The oldest request is evicted.
+	require.ErrorContains(t, c.use("request-0", "assertion", time.Now().Add(time.Minute)), "unknown or used request")
+
+	for i := 0; i < maxReplayCacheSize; i++ {
+		c.assertions[fmt.Sprintf("assertion-%d", i)] = time.Now().Add(time.Minute)
+	}
+	require.ErrorContains(t, c.use("request-1", "assertion", time.Now().Add(time.Minute)), "too many sign-ins")
+	require.Contains(t, c.requests, "request-1")
+}
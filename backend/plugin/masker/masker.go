@@ -0,0 +1,303 @@
+// Package masker implements the masking algorithms applied to the values of the sensitive columns.
+package masker
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	// DefaultFullMaskSubstitution is the substitution of the default full masker.
+	DefaultFullMaskSubstitution = "******"
+	defaultKeepSubstitution     = "*"
+	defaultPhoneSuffixLength    = 4
+)
+
+// Masker masks the values of a sensitive column.
+type Masker interface {
+	// Mask returns the masked value of the value.
+	// The masked value is always a string value, so that it does not claim the column type.
+	Mask(value *v1pb.RowValue) *v1pb.RowValue
+}
+
+// NewDefaultFullMasker returns the masker replacing the values with "******".
+func NewDefaultFullMasker() Masker {
+	return &fullMasker{substitution: DefaultFullMaskSubstitution}
+}
+
+// NewMasker creates the masker for the masking algorithm.
+func NewMasker(algorithm *storepb.MaskingAlgorithm) (Masker, error) {
+	switch algorithm.Type {
+	case storepb.MaskingAlgorithm_FULL:
+		substitution := algorithm.Substitution
+		if substitution == "" {
+			substitution = DefaultFullMaskSubstitution
+		}
+		return &fullMasker{substitution: substitution}, nil
+	case storepb.MaskingAlgorithm_KEEP:
+		if algorithm.PrefixLength < 0 || algorithm.SuffixLength < 0 {
+			return nil, errors.Errorf("prefix length and suffix length must not be negative")
+		}
+		substitution := algorithm.Substitution
+		if substitution == "" {
+			substitution = defaultKeepSubstitution
+		}
+		return &keepMasker{
+			prefixLength: int(algorithm.PrefixLength),
+			suffixLength: int(algorithm.SuffixLength),
+			substitution: substitution,
+		}, nil
+	case storepb.MaskingAlgorithm_HASH:
+		if algorithm.Salt == "" {
+			return nil, errors.Errorf("salt must not be empty")
+		}
+		return &hashMasker{salt: []byte(algorithm.Salt)}, nil
+	case storepb.MaskingAlgorithm_DATE_TRUNCATE:
+		if algorithm.DateUnit == storepb.MaskingAlgorithm_DATE_UNIT_UNSPECIFIED {
+			return nil, errors.Errorf("date unit must be specified")
+		}
+		return &dateTruncateMasker{unit: algorithm.DateUnit}, nil
+	case storepb.MaskingAlgorithm_RANGE:
+		if !(algorithm.RangeWidth > 0) || math.IsInf(algorithm.RangeWidth, 0) {
+			return nil, errors.Errorf("range width must be positive")
+		}
+		return &rangeMasker{width: algorithm.RangeWidth}, nil
+	case storepb.MaskingAlgorithm_EMAIL:
+		return &emailMasker{}, nil
+	case storepb.MaskingAlgorithm_PHONE:
+		if algorithm.SuffixLength < 0 {
+			return nil, errors.Errorf("suffix length must not be negative")
+		}
+		suffixLength := int(algorithm.SuffixLength)
+		if suffixLength == 0 {
+			suffixLength = defaultPhoneSuffixLength
+		}
+		return &phoneMasker{suffixLength: suffixLength}, nil
+	default:
+		return nil, errors.Errorf("unsupported masking algorithm type %s", algorithm.Type)
+	}
+}
+
+func newStringValue(s string) *v1pb.RowValue {
+	return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+}
+
+// getValueString returns the string representation of the value, and false if the value is NULL.
+func getValueString(value *v1pb.RowValue) (string, bool) {
+	switch v := value.GetKind().(type) {
+	case *v1pb.RowValue_StringValue:
+		return v.StringValue, true
+	case *v1pb.RowValue_BoolValue:
+		return strconv.FormatBool(v.BoolValue), true
+	case *v1pb.RowValue_BytesValue:
+		return base64.StdEncoding.EncodeToString(v.BytesValue), true
+	case *v1pb.RowValue_DoubleValue:
+		return strconv.FormatFloat(v.DoubleValue, 'f', -1, 64), true
+	case *v1pb.RowValue_FloatValue:
+		return strconv.FormatFloat(float64(v.FloatValue), 'f', -1, 32), true
+	case *v1pb.RowValue_Int32Value:
+		return strconv.FormatInt(int64(v.Int32Value), 10), true
+	case *v1pb.RowValue_Int64Value:
+		return strconv.FormatInt(v.Int64Value, 10), true
+	case *v1pb.RowValue_Uint32Value:
+		return strconv.FormatUint(uint64(v.Uint32Value), 10), true
+	case *v1pb.RowValue_Uint64Value:
+		return strconv.FormatUint(v.Uint64Value, 10), true
+	case *v1pb.RowValue_ValueValue:
+		if v.ValueValue == nil {
+			return "", false
+		}
+		if _, ok := v.ValueValue.Kind.(*structpb.Value_NullValue); ok {
+			return "", false
+		}
+		bytes, err := protojson.Marshal(v.ValueValue)
+		if err != nil {
+			return "", false
+		}
+		return string(bytes), true
+	default:
+		return "", false
+	}
+}
+
+// fullMasker replaces the value with the substitution.
+type fullMasker struct {
+	substitution string
+}
+
+// Mask implements the Masker interface.
+func (m *fullMasker) Mask(_ *v1pb.RowValue) *v1pb.RowValue {
+	return newStringValue(m.substitution)
+}
+
+// keepMasker keeps the first prefixLength and the last suffixLength characters, and replaces each of the others with the substitution.
+type keepMasker struct {
+	prefixLength int
+	suffixLength int
+	substitution string
+}
+
+// Mask implements the Masker interface.
+func (m *keepMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := getValueString(value)
+	if !ok {
+		return newStringValue(DefaultFullMaskSubstitution)
+	}
+	runes := []rune(s)
+	// Mask all characters if the kept characters would cover the whole value.
+	if m.prefixLength+m.suffixLength >= len(runes) {
+		return newStringValue(strings.Repeat(m.substitution, len(runes)))
+	}
+	var b strings.Builder
+	b.WriteString(string(runes[:m.prefixLength]))
+	b.WriteString(strings.Repeat(m.substitution, len(runes)-m.prefixLength-m.suffixLength))
+	b.WriteString(string(runes[len(runes)-m.suffixLength:]))
+	return newStringValue(b.String())
+}
+
+// hashMasker replaces the value with the hex encoded HMAC-SHA256 of the value keyed by the salt.
+type hashMasker struct {
+	salt []byte
+}
+
+// Mask implements the Masker interface.
+func (m *hashMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := getValueString(value)
+	if !ok {
+		return newStringValue(DefaultFullMaskSubstitution)
+	}
+	h := hmac.New(sha256.New, m.salt)
+	_, _ = h.Write([]byte(s))
+	return newStringValue(hex.EncodeToString(h.Sum(nil)))
+}
+
+// dateLayouts are the layouts of the date and time values returned by the database drivers.
+var dateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999-07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// dateTruncateMasker truncates the date or time value to the unit, keeping its format.
+type dateTruncateMasker struct {
+	unit storepb.MaskingAlgorithm_DateUnit
+}
+
+// Mask implements the Masker interface.
+func (m *dateTruncateMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := getValueString(value)
+	if !ok {
+		return newStringValue(DefaultFullMaskSubstitution)
+	}
+	s = strings.TrimSpace(s)
+	for _, layout := range dateLayouts {
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			continue
+		}
+		var truncated time.Time
+		switch m.unit {
+		case storepb.MaskingAlgorithm_YEAR:
+			truncated = time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+		case storepb.MaskingAlgorithm_MONTH:
+			truncated = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+		case storepb.MaskingAlgorithm_DAY:
+			truncated = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+		default:
+			truncated = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+		}
+		return newStringValue(truncated.Format(layout))
+	}
+	// The value is not a date or time, mask it fully rather than leaking it.
+	return newStringValue(DefaultFullMaskSubstitution)
+}
+
+// rangeMasker replaces the numeric value with the range of the width containing it.
+type rangeMasker struct {
+	width float64
+}
+
+// Mask implements the Masker interface.
+func (m *rangeMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := getValueString(value)
+	if !ok {
+		return newStringValue(DefaultFullMaskSubstitution)
+	}
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+		return newStringValue(DefaultFullMaskSubstitution)
+	}
+	lower := math.Floor(f/m.width) * m.width
+	upper := lower + m.width
+	return newStringValue("[" + strconv.FormatFloat(lower, 'f', -1, 64) + ", " + strconv.FormatFloat(upper, 'f', -1, 64) + ")")
+}
+
+// emailMasker keeps the first character of the local part and the domain of the email address.
+type emailMasker struct{}
+
+// Mask implements the Masker interface.
+func (*emailMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := getValueString(value)
+	if !ok {
+		return newStringValue(DefaultFullMaskSubstitution)
+	}
+	at := strings.LastIndex(s, "@")
+	if at <= 0 {
+		return newStringValue(DefaultFullMaskSubstitution)
+	}
+	local := []rune(s[:at])
+	return newStringValue(string(local[0]) + strings.Repeat("*", len(local)-1) + s[at:])
+}
+
+// phoneMasker keeps the format and the last suffixLength digits of the phone number.
+type phoneMasker struct {
+	suffixLength int
+}
+
+// Mask implements the Masker interface.
+func (m *phoneMasker) Mask(value *v1pb.RowValue) *v1pb.RowValue {
+	s, ok := getValueString(value)
+	if !ok {
+		return newStringValue(DefaultFullMaskSubstitution)
+	}
+	runes := []rune(s)
+	digits := 0
+	for _, r := range runes {
+		if unicode.IsDigit(r) {
+			digits++
+		}
+	}
+	// Mask all digits if the kept digits cover the phone number.
+	keep := m.suffixLength
+	if keep >= digits {
+		keep = 0
+	}
+	seen := 0
+	for i, r := range runes {
+		if !unicode.IsDigit(r) {
+			continue
+		}
+		if seen < digits-keep {
+			runes[i] = '*'
+		}
+		seen++
+	}
+	return newStringValue(string(runes))
+}
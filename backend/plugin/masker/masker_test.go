@@ -0,0 +1,147 @@
+package masker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+func TestMasker(t *testing.T) {
+	stringValue := func(s string) *v1pb.RowValue {
+		return &v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: s}}
+	}
+	nullValue := &v1pb.RowValue{Kind: &v1pb.RowValue_NullValue{NullValue: structpb.NullValue_NULL_VALUE}}
+
+	tests := []struct {
+		algorithm *storepb.MaskingAlgorithm
+		value     *v1pb.RowValue
+		want      string
+	}{
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_FULL},
+			value:     stringValue("secret"),
+			want:      "******",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_FULL, Substitution: "<hidden>"},
+			value:     &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 42}},
+			want:      "<hidden>",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_KEEP, PrefixLength: 2, SuffixLength: 3},
+			value:     stringValue("6222021234567890"),
+			want:      "62***********890",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_KEEP, PrefixLength: 1, Substitution: "#"},
+			value:     stringValue("张三丰"),
+			want:      "张##",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_KEEP, PrefixLength: 2, SuffixLength: 2},
+			value:     stringValue("abc"),
+			want:      "***",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_KEEP, PrefixLength: 2},
+			value:     nullValue,
+			want:      "******",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_HASH, Salt: "salt"},
+			value:     stringValue("alice"),
+			want:      "dc663a1de92b83cd9b6522902cf9420e70757f1eb293f2be7d881953cb2dc149",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_DATE_TRUNCATE, DateUnit: storepb.MaskingAlgorithm_MONTH},
+			value:     stringValue("2023-05-17 14:03:02"),
+			want:      "2023-05-01 00:00:00",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_DATE_TRUNCATE, DateUnit: storepb.MaskingAlgorithm_YEAR},
+			value:     stringValue("2023-05-17"),
+			want:      "2023-01-01",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_DATE_TRUNCATE, DateUnit: storepb.MaskingAlgorithm_HOUR},
+			value:     stringValue("2023-05-17T14:03:02.123+08:00"),
+			want:      "2023-05-17T14:00:00+08:00",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_DATE_TRUNCATE, DateUnit: storepb.MaskingAlgorithm_DAY},
+			value:     stringValue("not a date"),
+			want:      "******",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_RANGE, RangeWidth: 10},
+			value:     &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 25}},
+			want:      "[20, 30)",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_RANGE, RangeWidth: 1000},
+			value:     stringValue("-1234.5"),
+			want:      "[-2000, -1000)",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_EMAIL},
+			value:     stringValue("john.doe@example.com"),
+			want:      "j*******@example.com",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_EMAIL},
+			value:     stringValue("invalid"),
+			want:      "******",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_PHONE},
+			value:     stringValue("+1 (555) 123-4567"),
+			want:      "+* (***) ***-4567",
+		},
+		{
+			algorithm: &storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_PHONE, SuffixLength: 2},
+			value:     stringValue("123"),
+			want:      "*23",
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		m, err := NewMasker(test.algorithm)
+		a.NoError(err)
+		a.Equal(test.want, m.Mask(test.value).GetStringValue(), "algorithm %v, value %v", test.algorithm, test.value)
+	}
+}
+
+func TestHashMaskerIsDeterministic(t *testing.T) {
+	a := require.New(t)
+	m1, err := NewMasker(&storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_HASH, Salt: "salt"})
+	a.NoError(err)
+	m2, err := NewMasker(&storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_HASH, Salt: "salt"})
+	a.NoError(err)
+	m3, err := NewMasker(&storepb.MaskingAlgorithm{Type: storepb.MaskingAlgorithm_HASH, Salt: "pepper"})
+	a.NoError(err)
+
+	// The same value of different types in different columns is masked to the same result.
+	value := &v1pb.RowValue{Kind: &v1pb.RowValue_Int64Value{Int64Value: 42}}
+	a.Equal(m1.Mask(value).GetStringValue(), m2.Mask(&v1pb.RowValue{Kind: &v1pb.RowValue_StringValue{StringValue: "42"}}).GetStringValue())
+	a.NotEqual(m1.Mask(value).GetStringValue(), m3.Mask(value).GetStringValue())
+}
+
+func TestNewMaskerValidation(t *testing.T) {
+	a := require.New(t)
+	for _, algorithm := range []*storepb.MaskingAlgorithm{
+		{},
+		{Type: storepb.MaskingAlgorithm_KEEP, PrefixLength: -1},
+		{Type: storepb.MaskingAlgorithm_HASH},
+		{Type: storepb.MaskingAlgorithm_DATE_TRUNCATE},
+		{Type: storepb.MaskingAlgorithm_RANGE},
+		{Type: storepb.MaskingAlgorithm_PHONE, SuffixLength: -1},
+	} {
+		_, err := NewMasker(algorithm)
+		a.Error(err, "algorithm %v", algorithm)
+	}
+}
@@ -0,0 +1,1129 @@
+// Package mssql provides the MSSQL differ plugin.
+package mssql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	tsqlparser "github.com/bytebase/tsql-parser"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/differ"
+)
+
+const defaultSchema = "dbo"
+
+var _ differ.SchemaDiffer = (*SchemaDiffer)(nil)
+
+func init() {
+	differ.Register(parser.MSSQL, &SchemaDiffer{})
+}
+
+// SchemaDiffer it the differ for MSSQL dialect.
+type SchemaDiffer struct {
+}
+
+// diffNode defines different modification types as the safe change order.
+// The safe change order means we can change them with no dependency conflicts as this order.
+type diffNode struct {
+	// Drop nodes
+	dropTriggerList            []string
+	dropViewList               []string
+	dropFunctionList           []string
+	dropForeignKeyList         []string
+	dropConstraintExceptFkList []string
+	dropIndexList              []string
+	dropDefaultList            []string
+	dropColumnList             []string
+	dropTableList              []string
+	dropSequenceList           []string
+	dropSchemaList             []string
+
+	// Create nodes
+	createSchemaList             []string
+	createSequenceList           []string
+	alterSequenceList            []string
+	createTableList              []string
+	createColumnList             []string
+	alterColumnList              []string
+	createDefaultList            []string
+	createConstraintExceptFkList []string
+	createIndexList              []string
+	createForeignKeyList         []string
+	createFunctionList           []string
+	createViewList               []string
+	createTriggerList            []string
+}
+
+type constraintType int
+
+const (
+	constraintTypePrimaryKey constraintType = iota
+	constraintTypeUnique
+	constraintTypeCheck
+	constraintTypeForeignKey
+)
+
+// objectName is the name of a schema object, the identifiers are unquoted.
+type objectName struct {
+	schema string
+	name   string
+}
+
+func (n objectName) String() string {
+	return fmt.Sprintf("%s.%s", quoteIdentifier(n.schema), quoteIdentifier(n.name))
+}
+
+// key returns the key to match the object between the old and new schema.
+func (n objectName) key(ignoreCase bool) string {
+	return fmt.Sprintf("%s.%s", normalizeKey(n.schema, ignoreCase), normalizeKey(n.name, ignoreCase))
+}
+
+type schemaInfo struct {
+	schemaMap    map[string]*objectInfo
+	tableMap     map[string]*tableInfo
+	indexMap     map[string]*objectInfo
+	sequenceMap  map[string]*sequenceInfo
+	viewMap      map[string]*objectInfo
+	functionMap  map[string]*objectInfo
+	procedureMap map[string]*objectInfo
+	triggerMap   map[string]*objectInfo
+}
+
+func newSchemaInfo() *schemaInfo {
+	return &schemaInfo{
+		schemaMap:    make(map[string]*objectInfo),
+		tableMap:     make(map[string]*tableInfo),
+		indexMap:     make(map[string]*objectInfo),
+		sequenceMap:  make(map[string]*sequenceInfo),
+		viewMap:      make(map[string]*objectInfo),
+		functionMap:  make(map[string]*objectInfo),
+		procedureMap: make(map[string]*objectInfo),
+		triggerMap:   make(map[string]*objectInfo),
+	}
+}
+
+type tableInfo struct {
+	id   int
+	name objectName
+	// prefix is the text before the column list, such as "CREATE TABLE t".
+	prefix string
+	// suffix is the text after the column list, such as the filegroup.
+	suffix         string
+	columnList     []*columnInfo
+	constraintList []*constraintInfo
+}
+
+func (t *tableInfo) getColumn(name string, ignoreCase bool) *columnInfo {
+	for _, column := range t.columnList {
+		if normalizeKey(column.name, ignoreCase) == normalizeKey(name, ignoreCase) {
+			return column
+		}
+	}
+	return nil
+}
+
+func (t *tableInfo) getConstraint(key string, ignoreCase bool) *constraintInfo {
+	for _, constraint := range t.constraintList {
+		if constraint.key(ignoreCase) == key {
+			return constraint
+		}
+	}
+	return nil
+}
+
+type columnInfo struct {
+	name string
+	// definition is the column definition without the constraints except NULL and NOT NULL.
+	definition string
+	// dataType is the data type with the collation, which is used in the ALTER COLUMN statement.
+	dataType            string
+	dataTypeCompareText string
+	nullable            bool
+	defaultInfo         *defaultInfo
+	// computed is true for the computed columns, which are recreated if changed.
+	computed bool
+}
+
+// defaultInfo is the default constraint of a column.
+type defaultInfo struct {
+	name        string
+	expression  string
+	compareText string
+}
+
+type constraintInfo struct {
+	name        string
+	tp          constraintType
+	columnList  []string
+	definition  string
+	compareText string
+}
+
+// key returns the key to match the constraint between the old and new schema.
+// The unnamed constraints are matched by the definition.
+func (c *constraintInfo) key(ignoreCase bool) string {
+	if c.name != "" {
+		return normalizeKey(c.name, ignoreCase)
+	}
+	if c.tp == constraintTypePrimaryKey {
+		return "PRIMARY KEY"
+	}
+	return c.compareText
+}
+
+type objectInfo struct {
+	id    int
+	name  objectName
+	table objectName
+	// text is the statement text. For the views, functions, procedures and triggers,
+	// it is the text after the CREATE, CREATE OR ALTER or ALTER keywords.
+	text        string
+	compareText string
+}
+
+type sequenceInfo struct {
+	id   int
+	name objectName
+	text string
+	// dataType is the data type of the sequence, which cannot be altered.
+	dataType string
+	// specList is the sequence options except the data type and the START WITH clause.
+	// We ignore the START WITH clause because it cannot be altered.
+	specList    []string
+	compareText string
+}
+
+// SchemaDiff computes the schema differences between old and new schema.
+func (*SchemaDiffer) SchemaDiff(oldStmt, newStmt string, ignoreCaseSensitive bool) (string, error) {
+	oldSchema, err := buildSchemaInfo(oldStmt, ignoreCaseSensitive)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse old statements %q", oldStmt)
+	}
+	newSchema, err := buildSchemaInfo(newStmt, ignoreCaseSensitive)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse new statements %q", newStmt)
+	}
+
+	diff := &diffNode{}
+	diff.diffSchema(oldSchema, newSchema)
+	if err := diff.diffTable(oldSchema, newSchema, ignoreCaseSensitive); err != nil {
+		return "", err
+	}
+	diff.diffIndex(oldSchema, newSchema, ignoreCaseSensitive)
+	diff.diffSequence(oldSchema, newSchema, ignoreCaseSensitive)
+	diff.dropViewList, diff.createViewList = diffObject(oldSchema.viewMap, newSchema.viewMap, "VIEW")
+	dropFunctionList, createFunctionList := diffObject(oldSchema.functionMap, newSchema.functionMap, "FUNCTION")
+	dropProcedureList, createProcedureList := diffObject(oldSchema.procedureMap, newSchema.procedureMap, "PROCEDURE")
+	diff.dropFunctionList = append(dropFunctionList, dropProcedureList...)
+	diff.createFunctionList = append(createFunctionList, createProcedureList...)
+	diff.dropTriggerList, diff.createTriggerList = diffObject(oldSchema.triggerMap, newSchema.triggerMap, "TRIGGER")
+
+	return diff.deparse(), nil
+}
+
+func (diff *diffNode) diffSchema(oldSchema, newSchema *schemaInfo) {
+	for key, newObject := range newSchema.schemaMap {
+		if _, ok := oldSchema.schemaMap[key]; !ok {
+			diff.createSchemaList = append(diff.createSchemaList, newObject.text)
+		}
+	}
+	for key, oldObject := range oldSchema.schemaMap {
+		if _, ok := newSchema.schemaMap[key]; !ok {
+			diff.dropSchemaList = append(diff.dropSchemaList, fmt.Sprintf("DROP SCHEMA %s;", quoteIdentifier(oldObject.name.name)))
+		}
+	}
+	sort.Strings(diff.createSchemaList)
+	sort.Strings(diff.dropSchemaList)
+}
+
+func (diff *diffNode) diffTable(oldSchema, newSchema *schemaInfo, ignoreCase bool) error {
+	for _, newTable := range sortedTables(newSchema.tableMap) {
+		oldTable, ok := oldSchema.tableMap[newTable.name.key(ignoreCase)]
+		if !ok {
+			diff.createTable(newTable)
+			continue
+		}
+		if err := diff.modifyTable(oldTable, newTable, ignoreCase); err != nil {
+			return err
+		}
+	}
+
+	// Drop the tables in the reverse order of creation, so that the referencing tables are dropped first.
+	oldTableList := sortedTables(oldSchema.tableMap)
+	for i := len(oldTableList) - 1; i >= 0; i-- {
+		oldTable := oldTableList[i]
+		if _, ok := newSchema.tableMap[oldTable.name.key(ignoreCase)]; ok {
+			continue
+		}
+		for _, constraint := range oldTable.constraintList {
+			if constraint.tp == constraintTypeForeignKey && constraint.name != "" {
+				diff.dropForeignKeyList = append(diff.dropForeignKeyList, dropConstraintStmt(oldTable.name, constraint.name))
+			}
+		}
+		diff.dropTableList = append(diff.dropTableList, fmt.Sprintf("DROP TABLE %s;", oldTable.name))
+	}
+	return nil
+}
+
+func (diff *diffNode) createTable(table *tableInfo) {
+	var itemList []string
+	for _, column := range table.columnList {
+		itemList = append(itemList, column.definition)
+	}
+	for _, constraint := range table.constraintList {
+		// Create foreign keys after all tables are created to avoid the dependency conflicts.
+		if constraint.tp == constraintTypeForeignKey {
+			diff.createForeignKeyList = append(diff.createForeignKeyList, addConstraintStmt(table.name, constraint))
+			continue
+		}
+		itemList = append(itemList, constraint.definition)
+	}
+	var buf strings.Builder
+	_, _ = buf.WriteString(table.prefix)
+	_, _ = buf.WriteString(" (\n")
+	for i, item := range itemList {
+		_, _ = buf.WriteString("    ")
+		_, _ = buf.WriteString(item)
+		if i != len(itemList)-1 {
+			_, _ = buf.WriteString(",")
+		}
+		_, _ = buf.WriteString("\n")
+	}
+	_, _ = buf.WriteString(")")
+	if table.suffix != "" {
+		_, _ = buf.WriteString(" ")
+		_, _ = buf.WriteString(table.suffix)
+	}
+	_, _ = buf.WriteString(";")
+	diff.createTableList = append(diff.createTableList, buf.String())
+}
+
+func (diff *diffNode) modifyTable(oldTable, newTable *tableInfo, ignoreCase bool) error {
+	for _, newColumn := range newTable.columnList {
+		oldColumn := oldTable.getColumn(newColumn.name, ignoreCase)
+		if oldColumn == nil {
+			diff.createColumnList = append(diff.createColumnList, fmt.Sprintf("ALTER TABLE %s ADD %s;", newTable.name, newColumn.definition))
+			continue
+		}
+		if oldColumn.computed || newColumn.computed {
+			if oldColumn.computed != newColumn.computed || oldColumn.dataTypeCompareText != newColumn.dataTypeCompareText {
+				diff.dropColumn(oldTable.name, oldColumn)
+				diff.createColumnList = append(diff.createColumnList, fmt.Sprintf("ALTER TABLE %s ADD %s;", newTable.name, newColumn.definition))
+			}
+			continue
+		}
+		if oldColumn.dataTypeCompareText != newColumn.dataTypeCompareText || oldColumn.nullable != newColumn.nullable {
+			nullable := "NOT NULL"
+			if newColumn.nullable {
+				nullable = "NULL"
+			}
+			diff.alterColumnList = append(diff.alterColumnList, fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s %s %s;", newTable.name, quoteIdentifier(newColumn.name), newColumn.dataType, nullable))
+		}
+		if !isEqualDefault(oldColumn.defaultInfo, newColumn.defaultInfo, ignoreCase) {
+			if oldColumn.defaultInfo != nil {
+				diff.dropDefaultList = append(diff.dropDefaultList, dropDefaultStmt(oldTable.name, oldColumn))
+			}
+			if newColumn.defaultInfo != nil {
+				diff.createDefaultList = append(diff.createDefaultList, addDefaultStmt(newTable.name, newColumn))
+			}
+		}
+	}
+	for _, oldColumn := range oldTable.columnList {
+		if newTable.getColumn(oldColumn.name, ignoreCase) == nil {
+			diff.dropColumn(oldTable.name, oldColumn)
+		}
+	}
+
+	for _, oldConstraint := range oldTable.constraintList {
+		newConstraint := newTable.getConstraint(oldConstraint.key(ignoreCase), ignoreCase)
+		if newConstraint != nil && newConstraint.compareText == oldConstraint.compareText {
+			continue
+		}
+		if oldConstraint.name == "" {
+			return errors.Errorf("cannot drop the unnamed constraint %q of table %s, please name the constraint", oldConstraint.definition, oldTable.name)
+		}
+		stmt := dropConstraintStmt(oldTable.name, oldConstraint.name)
+		if oldConstraint.tp == constraintTypeForeignKey {
+			diff.dropForeignKeyList = append(diff.dropForeignKeyList, stmt)
+		} else {
+			diff.dropConstraintExceptFkList = append(diff.dropConstraintExceptFkList, stmt)
+		}
+	}
+	for _, newConstraint := range newTable.constraintList {
+		oldConstraint := oldTable.getConstraint(newConstraint.key(ignoreCase), ignoreCase)
+		if oldConstraint != nil && oldConstraint.compareText == newConstraint.compareText {
+			continue
+		}
+		if newConstraint.tp == constraintTypeForeignKey {
+			diff.createForeignKeyList = append(diff.createForeignKeyList, addConstraintStmt(newTable.name, newConstraint))
+		} else {
+			diff.createConstraintExceptFkList = append(diff.createConstraintExceptFkList, addConstraintStmt(newTable.name, newConstraint))
+		}
+	}
+	return nil
+}
+
+// dropColumn drops the column with its default constraint, because SQL Server reports an error
+// if we drop a column referenced by the default constraint.
+func (diff *diffNode) dropColumn(table objectName, column *columnInfo) {
+	if column.defaultInfo != nil {
+		diff.dropDefaultList = append(diff.dropDefaultList, dropDefaultStmt(table, column))
+	}
+	diff.dropColumnList = append(diff.dropColumnList, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", table, quoteIdentifier(column.name)))
+}
+
+func isEqualDefault(oldDefault, newDefault *defaultInfo, ignoreCase bool) bool {
+	if oldDefault == nil || newDefault == nil {
+		return oldDefault == nil && newDefault == nil
+	}
+	// The unnamed default constraint in the new schema matches the default constraint with any name,
+	// because SQL Server generates the name for it.
+	if newDefault.name != "" && normalizeKey(oldDefault.name, ignoreCase) != normalizeKey(newDefault.name, ignoreCase) {
+		return false
+	}
+	return oldDefault.compareText == newDefault.compareText
+}
+
+// dropDefaultStmt returns the statement to drop the default constraint of the column.
+// The name of the unnamed default constraint is generated by SQL Server, so we look it up in the system catalog.
+// The statement does not contain semicolons, so that it is executed in a batch of its own.
+func dropDefaultStmt(table objectName, column *columnInfo) string {
+	if column.defaultInfo.name != "" {
+		return dropConstraintStmt(table, column.defaultInfo.name)
+	}
+	return fmt.Sprintf(
+		"DECLARE @sql NVARCHAR(MAX) = (SELECT N'ALTER TABLE %s DROP CONSTRAINT ' + QUOTENAME(dc.name) FROM sys.default_constraints dc INNER JOIN sys.columns c ON dc.parent_object_id = c.object_id AND dc.parent_column_id = c.column_id WHERE dc.parent_object_id = OBJECT_ID(N'%s') AND c.name = N'%s') EXEC sp_executesql @sql;",
+		escapeString(table.String()),
+		escapeString(table.String()),
+		escapeString(column.name),
+	)
+}
+
+func addDefaultStmt(table objectName, column *columnInfo) string {
+	if column.defaultInfo.name != "" {
+		return fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s DEFAULT %s FOR %s;", table, quoteIdentifier(column.defaultInfo.name), column.defaultInfo.expression, quoteIdentifier(column.name))
+	}
+	return fmt.Sprintf("ALTER TABLE %s ADD DEFAULT %s FOR %s;", table, column.defaultInfo.expression, quoteIdentifier(column.name))
+}
+
+func addConstraintStmt(table objectName, constraint *constraintInfo) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD %s;", table, constraint.definition)
+}
+
+func dropConstraintStmt(table objectName, constraintName string) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", table, quoteIdentifier(constraintName))
+}
+
+func (diff *diffNode) diffIndex(oldSchema, newSchema *schemaInfo, ignoreCase bool) {
+	for _, newIndex := range sortedObjects(newSchema.indexMap) {
+		oldIndex, ok := oldSchema.indexMap[indexKey(newIndex, ignoreCase)]
+		if ok && oldIndex.compareText == newIndex.compareText {
+			continue
+		}
+		if ok {
+			diff.dropIndexList = append(diff.dropIndexList, dropIndexStmt(oldIndex))
+		}
+		diff.createIndexList = append(diff.createIndexList, newIndex.text)
+	}
+	for _, oldIndex := range sortedObjects(oldSchema.indexMap) {
+		if _, ok := newSchema.indexMap[indexKey(oldIndex, ignoreCase)]; ok {
+			continue
+		}
+		// The index is dropped with the table.
+		if _, ok := newSchema.tableMap[oldIndex.table.key(ignoreCase)]; !ok {
+			continue
+		}
+		diff.dropIndexList = append(diff.dropIndexList, dropIndexStmt(oldIndex))
+	}
+}
+
+// indexKey returns the key of the index, the index name is unique in the table in SQL Server.
+func indexKey(index *objectInfo, ignoreCase bool) string {
+	return fmt.Sprintf("%s.%s", index.table.key(ignoreCase), normalizeKey(index.name.name, ignoreCase))
+}
+
+func dropIndexStmt(index *objectInfo) string {
+	return fmt.Sprintf("DROP INDEX %s ON %s;", quoteIdentifier(index.name.name), index.table)
+}
+
+func (diff *diffNode) diffSequence(oldSchema, newSchema *schemaInfo, ignoreCase bool) {
+	for _, newSequence := range sortedSequences(newSchema.sequenceMap) {
+		oldSequence, ok := oldSchema.sequenceMap[newSequence.name.key(ignoreCase)]
+		if !ok {
+			diff.createSequenceList = append(diff.createSequenceList, newSequence.text)
+			continue
+		}
+		// The data type of the sequence cannot be altered, so we recreate it.
+		if oldSequence.dataType != newSequence.dataType {
+			diff.dropSequenceList = append(diff.dropSequenceList, fmt.Sprintf("DROP SEQUENCE %s;", oldSequence.name))
+			diff.createSequenceList = append(diff.createSequenceList, newSequence.text)
+			continue
+		}
+		if oldSequence.compareText != newSequence.compareText && len(newSequence.specList) > 0 {
+			diff.alterSequenceList = append(diff.alterSequenceList, fmt.Sprintf("ALTER SEQUENCE %s %s;", newSequence.name, strings.Join(newSequence.specList, " ")))
+		}
+	}
+	for _, oldSequence := range sortedSequences(oldSchema.sequenceMap) {
+		if _, ok := newSchema.sequenceMap[oldSequence.name.key(ignoreCase)]; !ok {
+			diff.dropSequenceList = append(diff.dropSequenceList, fmt.Sprintf("DROP SEQUENCE %s;", oldSequence.name))
+		}
+	}
+}
+
+// diffObject computes the differences of the objects which are changed as a whole, such as views and procedures.
+// The changed objects are replaced by CREATE OR ALTER statements.
+func diffObject(oldMap, newMap map[string]*objectInfo, objectType string) ([]string, []string) {
+	var dropList, createList []string
+	for _, key := range sortedObjectKeys(newMap) {
+		newObject := newMap[key]
+		oldObject, ok := oldMap[key]
+		if !ok {
+			createList = append(createList, fmt.Sprintf("CREATE %s", newObject.text))
+			continue
+		}
+		if oldObject.compareText == newObject.compareText {
+			continue
+		}
+		createList = append(createList, fmt.Sprintf("CREATE OR ALTER %s", newObject.text))
+	}
+	oldKeyList := sortedObjectKeys(oldMap)
+	for i := len(oldKeyList) - 1; i >= 0; i-- {
+		if _, ok := newMap[oldKeyList[i]]; !ok {
+			dropList = append(dropList, fmt.Sprintf("DROP %s %s;", objectType, oldMap[oldKeyList[i]].name))
+		}
+	}
+	return dropList, createList
+}
+
+func sortedTables(m map[string]*tableInfo) []*tableInfo {
+	var list []*tableInfo
+	for _, table := range m {
+		list = append(list, table)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].id < list[j].id
+	})
+	return list
+}
+
+func sortedObjects(m map[string]*objectInfo) []*objectInfo {
+	var list []*objectInfo
+	for _, object := range m {
+		list = append(list, object)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].id < list[j].id
+	})
+	return list
+}
+
+func sortedObjectKeys(m map[string]*objectInfo) []string {
+	var list []string
+	for key := range m {
+		list = append(list, key)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return m[list[i]].id < m[list[j]].id
+	})
+	return list
+}
+
+func sortedSequences(m map[string]*sequenceInfo) []*sequenceInfo {
+	var list []*sequenceInfo
+	for _, sequence := range m {
+		list = append(list, sequence)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].id < list[j].id
+	})
+	return list
+}
+
+func printStmtSlice(buf *strings.Builder, stmtList []string) {
+	for _, stmt := range stmtList {
+		_, _ = buf.WriteString(stmt)
+		_, _ = buf.WriteString("\n\n")
+	}
+}
+
+// deparse statements as the safe change order.
+func (diff *diffNode) deparse() string {
+	var buf strings.Builder
+
+	// drop
+	printStmtSlice(&buf, diff.dropTriggerList)
+	printStmtSlice(&buf, diff.dropViewList)
+	printStmtSlice(&buf, diff.dropFunctionList)
+	printStmtSlice(&buf, diff.dropForeignKeyList)
+	printStmtSlice(&buf, diff.dropConstraintExceptFkList)
+	printStmtSlice(&buf, diff.dropIndexList)
+	printStmtSlice(&buf, diff.dropDefaultList)
+	printStmtSlice(&buf, diff.dropColumnList)
+	printStmtSlice(&buf, diff.dropTableList)
+	printStmtSlice(&buf, diff.dropSequenceList)
+	printStmtSlice(&buf, diff.dropSchemaList)
+
+	// create
+	printStmtSlice(&buf, diff.createSchemaList)
+	printStmtSlice(&buf, diff.createSequenceList)
+	printStmtSlice(&buf, diff.alterSequenceList)
+	printStmtSlice(&buf, diff.createTableList)
+	printStmtSlice(&buf, diff.createColumnList)
+	printStmtSlice(&buf, diff.alterColumnList)
+	printStmtSlice(&buf, diff.createDefaultList)
+	printStmtSlice(&buf, diff.createConstraintExceptFkList)
+	printStmtSlice(&buf, diff.createIndexList)
+	printStmtSlice(&buf, diff.createForeignKeyList)
+	printStmtSlice(&buf, diff.createFunctionList)
+	printStmtSlice(&buf, diff.createViewList)
+	printStmtSlice(&buf, diff.createTriggerList)
+
+	return buf.String()
+}
+
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf("[%s]", strings.ReplaceAll(identifier, "]", "]]"))
+}
+
+func escapeString(s string) string {
+	return strings.ReplaceAll(s, "'", "''")
+}
+
+func normalizeKey(identifier string, ignoreCase bool) string {
+	if ignoreCase {
+		return strings.ToLower(identifier)
+	}
+	return identifier
+}
+
+// schemaBuilder builds the schema info from the T-SQL parse tree.
+type schemaBuilder struct {
+	stream     antlr.TokenStream
+	schema     *schemaInfo
+	ignoreCase bool
+	id         int
+}
+
+func buildSchemaInfo(statement string, ignoreCase bool) (*schemaInfo, error) {
+	schema := newSchemaInfo()
+	if strings.TrimSpace(statement) == "" {
+		return schema, nil
+	}
+	tree, err := parser.ParseTSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	file, ok := tree.(*tsqlparser.Tsql_fileContext)
+	if !ok {
+		return nil, errors.Errorf("failed to parse T-SQL statement, unexpected tree type %T", tree)
+	}
+	b := &schemaBuilder{
+		stream:     file.GetParser().GetTokenStream(),
+		schema:     schema,
+		ignoreCase: ignoreCase,
+	}
+	for _, batch := range file.AllBatch() {
+		if ctx := batch.Batch_level_statement(); ctx != nil {
+			b.addBatchLevelStatement(ctx, defaultSchema)
+		}
+		for _, clause := range batch.AllSql_clauses() {
+			if clause.Ddl_clause() == nil {
+				continue
+			}
+			if err := b.addDDLClause(clause.Ddl_clause()); err != nil {
+				return nil, err
+			}
+		}
+	}
+	b.markPrimaryKeyColumnsNotNull()
+	return schema, nil
+}
+
+func (b *schemaBuilder) nextID() int {
+	b.id++
+	return b.id
+}
+
+func (b *schemaBuilder) addBatchLevelStatement(ctx tsqlparser.IBatch_level_statementContext, schemaName string) {
+	switch {
+	case ctx.Create_view() != nil:
+		b.addView(ctx.Create_view(), schemaName)
+	case ctx.Create_or_alter_function() != nil:
+		function := ctx.Create_or_alter_function()
+		name := normalizeFuncProcName(function.Func_proc_name_schema())
+		b.schema.functionMap[name.key(b.ignoreCase)] = b.newObjectInfo(name, objectName{}, function.FUNCTION().GetSymbol().GetTokenIndex(), function)
+	case ctx.Create_or_alter_procedure() != nil:
+		procedure := ctx.Create_or_alter_procedure()
+		name := normalizeFuncProcName(procedure.Func_proc_name_schema())
+		b.schema.procedureMap[name.key(b.ignoreCase)] = b.newObjectInfo(name, objectName{}, procedure.GetProc().GetTokenIndex(), procedure)
+	case ctx.Create_or_alter_trigger() != nil:
+		// We only support the DML triggers, the DDL triggers are database level objects.
+		trigger := ctx.Create_or_alter_trigger().Create_or_alter_dml_trigger()
+		if trigger == nil {
+			return
+		}
+		name := normalizeSimpleName(trigger.Simple_name(), schemaName)
+		table := normalizeTableName(trigger.Table_name(), schemaName)
+		b.schema.triggerMap[name.key(b.ignoreCase)] = b.newObjectInfo(name, table, trigger.TRIGGER().GetSymbol().GetTokenIndex(), trigger)
+	}
+}
+
+func (b *schemaBuilder) addView(ctx tsqlparser.ICreate_viewContext, schemaName string) {
+	name := normalizeSimpleName(ctx.Simple_name(), schemaName)
+	b.schema.viewMap[name.key(b.ignoreCase)] = b.newObjectInfo(name, objectName{}, ctx.VIEW().GetSymbol().GetTokenIndex(), ctx)
+}
+
+func (b *schemaBuilder) addDDLClause(ctx tsqlparser.IDdl_clauseContext) error {
+	switch {
+	case ctx.Create_schema() != nil:
+		b.addSchema(ctx.Create_schema())
+	case ctx.Create_table() != nil:
+		b.addTable(ctx.Create_table(), defaultSchema)
+	case ctx.Alter_table() != nil:
+		return b.addAlterTable(ctx.Alter_table())
+	case ctx.Create_index() != nil:
+		b.addIndex(ctx.Create_index())
+	case ctx.Create_sequence() != nil:
+		b.addSequence(ctx.Create_sequence())
+	}
+	return nil
+}
+
+func (b *schemaBuilder) addSchema(ctx tsqlparser.ICreate_schemaContext) {
+	// CREATE SCHEMA AUTHORIZATION owner creates the objects in the default schema of the owner.
+	schemaName := defaultSchema
+	if ctx.GetSchema_name() != nil {
+		schemaName = normalizeIdentifier(ctx.GetSchema_name())
+		text := fmt.Sprintf("CREATE SCHEMA %s", quoteIdentifier(schemaName))
+		if ctx.GetOwner_name() != nil {
+			text = fmt.Sprintf("%s AUTHORIZATION %s", text, quoteIdentifier(normalizeIdentifier(ctx.GetOwner_name())))
+		}
+		b.schema.schemaMap[normalizeKey(schemaName, b.ignoreCase)] = &objectInfo{
+			id:   b.nextID(),
+			name: objectName{name: schemaName},
+			text: text + ";",
+		}
+	}
+	// The unqualified objects in the CREATE SCHEMA statement belong to the created schema.
+	for _, table := range ctx.AllCreate_table() {
+		b.addTable(table, schemaName)
+	}
+	for _, view := range ctx.AllCreate_view() {
+		b.addView(view, schemaName)
+	}
+}
+
+func (b *schemaBuilder) addTable(ctx tsqlparser.ICreate_tableContext, schemaName string) {
+	name := normalizeTableName(ctx.Table_name(), schemaName)
+	table := &tableInfo{
+		id:     b.nextID(),
+		name:   name,
+		prefix: b.textBetween(ctx.GetStart().GetTokenIndex(), ctx.LR_BRACKET().GetSymbol().GetTokenIndex()-1),
+		suffix: b.textBetween(ctx.RR_BRACKET().GetSymbol().GetTokenIndex()+1, b.lastTokenIndex(ctx)),
+	}
+	b.schema.tableMap[name.key(b.ignoreCase)] = table
+	b.addColumnDefTableConstraints(table, ctx.Column_def_table_constraints())
+	for _, index := range ctx.AllTable_indices() {
+		b.addTableIndex(table, index)
+	}
+}
+
+func (b *schemaBuilder) addColumnDefTableConstraints(table *tableInfo, ctx tsqlparser.IColumn_def_table_constraintsContext) {
+	var defaultList []tsqlparser.ITable_constraintContext
+	for _, item := range ctx.AllColumn_def_table_constraint() {
+		switch {
+		case item.Column_definition() != nil:
+			b.addColumn(table, item.Column_definition())
+		case item.Materialized_column_definition() != nil:
+			column := item.Materialized_column_definition()
+			table.columnList = append(table.columnList, &columnInfo{
+				name:                normalizeIdentifier(column.Id_()),
+				definition:          b.text(column),
+				dataTypeCompareText: strings.ToLower(b.normalizedText(column)),
+				nullable:            true,
+				computed:            true,
+			})
+		case item.Table_constraint() != nil:
+			constraint := item.Table_constraint()
+			if constraint.DEFAULT() != nil {
+				// Apply the default constraints after all columns are added.
+				defaultList = append(defaultList, constraint)
+				continue
+			}
+			if info := b.newTableConstraintInfo(constraint); info != nil {
+				table.constraintList = append(table.constraintList, info)
+			}
+		}
+	}
+	for _, constraint := range defaultList {
+		column := table.getColumn(normalizeIdentifier(constraint.GetColumn()), b.ignoreCase)
+		if column == nil {
+			continue
+		}
+		column.defaultInfo = &defaultInfo{
+			name:        normalizeIdentifier(constraint.GetConstraint()),
+			expression:  b.text(constraint.GetConstant_expr()),
+			compareText: b.normalizedText(constraint.GetConstant_expr()),
+		}
+	}
+}
+
+func (b *schemaBuilder) addColumn(table *tableInfo, ctx tsqlparser.IColumn_definitionContext) {
+	column := &columnInfo{
+		name:     normalizeIdentifier(ctx.Id_()),
+		nullable: true,
+	}
+	// The column definition consists of the parts except the constraints, NULL and NOT NULL are appended at last.
+	partList := []string{b.text(ctx.Id_())}
+	if dataType := ctx.Data_type(); dataType != nil {
+		partList = append(partList, b.text(dataType))
+		// The IDENTITY property is parsed as a part of the data type, but it cannot be altered.
+		stop := dataType.GetStop().GetTokenIndex()
+		if dataType.IDENTITY() != nil {
+			stop = dataType.IDENTITY().GetSymbol().GetTokenIndex() - 1
+		}
+		column.dataType = b.textBetween(dataType.GetStart().GetTokenIndex(), stop)
+		column.dataTypeCompareText = strings.ToLower(b.normalizedTextBetween(dataType.GetStart().GetTokenIndex(), stop))
+	} else {
+		stop := ctx.Expression().GetStop().GetTokenIndex()
+		if ctx.PERSISTED() != nil {
+			stop = ctx.PERSISTED().GetSymbol().GetTokenIndex()
+		}
+		partList = append(partList, b.textBetween(ctx.AS().GetSymbol().GetTokenIndex(), stop))
+		column.dataTypeCompareText = strings.ToLower(b.normalizedTextBetween(ctx.AS().GetSymbol().GetTokenIndex(), stop))
+		column.computed = true
+	}
+
+	nullSpec := ""
+	for _, element := range ctx.AllColumn_definition_element() {
+		switch {
+		case element.Column_constraint() != nil:
+			constraint := element.Column_constraint()
+			if constraint.Null_notnull() != nil {
+				column.nullable = constraint.Null_notnull().NOT() == nil
+				nullSpec = b.text(constraint.Null_notnull())
+				continue
+			}
+			if info := b.newColumnConstraintInfo(column.name, constraint); info != nil {
+				table.constraintList = append(table.constraintList, info)
+			}
+		case element.DEFAULT() != nil:
+			column.defaultInfo = &defaultInfo{
+				name:        normalizeIdentifier(element.GetConstraint()),
+				expression:  b.text(element.GetConstant_expr()),
+				compareText: b.normalizedText(element.GetConstant_expr()),
+			}
+			partList = append(partList, b.text(element))
+		case element.COLLATE() != nil:
+			column.dataType = fmt.Sprintf("%s %s", column.dataType, b.text(element))
+			column.dataTypeCompareText = fmt.Sprintf("%s %s", column.dataTypeCompareText, strings.ToLower(b.normalizedText(element)))
+			partList = append(partList, b.text(element))
+		default:
+			partList = append(partList, b.text(element))
+		}
+	}
+	if ctx.Column_index() != nil {
+		partList = append(partList, b.text(ctx.Column_index()))
+	}
+	if nullSpec != "" {
+		partList = append(partList, nullSpec)
+	}
+	column.definition = strings.Join(partList, " ")
+	table.columnList = append(table.columnList, column)
+}
+
+// newColumnConstraintInfo converts the column constraint to the table constraint.
+func (b *schemaBuilder) newColumnConstraintInfo(columnName string, ctx tsqlparser.IColumn_constraintContext) *constraintInfo {
+	constraint := &constraintInfo{
+		name: normalizeIdentifier(ctx.GetConstraint()),
+	}
+	var partList []string
+	if constraint.name != "" {
+		partList = append(partList, fmt.Sprintf("CONSTRAINT %s", quoteIdentifier(constraint.name)))
+	}
+	switch {
+	case ctx.PRIMARY() != nil || ctx.UNIQUE() != nil:
+		constraint.tp = constraintTypePrimaryKey
+		keyword := "PRIMARY KEY"
+		if ctx.UNIQUE() != nil {
+			constraint.tp = constraintTypeUnique
+			keyword = "UNIQUE"
+		}
+		partList = append(partList, keyword)
+		if ctx.Clustered() != nil {
+			partList = append(partList, b.text(ctx.Clustered()))
+		}
+		partList = append(partList, fmt.Sprintf("(%s)", quoteIdentifier(columnName)))
+		if options := b.text(ctx.Primary_key_options()); options != "" {
+			partList = append(partList, options)
+		}
+		constraint.columnList = []string{columnName}
+	case ctx.Foreign_key_options() != nil:
+		constraint.tp = constraintTypeForeignKey
+		partList = append(partList, fmt.Sprintf("FOREIGN KEY (%s)", quoteIdentifier(columnName)), b.text(ctx.Foreign_key_options()))
+	case ctx.Check_constraint() != nil:
+		constraint.tp = constraintTypeCheck
+		partList = append(partList, b.text(ctx.Check_constraint()))
+	default:
+		return nil
+	}
+	constraint.definition = strings.Join(partList, " ")
+	constraint.compareText = normalizeText(constraint.definition)
+	return constraint
+}
+
+func (b *schemaBuilder) newTableConstraintInfo(ctx tsqlparser.ITable_constraintContext) *constraintInfo {
+	constraint := &constraintInfo{
+		name:        normalizeIdentifier(ctx.GetConstraint()),
+		definition:  b.text(ctx),
+		compareText: b.normalizedText(ctx),
+	}
+	switch {
+	case ctx.PRIMARY() != nil || ctx.UNIQUE() != nil:
+		constraint.tp = constraintTypePrimaryKey
+		if ctx.UNIQUE() != nil {
+			constraint.tp = constraintTypeUnique
+		}
+		for _, id := range ctx.Column_name_list_with_order().AllId_() {
+			constraint.columnList = append(constraint.columnList, normalizeIdentifier(id))
+		}
+	case ctx.FOREIGN() != nil:
+		constraint.tp = constraintTypeForeignKey
+	case ctx.Check_constraint() != nil:
+		constraint.tp = constraintTypeCheck
+	default:
+		return nil
+	}
+	return constraint
+}
+
+func (b *schemaBuilder) addIndex(ctx tsqlparser.ICreate_indexContext) {
+	index := &objectInfo{
+		id:          b.nextID(),
+		name:        objectName{name: normalizeIdentifier(ctx.Id_(0))},
+		table:       normalizeTableName(ctx.Table_name(), defaultSchema),
+		text:        b.statementText(ctx),
+		compareText: b.normalizedText(ctx),
+	}
+	b.schema.indexMap[indexKey(index, b.ignoreCase)] = index
+}
+
+// addTableIndex converts the inline index to the CREATE INDEX statement.
+func (b *schemaBuilder) addTableIndex(table *tableInfo, ctx tsqlparser.ITable_indicesContext) {
+	indexName := normalizeIdentifier(ctx.Id_(0))
+	var text string
+	switch {
+	case ctx.COLUMNSTORE() != nil && ctx.Column_name_list() == nil:
+		text = fmt.Sprintf("CREATE CLUSTERED COLUMNSTORE INDEX %s ON %s;", quoteIdentifier(indexName), table.name)
+	case ctx.COLUMNSTORE() != nil:
+		text = fmt.Sprintf("CREATE NONCLUSTERED COLUMNSTORE INDEX %s ON %s (%s);", quoteIdentifier(indexName), table.name, b.text(ctx.Column_name_list()))
+	default:
+		var partList []string
+		partList = append(partList, "CREATE")
+		if ctx.UNIQUE() != nil {
+			partList = append(partList, "UNIQUE")
+		}
+		if ctx.Clustered() != nil {
+			partList = append(partList, strings.ToUpper(b.text(ctx.Clustered())))
+		}
+		partList = append(partList, "INDEX", quoteIdentifier(indexName), "ON", table.name.String(), fmt.Sprintf("(%s);", b.text(ctx.Column_name_list_with_order())))
+		text = strings.Join(partList, " ")
+	}
+	index := &objectInfo{
+		id:          b.nextID(),
+		name:        objectName{name: indexName},
+		table:       table.name,
+		text:        text,
+		compareText: normalizeText(text),
+	}
+	b.schema.indexMap[indexKey(index, b.ignoreCase)] = index
+}
+
+func (b *schemaBuilder) addAlterTable(ctx tsqlparser.IAlter_tableContext) error {
+	name := normalizeTableName(ctx.Table_name(0), defaultSchema)
+	table, ok := b.schema.tableMap[name.key(b.ignoreCase)]
+	if !ok {
+		return errors.Errorf("table %s not found", name)
+	}
+	switch {
+	case ctx.WITH() != nil && ctx.ADD() != nil:
+		// ALTER TABLE t WITH CHECK ADD CONSTRAINT c FOREIGN KEY ... is generated by SSMS.
+		constraint := &constraintInfo{
+			name:        normalizeIdentifier(ctx.GetConstraint()),
+			tp:          constraintTypeCheck,
+			definition:  b.textBetween(ctx.ADD().GetSymbol().GetTokenIndex()+1, b.lastTokenIndex(ctx)),
+			compareText: b.normalizedTextBetween(ctx.ADD().GetSymbol().GetTokenIndex()+1, b.lastTokenIndex(ctx)),
+		}
+		if ctx.FOREIGN() != nil {
+			constraint.tp = constraintTypeForeignKey
+		}
+		table.constraintList = append(table.constraintList, constraint)
+	case ctx.ADD() != nil:
+		b.addColumnDefTableConstraints(table, ctx.Column_def_table_constraints())
+	}
+	return nil
+}
+
+func (b *schemaBuilder) addSequence(ctx tsqlparser.ICreate_sequenceContext) {
+	schemaName := defaultSchema
+	if ctx.GetSchema_name() != nil {
+		schemaName = normalizeIdentifier(ctx.GetSchema_name())
+	}
+	name := objectName{schema: schemaName, name: normalizeIdentifier(ctx.GetSequence_name())}
+	sequence := &sequenceInfo{
+		id:   b.nextID(),
+		name: name,
+		text: b.statementText(ctx),
+	}
+
+	// Skip the data type and the START WITH clause in the spec list.
+	skip := make(map[int]bool)
+	if ctx.Data_type() != nil {
+		sequence.dataType = strings.ToLower(b.normalizedText(ctx.Data_type()))
+		for i := ctx.AS().GetSymbol().GetTokenIndex(); i <= ctx.Data_type().GetStop().GetTokenIndex(); i++ {
+			skip[i] = true
+		}
+	}
+	if ctx.START() != nil {
+		for i := ctx.START().GetSymbol().GetTokenIndex(); i <= ctx.START().GetSymbol().GetTokenIndex()+2; i++ {
+			skip[i] = true
+		}
+	}
+	for i := ctx.GetSequence_name().GetStop().GetTokenIndex() + 1; i <= b.lastTokenIndex(ctx); i++ {
+		token := b.stream.Get(i)
+		if skip[i] || token.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		sequence.specList = append(sequence.specList, token.GetText())
+	}
+	sequence.compareText = strings.Join(sequence.specList, " ")
+	b.schema.sequenceMap[name.key(b.ignoreCase)] = sequence
+}
+
+// markPrimaryKeyColumnsNotNull marks the primary key columns as NOT NULL,
+// because SQL Server makes the primary key columns NOT NULL implicitly.
+func (b *schemaBuilder) markPrimaryKeyColumnsNotNull() {
+	for _, table := range b.schema.tableMap {
+		for _, constraint := range table.constraintList {
+			if constraint.tp != constraintTypePrimaryKey {
+				continue
+			}
+			for _, columnName := range constraint.columnList {
+				if column := table.getColumn(columnName, b.ignoreCase); column != nil {
+					column.nullable = false
+				}
+			}
+		}
+	}
+}
+
+// newObjectInfo returns the object info for the views, functions, procedures and triggers.
+// The text starts from the object type keyword, so that we can prepend CREATE or CREATE OR ALTER.
+func (b *schemaBuilder) newObjectInfo(name objectName, table objectName, keywordIndex int, ctx antlr.ParserRuleContext) *objectInfo {
+	return &objectInfo{
+		id:          b.nextID(),
+		name:        name,
+		table:       table,
+		text:        b.textBetween(keywordIndex, b.lastTokenIndex(ctx)) + ";",
+		compareText: b.normalizedTextBetween(keywordIndex, b.lastTokenIndex(ctx)),
+	}
+}
+
+// text returns the original text of the rule context.
+func (b *schemaBuilder) text(ctx antlr.ParserRuleContext) string {
+	if ctx == nil || ctx.GetStop() == nil {
+		return ""
+	}
+	return b.textBetween(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex())
+}
+
+// statementText returns the original text of the statement ending with a semicolon.
+func (b *schemaBuilder) statementText(ctx antlr.ParserRuleContext) string {
+	return b.textBetween(ctx.GetStart().GetTokenIndex(), b.lastTokenIndex(ctx)) + ";"
+}
+
+// lastTokenIndex returns the index of the last token of the rule context except the trailing semicolon.
+func (b *schemaBuilder) lastTokenIndex(ctx antlr.ParserRuleContext) int {
+	stop := ctx.GetStop().GetTokenIndex()
+	if ctx.GetStop().GetTokenType() == tsqlparser.TSqlParserSEMI {
+		stop--
+	}
+	return stop
+}
+
+// textBetween returns the original text between the tokens. The T-SQL lexer skips the whitespaces
+// instead of putting them on the hidden channel, so we read the text from the input stream.
+func (b *schemaBuilder) textBetween(start, stop int) string {
+	if start > stop {
+		return ""
+	}
+	startToken, stopToken := b.stream.Get(start), b.stream.Get(stop)
+	return strings.TrimSpace(startToken.GetInputStream().GetText(startToken.GetStart(), stopToken.GetStop()))
+}
+
+// normalizedText returns the text of the default channel tokens joined by a space,
+// so that the comparison ignores the differences in whitespaces and comments.
+func (b *schemaBuilder) normalizedText(ctx antlr.ParserRuleContext) string {
+	if ctx == nil || ctx.GetStop() == nil {
+		return ""
+	}
+	return b.normalizedTextBetween(ctx.GetStart().GetTokenIndex(), b.lastTokenIndex(ctx))
+}
+
+func (b *schemaBuilder) normalizedTextBetween(start, stop int) string {
+	var tokenList []string
+	for i := start; i <= stop; i++ {
+		token := b.stream.Get(i)
+		if token.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		tokenList = append(tokenList, token.GetText())
+	}
+	return strings.Join(tokenList, " ")
+}
+
+// normalizeText collapses the whitespaces of the text.
+func normalizeText(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// normalizeIdentifier returns the unquoted identifier with the original case.
+func normalizeIdentifier(ctx tsqlparser.IId_Context) string {
+	if ctx == nil {
+		return ""
+	}
+	text := ctx.GetText()
+	if len(text) >= 2 && text[0] == '[' && text[len(text)-1] == ']' {
+		return strings.ReplaceAll(text[1:len(text)-1], "]]", "]")
+	}
+	if len(text) >= 2 && text[0] == '"' && text[len(text)-1] == '"' {
+		return strings.ReplaceAll(text[1:len(text)-1], `""`, `"`)
+	}
+	return text
+}
+
+func normalizeTableName(ctx tsqlparser.ITable_nameContext, schemaName string) objectName {
+	if ctx.GetSchema() != nil {
+		schemaName = normalizeIdentifier(ctx.GetSchema())
+	}
+	return objectName{schema: schemaName, name: normalizeIdentifier(ctx.GetTable())}
+}
+
+func normalizeSimpleName(ctx tsqlparser.ISimple_nameContext, schemaName string) objectName {
+	if ctx.GetSchema() != nil {
+		schemaName = normalizeIdentifier(ctx.GetSchema())
+	}
+	return objectName{schema: schemaName, name: normalizeIdentifier(ctx.GetName())}
+}
+
+func normalizeFuncProcName(ctx tsqlparser.IFunc_proc_name_schemaContext) objectName {
+	schemaName := defaultSchema
+	if ctx.GetSchema() != nil {
+		schemaName = normalizeIdentifier(ctx.GetSchema())
+	}
+	return objectName{schema: schemaName, name: normalizeIdentifier(ctx.GetProcedure())}
+}
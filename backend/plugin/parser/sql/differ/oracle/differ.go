@@ -0,0 +1,903 @@
+// Package oracle provides the Oracle differ plugin.
+package oracle
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	plsql "github.com/bytebase/plsql-parser"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/differ"
+)
+
+var _ differ.SchemaDiffer = (*SchemaDiffer)(nil)
+
+func init() {
+	differ.Register(parser.Oracle, &SchemaDiffer{})
+}
+
+// SchemaDiffer it the differ for Oracle dialect.
+type SchemaDiffer struct {
+}
+
+// diffNode defines different modification types as the safe change order.
+// The safe change order means we can change them with no dependency conflicts as this order.
+type diffNode struct {
+	// Drop nodes
+	dropTriggerList            []string
+	dropViewList               []string
+	dropFunctionList           []string
+	dropForeignKeyList         []string
+	dropConstraintExceptFkList []string
+	dropIndexList              []string
+	dropColumnList             []string
+	dropTableList              []string
+	dropSequenceList           []string
+
+	// Create nodes
+	createSequenceList           []string
+	alterSequenceList            []string
+	createTableList              []string
+	createColumnList             []string
+	alterColumnList              []string
+	createConstraintExceptFkList []string
+	createIndexList              []string
+	createForeignKeyList         []string
+	createFunctionList           []string
+	createViewList               []string
+	createTriggerList            []string
+}
+
+type constraintType int
+
+const (
+	constraintTypePrimaryKey constraintType = iota
+	constraintTypeUnique
+	constraintTypeCheck
+	constraintTypeForeignKey
+)
+
+// objectName is the normalized name of a schema object.
+type objectName struct {
+	schema string
+	name   string
+}
+
+func (n objectName) String() string {
+	if n.schema == "" {
+		return quoteIdentifier(n.name)
+	}
+	return fmt.Sprintf("%s.%s", quoteIdentifier(n.schema), quoteIdentifier(n.name))
+}
+
+type schemaInfo struct {
+	tableMap     map[objectName]*tableInfo
+	indexMap     map[objectName]*objectInfo
+	sequenceMap  map[objectName]*sequenceInfo
+	viewMap      map[objectName]*objectInfo
+	functionMap  map[objectName]*objectInfo
+	procedureMap map[objectName]*objectInfo
+	triggerMap   map[objectName]*objectInfo
+}
+
+func newSchemaInfo() *schemaInfo {
+	return &schemaInfo{
+		tableMap:     make(map[objectName]*tableInfo),
+		indexMap:     make(map[objectName]*objectInfo),
+		sequenceMap:  make(map[objectName]*sequenceInfo),
+		viewMap:      make(map[objectName]*objectInfo),
+		functionMap:  make(map[objectName]*objectInfo),
+		procedureMap: make(map[objectName]*objectInfo),
+		triggerMap:   make(map[objectName]*objectInfo),
+	}
+}
+
+type tableInfo struct {
+	id   int
+	name objectName
+	// prefix is the text before the column list, such as "CREATE GLOBAL TEMPORARY TABLE t".
+	prefix string
+	// suffix is the text after the column list, such as the physical properties.
+	suffix string
+	// text is the whole CREATE TABLE statement, which is used if the table is not a relational table.
+	text           string
+	columnList     []*columnInfo
+	constraintList []*constraintInfo
+}
+
+func (t *tableInfo) getColumn(name string) *columnInfo {
+	for _, column := range t.columnList {
+		if column.name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+func (t *tableInfo) getConstraint(key string) *constraintInfo {
+	for _, constraint := range t.constraintList {
+		if constraint.key() == key {
+			return constraint
+		}
+	}
+	return nil
+}
+
+type columnInfo struct {
+	name string
+	// definition is the column definition without inline constraints.
+	definition string
+	// dataType and defaultValue are the original text, and the compare texts are used to compare them.
+	dataType                string
+	dataTypeCompareText     string
+	defaultValue            string
+	defaultValueCompareText string
+	notNull                 bool
+	// virtual is true for the virtual columns, which are recreated if changed.
+	virtual bool
+}
+
+type constraintInfo struct {
+	name        string
+	tp          constraintType
+	columnList  []string
+	definition  string
+	compareText string
+}
+
+// key returns the key to match the constraint between the old and new schema.
+// The unnamed constraints are matched by the definition.
+func (c *constraintInfo) key() string {
+	if c.name != "" {
+		return c.name
+	}
+	if c.tp == constraintTypePrimaryKey {
+		return "PRIMARY KEY"
+	}
+	return c.compareText
+}
+
+type objectInfo struct {
+	id          int
+	name        objectName
+	table       objectName
+	text        string
+	compareText string
+}
+
+type sequenceInfo struct {
+	id   int
+	name objectName
+	text string
+	// specList is the sequence options except the START WITH clause.
+	// We ignore the START WITH clause because it cannot be altered and Oracle dumps the current value as it.
+	specList    []string
+	compareText string
+}
+
+// SchemaDiff computes the schema differences between old and new schema.
+func (*SchemaDiffer) SchemaDiff(oldStmt, newStmt string, _ bool) (string, error) {
+	oldSchema, err := buildSchemaInfo(oldStmt)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse old statements %q", oldStmt)
+	}
+	newSchema, err := buildSchemaInfo(newStmt)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse new statements %q", newStmt)
+	}
+
+	diff := &diffNode{}
+	if err := diff.diffTable(oldSchema, newSchema); err != nil {
+		return "", err
+	}
+	diff.diffIndex(oldSchema, newSchema)
+	diff.diffSequence(oldSchema, newSchema)
+	diff.dropViewList, diff.createViewList = diffObject(oldSchema.viewMap, newSchema.viewMap, "VIEW", true /* replaceable */)
+	dropFunctionList, createFunctionList := diffObject(oldSchema.functionMap, newSchema.functionMap, "FUNCTION", true /* replaceable */)
+	dropProcedureList, createProcedureList := diffObject(oldSchema.procedureMap, newSchema.procedureMap, "PROCEDURE", true /* replaceable */)
+	diff.dropFunctionList = append(dropFunctionList, dropProcedureList...)
+	diff.createFunctionList = append(createFunctionList, createProcedureList...)
+	diff.dropTriggerList, diff.createTriggerList = diffObject(oldSchema.triggerMap, newSchema.triggerMap, "TRIGGER", true /* replaceable */)
+
+	return diff.deparse(), nil
+}
+
+func (diff *diffNode) diffTable(oldSchema, newSchema *schemaInfo) error {
+	for _, newTable := range sortedTables(newSchema.tableMap) {
+		oldTable, ok := oldSchema.tableMap[newTable.name]
+		if !ok {
+			diff.createTable(newTable)
+			continue
+		}
+		if err := diff.modifyTable(oldTable, newTable); err != nil {
+			return err
+		}
+	}
+
+	// Drop the tables in the reverse order of creation, so that the referencing tables are dropped first.
+	oldTableList := sortedTables(oldSchema.tableMap)
+	for i := len(oldTableList) - 1; i >= 0; i-- {
+		oldTable := oldTableList[i]
+		if _, ok := newSchema.tableMap[oldTable.name]; ok {
+			continue
+		}
+		for _, constraint := range oldTable.constraintList {
+			if constraint.tp == constraintTypeForeignKey && constraint.name != "" {
+				diff.dropForeignKeyList = append(diff.dropForeignKeyList, dropConstraintStmt(oldTable.name, constraint.name))
+			}
+		}
+		diff.dropTableList = append(diff.dropTableList, fmt.Sprintf("DROP TABLE %s;", oldTable.name))
+	}
+	return nil
+}
+
+func (diff *diffNode) createTable(table *tableInfo) {
+	if table.text != "" {
+		diff.createTableList = append(diff.createTableList, table.text)
+		return
+	}
+	var itemList []string
+	for _, column := range table.columnList {
+		itemList = append(itemList, column.definition)
+	}
+	for _, constraint := range table.constraintList {
+		// Create foreign keys after all tables are created to avoid the dependency conflicts.
+		if constraint.tp == constraintTypeForeignKey {
+			diff.createForeignKeyList = append(diff.createForeignKeyList, addConstraintStmt(table.name, constraint))
+			continue
+		}
+		itemList = append(itemList, constraint.definition)
+	}
+	var buf strings.Builder
+	_, _ = buf.WriteString(table.prefix)
+	_, _ = buf.WriteString(" (\n")
+	for i, item := range itemList {
+		_, _ = buf.WriteString("    ")
+		_, _ = buf.WriteString(item)
+		if i != len(itemList)-1 {
+			_, _ = buf.WriteString(",")
+		}
+		_, _ = buf.WriteString("\n")
+	}
+	_, _ = buf.WriteString(")")
+	if table.suffix != "" {
+		_, _ = buf.WriteString(" ")
+		_, _ = buf.WriteString(table.suffix)
+	}
+	_, _ = buf.WriteString(";")
+	diff.createTableList = append(diff.createTableList, buf.String())
+}
+
+func (diff *diffNode) modifyTable(oldTable, newTable *tableInfo) error {
+	for _, newColumn := range newTable.columnList {
+		oldColumn := oldTable.getColumn(newColumn.name)
+		if oldColumn == nil {
+			diff.createColumnList = append(diff.createColumnList, fmt.Sprintf("ALTER TABLE %s ADD (%s);", newTable.name, newColumn.definition))
+			continue
+		}
+		if oldColumn.virtual || newColumn.virtual {
+			if oldColumn.virtual != newColumn.virtual || oldColumn.dataTypeCompareText != newColumn.dataTypeCompareText {
+				diff.dropColumnList = append(diff.dropColumnList, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", oldTable.name, quoteIdentifier(oldColumn.name)))
+				diff.createColumnList = append(diff.createColumnList, fmt.Sprintf("ALTER TABLE %s ADD (%s);", newTable.name, newColumn.definition))
+			}
+			continue
+		}
+		if stmt := modifyColumnStmt(newTable.name, oldColumn, newColumn); stmt != "" {
+			diff.alterColumnList = append(diff.alterColumnList, stmt)
+		}
+	}
+	for _, oldColumn := range oldTable.columnList {
+		if newTable.getColumn(oldColumn.name) == nil {
+			diff.dropColumnList = append(diff.dropColumnList, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", oldTable.name, quoteIdentifier(oldColumn.name)))
+		}
+	}
+
+	for _, oldConstraint := range oldTable.constraintList {
+		newConstraint := newTable.getConstraint(oldConstraint.key())
+		if newConstraint != nil && newConstraint.compareText == oldConstraint.compareText {
+			continue
+		}
+		stmt, err := dropUnnamedOrNamedConstraintStmt(oldTable.name, oldConstraint)
+		if err != nil {
+			return err
+		}
+		if oldConstraint.tp == constraintTypeForeignKey {
+			diff.dropForeignKeyList = append(diff.dropForeignKeyList, stmt)
+		} else {
+			diff.dropConstraintExceptFkList = append(diff.dropConstraintExceptFkList, stmt)
+		}
+	}
+	for _, newConstraint := range newTable.constraintList {
+		oldConstraint := oldTable.getConstraint(newConstraint.key())
+		if oldConstraint != nil && oldConstraint.compareText == newConstraint.compareText {
+			continue
+		}
+		if newConstraint.tp == constraintTypeForeignKey {
+			diff.createForeignKeyList = append(diff.createForeignKeyList, addConstraintStmt(newTable.name, newConstraint))
+		} else {
+			diff.createConstraintExceptFkList = append(diff.createConstraintExceptFkList, addConstraintStmt(newTable.name, newConstraint))
+		}
+	}
+	return nil
+}
+
+// modifyColumnStmt returns the ALTER TABLE MODIFY statement with the changed attributes only,
+// because Oracle reports an error if we modify a NOT NULL column to NOT NULL.
+func modifyColumnStmt(table objectName, oldColumn, newColumn *columnInfo) string {
+	var attributeList []string
+	if oldColumn.dataTypeCompareText != newColumn.dataTypeCompareText {
+		attributeList = append(attributeList, newColumn.dataType)
+	}
+	if oldColumn.defaultValueCompareText != newColumn.defaultValueCompareText {
+		if newColumn.defaultValue == "" {
+			attributeList = append(attributeList, "DEFAULT NULL")
+		} else {
+			attributeList = append(attributeList, "DEFAULT "+newColumn.defaultValue)
+		}
+	}
+	if oldColumn.notNull != newColumn.notNull {
+		if newColumn.notNull {
+			attributeList = append(attributeList, "NOT NULL")
+		} else {
+			attributeList = append(attributeList, "NULL")
+		}
+	}
+	if len(attributeList) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("ALTER TABLE %s MODIFY (%s %s);", table, quoteIdentifier(newColumn.name), strings.Join(attributeList, " "))
+}
+
+func addConstraintStmt(table objectName, constraint *constraintInfo) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD %s;", table, constraint.definition)
+}
+
+func dropConstraintStmt(table objectName, constraintName string) string {
+	return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", table, quoteIdentifier(constraintName))
+}
+
+func dropUnnamedOrNamedConstraintStmt(table objectName, constraint *constraintInfo) (string, error) {
+	if constraint.name != "" {
+		return dropConstraintStmt(table, constraint.name), nil
+	}
+	switch constraint.tp {
+	case constraintTypePrimaryKey:
+		return fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY;", table), nil
+	case constraintTypeUnique:
+		var columnList []string
+		for _, column := range constraint.columnList {
+			columnList = append(columnList, quoteIdentifier(column))
+		}
+		return fmt.Sprintf("ALTER TABLE %s DROP UNIQUE (%s);", table, strings.Join(columnList, ", ")), nil
+	default:
+		return "", errors.Errorf("cannot drop the unnamed constraint %q of table %s, please name the constraint", constraint.definition, table)
+	}
+}
+
+func (diff *diffNode) diffIndex(oldSchema, newSchema *schemaInfo) {
+	for _, newIndex := range sortedObjects(newSchema.indexMap) {
+		oldIndex, ok := oldSchema.indexMap[newIndex.name]
+		if ok && oldIndex.compareText == newIndex.compareText {
+			continue
+		}
+		if ok {
+			diff.dropIndexList = append(diff.dropIndexList, fmt.Sprintf("DROP INDEX %s;", oldIndex.name))
+		}
+		diff.createIndexList = append(diff.createIndexList, newIndex.text)
+	}
+	for _, oldIndex := range sortedObjects(oldSchema.indexMap) {
+		if _, ok := newSchema.indexMap[oldIndex.name]; ok {
+			continue
+		}
+		// The index is dropped with the table.
+		if _, ok := newSchema.tableMap[oldIndex.table]; !ok {
+			continue
+		}
+		diff.dropIndexList = append(diff.dropIndexList, fmt.Sprintf("DROP INDEX %s;", oldIndex.name))
+	}
+}
+
+func (diff *diffNode) diffSequence(oldSchema, newSchema *schemaInfo) {
+	var newSequenceList []*sequenceInfo
+	for _, sequence := range newSchema.sequenceMap {
+		newSequenceList = append(newSequenceList, sequence)
+	}
+	sort.Slice(newSequenceList, func(i, j int) bool {
+		return newSequenceList[i].id < newSequenceList[j].id
+	})
+	for _, newSequence := range newSequenceList {
+		oldSequence, ok := oldSchema.sequenceMap[newSequence.name]
+		if !ok {
+			diff.createSequenceList = append(diff.createSequenceList, newSequence.text)
+			continue
+		}
+		if oldSequence.compareText != newSequence.compareText && len(newSequence.specList) > 0 {
+			diff.alterSequenceList = append(diff.alterSequenceList, fmt.Sprintf("ALTER SEQUENCE %s %s;", newSequence.name, strings.Join(newSequence.specList, " ")))
+		}
+	}
+
+	var oldSequenceList []*sequenceInfo
+	for _, sequence := range oldSchema.sequenceMap {
+		oldSequenceList = append(oldSequenceList, sequence)
+	}
+	sort.Slice(oldSequenceList, func(i, j int) bool {
+		return oldSequenceList[i].id < oldSequenceList[j].id
+	})
+	for _, oldSequence := range oldSequenceList {
+		if _, ok := newSchema.sequenceMap[oldSequence.name]; !ok {
+			diff.dropSequenceList = append(diff.dropSequenceList, fmt.Sprintf("DROP SEQUENCE %s;", oldSequence.name))
+		}
+	}
+}
+
+// diffObject computes the differences of the objects which are changed as a whole, such as views and functions.
+// The changed objects are replaced by CREATE OR REPLACE statements if they are replaceable.
+func diffObject(oldMap, newMap map[objectName]*objectInfo, objectType string, replaceable bool) ([]string, []string) {
+	var dropList, createList []string
+	for _, newObject := range sortedObjects(newMap) {
+		oldObject, ok := oldMap[newObject.name]
+		if !ok {
+			createList = append(createList, newObject.text)
+			continue
+		}
+		if oldObject.compareText == newObject.compareText {
+			continue
+		}
+		if replaceable {
+			createList = append(createList, createOrReplace(newObject.text))
+			continue
+		}
+		dropList = append(dropList, fmt.Sprintf("DROP %s %s;", objectType, oldObject.name))
+		createList = append(createList, newObject.text)
+	}
+	oldObjectList := sortedObjects(oldMap)
+	for i := len(oldObjectList) - 1; i >= 0; i-- {
+		oldObject := oldObjectList[i]
+		if _, ok := newMap[oldObject.name]; !ok {
+			dropList = append(dropList, fmt.Sprintf("DROP %s %s;", objectType, oldObject.name))
+		}
+	}
+	return dropList, createList
+}
+
+// createOrReplace converts the CREATE statement to CREATE OR REPLACE statement.
+func createOrReplace(text string) string {
+	fields := strings.Fields(text)
+	if len(fields) >= 3 && strings.EqualFold(fields[1], "OR") && strings.EqualFold(fields[2], "REPLACE") {
+		return text
+	}
+	index := strings.Index(strings.ToUpper(text), "CREATE")
+	if index < 0 {
+		return text
+	}
+	return text[:index+len("CREATE")] + " OR REPLACE" + text[index+len("CREATE"):]
+}
+
+func sortedTables(m map[objectName]*tableInfo) []*tableInfo {
+	var list []*tableInfo
+	for _, table := range m {
+		list = append(list, table)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].id < list[j].id
+	})
+	return list
+}
+
+func sortedObjects(m map[objectName]*objectInfo) []*objectInfo {
+	var list []*objectInfo
+	for _, object := range m {
+		list = append(list, object)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].id < list[j].id
+	})
+	return list
+}
+
+func printStmtSlice(buf *strings.Builder, stmtList []string) {
+	for _, stmt := range stmtList {
+		_, _ = buf.WriteString(stmt)
+		_, _ = buf.WriteString("\n\n")
+	}
+}
+
+// deparse statements as the safe change order.
+func (diff *diffNode) deparse() string {
+	var buf strings.Builder
+
+	// drop
+	printStmtSlice(&buf, diff.dropTriggerList)
+	printStmtSlice(&buf, diff.dropViewList)
+	printStmtSlice(&buf, diff.dropFunctionList)
+	printStmtSlice(&buf, diff.dropForeignKeyList)
+	printStmtSlice(&buf, diff.dropConstraintExceptFkList)
+	printStmtSlice(&buf, diff.dropIndexList)
+	printStmtSlice(&buf, diff.dropColumnList)
+	printStmtSlice(&buf, diff.dropTableList)
+	printStmtSlice(&buf, diff.dropSequenceList)
+
+	// create
+	printStmtSlice(&buf, diff.createSequenceList)
+	printStmtSlice(&buf, diff.alterSequenceList)
+	printStmtSlice(&buf, diff.createTableList)
+	printStmtSlice(&buf, diff.createColumnList)
+	printStmtSlice(&buf, diff.alterColumnList)
+	printStmtSlice(&buf, diff.createConstraintExceptFkList)
+	printStmtSlice(&buf, diff.createIndexList)
+	printStmtSlice(&buf, diff.createForeignKeyList)
+	printStmtSlice(&buf, diff.createFunctionList)
+	printStmtSlice(&buf, diff.createViewList)
+	printStmtSlice(&buf, diff.createTriggerList)
+
+	return buf.String()
+}
+
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, identifier)
+}
+
+// schemaBuilder builds the schema info from the PL/SQL parse tree.
+type schemaBuilder struct {
+	stream antlr.TokenStream
+	schema *schemaInfo
+}
+
+func buildSchemaInfo(statement string) (*schemaInfo, error) {
+	schema := newSchemaInfo()
+	if strings.TrimSpace(statement) == "" {
+		return schema, nil
+	}
+	tree, stream, err := parser.ParsePLSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	script, ok := tree.(*plsql.Sql_scriptContext)
+	if !ok {
+		return nil, errors.Errorf("expect Sql_scriptContext but got %T", tree)
+	}
+	builder := &schemaBuilder{
+		stream: stream,
+		schema: schema,
+	}
+	for i, unit := range script.AllUnit_statement() {
+		if err := builder.addUnitStatement(i, unit); err != nil {
+			return nil, err
+		}
+	}
+	return schema, nil
+}
+
+func (b *schemaBuilder) addUnitStatement(id int, unit plsql.IUnit_statementContext) error {
+	switch {
+	case unit.Create_table() != nil:
+		return b.addTable(id, unit.Create_table())
+	case unit.Alter_table() != nil:
+		return b.addAlterTable(unit.Alter_table())
+	case unit.Create_index() != nil:
+		ctx := unit.Create_index()
+		name := normalizeIndexName(ctx.Index_name())
+		var table objectName
+		if clause := ctx.Table_index_clause(); clause != nil {
+			table = normalizeTableviewName(clause.Tableview_name())
+		}
+		b.schema.indexMap[name] = b.newObjectInfo(id, name, table, ctx)
+	case unit.Create_sequence() != nil:
+		b.addSequence(id, unit.Create_sequence())
+	case unit.Create_view() != nil:
+		ctx := unit.Create_view()
+		name := objectName{name: parser.PLSQLNormalizeIDExpression(ctx.GetV())}
+		if ctx.Schema_name() != nil {
+			name.schema = parser.PLSQLNormalizeIdentifierContext(ctx.Schema_name().Identifier())
+		}
+		b.schema.viewMap[name] = b.newObjectInfo(id, name, objectName{}, ctx)
+	case unit.Create_function_body() != nil:
+		ctx := unit.Create_function_body()
+		name := normalizeName(ctx.Function_name().Identifier(), ctx.Function_name().Id_expression())
+		b.schema.functionMap[name] = b.newObjectInfo(id, name, objectName{}, ctx)
+	case unit.Create_procedure_body() != nil:
+		ctx := unit.Create_procedure_body()
+		name := normalizeName(ctx.Procedure_name().Identifier(), ctx.Procedure_name().Id_expression())
+		b.schema.procedureMap[name] = b.newObjectInfo(id, name, objectName{}, ctx)
+	case unit.Create_trigger() != nil:
+		ctx := unit.Create_trigger()
+		name := normalizeName(ctx.Trigger_name().Identifier(), ctx.Trigger_name().Id_expression())
+		b.schema.triggerMap[name] = b.newObjectInfo(id, name, objectName{}, ctx)
+	}
+	return nil
+}
+
+func (b *schemaBuilder) addTable(id int, ctx plsql.ICreate_tableContext) error {
+	name := objectName{name: parser.PLSQLNormalizeIdentifierContext(ctx.Table_name().Identifier())}
+	if ctx.Schema_name() != nil {
+		name.schema = parser.PLSQLNormalizeIdentifierContext(ctx.Schema_name().Identifier())
+	}
+	if _, ok := b.schema.tableMap[name]; ok {
+		return errors.Errorf("duplicate table %s", name)
+	}
+	table := &tableInfo{
+		id:   id,
+		name: name,
+	}
+	b.schema.tableMap[name] = table
+
+	relationalTable := ctx.Relational_table()
+	if relationalTable == nil || relationalTable.LEFT_PAREN() == nil {
+		table.text = b.statementText(ctx)
+		return nil
+	}
+	table.prefix = b.textBetween(ctx.GetStart().GetTokenIndex(), relationalTable.LEFT_PAREN().GetSymbol().GetTokenIndex()-1)
+	table.suffix = b.textBetween(relationalTable.RIGHT_PAREN().GetSymbol().GetTokenIndex()+1, b.lastTokenIndex(ctx))
+	for _, property := range relationalTable.AllRelational_property() {
+		switch {
+		case property.Column_definition() != nil:
+			if constraint := b.newAmbiguousCheckConstraintInfo(property.Column_definition()); constraint != nil {
+				table.constraintList = append(table.constraintList, constraint)
+				continue
+			}
+			table.columnList = append(table.columnList, b.newColumnInfo(property.Column_definition()))
+			for _, constraint := range property.Column_definition().AllInline_constraint() {
+				if constraint := b.newInlineConstraintInfo(property.Column_definition(), constraint); constraint != nil {
+					table.constraintList = append(table.constraintList, constraint)
+				}
+			}
+		case property.Virtual_column_definition() != nil:
+			column := property.Virtual_column_definition()
+			table.columnList = append(table.columnList, &columnInfo{
+				name:                parser.PLSQLNormalizeIdentifierContext(column.Column_name().Identifier()),
+				definition:          b.text(column),
+				dataTypeCompareText: b.normalizedText(column),
+				virtual:             true,
+			})
+		case property.Out_of_line_constraint() != nil:
+			table.constraintList = append(table.constraintList, b.newOutOfLineConstraintInfo(property.Out_of_line_constraint()))
+		}
+	}
+	return nil
+}
+
+func (b *schemaBuilder) addAlterTable(ctx plsql.IAlter_tableContext) error {
+	clauses := ctx.Constraint_clauses()
+	if clauses == nil || clauses.ADD() == nil {
+		return nil
+	}
+	name := normalizeTableviewName(ctx.Tableview_name())
+	table, ok := b.schema.tableMap[name]
+	if !ok {
+		return errors.Errorf("table %s not found", name)
+	}
+	for _, constraint := range clauses.AllOut_of_line_constraint() {
+		table.constraintList = append(table.constraintList, b.newOutOfLineConstraintInfo(constraint))
+	}
+	return nil
+}
+
+func (b *schemaBuilder) addSequence(id int, ctx plsql.ICreate_sequenceContext) {
+	var name objectName
+	idList := ctx.Sequence_name().AllId_expression()
+	name.name = parser.PLSQLNormalizeIDExpression(idList[len(idList)-1])
+	if len(idList) > 1 {
+		name.schema = parser.PLSQLNormalizeIDExpression(idList[len(idList)-2])
+	}
+	sequence := &sequenceInfo{
+		id:   id,
+		name: name,
+		text: b.statementText(ctx),
+	}
+	for _, spec := range ctx.AllSequence_spec() {
+		sequence.specList = append(sequence.specList, b.text(spec))
+	}
+	sequence.compareText = strings.Join(sequence.specList, " ")
+	b.schema.sequenceMap[name] = sequence
+}
+
+func (b *schemaBuilder) newObjectInfo(id int, name objectName, table objectName, ctx antlr.ParserRuleContext) *objectInfo {
+	return &objectInfo{
+		id:          id,
+		name:        name,
+		table:       table,
+		text:        b.statementText(ctx),
+		compareText: b.normalizedText(ctx),
+	}
+}
+
+func (b *schemaBuilder) newColumnInfo(ctx plsql.IColumn_definitionContext) *columnInfo {
+	column := &columnInfo{
+		name: parser.PLSQLNormalizeIdentifierContext(ctx.Column_name().Identifier()),
+	}
+	if ctx.Datatype() != nil {
+		column.dataType = b.text(ctx.Datatype())
+		column.dataTypeCompareText = b.normalizedText(ctx.Datatype())
+	} else if ctx.Regular_id() != nil {
+		column.dataType = b.text(ctx.Regular_id())
+		column.dataTypeCompareText = b.normalizedText(ctx.Regular_id())
+	}
+	if ctx.DEFAULT() != nil && ctx.Expression() != nil {
+		start, stop := ctx.DEFAULT().GetSymbol().GetTokenIndex()+1, ctx.Expression().GetStop().GetTokenIndex()
+		column.defaultValue = b.textBetween(start, stop)
+		column.defaultValueCompareText = b.normalizedTextBetween(start, stop)
+	}
+	for _, constraint := range ctx.AllInline_constraint() {
+		if constraint.NULL_() != nil {
+			column.notNull = constraint.NOT() != nil
+		}
+	}
+
+	// The column definition excludes the inline constraints, which are converted to the out-of-line constraints.
+	stop := ctx.GetStop().GetTokenIndex()
+	if len(ctx.AllInline_constraint()) > 0 {
+		stop = ctx.Inline_constraint(0).GetStart().GetTokenIndex() - 1
+	} else if ctx.Inline_ref_constraint() != nil {
+		stop = ctx.Inline_ref_constraint().GetStart().GetTokenIndex() - 1
+	}
+	column.definition = b.textBetween(ctx.GetStart().GetTokenIndex(), stop)
+	if column.notNull {
+		column.definition += " NOT NULL"
+	}
+	return column
+}
+
+// newInlineConstraintInfo converts the inline constraint to the out-of-line constraint.
+// It returns nil for the NULL and NOT NULL constraints, which are considered as column attributes.
+func (b *schemaBuilder) newInlineConstraintInfo(column plsql.IColumn_definitionContext, ctx plsql.IInline_constraintContext) *constraintInfo {
+	columnName := parser.PLSQLNormalizeIdentifierContext(column.Column_name().Identifier())
+	constraint := &constraintInfo{
+		columnList: []string{columnName},
+	}
+	var body string
+	switch {
+	case ctx.NULL_() != nil:
+		return nil
+	case ctx.PRIMARY() != nil:
+		constraint.tp = constraintTypePrimaryKey
+		body = fmt.Sprintf("PRIMARY KEY (%s)", quoteIdentifier(columnName))
+	case ctx.UNIQUE() != nil:
+		constraint.tp = constraintTypeUnique
+		body = fmt.Sprintf("UNIQUE (%s)", quoteIdentifier(columnName))
+	case ctx.References_clause() != nil:
+		constraint.tp = constraintTypeForeignKey
+		body = fmt.Sprintf("FOREIGN KEY (%s) %s", quoteIdentifier(columnName), b.text(ctx.References_clause()))
+	case ctx.Check_constraint() != nil:
+		constraint.tp = constraintTypeCheck
+		body = b.text(ctx.Check_constraint())
+	default:
+		return nil
+	}
+	if ctx.Constraint_state() != nil {
+		body += " " + b.text(ctx.Constraint_state())
+	}
+	if ctx.Constraint_name() != nil {
+		constraint.name = normalizeConstraintName(ctx.Constraint_name())
+		body = fmt.Sprintf("CONSTRAINT %s %s", quoteIdentifier(constraint.name), body)
+	}
+	constraint.definition = body
+	constraint.compareText = normalizeText(body)
+	return constraint
+}
+
+// newAmbiguousCheckConstraintInfo returns the check constraint if the column definition is actually a named check constraint.
+// CONSTRAINT is a non-reserved keyword, so the parser recognizes "CONSTRAINT ck CHECK (a > 0)" as a column definition,
+// whose name is CONSTRAINT, type is ck and inline constraint is CHECK (a > 0).
+func (b *schemaBuilder) newAmbiguousCheckConstraintInfo(ctx plsql.IColumn_definitionContext) *constraintInfo {
+	if ctx.Regular_id() == nil || len(ctx.AllInline_constraint()) != 1 || ctx.Inline_constraint(0).Check_constraint() == nil {
+		return nil
+	}
+	idExpression := ctx.Column_name().Identifier().Id_expression()
+	if idExpression.Regular_id() == nil || !strings.EqualFold(idExpression.Regular_id().GetText(), "CONSTRAINT") {
+		return nil
+	}
+	name := strings.ToUpper(ctx.Regular_id().GetText())
+	body := b.text(ctx.Inline_constraint(0))
+	return &constraintInfo{
+		name:        name,
+		tp:          constraintTypeCheck,
+		definition:  fmt.Sprintf("CONSTRAINT %s %s", quoteIdentifier(name), body),
+		compareText: normalizeText(body),
+	}
+}
+
+func (b *schemaBuilder) newOutOfLineConstraintInfo(ctx plsql.IOut_of_line_constraintContext) *constraintInfo {
+	constraint := &constraintInfo{
+		definition:  b.text(ctx),
+		compareText: b.normalizedText(ctx),
+	}
+	if ctx.Constraint_name() != nil {
+		constraint.name = normalizeConstraintName(ctx.Constraint_name())
+	}
+	switch {
+	case ctx.PRIMARY() != nil:
+		constraint.tp = constraintTypePrimaryKey
+	case ctx.UNIQUE() != nil:
+		constraint.tp = constraintTypeUnique
+	case ctx.Foreign_key_clause() != nil:
+		constraint.tp = constraintTypeForeignKey
+	default:
+		constraint.tp = constraintTypeCheck
+	}
+	for _, column := range ctx.AllColumn_name() {
+		constraint.columnList = append(constraint.columnList, parser.PLSQLNormalizeIdentifierContext(column.Identifier()))
+	}
+	return constraint
+}
+
+// text returns the original text of the rule context.
+func (b *schemaBuilder) text(ctx antlr.ParserRuleContext) string {
+	return b.textBetween(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex())
+}
+
+// statementText returns the original text of the statement ending with a semicolon.
+func (b *schemaBuilder) statementText(ctx antlr.ParserRuleContext) string {
+	return b.textBetween(ctx.GetStart().GetTokenIndex(), b.lastTokenIndex(ctx)) + ";"
+}
+
+// lastTokenIndex returns the index of the last token of the rule context except the trailing semicolon.
+func (b *schemaBuilder) lastTokenIndex(ctx antlr.ParserRuleContext) int {
+	stop := ctx.GetStop().GetTokenIndex()
+	if ctx.GetStop().GetTokenType() == plsql.PlSqlParserSEMICOLON {
+		stop--
+	}
+	return stop
+}
+
+func (b *schemaBuilder) textBetween(start, stop int) string {
+	if start > stop {
+		return ""
+	}
+	return strings.TrimSpace(b.stream.GetTextFromInterval(antlr.NewInterval(start, stop)))
+}
+
+// normalizedText returns the text of the default channel tokens joined by a space,
+// so that the comparison ignores the differences in whitespaces and comments.
+func (b *schemaBuilder) normalizedText(ctx antlr.ParserRuleContext) string {
+	return b.normalizedTextBetween(ctx.GetStart().GetTokenIndex(), b.lastTokenIndex(ctx))
+}
+
+func (b *schemaBuilder) normalizedTextBetween(start, stop int) string {
+	var tokenList []string
+	for i := start; i <= stop; i++ {
+		token := b.stream.Get(i)
+		if token.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		tokenList = append(tokenList, token.GetText())
+	}
+	return strings.Join(tokenList, " ")
+}
+
+// normalizeText collapses the whitespaces of the text.
+func normalizeText(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func normalizeName(identifier plsql.IIdentifierContext, idExpression plsql.IId_expressionContext) objectName {
+	if idExpression == nil {
+		return objectName{name: parser.PLSQLNormalizeIdentifierContext(identifier)}
+	}
+	return objectName{
+		schema: parser.PLSQLNormalizeIdentifierContext(identifier),
+		name:   parser.PLSQLNormalizeIDExpression(idExpression),
+	}
+}
+
+func normalizeTableviewName(ctx plsql.ITableview_nameContext) objectName {
+	if ctx == nil {
+		return objectName{}
+	}
+	return normalizeName(ctx.Identifier(), ctx.Id_expression())
+}
+
+func normalizeIndexName(ctx plsql.IIndex_nameContext) objectName {
+	return normalizeName(ctx.Identifier(), ctx.Id_expression())
+}
+
+func normalizeConstraintName(ctx plsql.IConstraint_nameContext) string {
+	idList := ctx.AllId_expression()
+	if len(idList) > 0 {
+		return parser.PLSQLNormalizeIDExpression(idList[len(idList)-1])
+	}
+	return parser.PLSQLNormalizeIdentifierContext(ctx.Identifier())
+}
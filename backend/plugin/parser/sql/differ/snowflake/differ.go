@@ -0,0 +1,820 @@
+// Package snowflake provides the Snowflake differ plugin.
+package snowflake
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/antlr4-go/antlr/v4"
+	snowparser "github.com/bytebase/snowsql-parser"
+	"github.com/pkg/errors"
+
+	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
+	"github.com/bytebase/bytebase/backend/plugin/parser/sql/differ"
+)
+
+const defaultSchema = "PUBLIC"
+
+var _ differ.SchemaDiffer = (*SchemaDiffer)(nil)
+
+func init() {
+	differ.Register(parser.Snowflake, &SchemaDiffer{})
+}
+
+// SchemaDiffer it the differ for Snowflake dialect.
+type SchemaDiffer struct {
+}
+
+// diffNode defines different modification types as the safe change order.
+// The safe change order means we can change them with no dependency conflicts as this order.
+type diffNode struct {
+	// Drop nodes
+	dropViewList               []string
+	dropFunctionList           []string
+	dropForeignKeyList         []string
+	dropConstraintExceptFkList []string
+	dropColumnList             []string
+	dropTableList              []string
+	dropSequenceList           []string
+	dropSchemaList             []string
+
+	// Create nodes
+	createSchemaList             []string
+	createSequenceList           []string
+	alterSequenceList            []string
+	createTableList              []string
+	createColumnList             []string
+	alterColumnList              []string
+	createConstraintExceptFkList []string
+	createForeignKeyList         []string
+	createFunctionList           []string
+	createViewList               []string
+}
+
+type constraintType int
+
+const (
+	constraintTypePrimaryKey constraintType = iota
+	constraintTypeUnique
+	constraintTypeForeignKey
+)
+
+// objectName is the name of a schema object, the identifiers are unquoted.
+// We ignore the database name because the schema is dumped in a single database.
+type objectName struct {
+	schema string
+	name   string
+}
+
+func (n objectName) String() string {
+	return fmt.Sprintf("%s.%s", quoteIdentifier(n.schema), quoteIdentifier(n.name))
+}
+
+type schemaInfo struct {
+	schemaMap   map[string]*objectInfo
+	tableMap    map[objectName]*tableInfo
+	sequenceMap map[objectName]*sequenceInfo
+	// viewMap, functionMap and procedureMap are keyed by the signature, because functions and procedures can be overloaded.
+	viewMap      map[string]*objectInfo
+	functionMap  map[string]*objectInfo
+	procedureMap map[string]*objectInfo
+}
+
+func newSchemaInfo() *schemaInfo {
+	return &schemaInfo{
+		schemaMap:    make(map[string]*objectInfo),
+		tableMap:     make(map[objectName]*tableInfo),
+		sequenceMap:  make(map[objectName]*sequenceInfo),
+		viewMap:      make(map[string]*objectInfo),
+		functionMap:  make(map[string]*objectInfo),
+		procedureMap: make(map[string]*objectInfo),
+	}
+}
+
+type tableInfo struct {
+	id   int
+	name objectName
+	// prefix is the text before the column list, such as "CREATE TABLE t".
+	prefix string
+	// suffix is the text after the column list, such as the cluster by clause.
+	suffix         string
+	columnList     []*columnInfo
+	constraintList []*constraintInfo
+}
+
+func (t *tableInfo) getColumn(name string) *columnInfo {
+	for _, column := range t.columnList {
+		if column.name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+func (t *tableInfo) getConstraint(key string) *constraintInfo {
+	for _, constraint := range t.constraintList {
+		if constraint.key() == key {
+			return constraint
+		}
+	}
+	return nil
+}
+
+type columnInfo struct {
+	name string
+	// definition is the column definition without the inline constraints except NULL and NOT NULL.
+	definition          string
+	dataType            string
+	dataTypeCompareText string
+	// defaultValue is the expression of the DEFAULT clause, AUTOINCREMENT and IDENTITY cannot be altered.
+	defaultValue            string
+	defaultValueCompareText string
+	notNull                 bool
+	comment                 string
+}
+
+type constraintInfo struct {
+	name        string
+	tp          constraintType
+	columnList  []string
+	definition  string
+	compareText string
+}
+
+// key returns the key to match the constraint between the old and new schema.
+// The unnamed constraints are matched by the definition.
+func (c *constraintInfo) key() string {
+	if c.name != "" {
+		return c.name
+	}
+	if c.tp == constraintTypePrimaryKey {
+		return "PRIMARY KEY"
+	}
+	return c.compareText
+}
+
+type objectInfo struct {
+	id   int
+	name objectName
+	// signature is the name with the argument types for functions and procedures, such as "S"."F"(NUMBER),
+	// and the name for views.
+	signature   string
+	text        string
+	compareText string
+}
+
+type sequenceInfo struct {
+	id   int
+	name objectName
+	text string
+	// increment is the INCREMENT BY value. We ignore the START WITH clause because it cannot be altered.
+	increment string
+}
+
+// SchemaDiff computes the schema differences between old and new schema.
+func (*SchemaDiffer) SchemaDiff(oldStmt, newStmt string, _ bool) (string, error) {
+	oldSchema, err := buildSchemaInfo(oldStmt)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse old statements %q", oldStmt)
+	}
+	newSchema, err := buildSchemaInfo(newStmt)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to parse new statements %q", newStmt)
+	}
+
+	diff := &diffNode{}
+	diff.diffSchema(oldSchema, newSchema)
+	if err := diff.diffTable(oldSchema, newSchema); err != nil {
+		return "", err
+	}
+	diff.diffSequence(oldSchema, newSchema)
+	diff.dropViewList, diff.createViewList = diffObject(oldSchema.viewMap, newSchema.viewMap, "VIEW")
+	dropFunctionList, createFunctionList := diffObject(oldSchema.functionMap, newSchema.functionMap, "FUNCTION")
+	dropProcedureList, createProcedureList := diffObject(oldSchema.procedureMap, newSchema.procedureMap, "PROCEDURE")
+	diff.dropFunctionList = append(dropFunctionList, dropProcedureList...)
+	diff.createFunctionList = append(createFunctionList, createProcedureList...)
+
+	return diff.deparse(), nil
+}
+
+func (diff *diffNode) diffSchema(oldSchema, newSchema *schemaInfo) {
+	for _, newObject := range sortedObjects(newSchema.schemaMap) {
+		if _, ok := oldSchema.schemaMap[newObject.name.name]; !ok {
+			diff.createSchemaList = append(diff.createSchemaList, newObject.text)
+		}
+	}
+	oldObjectList := sortedObjects(oldSchema.schemaMap)
+	for i := len(oldObjectList) - 1; i >= 0; i-- {
+		if _, ok := newSchema.schemaMap[oldObjectList[i].name.name]; !ok {
+			diff.dropSchemaList = append(diff.dropSchemaList, fmt.Sprintf("DROP SCHEMA %s;", quoteIdentifier(oldObjectList[i].name.name)))
+		}
+	}
+}
+
+func (diff *diffNode) diffTable(oldSchema, newSchema *schemaInfo) error {
+	for _, newTable := range sortedTables(newSchema.tableMap) {
+		oldTable, ok := oldSchema.tableMap[newTable.name]
+		if !ok {
+			diff.createTable(newTable)
+			continue
+		}
+		if err := diff.modifyTable(oldTable, newTable); err != nil {
+			return err
+		}
+	}
+
+	// Drop the tables in the reverse order of creation, so that the referencing tables are dropped first.
+	oldTableList := sortedTables(oldSchema.tableMap)
+	for i := len(oldTableList) - 1; i >= 0; i-- {
+		oldTable := oldTableList[i]
+		if _, ok := newSchema.tableMap[oldTable.name]; ok {
+			continue
+		}
+		diff.dropTableList = append(diff.dropTableList, fmt.Sprintf("DROP TABLE %s;", oldTable.name))
+	}
+	return nil
+}
+
+func (diff *diffNode) createTable(table *tableInfo) {
+	var itemList []string
+	for _, column := range table.columnList {
+		itemList = append(itemList, column.definition)
+	}
+	for _, constraint := range table.constraintList {
+		// Create foreign keys after all tables are created to avoid the dependency conflicts.
+		if constraint.tp == constraintTypeForeignKey {
+			diff.createForeignKeyList = append(diff.createForeignKeyList, addConstraintStmt(table.name, constraint))
+			continue
+		}
+		itemList = append(itemList, constraint.definition)
+	}
+	var buf strings.Builder
+	_, _ = buf.WriteString(table.prefix)
+	_, _ = buf.WriteString(" (\n")
+	for i, item := range itemList {
+		_, _ = buf.WriteString("    ")
+		_, _ = buf.WriteString(item)
+		if i != len(itemList)-1 {
+			_, _ = buf.WriteString(",")
+		}
+		_, _ = buf.WriteString("\n")
+	}
+	_, _ = buf.WriteString(")")
+	if table.suffix != "" {
+		_, _ = buf.WriteString(" ")
+		_, _ = buf.WriteString(table.suffix)
+	}
+	_, _ = buf.WriteString(";")
+	diff.createTableList = append(diff.createTableList, buf.String())
+}
+
+func (diff *diffNode) modifyTable(oldTable, newTable *tableInfo) error {
+	for _, newColumn := range newTable.columnList {
+		oldColumn := oldTable.getColumn(newColumn.name)
+		if oldColumn == nil {
+			diff.createColumnList = append(diff.createColumnList, fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s;", newTable.name, newColumn.definition))
+			continue
+		}
+		stmtList, err := modifyColumnStmtList(newTable.name, oldColumn, newColumn)
+		if err != nil {
+			return err
+		}
+		diff.alterColumnList = append(diff.alterColumnList, stmtList...)
+	}
+	for _, oldColumn := range oldTable.columnList {
+		if newTable.getColumn(oldColumn.name) == nil {
+			diff.dropColumnList = append(diff.dropColumnList, fmt.Sprintf("ALTER TABLE %s DROP COLUMN %s;", oldTable.name, quoteIdentifier(oldColumn.name)))
+		}
+	}
+
+	for _, oldConstraint := range oldTable.constraintList {
+		newConstraint := newTable.getConstraint(oldConstraint.key())
+		if newConstraint != nil && newConstraint.compareText == oldConstraint.compareText {
+			continue
+		}
+		if oldConstraint.tp == constraintTypeForeignKey {
+			diff.dropForeignKeyList = append(diff.dropForeignKeyList, dropConstraintStmt(oldTable.name, oldConstraint))
+		} else {
+			diff.dropConstraintExceptFkList = append(diff.dropConstraintExceptFkList, dropConstraintStmt(oldTable.name, oldConstraint))
+		}
+	}
+	for _, newConstraint := range newTable.constraintList {
+		oldConstraint := oldTable.getConstraint(newConstraint.key())
+		if oldConstraint != nil && oldConstraint.compareText == newConstraint.compareText {
+			continue
+		}
+		if newConstraint.tp == constraintTypeForeignKey {
+			diff.createForeignKeyList = append(diff.createForeignKeyList, addConstraintStmt(newTable.name, newConstraint))
+		} else {
+			diff.createConstraintExceptFkList = append(diff.createConstraintExceptFkList, addConstraintStmt(newTable.name, newConstraint))
+		}
+	}
+	return nil
+}
+
+// modifyColumnStmtList returns the ALTER TABLE ALTER COLUMN statements with the changed attributes only.
+func modifyColumnStmtList(table objectName, oldColumn, newColumn *columnInfo) ([]string, error) {
+	var stmtList []string
+	prefix := fmt.Sprintf("ALTER TABLE %s ALTER COLUMN %s", table, quoteIdentifier(newColumn.name))
+	if oldColumn.dataTypeCompareText != newColumn.dataTypeCompareText {
+		stmtList = append(stmtList, fmt.Sprintf("%s SET DATA TYPE %s;", prefix, newColumn.dataType))
+	}
+	if oldColumn.defaultValueCompareText != newColumn.defaultValueCompareText {
+		switch {
+		case newColumn.defaultValue == "":
+			stmtList = append(stmtList, fmt.Sprintf("%s DROP DEFAULT;", prefix))
+		case strings.HasSuffix(newColumn.defaultValueCompareText, ". NEXTVAL"):
+			// Snowflake only supports setting the sequence as the default value of an existing column.
+			stmtList = append(stmtList, fmt.Sprintf("%s SET DEFAULT %s;", prefix, newColumn.defaultValue))
+		default:
+			return nil, errors.Errorf("cannot change the default value of column %s in table %s to %q, Snowflake only supports the sequence as the default value of an existing column", quoteIdentifier(newColumn.name), table, newColumn.defaultValue)
+		}
+	}
+	if oldColumn.notNull != newColumn.notNull {
+		if newColumn.notNull {
+			stmtList = append(stmtList, fmt.Sprintf("%s SET NOT NULL;", prefix))
+		} else {
+			stmtList = append(stmtList, fmt.Sprintf("%s DROP NOT NULL;", prefix))
+		}
+	}
+	if oldColumn.comment != newColumn.comment {
+		if newColumn.comment == "" {
+			stmtList = append(stmtList, fmt.Sprintf("%s UNSET COMMENT;", prefix))
+		} else {
+			stmtList = append(stmtList, fmt.Sprintf("%s COMMENT %s;", prefix, newColumn.comment))
+		}
+	}
+	return stmtList, nil
+}
+
+func addConstraintStmt(table objectName, constraint *constraintInfo) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD %s;", table, constraint.definition)
+}
+
+// dropConstraintStmt returns the statement to drop the constraint.
+// The unnamed constraints are dropped by the type and the columns.
+func dropConstraintStmt(table objectName, constraint *constraintInfo) string {
+	if constraint.name != "" {
+		return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT %s;", table, quoteIdentifier(constraint.name))
+	}
+	var columnList []string
+	for _, column := range constraint.columnList {
+		columnList = append(columnList, quoteIdentifier(column))
+	}
+	switch constraint.tp {
+	case constraintTypePrimaryKey:
+		return fmt.Sprintf("ALTER TABLE %s DROP PRIMARY KEY;", table)
+	case constraintTypeUnique:
+		return fmt.Sprintf("ALTER TABLE %s DROP UNIQUE (%s);", table, strings.Join(columnList, ", "))
+	default:
+		return fmt.Sprintf("ALTER TABLE %s DROP FOREIGN KEY (%s);", table, strings.Join(columnList, ", "))
+	}
+}
+
+func (diff *diffNode) diffSequence(oldSchema, newSchema *schemaInfo) {
+	var newSequenceList []*sequenceInfo
+	for _, sequence := range newSchema.sequenceMap {
+		newSequenceList = append(newSequenceList, sequence)
+	}
+	sort.Slice(newSequenceList, func(i, j int) bool {
+		return newSequenceList[i].id < newSequenceList[j].id
+	})
+	for _, newSequence := range newSequenceList {
+		oldSequence, ok := oldSchema.sequenceMap[newSequence.name]
+		if !ok {
+			diff.createSequenceList = append(diff.createSequenceList, newSequence.text)
+			continue
+		}
+		if oldSequence.increment != newSequence.increment {
+			increment := newSequence.increment
+			if increment == "" {
+				increment = "1"
+			}
+			diff.alterSequenceList = append(diff.alterSequenceList, fmt.Sprintf("ALTER SEQUENCE %s SET INCREMENT BY %s;", newSequence.name, increment))
+		}
+	}
+
+	var oldSequenceList []*sequenceInfo
+	for _, sequence := range oldSchema.sequenceMap {
+		oldSequenceList = append(oldSequenceList, sequence)
+	}
+	sort.Slice(oldSequenceList, func(i, j int) bool {
+		return oldSequenceList[i].id < oldSequenceList[j].id
+	})
+	for _, oldSequence := range oldSequenceList {
+		if _, ok := newSchema.sequenceMap[oldSequence.name]; !ok {
+			diff.dropSequenceList = append(diff.dropSequenceList, fmt.Sprintf("DROP SEQUENCE %s;", oldSequence.name))
+		}
+	}
+}
+
+// diffObject computes the differences of the objects which are changed as a whole, such as views and functions.
+// The changed objects are replaced by CREATE OR REPLACE statements.
+func diffObject(oldMap, newMap map[string]*objectInfo, objectType string) ([]string, []string) {
+	var dropList, createList []string
+	for _, newObject := range sortedObjects(newMap) {
+		oldObject, ok := oldMap[newObject.signature]
+		if !ok {
+			createList = append(createList, newObject.text)
+			continue
+		}
+		if oldObject.compareText == newObject.compareText {
+			continue
+		}
+		createList = append(createList, createOrReplace(newObject.text))
+	}
+	oldObjectList := sortedObjects(oldMap)
+	for i := len(oldObjectList) - 1; i >= 0; i-- {
+		oldObject := oldObjectList[i]
+		if _, ok := newMap[oldObject.signature]; !ok {
+			dropList = append(dropList, fmt.Sprintf("DROP %s %s;", objectType, oldObject.signature))
+		}
+	}
+	return dropList, createList
+}
+
+// createOrReplace converts the CREATE statement to CREATE OR REPLACE statement.
+func createOrReplace(text string) string {
+	fields := strings.Fields(text)
+	if len(fields) >= 3 && strings.EqualFold(fields[1], "OR") && strings.EqualFold(fields[2], "REPLACE") {
+		return text
+	}
+	index := strings.Index(strings.ToUpper(text), "CREATE")
+	if index < 0 {
+		return text
+	}
+	return text[:index+len("CREATE")] + " OR REPLACE" + text[index+len("CREATE"):]
+}
+
+func sortedTables(m map[objectName]*tableInfo) []*tableInfo {
+	var list []*tableInfo
+	for _, table := range m {
+		list = append(list, table)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].id < list[j].id
+	})
+	return list
+}
+
+func sortedObjects(m map[string]*objectInfo) []*objectInfo {
+	var list []*objectInfo
+	for _, object := range m {
+		list = append(list, object)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].id < list[j].id
+	})
+	return list
+}
+
+func printStmtSlice(buf *strings.Builder, stmtList []string) {
+	for _, stmt := range stmtList {
+		_, _ = buf.WriteString(stmt)
+		_, _ = buf.WriteString("\n\n")
+	}
+}
+
+// deparse statements as the safe change order.
+func (diff *diffNode) deparse() string {
+	var buf strings.Builder
+
+	// drop
+	printStmtSlice(&buf, diff.dropViewList)
+	printStmtSlice(&buf, diff.dropFunctionList)
+	printStmtSlice(&buf, diff.dropForeignKeyList)
+	printStmtSlice(&buf, diff.dropConstraintExceptFkList)
+	printStmtSlice(&buf, diff.dropColumnList)
+	printStmtSlice(&buf, diff.dropTableList)
+	printStmtSlice(&buf, diff.dropSequenceList)
+	printStmtSlice(&buf, diff.dropSchemaList)
+
+	// create
+	printStmtSlice(&buf, diff.createSchemaList)
+	printStmtSlice(&buf, diff.createSequenceList)
+	printStmtSlice(&buf, diff.alterSequenceList)
+	printStmtSlice(&buf, diff.createTableList)
+	printStmtSlice(&buf, diff.createColumnList)
+	printStmtSlice(&buf, diff.alterColumnList)
+	printStmtSlice(&buf, diff.createConstraintExceptFkList)
+	printStmtSlice(&buf, diff.createForeignKeyList)
+	printStmtSlice(&buf, diff.createFunctionList)
+	printStmtSlice(&buf, diff.createViewList)
+
+	return buf.String()
+}
+
+func quoteIdentifier(identifier string) string {
+	return fmt.Sprintf(`"%s"`, strings.ReplaceAll(identifier, `"`, `""`))
+}
+
+// schemaBuilder builds the schema info from the Snowflake parse tree.
+type schemaBuilder struct {
+	stream antlr.TokenStream
+	schema *schemaInfo
+}
+
+func buildSchemaInfo(statement string) (*schemaInfo, error) {
+	schema := newSchemaInfo()
+	if strings.TrimSpace(statement) == "" {
+		return schema, nil
+	}
+	tree, err := parser.ParseSnowSQL(statement)
+	if err != nil {
+		return nil, err
+	}
+	file, ok := tree.(*snowparser.Snowflake_fileContext)
+	if !ok {
+		return nil, errors.Errorf("failed to parse Snowflake statement, unexpected tree type %T", tree)
+	}
+	b := &schemaBuilder{
+		stream: file.GetParser().GetTokenStream(),
+		schema: schema,
+	}
+	for i, batch := range file.AllBatch() {
+		ddl := batch.Sql_command().Ddl_command()
+		if ddl == nil {
+			continue
+		}
+		if err := b.addDDLCommand(i, ddl); err != nil {
+			return nil, err
+		}
+	}
+	return schema, nil
+}
+
+func (b *schemaBuilder) addDDLCommand(id int, ctx snowparser.IDdl_commandContext) error {
+	if alter := ctx.Alter_command(); alter != nil {
+		if alter.Alter_table() != nil {
+			return b.addAlterTable(alter.Alter_table())
+		}
+		return nil
+	}
+	create := ctx.Create_command()
+	if create == nil {
+		return nil
+	}
+	switch {
+	case create.Create_schema() != nil:
+		ids := create.Create_schema().Schema_name().AllId_()
+		name := parser.NormalizeSnowSQLObjectNamePart(ids[len(ids)-1])
+		// The default schema exists in every database.
+		if name == defaultSchema {
+			return nil
+		}
+		b.schema.schemaMap[name] = &objectInfo{
+			id:   id,
+			name: objectName{name: name},
+			text: b.statementText(create.Create_schema()),
+		}
+	case create.Create_table() != nil:
+		b.addTable(id, create.Create_table())
+	case create.Create_sequence() != nil:
+		sequence := create.Create_sequence()
+		info := &sequenceInfo{
+			id:   id,
+			name: normalizeObjectName(sequence.Object_name()),
+			text: b.statementText(sequence),
+		}
+		if sequence.Increment_by() != nil {
+			info.increment = sequence.Increment_by().Num().GetText()
+		}
+		b.schema.sequenceMap[info.name] = info
+	case create.Create_view() != nil:
+		view := create.Create_view()
+		name := normalizeObjectName(view.Object_name())
+		b.schema.viewMap[name.String()] = b.newObjectInfo(id, name, name.String(), view.VIEW().GetSymbol().GetTokenIndex(), view)
+	case create.Create_function() != nil:
+		function := create.Create_function()
+		name := normalizeObjectName(function.Object_name())
+		signature := b.signature(name, function.AllArg_decl())
+		b.schema.functionMap[signature] = b.newObjectInfo(id, name, signature, function.FUNCTION().GetSymbol().GetTokenIndex(), function)
+	case create.Create_procedure() != nil:
+		procedure := create.Create_procedure()
+		name := normalizeObjectName(procedure.Object_name())
+		signature := b.signature(name, procedure.AllArg_decl())
+		b.schema.procedureMap[signature] = b.newObjectInfo(id, name, signature, procedure.PROCEDURE().GetSymbol().GetTokenIndex(), procedure)
+	}
+	return nil
+}
+
+func (b *schemaBuilder) addTable(id int, ctx snowparser.ICreate_tableContext) {
+	columnList := ctx.Column_decl_item_list()
+	table := &tableInfo{
+		id:     id,
+		name:   normalizeObjectName(ctx.Object_name()),
+		prefix: b.textBetween(ctx.GetStart().GetTokenIndex(), ctx.LR_BRACKET(0).GetSymbol().GetTokenIndex()-1),
+	}
+	// The suffix starts after the right bracket of the column list.
+	for _, bracket := range ctx.AllRR_BRACKET() {
+		if index := bracket.GetSymbol().GetTokenIndex(); index > columnList.GetStop().GetTokenIndex() {
+			table.suffix = b.textBetween(index+1, b.lastTokenIndex(ctx))
+			break
+		}
+	}
+	for _, item := range columnList.AllColumn_decl_item() {
+		if item.Full_col_decl() != nil {
+			b.addColumn(table, item.Full_col_decl())
+			continue
+		}
+		table.constraintList = append(table.constraintList, b.newOutOfLineConstraintInfo(item.Out_of_line_constraint()))
+	}
+	b.schema.tableMap[table.name] = table
+}
+
+func (b *schemaBuilder) addAlterTable(ctx snowparser.IAlter_tableContext) error {
+	name := normalizeObjectName(ctx.Object_name(0))
+	table, ok := b.schema.tableMap[name]
+	if !ok {
+		return errors.Errorf("table %s not found", name)
+	}
+	if action := ctx.Constraint_action(); action != nil && action.ADD() != nil {
+		table.constraintList = append(table.constraintList, b.newOutOfLineConstraintInfo(action.Out_of_line_constraint()))
+	}
+	return nil
+}
+
+func (b *schemaBuilder) addColumn(table *tableInfo, ctx snowparser.IFull_col_declContext) {
+	column := &columnInfo{
+		name:                parser.NormalizeSnowSQLObjectNamePart(ctx.Col_decl().Column_name().Id_()),
+		dataType:            b.text(ctx.Col_decl().Data_type()),
+		dataTypeCompareText: b.normalizedText(ctx.Col_decl().Data_type()),
+	}
+	// The column definition consists of the parts except the inline constraints, NOT NULL is appended after the defaults.
+	partList := []string{b.text(ctx.Col_decl())}
+	for _, collate := range ctx.AllCollate() {
+		partList = append(partList, b.text(collate))
+	}
+	for _, defaultValue := range ctx.AllDefault_value() {
+		if defaultValue.DEFAULT() != nil {
+			column.defaultValue = b.text(defaultValue.Expr())
+			column.defaultValueCompareText = b.normalizedText(defaultValue.Expr())
+		}
+		partList = append(partList, b.text(defaultValue))
+	}
+	for _, nullNotNull := range ctx.AllNull_not_null() {
+		column.notNull = nullNotNull.NOT() != nil
+	}
+	for _, constraint := range ctx.AllInline_constraint() {
+		if constraint.Null_not_null() != nil {
+			column.notNull = constraint.Null_not_null().NOT() != nil
+		}
+		table.constraintList = append(table.constraintList, b.newInlineConstraintInfo(column.name, constraint))
+	}
+	if column.notNull {
+		partList = append(partList, "NOT NULL")
+	}
+	if ctx.With_masking_policy() != nil {
+		partList = append(partList, b.text(ctx.With_masking_policy()))
+	}
+	if ctx.With_tags() != nil {
+		partList = append(partList, b.text(ctx.With_tags()))
+	}
+	if ctx.COMMENT() != nil {
+		column.comment = b.text(ctx.String_())
+		partList = append(partList, fmt.Sprintf("COMMENT %s", column.comment))
+	}
+	column.definition = strings.Join(partList, " ")
+	table.columnList = append(table.columnList, column)
+}
+
+// newInlineConstraintInfo converts the inline constraint to the out-of-line constraint.
+func (b *schemaBuilder) newInlineConstraintInfo(columnName string, ctx snowparser.IInline_constraintContext) *constraintInfo {
+	constraint := &constraintInfo{
+		columnList: []string{columnName},
+	}
+	var partList []string
+	if ctx.CONSTRAINT() != nil {
+		constraint.name = parser.NormalizeSnowSQLObjectNamePart(ctx.Id_())
+		partList = append(partList, fmt.Sprintf("CONSTRAINT %s", quoteIdentifier(constraint.name)))
+	}
+	switch {
+	case ctx.PRIMARY() != nil:
+		constraint.tp = constraintTypePrimaryKey
+		partList = append(partList, fmt.Sprintf("PRIMARY KEY (%s)", quoteIdentifier(columnName)))
+	case ctx.UNIQUE() != nil:
+		constraint.tp = constraintTypeUnique
+		partList = append(partList, fmt.Sprintf("UNIQUE (%s)", quoteIdentifier(columnName)))
+	default:
+		constraint.tp = constraintTypeForeignKey
+		reference := normalizeObjectName(ctx.Object_name()).String()
+		if ctx.Column_name() != nil {
+			reference = fmt.Sprintf("%s (%s)", reference, quoteIdentifier(parser.NormalizeSnowSQLObjectNamePart(ctx.Column_name().Id_())))
+		}
+		partList = append(partList, fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s", quoteIdentifier(columnName), reference))
+	}
+	if ctx.Constraint_properties() != nil {
+		partList = append(partList, b.text(ctx.Constraint_properties()))
+	}
+	constraint.definition = strings.Join(partList, " ")
+	constraint.compareText = constraint.definition
+	return constraint
+}
+
+func (b *schemaBuilder) newOutOfLineConstraintInfo(ctx snowparser.IOut_of_line_constraintContext) *constraintInfo {
+	constraint := &constraintInfo{
+		definition:  b.text(ctx),
+		compareText: b.normalizedText(ctx),
+	}
+	if ctx.CONSTRAINT() != nil {
+		constraint.name = parser.NormalizeSnowSQLObjectNamePart(ctx.Id_())
+	}
+	switch {
+	case ctx.PRIMARY() != nil:
+		constraint.tp = constraintTypePrimaryKey
+	case ctx.UNIQUE() != nil:
+		constraint.tp = constraintTypeUnique
+	default:
+		constraint.tp = constraintTypeForeignKey
+	}
+	if columnList := ctx.Column_list_in_parentheses(0); columnList != nil {
+		for _, column := range columnList.Column_list().AllColumn_name() {
+			constraint.columnList = append(constraint.columnList, parser.NormalizeSnowSQLObjectNamePart(column.Id_()))
+		}
+	}
+	return constraint
+}
+
+// newObjectInfo returns the object info for the views, functions and procedures.
+// The compare text starts from the object type keyword, so that CREATE and CREATE OR REPLACE are treated as the same.
+func (b *schemaBuilder) newObjectInfo(id int, name objectName, signature string, keywordIndex int, ctx antlr.ParserRuleContext) *objectInfo {
+	return &objectInfo{
+		id:          id,
+		name:        name,
+		signature:   signature,
+		text:        b.statementText(ctx),
+		compareText: b.normalizedTextBetween(keywordIndex, b.lastTokenIndex(ctx)),
+	}
+}
+
+// signature returns the name with the argument types, which is used to identify and drop the overloaded functions and procedures.
+func (b *schemaBuilder) signature(name objectName, argList []snowparser.IArg_declContext) string {
+	var typeList []string
+	for _, arg := range argList {
+		typeList = append(typeList, b.normalizedText(arg.Arg_data_type()))
+	}
+	return fmt.Sprintf("%s(%s)", name, strings.Join(typeList, ", "))
+}
+
+// text returns the original text of the rule context.
+func (b *schemaBuilder) text(ctx antlr.ParserRuleContext) string {
+	return b.textBetween(ctx.GetStart().GetTokenIndex(), ctx.GetStop().GetTokenIndex())
+}
+
+// statementText returns the original text of the statement ending with a semicolon.
+func (b *schemaBuilder) statementText(ctx antlr.ParserRuleContext) string {
+	return b.textBetween(ctx.GetStart().GetTokenIndex(), b.lastTokenIndex(ctx)) + ";"
+}
+
+// lastTokenIndex returns the index of the last token of the rule context except the trailing semicolon.
+func (b *schemaBuilder) lastTokenIndex(ctx antlr.ParserRuleContext) int {
+	stop := ctx.GetStop().GetTokenIndex()
+	if ctx.GetStop().GetTokenType() == snowparser.SnowflakeParserSEMI {
+		stop--
+	}
+	return stop
+}
+
+func (b *schemaBuilder) textBetween(start, stop int) string {
+	if start > stop {
+		return ""
+	}
+	return strings.TrimSpace(b.stream.GetTextFromInterval(antlr.NewInterval(start, stop)))
+}
+
+// normalizedText returns the text of the default channel tokens joined by a space,
+// so that the comparison ignores the differences in whitespaces and comments.
+func (b *schemaBuilder) normalizedText(ctx antlr.ParserRuleContext) string {
+	return b.normalizedTextBetween(ctx.GetStart().GetTokenIndex(), b.lastTokenIndex(ctx))
+}
+
+// normalizedTextBetween returns the normalized text between the tokens. The keywords and unquoted identifiers
+// are case insensitive in Snowflake, so we convert them to upper case except the quoted identifiers and strings.
+func (b *schemaBuilder) normalizedTextBetween(start, stop int) string {
+	var tokenList []string
+	for i := start; i <= stop; i++ {
+		token := b.stream.Get(i)
+		if token.GetChannel() != antlr.TokenDefaultChannel {
+			continue
+		}
+		switch token.GetTokenType() {
+		case snowparser.SnowflakeLexerDOUBLE_QUOTE_ID, snowparser.SnowflakeLexerSTRING, snowparser.SnowflakeLexerDBL_DOLLAR:
+			tokenList = append(tokenList, token.GetText())
+		default:
+			tokenList = append(tokenList, strings.ToUpper(token.GetText()))
+		}
+	}
+	return strings.Join(tokenList, " ")
+}
+
+func normalizeObjectName(ctx snowparser.IObject_nameContext) objectName {
+	name := objectName{
+		schema: defaultSchema,
+		name:   parser.NormalizeSnowSQLObjectNamePart(ctx.GetO()),
+	}
+	if ctx.GetS() != nil {
+		name.schema = parser.NormalizeSnowSQLObjectNamePart(ctx.GetS())
+	}
+	return name
+}
@@ -0,0 +1,71 @@
+package snowflake
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+type DifferTestData struct {
+	OldSchema string `yaml:"oldSchema"`
+	NewSchema string `yaml:"newSchema"`
+	Diff      string `yaml:"diff"`
+}
+
+func runDifferTest(t *testing.T, file string, record bool) {
+	snowflakeDiffer := &SchemaDiffer{}
+
+	var tests []DifferTestData
+	filepath := filepath.Join("test-data", file)
+	yamlFile, err := os.Open(filepath)
+	require.NoError(t, err)
+	defer yamlFile.Close()
+
+	byteValue, err := io.ReadAll(yamlFile)
+	require.NoError(t, err)
+	err = yaml.Unmarshal(byteValue, &tests)
+	require.NoError(t, err)
+
+	for i, test := range tests {
+		diff, err := snowflakeDiffer.SchemaDiff(test.OldSchema, test.NewSchema, false /* ignoreCaseSensitive */)
+		require.NoError(t, err)
+		if record {
+			tests[i].Diff = diff
+		} else {
+			require.Equal(t, test.Diff, diff, test.OldSchema)
+		}
+	}
+
+	if record {
+		err := yamlFile.Close()
+		require.NoError(t, err)
+		byteValue, err = yaml.Marshal(tests)
+		require.NoError(t, err)
+		err = os.WriteFile(filepath, byteValue, 0644)
+		require.NoError(t, err)
+	}
+}
+
+func TestComputeDiff(t *testing.T) {
+	testFileList := []string{
+		// Table
+		"test_differ_table.yaml",
+		// Constraint
+		"test_differ_constraint.yaml",
+		// Schema, sequence, view, function and procedure
+		"test_differ_object.yaml",
+	}
+	for _, test := range testFileList {
+		runDifferTest(t, test, false /* record */)
+	}
+}
+
+func TestComputeDiffChangeDefault(t *testing.T) {
+	snowflakeDiffer := &SchemaDiffer{}
+	_, err := snowflakeDiffer.SchemaDiff("CREATE TABLE t1 (a INT DEFAULT 1);", "CREATE TABLE t1 (a INT DEFAULT 2);", false /* ignoreCaseSensitive */)
+	require.ErrorContains(t, err, "cannot change the default value")
+}
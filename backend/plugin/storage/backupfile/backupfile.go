@@ -0,0 +1,388 @@
+// Package backupfile implements the backup file format with streaming compression and envelope encryption.
+//
+// A backup file starts with a header describing how its content is encoded, followed by the body:
+//
+//	magic "BBBACKUP" | version (1 byte) | header length (uint32, big endian) | header (JSON) | body
+//
+// The body is the zstd compressed content if compression is enabled. If encryption is enabled, the (compressed) content is
+// encrypted with a random data key using AES-256-GCM in fixed size segments, and the data key is wrapped by a KeyWrapper and
+// stored in the header. Each segment has a nonce made of a random prefix, the segment counter and a last-segment flag, so
+// reordered, dropped or truncated segments fail the authentication. The header is used as the additional authenticated data.
+//
+// Backup files written before the format was introduced have no header and are read as plaintext SQL.
+package backupfile
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"math"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+const (
+	// CompressionZstd is the zstd compression.
+	CompressionZstd = "zstd"
+	// EncryptionAES256GCM is the AES-256-GCM encryption.
+	EncryptionAES256GCM = "AES-256-GCM"
+
+	magic   = "BBBACKUP"
+	version = 1
+	// maxHeaderSize is the upper bound of the header size to reject corrupted files early.
+	maxHeaderSize = 1 << 20
+	// maxSegmentSize is the upper bound of the encrypted segment size, so a corrupted header cannot make the reader allocate
+	// a huge buffer before the authentication fails.
+	maxSegmentSize = 16 << 20
+	dataKeySize    = 32
+	// noncePrefixSize is the size of the random nonce prefix, the rest of the 12-byte GCM nonce is the 4-byte segment counter and the 1-byte last-segment flag.
+	noncePrefixSize    = 7
+	defaultSegmentSize = 64 * 1024
+)
+
+// Options is the options to write a backup file.
+type Options struct {
+	// Compress compresses the content with zstd.
+	Compress bool
+	// KeyWrapper wraps the data key encrypting the content. The content is not encrypted if it is nil.
+	KeyWrapper KeyWrapper
+}
+
+type header struct {
+	Compression string            `json:"compression,omitempty"`
+	Encryption  *encryptionHeader `json:"encryption,omitempty"`
+}
+
+type encryptionHeader struct {
+	Algorithm   string `json:"algorithm"`
+	KeyID       string `json:"keyId"`
+	WrappedKey  []byte `json:"wrappedKey"`
+	NoncePrefix []byte `json:"noncePrefix"`
+	SegmentSize int    `json:"segmentSize"`
+}
+
+// Writer writes the encoded backup file.
+type Writer struct {
+	w       io.Writer
+	zstd    *zstd.Encoder
+	segment *segmentWriter
+}
+
+// NewWriter returns a writer encoding the content into the backup file written to w.
+// The content is written as is if neither compression nor encryption is enabled.
+// Callers must call Close to flush the remaining content.
+func NewWriter(ctx context.Context, w io.Writer, opts Options) (*Writer, error) {
+	if !opts.Compress && opts.KeyWrapper == nil {
+		return &Writer{w: w}, nil
+	}
+
+	h := &header{}
+	if opts.Compress {
+		h.Compression = CompressionZstd
+	}
+	var aead cipher.AEAD
+	if opts.KeyWrapper != nil {
+		dataKey := make([]byte, dataKeySize)
+		if _, err := rand.Read(dataKey); err != nil {
+			return nil, errors.Wrap(err, "failed to generate data key")
+		}
+		wrappedKey, err := opts.KeyWrapper.WrapKey(ctx, dataKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to wrap data key")
+		}
+		noncePrefix := make([]byte, noncePrefixSize)
+		if _, err := rand.Read(noncePrefix); err != nil {
+			return nil, errors.Wrap(err, "failed to generate nonce prefix")
+		}
+		if aead, err = newAEAD(dataKey); err != nil {
+			return nil, err
+		}
+		h.Encryption = &encryptionHeader{
+			Algorithm:   EncryptionAES256GCM,
+			KeyID:       opts.KeyWrapper.KeyID(),
+			WrappedKey:  wrappedKey,
+			NoncePrefix: noncePrefix,
+			SegmentSize: defaultSegmentSize,
+		}
+	}
+
+	headerBytes, err := marshalHeader(h)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(headerBytes); err != nil {
+		return nil, errors.Wrap(err, "failed to write backup file header")
+	}
+
+	writer := &Writer{w: w}
+	if h.Encryption != nil {
+		writer.segment = &segmentWriter{
+			w:           w,
+			aead:        aead,
+			noncePrefix: h.Encryption.NoncePrefix,
+			aad:         headerBytes,
+			buf:         make([]byte, 0, h.Encryption.SegmentSize),
+		}
+		writer.w = writer.segment
+	}
+	if opts.Compress {
+		encoder, err := zstd.NewWriter(writer.w)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create zstd encoder")
+		}
+		writer.zstd = encoder
+		writer.w = encoder
+	}
+	return writer, nil
+}
+
+// Write writes the content.
+func (w *Writer) Write(p []byte) (int, error) {
+	return w.w.Write(p)
+}
+
+// Close flushes the remaining content. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.zstd != nil {
+		if err := w.zstd.Close(); err != nil {
+			return errors.Wrap(err, "failed to flush zstd encoder")
+		}
+	}
+	if w.segment != nil {
+		if err := w.segment.close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewReader returns a reader decoding the content of the backup file read from r.
+// Backup files without the header are returned as is, so the plaintext backups taken before remain restorable.
+// The keyWrapper is only required for encrypted backup files.
+func NewReader(ctx context.Context, r io.Reader, keyWrapper KeyWrapper) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+	prefix, err := br.Peek(len(magic))
+	if err != nil && err != io.EOF {
+		return nil, errors.Wrap(err, "failed to read backup file")
+	}
+	if string(prefix) != magic {
+		return io.NopCloser(br), nil
+	}
+
+	h, headerBytes, err := readHeader(br)
+	if err != nil {
+		return nil, err
+	}
+
+	var content io.Reader = br
+	if h.Encryption != nil {
+		if h.Encryption.Algorithm != EncryptionAES256GCM {
+			return nil, errors.Errorf("unsupported backup encryption algorithm %q", h.Encryption.Algorithm)
+		}
+		if keyWrapper == nil {
+			return nil, errors.Errorf("backup file is encrypted with key %q, but no backup encryption key is configured", h.Encryption.KeyID)
+		}
+		if keyWrapper.KeyID() != h.Encryption.KeyID {
+			return nil, errors.Errorf("backup file is encrypted with key %q, but the configured backup encryption key is %q", h.Encryption.KeyID, keyWrapper.KeyID())
+		}
+		if len(h.Encryption.NoncePrefix) != noncePrefixSize || h.Encryption.SegmentSize <= 0 || h.Encryption.SegmentSize > maxSegmentSize {
+			return nil, errors.Errorf("invalid backup encryption header")
+		}
+		dataKey, err := keyWrapper.UnwrapKey(ctx, h.Encryption.WrappedKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to unwrap data key")
+		}
+		aead, err := newAEAD(dataKey)
+		if err != nil {
+			return nil, err
+		}
+		content = &segmentReader{
+			r:           br,
+			aead:        aead,
+			noncePrefix: h.Encryption.NoncePrefix,
+			aad:         headerBytes,
+			buf:         make([]byte, h.Encryption.SegmentSize+aead.Overhead()),
+		}
+	}
+
+	switch h.Compression {
+	case "":
+		return io.NopCloser(content), nil
+	case CompressionZstd:
+		decoder, err := zstd.NewReader(content)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create zstd decoder")
+		}
+		return decoder.IOReadCloser(), nil
+	default:
+		return nil, errors.Errorf("unsupported backup compression %q", h.Compression)
+	}
+}
+
+func marshalHeader(h *header) ([]byte, error) {
+	body, err := json.Marshal(h)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal backup file header")
+	}
+	var buf bytes.Buffer
+	buf.WriteString(magic)
+	buf.WriteByte(version)
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(body)))
+	buf.Write(size[:])
+	buf.Write(body)
+	return buf.Bytes(), nil
+}
+
+// readHeader reads the header, and returns it with its raw bytes used as the additional authenticated data.
+func readHeader(r io.Reader) (*header, []byte, error) {
+	prefix := make([]byte, len(magic)+1+4)
+	if _, err := io.ReadFull(r, prefix); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read backup file header")
+	}
+	if v := prefix[len(magic)]; v != version {
+		return nil, nil, errors.Errorf("unsupported backup file version %d", v)
+	}
+	size := binary.BigEndian.Uint32(prefix[len(magic)+1:])
+	if size > maxHeaderSize {
+		return nil, nil, errors.Errorf("backup file header size %d exceeds the limit %d", size, maxHeaderSize)
+	}
+	body := make([]byte, size)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to read backup file header")
+	}
+	h := &header{}
+	if err := json.Unmarshal(body, h); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to unmarshal backup file header")
+	}
+	return h, append(prefix, body...), nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AES cipher")
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create GCM cipher")
+	}
+	return aead, nil
+}
+
+func segmentNonce(prefix []byte, counter uint32, last bool) []byte {
+	nonce := make([]byte, 0, noncePrefixSize+5)
+	nonce = append(nonce, prefix...)
+	nonce = binary.BigEndian.AppendUint32(nonce, counter)
+	if last {
+		return append(nonce, 1)
+	}
+	return append(nonce, 0)
+}
+
+// segmentWriter encrypts the content in segments of cap(buf) bytes.
+// A full segment is only sealed when more content arrives, so that the last segment is always sealed with the last-segment flag on close.
+type segmentWriter struct {
+	w           io.Writer
+	aead        cipher.AEAD
+	noncePrefix []byte
+	aad         []byte
+	buf         []byte
+	sealed      []byte
+	counter     uint32
+}
+
+func (w *segmentWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		if len(w.buf) == cap(w.buf) {
+			if err := w.seal(false); err != nil {
+				return n, err
+			}
+		}
+		m := copy(w.buf[len(w.buf):cap(w.buf)], p)
+		w.buf = w.buf[:len(w.buf)+m]
+		p = p[m:]
+		n += m
+	}
+	return n, nil
+}
+
+func (w *segmentWriter) seal(last bool) error {
+	if w.counter == math.MaxUint32 {
+		return errors.Errorf("backup file exceeds the maximum number of encrypted segments")
+	}
+	w.sealed = w.aead.Seal(w.sealed[:0], segmentNonce(w.noncePrefix, w.counter, last), w.buf, w.aad)
+	if _, err := w.w.Write(w.sealed); err != nil {
+		return errors.Wrap(err, "failed to write encrypted segment")
+	}
+	w.buf = w.buf[:0]
+	w.counter++
+	return nil
+}
+
+func (w *segmentWriter) close() error {
+	return w.seal(true /* last */)
+}
+
+// segmentReader decrypts the segments written by segmentWriter.
+type segmentReader struct {
+	r           *bufio.Reader
+	aead        cipher.AEAD
+	noncePrefix []byte
+	aad         []byte
+	buf         []byte
+	plaintext   []byte
+	counter     uint32
+	done        bool
+}
+
+func (r *segmentReader) Read(p []byte) (int, error) {
+	for len(r.plaintext) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+		if err := r.next(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.plaintext)
+	r.plaintext = r.plaintext[n:]
+	return n, nil
+}
+
+func (r *segmentReader) next() error {
+	n, err := io.ReadFull(r.r, r.buf)
+	last := false
+	switch err {
+	case nil:
+		// A full segment is the last one if nothing follows.
+		if _, err := r.r.Peek(1); err == io.EOF {
+			last = true
+		} else if err != nil {
+			return errors.Wrap(err, "failed to read encrypted segment")
+		}
+	case io.EOF, io.ErrUnexpectedEOF:
+		last = true
+	default:
+		return errors.Wrap(err, "failed to read encrypted segment")
+	}
+	if n < r.aead.Overhead() {
+		return errors.Errorf("backup file is truncated")
+	}
+	plaintext, err := r.aead.Open(r.buf[:0], segmentNonce(r.noncePrefix, r.counter, last), r.buf[:n], r.aad)
+	if err != nil {
+		return errors.Errorf("failed to decrypt segment %d of the backup file, the file is corrupted or truncated", r.counter)
+	}
+	r.plaintext = plaintext
+	r.counter++
+	r.done = last
+	return nil
+}
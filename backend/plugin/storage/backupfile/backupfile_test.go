@@ -0,0 +1,135 @@
+package backupfile
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestKeyWrapper(t *testing.T) KeyWrapper {
+	key := make([]byte, dataKeySize)
+	_, err := rand.Read(key)
+	require.NoError(t, err)
+	keyWrapper, err := NewWorkspaceKeyWrapper(key)
+	require.NoError(t, err)
+	return keyWrapper
+}
+
+func encode(t *testing.T, content []byte, opts Options) []byte {
+	var buf bytes.Buffer
+	w, err := NewWriter(context.Background(), &buf, opts)
+	require.NoError(t, err)
+	// Write in odd sized chunks to cross the segment boundaries.
+	for i := 0; i < len(content); i += 1000 {
+		end := i + 1000
+		if end > len(content) {
+			end = len(content)
+		}
+		_, err := w.Write(content[i:end])
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func decode(encoded []byte, keyWrapper KeyWrapper) ([]byte, error) {
+	r, err := NewReader(context.Background(), bytes.NewReader(encoded), keyWrapper)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+func TestRoundTrip(t *testing.T) {
+	a := require.New(t)
+	keyWrapper := newTestKeyWrapper(t)
+	contents := [][]byte{
+		nil,
+		[]byte("INSERT INTO t VALUES (1);\n"),
+		[]byte(strings.Repeat("INSERT INTO t VALUES (1);\n", 20000)),
+		// Exactly one full segment.
+		bytes.Repeat([]byte("x"), defaultSegmentSize),
+	}
+	random := make([]byte, 3*defaultSegmentSize+17)
+	_, err := rand.Read(random)
+	a.NoError(err)
+	contents = append(contents, random)
+
+	for _, opts := range []Options{
+		{},
+		{Compress: true},
+		{KeyWrapper: keyWrapper},
+		{Compress: true, KeyWrapper: keyWrapper},
+	} {
+		for _, content := range contents {
+			encoded := encode(t, content, opts)
+			if opts.KeyWrapper != nil {
+				a.NotContains(string(encoded), "INSERT INTO")
+			}
+			decoded, err := decode(encoded, keyWrapper)
+			a.NoError(err)
+			a.Equal(len(content), len(decoded))
+			a.True(bytes.Equal(content, decoded))
+		}
+	}
+}
+
+func TestPlaintextBackup(t *testing.T) {
+	a := require.New(t)
+	content := []byte("CREATE TABLE t (id int);\n")
+	decoded, err := decode(content, nil)
+	a.NoError(err)
+	a.Equal(content, decoded)
+
+	decoded, err = decode(nil, nil)
+	a.NoError(err)
+	a.Empty(decoded)
+}
+
+func TestDecryptFailure(t *testing.T) {
+	a := require.New(t)
+	keyWrapper := newTestKeyWrapper(t)
+	content := []byte(strings.Repeat("INSERT INTO t VALUES (1);\n", 20000))
+	encoded := encode(t, content, Options{KeyWrapper: keyWrapper})
+
+	_, err := decode(encoded, nil)
+	a.ErrorContains(err, "no backup encryption key is configured")
+
+	_, err = decode(encoded, newTestKeyWrapper(t))
+	a.ErrorContains(err, "but the configured backup encryption key is")
+
+	// Truncated at a segment boundary.
+	headerSize := len(encoded) - (len(content) + (len(content)/defaultSegmentSize+1)*16)
+	_, err = decode(encoded[:headerSize+defaultSegmentSize+16], keyWrapper)
+	a.Error(err)
+
+	// Truncated in the middle of a segment.
+	_, err = decode(encoded[:len(encoded)-1], keyWrapper)
+	a.Error(err)
+
+	// Tampered content.
+	tampered := bytes.Clone(encoded)
+	tampered[len(tampered)-20] ^= 1
+	_, err = decode(tampered, keyWrapper)
+	a.Error(err)
+}
+
+func TestCorruptedSegmentSize(t *testing.T) {
+	a := require.New(t)
+	keyWrapper := newTestKeyWrapper(t)
+	encoded := encode(t, []byte("INSERT INTO t VALUES (1);\n"), Options{KeyWrapper: keyWrapper})
+
+	h, headerBytes, err := readHeader(bytes.NewReader(encoded))
+	a.NoError(err)
+	h.Encryption.SegmentSize = 1 << 40
+	corruptedHeader, err := marshalHeader(h)
+	a.NoError(err)
+	_, err = decode(append(corruptedHeader, encoded[len(headerBytes):]...), keyWrapper)
+	a.ErrorContains(err, "invalid backup encryption header")
+}
@@ -0,0 +1,90 @@
+package backupfile
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// KeyWrapper wraps and unwraps the data keys encrypting the backup files.
+// It is compatible with key management services, which encrypt and decrypt small payloads with a key encryption key that never leaves the service.
+type KeyWrapper interface {
+	// KeyID returns the ID of the key encryption key. It is recorded in the backup files to tell which key is needed to restore them.
+	KeyID() string
+	// WrapKey encrypts the data key.
+	WrapKey(ctx context.Context, dataKey []byte) ([]byte, error)
+	// UnwrapKey decrypts the wrapped data key.
+	UnwrapKey(ctx context.Context, wrappedKey []byte) ([]byte, error)
+}
+
+// workspaceKeyWrapper wraps the data keys with a 256-bit workspace key using AES-GCM.
+type workspaceKeyWrapper struct {
+	id  string
+	key []byte
+}
+
+// NewWorkspaceKeyWrapper creates a key wrapper with the 256-bit workspace key.
+func NewWorkspaceKeyWrapper(key []byte) (KeyWrapper, error) {
+	if len(key) != dataKeySize {
+		return nil, errors.Errorf("backup encryption key must be %d bytes, got %d bytes", dataKeySize, len(key))
+	}
+	sum := sha256.Sum256(key)
+	return &workspaceKeyWrapper{
+		id:  "workspace:" + hex.EncodeToString(sum[:8]),
+		key: key,
+	}, nil
+}
+
+// LoadWorkspaceKeyFile loads the workspace key wrapper from the file containing the base64 encoded 256-bit key, e.g. generated by `openssl rand -base64 32`.
+func LoadWorkspaceKeyFile(path string) (KeyWrapper, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read backup encryption key file %q", path)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode backup encryption key file %q", path)
+	}
+	return NewWorkspaceKeyWrapper(key)
+}
+
+// KeyID returns the ID of the workspace key, which is derived from the key fingerprint.
+func (w *workspaceKeyWrapper) KeyID() string {
+	return w.id
+}
+
+// WrapKey encrypts the data key with the workspace key, and prepends the nonce to the result.
+func (w *workspaceKeyWrapper) WrapKey(_ context.Context, dataKey []byte) ([]byte, error) {
+	aead, err := newAEAD(w.key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	return aead.Seal(nonce, nonce, dataKey, []byte(w.id)), nil
+}
+
+// UnwrapKey decrypts the data key wrapped by WrapKey.
+func (w *workspaceKeyWrapper) UnwrapKey(_ context.Context, wrappedKey []byte) ([]byte, error) {
+	aead, err := newAEAD(w.key)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, errors.Errorf("invalid wrapped key")
+	}
+	nonce, ciphertext := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+	dataKey, err := aead.Open(nil, nonce, ciphertext, []byte(w.id))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decrypt wrapped key")
+	}
+	return dataKey, nil
+}
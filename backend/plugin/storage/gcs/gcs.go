@@ -0,0 +1,108 @@
+// Package gcs provides the client for Google Cloud Storage.
+package gcs
+
+import (
+	"context"
+	"io"
+
+	"cloud.google.com/go/storage"
+	"github.com/pkg/errors"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	bbstorage "github.com/bytebase/bytebase/backend/plugin/storage"
+)
+
+var _ bbstorage.Client = (*Client)(nil)
+
+// Client wraps the Google Cloud Storage client.
+type Client struct {
+	c      *storage.Client
+	bucket string
+}
+
+// NewClient returns a new Google Cloud Storage client.
+// The credentialsFileName is the path of a service account key file or other credential files accepted by GCP.
+func NewClient(ctx context.Context, bucket, credentialsFileName string) (*Client, error) {
+	return newClient(ctx, bucket, option.WithCredentialsFile(credentialsFileName))
+}
+
+func newClient(ctx context.Context, bucket string, opts ...option.ClientOption) (*Client, error) {
+	c, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create GCS client")
+	}
+	return &Client{
+		c:      c,
+		bucket: bucket,
+	}, nil
+}
+
+// ListObjects lists objects with prefix in their names.
+func (c *Client) ListObjects(ctx context.Context, prefix string) ([]*bbstorage.Object, error) {
+	var ret []*bbstorage.Object
+	it := c.c.Bucket(c.bucket).Objects(ctx, &storage.Query{Prefix: prefix})
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load the next page of GCS objects")
+		}
+		ret = append(ret, &bbstorage.Object{
+			Key:          attrs.Name,
+			LastModified: attrs.Updated,
+			Size:         attrs.Size,
+		})
+	}
+	return ret, nil
+}
+
+// DownloadObject downloads the object with path.
+func (c *Client) DownloadObject(ctx context.Context, path string, w io.WriterAt) (int64, error) {
+	reader, err := c.c.Bucket(c.bucket).Object(path).NewReader(ctx)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to read object %q from GCS", path)
+	}
+	defer reader.Close()
+	n, err := io.Copy(io.NewOffsetWriter(w, 0), reader)
+	if err != nil {
+		return n, errors.Wrapf(err, "failed to download object %q from GCS", path)
+	}
+	return n, nil
+}
+
+// UploadObject uploads an object with the path.
+// The writer uploads the body in chunks, so the body is never buffered in memory as a whole.
+// On failure, the upload is aborted by canceling the context rather than closing the writer, which would finalize a truncated object.
+func (c *Client) UploadObject(ctx context.Context, path string, body io.Reader) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	writer := c.c.Bucket(c.bucket).Object(path).NewWriter(ctx)
+	if _, err := io.Copy(writer, body); err != nil {
+		cancel()
+		return errors.Wrapf(err, "failed to upload object %q to GCS", path)
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrapf(err, "failed to finish uploading object %q to GCS", path)
+	}
+	return nil
+}
+
+// DeleteObjects deletes the objects with path.
+// GCS has no batch delete API, so objects are deleted one by one. Objects that do not exist are skipped.
+func (c *Client) DeleteObjects(ctx context.Context, pathList ...string) error {
+	bucket := c.c.Bucket(c.bucket)
+	for _, path := range pathList {
+		if err := bucket.Object(path).Delete(ctx); err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return errors.Wrapf(err, "failed to delete object %q from GCS", path)
+		}
+	}
+	return nil
+}
+
+// GetBucket returns the bucket.
+func (c *Client) GetBucket() string {
+	return c.bucket
+}
@@ -0,0 +1,154 @@
+package gcs
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+// fakeGCSServer serves the subset of the GCS JSON and XML APIs used by the client.
+type fakeGCSServer struct {
+	mu      sync.Mutex
+	objects map[string]string
+	deleted []string
+}
+
+func (f *fakeGCSServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	const objectsPath = "/storage/v1/b/bucket/o"
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == objectsPath:
+		prefix := r.URL.Query().Get("prefix")
+		var items []string
+		for _, name := range []string{"backup/1", "backup/2", "other/1"} {
+			if _, ok := f.objects[name]; ok && strings.HasPrefix(name, prefix) {
+				items = append(items, `{"name": "`+name+`", "size": "3", "updated": "2023-10-01T00:00:00Z"}`)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"kind": "storage#objects", "items": [`+strings.Join(items, ",")+`]}`)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, objectsPath+"/"):
+		name := strings.TrimPrefix(r.URL.Path, objectsPath+"/")
+		if _, ok := f.objects[name]; !ok {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"error": {"code": 404, "message": "No such object"}}`)
+			return
+		}
+		delete(f.objects, name)
+		f.deleted = append(f.deleted, name)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && r.URL.Path == "/upload"+objectsPath:
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.objects["uploaded"] = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"name": "uploaded", "bucket": "bucket", "size": "3"}`)
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/bucket/"):
+		content, ok := f.objects[strings.TrimPrefix(r.URL.Path, "/bucket/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(w, content)
+	default:
+		w.WriteHeader(http.StatusNotImplemented)
+	}
+}
+
+func newTestClient(t *testing.T, fake *fakeGCSServer) *Client {
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+	client, err := newClient(context.Background(), "bucket", option.WithEndpoint(server.URL+"/storage/v1/"), option.WithoutAuthentication())
+	require.NoError(t, err)
+	return client
+}
+
+func TestListObjects(t *testing.T) {
+	a := require.New(t)
+	client := newTestClient(t, &fakeGCSServer{objects: map[string]string{"backup/1": "foo", "backup/2": "bar", "other/1": "baz"}})
+
+	objects, err := client.ListObjects(context.Background(), "backup/")
+	a.NoError(err)
+	a.Len(objects, 2)
+	a.Equal("backup/1", objects[0].Key)
+	a.Equal("backup/2", objects[1].Key)
+	a.Equal(int64(3), objects[0].Size)
+	a.Equal(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), objects[0].LastModified.UTC())
+}
+
+func TestDownloadObject(t *testing.T) {
+	a := require.New(t)
+	client := newTestClient(t, &fakeGCSServer{objects: map[string]string{"backup/1": "foo"}})
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "download"))
+	a.NoError(err)
+	defer f.Close()
+	n, err := client.DownloadObject(context.Background(), "backup/1", f)
+	a.NoError(err)
+	a.Equal(int64(3), n)
+	content, err := os.ReadFile(f.Name())
+	a.NoError(err)
+	a.Equal("foo", string(content))
+
+	_, err = client.DownloadObject(context.Background(), "backup/2", f)
+	a.Error(err)
+}
+
+func TestUploadObject(t *testing.T) {
+	a := require.New(t)
+	fake := &fakeGCSServer{objects: map[string]string{}}
+	client := newTestClient(t, fake)
+
+	err := client.UploadObject(context.Background(), "uploaded", strings.NewReader("foo"))
+	a.NoError(err)
+	// The multipart upload body contains the object metadata and the content.
+	a.Contains(fake.objects["uploaded"], "foo")
+}
+
+func TestDeleteObjects(t *testing.T) {
+	a := require.New(t)
+	fake := &fakeGCSServer{objects: map[string]string{"backup/1": "foo", "backup/2": "bar"}}
+	client := newTestClient(t, fake)
+
+	// The objects that do not exist are skipped.
+	err := client.DeleteObjects(context.Background(), "backup/1", "missing", "backup/2")
+	a.NoError(err)
+	a.Equal([]string{"backup/1", "backup/2"}, fake.deleted)
+	a.Empty(fake.objects)
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failure")
+}
+
+func TestUploadObjectAbortsOnReadError(t *testing.T) {
+	a := require.New(t)
+	fake := &fakeGCSServer{objects: map[string]string{}}
+	client := newTestClient(t, fake)
+
+	// A truncated body must not be finalized as an object.
+	err := client.UploadObject(context.Background(), "uploaded", io.MultiReader(strings.NewReader("foo"), failingReader{}))
+	a.Error(err)
+	fake.mu.Lock()
+	defer fake.mu.Unlock()
+	a.NotContains(fake.objects, "uploaded")
+}
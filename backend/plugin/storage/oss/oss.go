@@ -0,0 +1,144 @@
+// Package oss provides the client for Alibaba Cloud Object Storage Service (OSS).
+package oss
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/go-ini/ini"
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+)
+
+var _ storage.Client = (*Client)(nil)
+
+// maxDeleteObjectsBatchSize is the maximum number of objects that can be deleted in one OSS request.
+const maxDeleteObjectsBatchSize = 1000
+
+// Credentials is the AccessKey pair of Alibaba Cloud.
+type Credentials struct {
+	AccessKeyID     string
+	AccessKeySecret string
+}
+
+// Client wraps the Alibaba Cloud OSS client.
+type Client struct {
+	b      *oss.Bucket
+	bucket string
+}
+
+// GetCredentialsFromFile loads Alibaba Cloud credentials from file.
+// The file is in the same format as the Alibaba Cloud credentials file (~/.alibabacloud/credentials), for example:
+//
+//	[default]
+//	type = access_key
+//	access_key_id = foo
+//	access_key_secret = bar
+func GetCredentialsFromFile(credentialsFileName string) (Credentials, error) {
+	cfg, err := ini.Load(credentialsFileName)
+	if err != nil {
+		return Credentials{}, errors.Wrapf(err, "failed to load Alibaba Cloud credentials file %q", credentialsFileName)
+	}
+	section := cfg.Section("default")
+	credentials := Credentials{
+		AccessKeyID:     section.Key("access_key_id").String(),
+		AccessKeySecret: section.Key("access_key_secret").String(),
+	}
+	if credentials.AccessKeyID == "" || credentials.AccessKeySecret == "" {
+		return Credentials{}, errors.Errorf("access_key_id and access_key_secret must be set in the default profile of %q", credentialsFileName)
+	}
+	return credentials, nil
+}
+
+// NewClient returns a new Alibaba Cloud OSS client.
+func NewClient(region, bucket string, credentials Credentials) (*Client, error) {
+	c, err := oss.New(getEndpoint(region), credentials.AccessKeyID, credentials.AccessKeySecret)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create OSS client")
+	}
+	b, err := c.Bucket(bucket)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get OSS bucket %q", bucket)
+	}
+	return &Client{
+		b:      b,
+		bucket: bucket,
+	}, nil
+}
+
+// getEndpoint returns the public endpoint of the region, e.g., https://oss-cn-hangzhou.aliyuncs.com for cn-hangzhou.
+func getEndpoint(region string) string {
+	return fmt.Sprintf("https://oss-%s.aliyuncs.com", region)
+}
+
+// ListObjects lists objects with prefix in their names.
+func (c *Client) ListObjects(ctx context.Context, prefix string) ([]*storage.Object, error) {
+	var ret []*storage.Object
+	continuationToken := ""
+	for {
+		options := []oss.Option{oss.WithContext(ctx), oss.Prefix(prefix)}
+		if continuationToken != "" {
+			options = append(options, oss.ContinuationToken(continuationToken))
+		}
+		result, err := c.b.ListObjectsV2(options...)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to load the next page of OSS objects")
+		}
+		for _, object := range result.Objects {
+			ret = append(ret, &storage.Object{
+				Key:          object.Key,
+				LastModified: object.LastModified,
+				Size:         object.Size,
+			})
+		}
+		if !result.IsTruncated {
+			break
+		}
+		continuationToken = result.NextContinuationToken
+	}
+	return ret, nil
+}
+
+// DownloadObject downloads the object with path.
+func (c *Client) DownloadObject(ctx context.Context, path string, w io.WriterAt) (int64, error) {
+	body, err := c.b.GetObject(path, oss.WithContext(ctx))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to read object %q from OSS", path)
+	}
+	defer body.Close()
+	n, err := io.Copy(io.NewOffsetWriter(w, 0), body)
+	if err != nil {
+		return n, errors.Wrapf(err, "failed to download object %q from OSS", path)
+	}
+	return n, nil
+}
+
+// UploadObject uploads an object with the path.
+func (c *Client) UploadObject(ctx context.Context, path string, body io.Reader) error {
+	if err := c.b.PutObject(path, body, oss.WithContext(ctx)); err != nil {
+		return errors.Wrapf(err, "failed to upload object %q to OSS", path)
+	}
+	return nil
+}
+
+// DeleteObjects deletes the objects with path.
+func (c *Client) DeleteObjects(ctx context.Context, pathList ...string) error {
+	for i := 0; i < len(pathList); i += maxDeleteObjectsBatchSize {
+		end := i + maxDeleteObjectsBatchSize
+		if end > len(pathList) {
+			end = len(pathList)
+		}
+		if _, err := c.b.DeleteObjects(pathList[i:end], oss.WithContext(ctx), oss.DeleteObjectsQuiet(true)); err != nil {
+			return errors.Wrapf(err, "failed to delete %d objects from OSS", end-i)
+		}
+	}
+	return nil
+}
+
+// GetBucket returns the bucket.
+func (c *Client) GetBucket() string {
+	return c.bucket
+}
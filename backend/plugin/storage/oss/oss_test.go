@@ -0,0 +1,52 @@
+package oss
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCredentialsFromFile(t *testing.T) {
+	tests := []struct {
+		content string
+		want    Credentials
+		wantErr bool
+	}{
+		{
+			content: "[default]\ntype = access_key\naccess_key_id = foo\naccess_key_secret = bar\n",
+			want: Credentials{
+				AccessKeyID:     "foo",
+				AccessKeySecret: "bar",
+			},
+		},
+		{
+			content: "[default]\ntype = access_key\naccess_key_id = foo\n",
+			wantErr: true,
+		},
+		{
+			content: "[other]\naccess_key_id = foo\naccess_key_secret = bar\n",
+			wantErr: true,
+		},
+	}
+
+	a := require.New(t)
+	for _, test := range tests {
+		credentialsFileName := filepath.Join(t.TempDir(), "credentials")
+		err := os.WriteFile(credentialsFileName, []byte(test.content), 0600)
+		a.NoError(err)
+		got, err := GetCredentialsFromFile(credentialsFileName)
+		if test.wantErr {
+			a.Error(err)
+			continue
+		}
+		a.NoError(err)
+		a.Equal(test.want, got)
+	}
+}
+
+func TestGetEndpoint(t *testing.T) {
+	a := require.New(t)
+	a.Equal("https://oss-cn-hangzhou.aliyuncs.com", getEndpoint("cn-hangzhou"))
+}
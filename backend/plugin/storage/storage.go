@@ -0,0 +1,57 @@
+// Package storage defines the interface of the cloud storage backends for backups and binlog files.
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Object is the metadata of an object in the cloud storage.
+type Object struct {
+	Key          string
+	LastModified time.Time
+	Size         int64
+}
+
+// Client is the client of a cloud storage backend.
+type Client interface {
+	// ListObjects lists objects with prefix in their names.
+	ListObjects(ctx context.Context, prefix string) ([]*Object, error)
+	// UploadObject uploads an object with the path.
+	UploadObject(ctx context.Context, path string, body io.Reader) error
+	// DownloadObject downloads the object with path, and returns the number of bytes downloaded.
+	DownloadObject(ctx context.Context, path string, w io.WriterAt) (int64, error)
+	// DeleteObjects deletes the objects with path.
+	DeleteObjects(ctx context.Context, pathList ...string) error
+	// GetBucket returns the bucket.
+	GetBucket() string
+}
+
+// DownloadFileFromCloud downloads a backup, binlog or metadata file from the cloud storage.
+// In case of network errors which will get partially downloaded files, we first download to a temporary file.
+// After that, we then rename it to the target file path.
+func DownloadFileFromCloud(ctx context.Context, client Client, filePathLocal, filePathOnCloud string) (err error) {
+	filePathTemp := filePathLocal + ".tmp"
+	fileTemp, err := os.Create(filePathTemp)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create the local temporary file %s", filePathTemp)
+	}
+	defer fileTemp.Close()
+	defer func() {
+		// Remove the partially downloaded file on failure.
+		if err != nil {
+			_ = os.Remove(filePathTemp)
+		}
+	}()
+	if _, err := client.DownloadObject(ctx, filePathOnCloud, fileTemp); err != nil {
+		return errors.Wrapf(err, "failed to download file %q from the cloud storage", filePathOnCloud)
+	}
+	if err := os.Rename(filePathTemp, filePathLocal); err != nil {
+		return errors.Wrapf(err, "failed to rename %q to %q", filePathTemp, filePathLocal)
+	}
+	return nil
+}
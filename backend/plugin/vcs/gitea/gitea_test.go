@@ -0,0 +1,766 @@
+package gitea
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/vcs"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/internal/oauth"
+)
+
+const testInstanceURL = "https://gitea.example.com"
+
+func TestProvider_FetchCommitByID(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "url": "https://gitea.example.com/api/v1/repos/octocat/Hello-World/git/commits/7638417db6d59f3c431d3e1f261cc637155684cd",
+  "sha": "7638417db6d59f3c431d3e1f261cc637155684cd",
+  "html_url": "https://gitea.example.com/octocat/Hello-World/commit/7638417db6d59f3c431d3e1f261cc637155684cd",
+  "commit": {
+    "author": {
+      "name": "Monalisa Octocat",
+      "email": "octocat@example.com",
+      "date": "2014-11-07T22:01:45Z"
+    },
+    "committer": {
+      "name": "Monalisa Octocat",
+      "email": "octocat@example.com",
+      "date": "2014-11-07T22:01:45Z"
+    },
+    "message": "added readme, because im a good citizen\n"
+  },
+  "parents": [
+    {
+      "sha": "1acc419d4d6a9ce985db7be48c6349a0475975b5"
+    }
+  ]
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchCommitByID(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "7638417db6d59f3c431d3e1f261cc637155684cd")
+	require.NoError(t, err)
+
+	want := &vcs.Commit{
+		ID:         "7638417db6d59f3c431d3e1f261cc637155684cd",
+		AuthorName: "Monalisa Octocat",
+		CreatedTs:  1415397705,
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_ExchangeOAuthToken(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/login/oauth/access_token", r.URL.Path)
+		assert.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "test_client_id", r.PostForm.Get("client_id"))
+		assert.Equal(t, "test_client_secret", r.PostForm.Get("client_secret"))
+		assert.Equal(t, "test_code", r.PostForm.Get("code"))
+		assert.Equal(t, "http://localhost:3000", r.PostForm.Get("redirect_uri"))
+		assert.Equal(t, "authorization_code", r.PostForm.Get("grant_type"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "access_token": "test_access_token",
+  "token_type": "bearer",
+  "expires_in": 3600,
+  "refresh_token": "test_refresh_token"
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.ExchangeOAuthToken(
+		ctx,
+		testInstanceURL,
+		&common.OAuthExchange{
+			ClientID:     "test_client_id",
+			ClientSecret: "test_client_secret",
+			Code:         "test_code",
+			RedirectURL:  "http://localhost:3000",
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "test_access_token", got.AccessToken)
+	assert.Equal(t, "test_refresh_token", got.RefreshToken)
+	assert.Equal(t, int64(3600), got.ExpiresIn)
+	assert.Equal(t, got.CreatedAt+3600, got.ExpiresTs)
+}
+
+func TestProvider_FetchAllRepositoryList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/user/repos", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+[
+  {
+    "id": 1,
+    "name": "Hello-World",
+    "full_name": "octocat/Hello-World",
+    "html_url": "https://gitea.example.com/octocat/Hello-World",
+    "permissions": {
+      "admin": true,
+      "push": true,
+      "pull": true
+    }
+  },
+  {
+    "id": 2,
+    "name": "Spoon-Knife",
+    "full_name": "octocat/Spoon-Knife",
+    "html_url": "https://gitea.example.com/octocat/Spoon-Knife",
+    "permissions": {
+      "admin": false,
+      "push": true,
+      "pull": true
+    }
+  }
+]
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchAllRepositoryList(ctx, common.OauthContext{}, testInstanceURL)
+	require.NoError(t, err)
+
+	// Repositories without admin permission are excluded.
+	want := []*vcs.Repository{
+		{
+			ID:       "octocat/Hello-World",
+			Name:     "Hello-World",
+			FullPath: "octocat/Hello-World",
+			WebURL:   "https://gitea.example.com/octocat/Hello-World",
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_FetchRepositoryFileList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/git/trees/main", r.URL.Path)
+		body := `
+{
+  "sha": "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+  "tree": [
+    {
+      "path": "db",
+      "mode": "040000",
+      "type": "tree",
+      "sha": "f484d249c660418515fb01c2b9662073663c242e"
+    },
+    {
+      "path": "db/1.0__init.sql",
+      "mode": "100644",
+      "type": "blob",
+      "size": 30,
+      "sha": "45b983be36b73c0788dc9cbcb76cbb80fc7bb057"
+    }
+  ],
+  "truncated": true,
+  "page": 1
+}
+`
+		if r.URL.Query().Get("page") == "2" {
+			body = `
+{
+  "sha": "9fb037999f264ba9a7fc6274d15fa3ae2ab98312",
+  "tree": [
+    {
+      "path": "README.md",
+      "mode": "100644",
+      "type": "blob",
+      "size": 132,
+      "sha": "7c258a9869f33c1e1e1f74fbb32f07c86cb5a75b"
+    },
+    {
+      "path": "db/1.1__add_table.sql",
+      "mode": "100644",
+      "type": "blob",
+      "size": 30,
+      "sha": "45b983be36b73c0788dc9cbcb76cbb80fc7bb058"
+    }
+  ],
+  "truncated": false,
+  "page": 2
+}
+`
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.FetchRepositoryFileList(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "main", "db")
+	require.NoError(t, err)
+
+	want := []*vcs.RepositoryTreeNode{
+		{
+			Path: "db/1.0__init.sql",
+			Type: "blob",
+		},
+		{
+			Path: "db/1.1__add_table.sql",
+			Type: "blob",
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_CreateFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/contents/notes/hello.txt", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		wantBody := `{"message":"my commit message","content":"bXkgbmV3IGZpbGUgY29udGVudHM=","branch":"master","author":{"name":"Bytebase","email":"support@bytebase.com"}}`
+		assert.Equal(t, wantBody, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.CreateFile(
+		ctx,
+		common.OauthContext{},
+		testInstanceURL,
+		"octocat/Hello-World",
+		"notes/hello.txt",
+		vcs.FileCommitCreate{
+			Branch:        "master",
+			Content:       "my new file contents",
+			CommitMessage: "my commit message",
+			AuthorName:    vcs.BytebaseAuthorName,
+			AuthorEmail:   vcs.BytebaseAuthorEmail,
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestProvider_OverwriteFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/contents/notes/hello.txt", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		wantBody := `{"message":"update file","content":"bXkgbmV3IGZpbGUgY29udGVudHM=","sha":"95b966ae1c166bd92f8ae7d1c313e738c731dfc3","branch":"master"}`
+		assert.Equal(t, wantBody, string(body))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.OverwriteFile(
+		ctx,
+		common.OauthContext{},
+		testInstanceURL,
+		"octocat/Hello-World",
+		"notes/hello.txt",
+		vcs.FileCommitCreate{
+			Branch:        "master",
+			Content:       "my new file contents",
+			CommitMessage: "update file",
+			SHA:           "95b966ae1c166bd92f8ae7d1c313e738c731dfc3",
+		},
+	)
+	require.NoError(t, err)
+}
+
+func TestProvider_ReadFileMeta(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/api/v1/repos/octocat/Hello-World/branches/main":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+{
+  "name": "main",
+  "commit": {
+    "id": "7638417db6d59f3c431d3e1f261cc637155684cd",
+    "message": "added readme"
+  }
+}
+`)),
+			}, nil
+		case "/api/v1/repos/octocat/Hello-World/contents/README.md":
+			assert.Equal(t, "7638417db6d59f3c431d3e1f261cc637155684cd", r.URL.Query().Get("ref"))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+{
+  "name": "README.md",
+  "path": "README.md",
+  "sha": "3d21ec53a331a6f037a91c368710b99387d012c1",
+  "last_commit_sha": "7638417db6d59f3c431d3e1f261cc637155684cd",
+  "type": "file",
+  "size": 5362,
+  "encoding": "base64",
+  "content": "IyBZb2dhIEJvmsgaW4gcHJvZ3Jlc3MhIEZlZWwgZnJdHVybi4K"
+}
+`)),
+			}, nil
+		}
+		return nil, errors.Errorf("unexpected request %s", r.URL.Path)
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.ReadFileMeta(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "README.md", vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: "main"})
+	require.NoError(t, err)
+
+	want := &vcs.FileMeta{
+		Name:         "README.md",
+		Path:         "README.md",
+		Size:         5362,
+		SHA:          "3d21ec53a331a6f037a91c368710b99387d012c1",
+		LastCommitID: "7638417db6d59f3c431d3e1f261cc637155684cd",
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_ReadFileContent(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/raw/db/1.0__init.sql", r.URL.Path)
+		assert.Equal(t, "main", r.URL.Query().Get("ref"))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`CREATE TABLE t (id INT);`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.ReadFileContent(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "db/1.0__init.sql", vcs.RefInfo{RefType: vcs.RefTypeBranch, RefName: "main"})
+	require.NoError(t, err)
+	assert.Equal(t, "CREATE TABLE t (id INT);", got)
+}
+
+func TestProvider_CreateWebhook(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/hooks", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "id": 12345678,
+  "type": "gitea",
+  "config": {
+    "content_type": "json",
+    "url": "https://example.com/webhook"
+  },
+  "events": [
+    "push"
+  ],
+  "active": true,
+  "updated_at": "2019-06-03T00:57:16Z",
+  "created_at": "2019-06-03T00:57:16Z"
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.CreateWebhook(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", []byte(""))
+	require.NoError(t, err)
+	assert.Equal(t, "12345678", got)
+}
+
+func TestProvider_PatchWebhook(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPatch, r.Method)
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/hooks/1", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.PatchWebhook(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "1", []byte(""))
+	require.NoError(t, err)
+}
+
+func TestProvider_DeleteWebhook(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodDelete, r.Method)
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/hooks/1", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.DeleteWebhook(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "1")
+	require.NoError(t, err)
+}
+
+func TestOAuth_RefreshToken(t *testing.T) {
+	ctx := context.Background()
+	client := &http.Client{
+		Transport: &common.MockRoundTripper{
+			MockRoundTrip: func(r *http.Request) (*http.Response, error) {
+				token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+				if token == "expired" {
+					return &http.Response{
+						StatusCode: http.StatusUnauthorized,
+						Body: io.NopCloser(strings.NewReader(`
+					{"message":"token is required","url":"https://gitea.example.com/api/swagger"}
+					`)),
+					}, nil
+				}
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body: io.NopCloser(strings.NewReader(`
+{
+  "access_token": "refreshed_access_token",
+  "token_type": "bearer",
+  "expires_in": 3600,
+  "refresh_token": "refreshed_refresh_token"
+}
+`)),
+				}, nil
+			},
+		},
+	}
+	token := "expired"
+
+	calledRefresher := false
+	refresher := func(accessToken, refreshToken string, _ int64) error {
+		calledRefresher = true
+		assert.Equal(t, "refreshed_access_token", accessToken)
+		assert.Equal(t, "refreshed_refresh_token", refreshToken)
+		return nil
+	}
+
+	_, _, _, err := oauth.Get(
+		ctx,
+		client,
+		"https://gitea.example.com/api/v1/user",
+		&token,
+		tokenRefresher(
+			testInstanceURL,
+			oauthContext{},
+			refresher,
+		),
+	)
+	require.NoError(t, err)
+	assert.Equal(t, "refreshed_access_token", token)
+	assert.True(t, calledRefresher)
+}
+
+func TestProvider_GetBranch(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/branches/feature/gitops", r.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "name": "feature/gitops",
+  "commit": {
+    "id": "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+    "message": "Merge pull request #6"
+  },
+  "protected": false
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.GetBranch(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "feature/gitops")
+	require.NoError(t, err)
+
+	want := &vcs.BranchInfo{
+		Name:         "feature/gitops",
+		LastCommitID: "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_CreateBranch(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/branches", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		wantBody := `{"new_branch_name":"bytebase-vcs-1","old_ref_name":"7fd1a60b01f91b314f59955a4e4d4e80d8edf11d"}`
+		assert.Equal(t, wantBody, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.CreateBranch(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", &vcs.BranchInfo{
+		Name:         "bytebase-vcs-1",
+		LastCommitID: "7fd1a60b01f91b314f59955a4e4d4e80d8edf11d",
+	})
+	require.NoError(t, err)
+}
+
+func TestProvider_CreatePullRequest(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/pulls", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		wantBody := `{"title":"Amazing new feature","body":"Please pull these awesome changes in!","head":"octocat:new-feature","base":"master"}`
+		assert.Equal(t, wantBody, string(body))
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "id": 1,
+  "number": 1347,
+  "html_url": "https://gitea.example.com/octocat/Hello-World/pulls/1347",
+  "title": "Amazing new feature",
+  "head": {
+    "ref": "new-feature",
+    "sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e"
+  }
+}
+`)),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.CreatePullRequest(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", &vcs.PullRequestCreate{
+		Title: "Amazing new feature",
+		Body:  "Please pull these awesome changes in!",
+		Head:  "octocat:new-feature",
+		Base:  "master",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://gitea.example.com/octocat/Hello-World/pulls/1347", got.URL)
+}
+
+func TestProvider_UpsertEnvironmentVariable(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/actions/secrets/SQL_REVIEW_API_SECRET", r.URL.Path)
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, `{"data":"secret_value"}`, string(body))
+		return &http.Response{
+			StatusCode: http.StatusNoContent,
+			Body:       io.NopCloser(strings.NewReader("")),
+		}, nil
+	},
+	)
+
+	ctx := context.Background()
+	err := p.UpsertEnvironmentVariable(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", vcs.SQLReviewAPISecretName, "secret_value")
+	require.NoError(t, err)
+}
+
+func TestProvider_ListPullRequestFile(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		switch r.URL.Path {
+		case "/api/v1/repos/octocat/Hello-World/pulls/1":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+{
+  "number": 1,
+  "head": {
+    "ref": "new-feature",
+    "sha": "6dcb09b5b57875f334f61aebed695e2e4193db5e"
+  }
+}
+`)),
+			}, nil
+		case "/api/v1/repos/octocat/Hello-World/pulls/1/files":
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body: io.NopCloser(strings.NewReader(`
+[
+  {
+    "filename": "db/1.0__init.sql",
+    "status": "added",
+    "additions": 1,
+    "deletions": 0,
+    "changes": 1
+  },
+  {
+    "filename": "db/0.9__legacy.sql",
+    "status": "deleted",
+    "additions": 0,
+    "deletions": 3,
+    "changes": 3
+  }
+]
+`)),
+			}, nil
+		}
+		return nil, errors.Errorf("unexpected request %s", r.URL.Path)
+	},
+	)
+
+	ctx := context.Background()
+	got, err := p.ListPullRequestFile(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "1")
+	require.NoError(t, err)
+
+	want := []*vcs.PullRequestFile{
+		{
+			Path:         "db/1.0__init.sql",
+			LastCommitID: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			IsDeleted:    false,
+		},
+		{
+			Path:         "db/0.9__legacy.sql",
+			LastCommitID: "6dcb09b5b57875f334f61aebed695e2e4193db5e",
+			IsDeleted:    true,
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestProvider_GetDiffFileList(t *testing.T) {
+	p := newMockProvider(func(r *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/repos/octocat/Hello-World/compare/before_sha...after_sha", r.URL.Path)
+		// Commits are listed from the newest to the oldest.
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(strings.NewReader(`
+{
+  "total_commits": 3,
+  "commits": [
+    {
+      "sha": "c3",
+      "files": [
+        {"filename": "db/tmp.sql", "status": "removed"},
+        {"filename": "db/2.sql", "status": "modified"}
+      ]
+    },
+    {
+      "sha": "c2",
+      "files": [
+        {"filename": "db/tmp.sql", "status": "added"},
+        {"filename": "db/2.sql", "status": "added"}
+      ]
+    },
+    {
+      "sha": "c1",
+      "files": [
+        {"filename": "db/1.sql", "status": "modified"},
+        {"filename": "db/old.sql", "status": "removed"}
+      ]
+    }
+  ]
+}
+`)),
+		}, nil
+	},
+	)
+	ctx := context.Background()
+	got, err := p.GetDiffFileList(ctx, common.OauthContext{}, testInstanceURL, "octocat/Hello-World", "before_sha", "after_sha")
+	require.NoError(t, err)
+
+	want := []vcs.FileDiff{
+		{
+			Path: "db/1.sql",
+			Type: vcs.FileDiffTypeModified,
+		},
+		{
+			Path: "db/old.sql",
+			Type: vcs.FileDiffTypeRemoved,
+		},
+		{
+			Path: "db/2.sql",
+			Type: vcs.FileDiffTypeAdded,
+		},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestWebhookPushEvent_ToVCS(t *testing.T) {
+	pushEvent := WebhookPushEvent{
+		Ref:    "refs/heads/main",
+		Before: "before_sha",
+		After:  "after_sha",
+		Repository: WebhookRepository{
+			ID:       1,
+			FullName: "octocat/Hello-World",
+			HTMLURL:  "https://gitea.example.com/octocat/Hello-World",
+		},
+		Sender: WebhookSender{
+			Login: "octocat",
+		},
+		Commits: []WebhookCommit{
+			{
+				ID:      "after_sha",
+				Message: "Add migration\n\nCreate the table t.",
+				URL:     "https://gitea.example.com/octocat/Hello-World/commit/after_sha",
+				Author: WebhookCommitAuthor{
+					Name:  "Monalisa Octocat",
+					Email: "octocat@example.com",
+				},
+				Added: []string{"db/1.0__init.sql"},
+			},
+		},
+	}
+
+	got := pushEvent.ToVCS()
+	assert.Equal(t, vcs.Gitea, got.VCSType)
+	assert.Equal(t, "octocat/Hello-World", got.RepositoryID)
+	assert.Equal(t, "octocat/Hello-World", got.RepositoryFullPath)
+	assert.Equal(t, "https://gitea.example.com/octocat/Hello-World", got.RepositoryURL)
+	assert.Equal(t, "octocat", got.AuthorName)
+	require.Len(t, got.CommitList, 1)
+	assert.Equal(t, "Add migration", got.CommitList[0].Title)
+	assert.Equal(t, []string{"db/1.0__init.sql"}, got.CommitList[0].AddedList)
+}
+
+func newMockProvider(mockRoundTrip func(r *http.Request) (*http.Response, error)) vcs.Provider {
+	return newProvider(
+		vcs.ProviderConfig{
+			Client: &http.Client{
+				Transport: &common.MockRoundTripper{
+					MockRoundTrip: mockRoundTrip,
+				},
+			},
+		},
+	)
+}
@@ -0,0 +1,16 @@
+package gitea
+
+import (
+	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
+)
+
+const (
+	// SQLReviewActionFilePath is the SQL review action file path.
+	SQLReviewActionFilePath = ".gitea/workflows/bytebase-sql-review.yml"
+)
+
+// SetupSQLReviewCI will setup the SQL review CI content with SQL review endpoint.
+// Gitea Actions is compatible with GitHub Actions, thus we share the same workflow.
+func SetupSQLReviewCI(endpoint string) string {
+	return github.SetupSQLReviewCI(endpoint)
+}
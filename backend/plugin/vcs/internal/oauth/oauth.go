@@ -171,6 +171,12 @@ func getOAuthErrorDetails(code int, body []byte) error {
 		return &oauthError{}
 	}
 
+	// Special case for Gitea OAuth error, Gitea treats the expired access token
+	// as absent and responds with 401.
+	if code == http.StatusUnauthorized && bytes.Contains(body, []byte("token is required")) {
+		return &oauthError{}
+	}
+
 	var oe oauthError
 	if err := json.Unmarshal(body, &oe); err != nil {
 		// If we failed to unmarshal body with oauth error, it's not oauthError and we should return nil.
@@ -26,6 +26,8 @@ const (
 	Bitbucket Type = "BITBUCKET"
 	// AzureDevOps is the VCS type for Azure DevOps.
 	AzureDevOps Type = "AZURE_DEVOPS"
+	// Gitea is the VCS type for self-hosted Gitea and Forgejo.
+	Gitea Type = "GITEA"
 
 	// SQLReviewAPISecretName is the api secret name used in GitHub action or GitLab CI workflow.
 	SQLReviewAPISecretName = "SQL_REVIEW_API_SECRET"
@@ -2,6 +2,9 @@ package webhook
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -9,6 +12,15 @@ import (
 	"github.com/pkg/errors"
 )
 
+const (
+	// SignatureHeader is the header carrying the HMAC-SHA256 signature of the request body, signed by the webhook secret.
+	// The value is in the format of "sha256=<hex digest>".
+	SignatureHeader = "X-Bytebase-Signature-256"
+	// DeliveryHeader is the header carrying the unique identifier of the delivery.
+	// Receivers may use it to dedup the redelivered events.
+	DeliveryHeader = "X-Bytebase-Delivery"
+)
+
 // CustomWebhookResponse is the API message for Custom webhook response.
 type CustomWebhookResponse struct {
 	Code    int    `json:"code"`
@@ -62,6 +74,12 @@ func (*CustomReceiver) post(context Context) error {
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if context.DeliveryID != "" {
+		req.Header.Set(DeliveryHeader, context.DeliveryID)
+	}
+	if context.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(context.Secret, body))
+	}
 	client := &http.Client{
 		Timeout: timeout,
 	}
@@ -91,3 +109,11 @@ func (*CustomReceiver) post(context Context) error {
 
 	return nil
 }
+
+// Sign returns the value of the signature header for the body signed by the secret.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	// hash.Hash never returns an error on Write.
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,66 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomReceiverSignature(t *testing.T) {
+	tests := []struct {
+		name       string
+		secret     string
+		deliveryID string
+	}{
+		{
+			name:       "signed",
+			secret:     "my-secret",
+			deliveryID: "101",
+		},
+		{
+			name: "unsigned",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a := require.New(t)
+			var header http.Header
+			var body []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				header = r.Header.Clone()
+				b, err := io.ReadAll(r.Body)
+				a.NoError(err)
+				body = b
+				_, _ = w.Write([]byte(`{"code":0}`))
+			}))
+			defer server.Close()
+
+			err := Post("bb.plugin.webhook.custom", Context{
+				URL:          server.URL,
+				Secret:       test.secret,
+				DeliveryID:   test.deliveryID,
+				Level:        WebhookInfo,
+				ActivityType: "bb.issue.create",
+				Title:        "Issue created",
+			})
+			a.NoError(err)
+
+			a.Equal(test.deliveryID, header.Get(DeliveryHeader))
+			if test.secret == "" {
+				a.Empty(header.Get(SignatureHeader))
+				return
+			}
+			// Verify the signature the way a receiver would.
+			mac := hmac.New(sha256.New, []byte(test.secret))
+			_, _ = mac.Write(body)
+			a.Equal("sha256="+hex.EncodeToString(mac.Sum(nil)), header.Get(SignatureHeader))
+		})
+	}
+}
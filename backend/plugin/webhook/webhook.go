@@ -64,25 +64,31 @@ type Project struct {
 
 // Approval object of issue approval.
 type Approval struct {
-	MentionUsersByPhone []string
+	MentionUsersByPhone []string `json:"mentionUsersByPhone"`
 }
 
 // Context is the context of webhook.
+// It is persisted as the payload of the webhook delivery, except for the fields of the webhook itself.
 type Context struct {
-	URL          string
-	Level        Level
-	ActivityType string
-	Title        string
-	Description  string
-	Link         string
-	CreatorID    int
-	CreatorName  string
-	CreatorEmail string
-	CreatedTs    int64
-	Issue        *Issue
-	Project      *Project
-	TaskResult   *TaskResult
-	Approval     *Approval
+	URL string `json:"-"`
+	// Secret is used to sign the request body if the receiver supports it.
+	Secret string `json:"-"`
+	// DeliveryID is the unique identifier of the delivery, which is the same across the attempts.
+	DeliveryID string `json:"-"`
+
+	Level        Level       `json:"level"`
+	ActivityType string      `json:"activityType"`
+	Title        string      `json:"title"`
+	Description  string      `json:"description"`
+	Link         string      `json:"link"`
+	CreatorID    int         `json:"creatorId"`
+	CreatorName  string      `json:"creatorName"`
+	CreatorEmail string      `json:"creatorEmail"`
+	CreatedTs    int64       `json:"createdTs"`
+	Issue        *Issue      `json:"issue,omitempty"`
+	Project      *Project    `json:"project,omitempty"`
+	TaskResult   *TaskResult `json:"taskResult,omitempty"`
+	Approval     *Approval   `json:"approval,omitempty"`
 }
 
 // Receiver is the webhook receiver.
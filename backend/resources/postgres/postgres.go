@@ -126,6 +126,43 @@ func Start(port int, binDir, dataDir string, serverLog bool) (err error) {
 	return nil
 }
 
+// StartForRecovery starts a postgres instance to recover the data directory extracted from a base backup.
+// It returns without waiting, because the instance only accepts connections after the recovery finishes.
+// When running as root, the directory containing dataDir is handed over to the bytebase user, since postgres refuses to run as root.
+func StartForRecovery(port int, binDir, dataDir, logFile string) error {
+	pgbin := filepath.Join(binDir, "pg_ctl")
+	p := exec.Command(pgbin, "start", "-W",
+		"-D", dataDir,
+		"-l", logFile,
+		"-o", fmt.Sprintf(`-p %d -k %s -h ""`, port, common.GetPostgresSocketDir()))
+
+	uid, gid, sameUser, err := shouldSwitchUser()
+	if err != nil {
+		return err
+	}
+	if !sameUser {
+		workDir := filepath.Dir(dataDir)
+		if err := filepath.Walk(workDir, func(path string, _ os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			return os.Lchown(path, uid, gid)
+		}); err != nil {
+			return errors.Wrapf(err, "failed to change owner of %q to bytebase", workDir)
+		}
+		p.SysProcAttr = &syscall.SysProcAttr{
+			Setpgid:    true,
+			Credential: &syscall.Credential{Uid: uint32(uid)},
+		}
+	}
+
+	p.Stderr = os.Stderr
+	if err := p.Run(); err != nil {
+		return errors.Wrapf(err, "failed to start postgres %q", p.String())
+	}
+	return nil
+}
+
 // Stop stops a postgres instance, outputs to stdout and stderr.
 func Stop(pgBinDir, pgDataDir string) error {
 	pgbin := filepath.Join(pgBinDir, "pg_ctl")
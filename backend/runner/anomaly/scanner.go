@@ -320,7 +320,7 @@ func (s *Scanner) checkDatabaseAnomaly(ctx context.Context, instance *store.Inst
 
 func (s *Scanner) checkBackupAnomaly(ctx context.Context, environment *store.EnvironmentMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, policyMap map[int]*api.BackupPlanPolicy) {
 	if disableBackupAnomalyCheck(instance.Engine) {
-		// skip checking backup anomalies for MongoDB, Spanner, Redis, Oracle, etc. because they don't support Backup.
+		// skip checking backup anomalies for Spanner, Oracle, etc. because they don't support Backup.
 		return
 	}
 
@@ -485,9 +485,7 @@ func (s *Scanner) checkBackupAnomaly(ctx context.Context, environment *store.Env
 
 func disableBackupAnomalyCheck(dbTp db.Type) bool {
 	m := map[db.Type]struct{}{
-		db.MongoDB:  {},
 		db.Spanner:  {},
-		db.Redis:    {},
 		db.Oracle:   {},
 		db.MSSQL:    {},
 		db.MariaDB:  {},
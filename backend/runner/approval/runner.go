@@ -393,7 +393,7 @@ func getDatabaseGeneralIssueRisk(ctx context.Context, s *store.Store, licenseSer
 		if seenTaskType[api.TaskDatabaseCreate] {
 			return store.RiskSourceDatabaseCreate
 		}
-		if seenTaskType[api.TaskDatabaseSchemaUpdate] || seenTaskType[api.TaskDatabaseSchemaUpdateSDL] || seenTaskType[api.TaskDatabaseSchemaUpdateGhostSync] {
+		if seenTaskType[api.TaskDatabaseSchemaUpdate] || seenTaskType[api.TaskDatabaseSchemaUpdateSDL] || seenTaskType[api.TaskDatabaseSchemaUpdateGhostSync] || seenTaskType[api.TaskDatabaseSchemaUpdatePGOnlineSync] {
 			return store.RiskSourceDatabaseSchemaUpdate
 		}
 		if seenTaskType[api.TaskDatabaseDataUpdate] {
@@ -25,20 +25,25 @@ import (
 	api "github.com/bytebase/bytebase/backend/legacyapi"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/mysql"
-	"github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/backupfile"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 )
 
 // NewRunner creates a new backup runner.
-func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, s3Client *s3.Client, stateCfg *state.State, profile *config.Profile) *Runner {
+func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, storageClient storage.Client, backupKeyWrapper backupfile.KeyWrapper, stateCfg *state.State, profile *config.Profile) *Runner {
 	return &Runner{
 		store:                     store,
 		dbFactory:                 dbFactory,
-		s3Client:                  s3Client,
+		storageClient:             storageClient,
+		backupKeyWrapper:          backupKeyWrapper,
 		stateCfg:                  stateCfg,
 		profile:                   profile,
 		downloadBinlogInstanceIDs: make(map[int]bool),
+		archiveWALInstanceIDs:     make(map[int]bool),
+		receiveWALCancels:         make(map[int]context.CancelFunc),
+		walRemovedInstanceIDs:     make(map[int]bool),
 	}
 }
 
@@ -46,13 +51,24 @@ func NewRunner(store *store.Store, dbFactory *dbfactory.DBFactory, s3Client *s3.
 type Runner struct {
 	store                     *store.Store
 	dbFactory                 *dbfactory.DBFactory
-	s3Client                  *s3.Client
+	storageClient             storage.Client
+	backupKeyWrapper          backupfile.KeyWrapper
 	stateCfg                  *state.State
 	profile                   *config.Profile
 	downloadBinlogInstanceIDs map[int]bool
 	backupWg                  sync.WaitGroup
 	downloadBinlogWg          sync.WaitGroup
 	downloadBinlogMu          sync.Mutex
+	archiveWALInstanceIDs     map[int]bool
+	receiveWALCancels         map[int]context.CancelFunc
+	walRemovedInstanceIDs     map[int]bool
+	archiveWALWg              sync.WaitGroup
+	archiveWALMu              sync.Mutex
+	// verifyingBackup is true if a round of backup verification is running.
+	verifyingBackup            bool
+	lastBackupVerificationTime time.Time
+	verifyBackupWg             sync.WaitGroup
+	verifyBackupMu             sync.Mutex
 }
 
 // Run is the runner for backup runner.
@@ -76,11 +92,15 @@ func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
 				}()
 				r.startAutoBackups(ctx)
 				r.downloadBinlogFiles(ctx)
+				r.archiveWAL(ctx)
 				r.purgeExpiredBackupData(ctx)
+				r.verifyBackups(ctx)
 			}()
 		case <-ctx.Done(): // if cancel() execute
 			r.backupWg.Wait()
 			r.downloadBinlogWg.Wait()
+			r.archiveWALWg.Wait()
+			r.verifyBackupWg.Wait()
 			return
 		}
 	}
@@ -127,24 +147,31 @@ func (r *Runner) purgeExpiredBackupData(ctx context.Context) {
 	}
 
 	for _, instance := range instanceList {
-		if instance.Engine != db.MySQL && instance.Engine != db.MariaDB {
+		if instance.Engine != db.MySQL && instance.Engine != db.MariaDB && instance.Engine != db.Postgres {
 			continue
 		}
-		maxRetentionPeriodTs, err := r.getMaxRetentionPeriodTsForMySQLInstance(ctx, instance)
+		maxRetentionPeriodTs, err := r.getMaxRetentionPeriodTsForInstance(ctx, instance)
 		if err != nil {
-			log.Error("Failed to get max retention period for MySQL instance", zap.String("instance", instance.Title), zap.Error(err))
+			log.Error("Failed to get max retention period for instance", zap.String("instance", instance.Title), zap.Error(err))
 			continue
 		}
 		if maxRetentionPeriodTs == math.MaxInt {
 			continue
 		}
+		if instance.Engine == db.Postgres {
+			archive := NewWALArchive(r.profile.DataDir, instance.UID, r.profile.BackupStorageBackend, r.storageClient, r.backupKeyWrapper)
+			if err := archive.purge(ctx, maxRetentionPeriodTs); err != nil {
+				log.Error("Failed to purge WAL archive for instance", zap.String("instance", instance.Title), zap.Int("retentionPeriodTs", maxRetentionPeriodTs), zap.Error(err))
+			}
+			continue
+		}
 		if err := r.purgeBinlogFiles(ctx, instance.UID, maxRetentionPeriodTs); err != nil {
 			log.Error("Failed to purge binlog files for instance", zap.String("instance", instance.Title), zap.Int("retentionPeriodTs", maxRetentionPeriodTs), zap.Error(err))
 		}
 	}
 }
 
-func (r *Runner) getMaxRetentionPeriodTsForMySQLInstance(ctx context.Context, instance *store.InstanceMessage) (int, error) {
+func (r *Runner) getMaxRetentionPeriodTsForInstance(ctx context.Context, instance *store.InstanceMessage) (int, error) {
 	backupSettingList, err := r.store.ListBackupSettingV2(ctx, &store.FindBackupSettingMessage{InstanceUID: &instance.UID})
 	if err != nil {
 		log.Error("Failed to find backup settings for instance.", zap.String("instance", instance.Title), zap.Error(err))
@@ -164,7 +191,7 @@ func (r *Runner) purgeBinlogFiles(ctx context.Context, instanceID, retentionPeri
 	switch r.profile.BackupStorageBackend {
 	case api.BackupStorageBackendLocal:
 		return r.purgeBinlogFilesLocal(binlogDir, retentionPeriodTs)
-	case api.BackupStorageBackendS3:
+	case api.BackupStorageBackendS3, api.BackupStorageBackendGCS, api.BackupStorageBackendOSS:
 		return r.purgeBinlogFilesOnCloud(ctx, binlogDir, retentionPeriodTs)
 	default:
 		return errors.Errorf("purge binlog files not implemented for storage backend %s", r.profile.BackupStorageBackend)
@@ -173,7 +200,7 @@ func (r *Runner) purgeBinlogFiles(ctx context.Context, instanceID, retentionPeri
 
 func (r *Runner) purgeBinlogFilesOnCloud(ctx context.Context, binlogDir string, retentionPeriodTs int) error {
 	binlogDirOnCloud := common.GetBinlogRelativeDir(binlogDir)
-	listOutput, err := r.s3Client.ListObjects(ctx, binlogDirOnCloud)
+	listOutput, err := r.storageClient.ListObjects(ctx, binlogDirOnCloud)
 	if err != nil {
 		return errors.Wrapf(err, "failed to list binlog dir %q in the cloud storage", binlogDirOnCloud)
 	}
@@ -181,12 +208,12 @@ func (r *Runner) purgeBinlogFilesOnCloud(ctx context.Context, binlogDir string,
 	for _, item := range listOutput {
 		expireTime := item.LastModified.Add(time.Duration(retentionPeriodTs) * time.Second)
 		if time.Now().After(expireTime) {
-			purgeBinlogPathList = append(purgeBinlogPathList, *item.Key)
+			purgeBinlogPathList = append(purgeBinlogPathList, item.Key)
 		}
 	}
 	if len(purgeBinlogPathList) > 0 {
 		log.Debug(fmt.Sprintf("Deleting %d expired binlog files from the cloud storage.", len(purgeBinlogPathList)))
-		if _, err := r.s3Client.DeleteObjects(ctx, purgeBinlogPathList...); err != nil {
+		if err := r.storageClient.DeleteObjects(ctx, purgeBinlogPathList...); err != nil {
 			return errors.Wrapf(err, "failed to delete %d expired binlog files from the cloud storage", len(purgeBinlogPathList))
 		}
 	}
@@ -246,9 +273,12 @@ func (r *Runner) purgeBackup(ctx context.Context, backup *store.BackupMessage) e
 			return errors.Wrapf(err, "failed to delete an expired backup file %q", backupFilePath)
 		}
 		log.Debug(fmt.Sprintf("Deleted expired local backup file %s", backupFilePath))
-	case api.BackupStorageBackendS3:
+	case api.BackupStorageBackendS3, api.BackupStorageBackendGCS, api.BackupStorageBackendOSS:
+		if backup.StorageBackend != r.profile.BackupStorageBackend {
+			return errors.Errorf("backup %q is stored in %s, but the current backup storage backend is %s", backup.Name, backup.StorageBackend, r.profile.BackupStorageBackend)
+		}
 		backupFilePath := getBackupRelativeFilePath(backup.DatabaseUID, backup.Name)
-		if _, err := r.s3Client.DeleteObjects(ctx, backupFilePath); err != nil {
+		if err := r.storageClient.DeleteObjects(ctx, backupFilePath); err != nil {
 			return errors.Wrapf(err, "failed to delete backup file %s in the cloud storage", backupFilePath)
 		}
 		log.Debug(fmt.Sprintf("Deleted expired backup file %s in the cloud storage", backupFilePath))
@@ -301,7 +331,7 @@ func (r *Runner) downloadBinlogFilesForInstance(ctx context.Context, instance *s
 		log.Error("Failed to cast driver to mysql.Driver", zap.String("instance", instance.ResourceID))
 		return
 	}
-	if err := mysqlDriver.FetchAllBinlogFiles(ctx, false /* downloadLatestBinlogFile */, r.s3Client); err != nil {
+	if err := mysqlDriver.FetchAllBinlogFiles(ctx, false /* downloadLatestBinlogFile */, r.storageClient); err != nil {
 		log.Error("Failed to download all binlog files for instance", zap.String("instance", instance.ResourceID), zap.Error(err))
 		return
 	}
@@ -342,8 +372,8 @@ func (r *Runner) startAutoBackups(ctx context.Context) {
 		if instance.Deleted {
 			continue
 		}
-		// backup for ClickHouse, Snowflake, MongoDB, Spanner, Redis, Oracle is not supported.
-		if instance.Engine == db.ClickHouse || instance.Engine == db.Snowflake || instance.Engine == db.MongoDB || instance.Engine == db.Spanner || instance.Engine == db.Redis || instance.Engine == db.Oracle {
+		// backup for ClickHouse, Snowflake, Spanner, Oracle, MSSQL is not supported.
+		if instance.Engine == db.ClickHouse || instance.Engine == db.Snowflake || instance.Engine == db.Spanner || instance.Engine == db.Oracle || instance.Engine == db.MSSQL {
 			continue
 		}
 		environment, err := r.store.GetEnvironmentV2(ctx, &store.FindEnvironmentMessage{ResourceID: &database.EffectiveEnvironmentID})
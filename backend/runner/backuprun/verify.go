@@ -0,0 +1,466 @@
+package backuprun
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/backupfile"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+const (
+	defaultBackupVerificationSampleSize = 3
+	defaultBackupVerificationInterval   = 24 * time.Hour
+	// Only the backups taken within the period are sampled for verification.
+	backupVerificationRecentPeriod = 7 * 24 * time.Hour
+	// The scratch database restored from a backup is named by the prefix and the backup ID.
+	backupVerificationDatabasePrefix = "bbverify_"
+	// The INSERT statements longer than the limit are not counted, the table name always fits in it.
+	maxInsertStatementPrefixSize = 1024
+)
+
+var (
+	insertStatementPrefix = []byte("INSERT INTO ")
+	insertValuesKeyword   = []byte(" VALUES (")
+)
+
+// IsBackupVerificationSupported returns true if the backups of the engine can be verified by restoring them into a scratch database.
+func IsBackupVerificationSupported(engine db.Type) bool {
+	switch engine {
+	case db.MySQL, db.MariaDB, db.TiDB, db.Postgres:
+		return true
+	default:
+		return false
+	}
+}
+
+// BackupStatsWriter computes the checksum and the table row counts of a backup file while it is written.
+// The row counts are collected from the single row INSERT statements produced by the MySQL and PostgreSQL dumps,
+// so that the verification is able to compare them with the restored tables.
+type BackupStatsWriter struct {
+	hash hash.Hash
+	size int64
+	// line is the prefix of the current line.
+	line           []byte
+	tableRowCounts map[string]int64
+}
+
+// NewBackupStatsWriter creates a new backup stats writer.
+func NewBackupStatsWriter() *BackupStatsWriter {
+	return &BackupStatsWriter{
+		hash:           sha256.New(),
+		tableRowCounts: make(map[string]int64),
+	}
+}
+
+// Write implements the io.Writer interface.
+func (w *BackupStatsWriter) Write(p []byte) (int, error) {
+	if _, err := w.hash.Write(p); err != nil {
+		return 0, err
+	}
+	w.size += int64(len(p))
+	for data := p; len(data) > 0; {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			w.appendLine(data)
+			break
+		}
+		w.appendLine(data[:i])
+		w.countLine()
+		data = data[i+1:]
+	}
+	return len(p), nil
+}
+
+func (w *BackupStatsWriter) appendLine(data []byte) {
+	if room := maxInsertStatementPrefixSize - len(w.line); room > 0 {
+		if len(data) > room {
+			data = data[:room]
+		}
+		w.line = append(w.line, data...)
+	}
+}
+
+func (w *BackupStatsWriter) countLine() {
+	defer func() {
+		w.line = w.line[:0]
+	}()
+	if !bytes.HasPrefix(w.line, insertStatementPrefix) {
+		return
+	}
+	rest := w.line[len(insertStatementPrefix):]
+	i := bytes.Index(rest, insertValuesKeyword)
+	if i <= 0 {
+		return
+	}
+	w.tableRowCounts[string(rest[:i])]++
+}
+
+// Checksum returns the hex encoded SHA-256 checksum of the written content.
+func (w *BackupStatsWriter) Checksum() string {
+	return hex.EncodeToString(w.hash.Sum(nil))
+}
+
+// Size returns the size of the written content.
+func (w *BackupStatsWriter) Size() int64 {
+	return w.size
+}
+
+// TableRowCounts returns the number of rows of each table in the written content.
+func (w *BackupStatsWriter) TableRowCounts() map[string]int64 {
+	// The last line may not end with a newline.
+	w.countLine()
+	return w.tableRowCounts
+}
+
+type backupVerificationCandidate struct {
+	backup   *store.BackupMessage
+	database *store.DatabaseMessage
+}
+
+// verifyBackups starts a round of backup verification on the designated instance if it is due.
+func (r *Runner) verifyBackups(ctx context.Context) {
+	setting, err := r.store.GetBackupVerificationSetting(ctx)
+	if err != nil {
+		log.Error("Failed to get backup verification setting", zap.Error(err))
+		return
+	}
+	if setting.Instance == "" {
+		return
+	}
+	interval := defaultBackupVerificationInterval
+	if setting.Interval != nil && setting.Interval.AsDuration() > 0 {
+		interval = setting.Interval.AsDuration()
+	}
+	sampleSize := defaultBackupVerificationSampleSize
+	if setting.SampleSize > 0 {
+		sampleSize = int(setting.SampleSize)
+	}
+
+	r.verifyBackupMu.Lock()
+	defer r.verifyBackupMu.Unlock()
+	if r.verifyingBackup || time.Since(r.lastBackupVerificationTime) < interval {
+		return
+	}
+	instanceID, err := common.GetInstanceID(setting.Instance)
+	if err != nil {
+		log.Error("Invalid backup verification instance", zap.String("instance", setting.Instance), zap.Error(err))
+		return
+	}
+	instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &instanceID})
+	if err != nil {
+		log.Error("Failed to get backup verification instance", zap.String("instance", instanceID), zap.Error(err))
+		return
+	}
+	if instance == nil || instance.Deleted {
+		log.Warn("Backup verification instance not found", zap.String("instance", instanceID))
+		return
+	}
+	if !IsBackupVerificationSupported(instance.Engine) {
+		log.Warn("Backup verification is not supported for the instance engine", zap.String("instance", instanceID), zap.String("engine", string(instance.Engine)))
+		return
+	}
+
+	r.verifyingBackup = true
+	r.lastBackupVerificationTime = time.Now()
+	r.verifyBackupWg.Add(1)
+	go func() {
+		defer func() {
+			r.verifyBackupMu.Lock()
+			r.verifyingBackup = false
+			r.verifyBackupMu.Unlock()
+			r.verifyBackupWg.Done()
+		}()
+		r.verifyBackupsOnInstance(ctx, instance, sampleSize)
+	}()
+}
+
+func (r *Runner) verifyBackupsOnInstance(ctx context.Context, instance *store.InstanceMessage, sampleSize int) {
+	candidates, err := r.listBackupVerificationCandidates(ctx, instance.Engine)
+	if err != nil {
+		log.Error("Failed to list backups for verification", zap.Error(err))
+		return
+	}
+	if len(candidates) == 0 {
+		return
+	}
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	if len(candidates) > sampleSize {
+		candidates = candidates[:sampleSize]
+	}
+
+	adminDriver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
+	if err != nil {
+		log.Error("Failed to get admin driver for backup verification instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+		return
+	}
+	defer adminDriver.Close(ctx)
+
+	for _, candidate := range candidates {
+		verification, err := r.verifyBackup(ctx, adminDriver, instance, candidate.backup)
+		if err != nil {
+			// The error is caused by the verification instance rather than the backup, so we don't record it.
+			log.Error("Failed to verify backup", zap.String("database", candidate.database.DatabaseName), zap.String("backup", candidate.backup.Name), zap.Error(err))
+			continue
+		}
+		r.recordBackupVerification(ctx, candidate.database, candidate.backup, verification)
+	}
+}
+
+// listBackupVerificationCandidates returns the latest recent backup of each database which has not been verified yet.
+func (r *Runner) listBackupVerificationCandidates(ctx context.Context, engine db.Type) ([]*backupVerificationCandidate, error) {
+	statusDone := api.BackupStatusDone
+	rowStatusNormal := api.Normal
+	backupList, err := r.store.ListBackupV2(ctx, &store.FindBackupMessage{
+		Status:    &statusDone,
+		RowStatus: &rowStatusNormal,
+	})
+	if err != nil {
+		return nil, err
+	}
+	latestBackups := make(map[int]*store.BackupMessage)
+	for _, backup := range backupList {
+		if backup.CreatedTs < time.Now().Add(-backupVerificationRecentPeriod).Unix() {
+			continue
+		}
+		if latest, ok := latestBackups[backup.DatabaseUID]; !ok || backup.CreatedTs > latest.CreatedTs {
+			latestBackups[backup.DatabaseUID] = backup
+		}
+	}
+
+	var candidates []*backupVerificationCandidate
+	for databaseUID, backup := range latestBackups {
+		if backup.Payload.Verification != nil {
+			continue
+		}
+		databaseUID := databaseUID
+		database, err := r.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: &databaseUID})
+		if err != nil {
+			return nil, err
+		}
+		if database == nil {
+			continue
+		}
+		instance, err := r.store.GetInstanceV2(ctx, &store.FindInstanceMessage{ResourceID: &database.InstanceID})
+		if err != nil {
+			return nil, err
+		}
+		if instance == nil || instance.Deleted || instance.Engine != engine {
+			continue
+		}
+		candidates = append(candidates, &backupVerificationCandidate{backup: backup, database: database})
+	}
+	return candidates, nil
+}
+
+// verifyBackup restores the backup into a scratch database on the instance and checks the restored data.
+// It returns an error only if the verification cannot be carried out on the instance.
+func (r *Runner) verifyBackup(ctx context.Context, adminDriver db.Driver, instance *store.InstanceMessage, backup *store.BackupMessage) (*api.BackupVerification, error) {
+	verification := &api.BackupVerification{
+		VerifiedTs: time.Now().Unix(),
+		InstanceID: instance.ResourceID,
+	}
+	failed := func(format string, args ...any) (*api.BackupVerification, error) {
+		verification.Detail = fmt.Sprintf(format, args...)
+		return verification, nil
+	}
+
+	backupFilePath := filepath.Join(r.profile.DataDir, backup.Path)
+	if backup.StorageBackend != api.BackupStorageBackendLocal {
+		if r.storageClient == nil {
+			return nil, errors.Errorf("cloud storage is not configured, cannot download backup file %q", backup.Path)
+		}
+		tmpDir, err := os.MkdirTemp("", "bb-backup-verify")
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create temporary directory")
+		}
+		defer os.RemoveAll(tmpDir)
+		backupFilePath = filepath.Join(tmpDir, filepath.Base(backup.Path))
+		if err := storage.DownloadFileFromCloud(ctx, r.storageClient, backupFilePath, backup.Path); err != nil {
+			return failed("Failed to download backup file %q from %s: %v", backup.Path, backup.StorageBackend, err)
+		}
+	}
+
+	checksum, err := getBackupContentChecksum(ctx, backupFilePath, r.backupKeyWrapper)
+	if err != nil {
+		return failed("Failed to read backup file: %v", err)
+	}
+	if backup.Payload.Checksum != "" && checksum != backup.Payload.Checksum {
+		return failed("Backup file checksum %s does not match the checksum %s recorded when taking the backup", checksum, backup.Payload.Checksum)
+	}
+
+	scratchDatabaseName := fmt.Sprintf("%s%d", backupVerificationDatabasePrefix, backup.UID)
+	if err := createScratchDatabase(ctx, adminDriver, instance.Engine, scratchDatabaseName); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := dropScratchDatabase(ctx, adminDriver, instance.Engine, scratchDatabaseName); err != nil {
+			log.Warn("Failed to drop the backup verification database", zap.String("instance", instance.ResourceID), zap.String("database", scratchDatabaseName), zap.Error(err))
+		}
+	}()
+
+	adminDataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
+	if adminDataSource == nil {
+		return nil, common.Errorf(common.Internal, "admin data source not found for instance %q", instance.Title)
+	}
+	scratchDriver, err := r.dbFactory.GetDataSourceDriver(ctx, instance.Engine, adminDataSource, scratchDatabaseName, instance.ResourceID, instance.UID, false /* datashare */, false /* readOnly */, false /* schemaTenantMode */)
+	if err != nil {
+		return nil, err
+	}
+	defer scratchDriver.Close(ctx)
+
+	backupFile, err := os.Open(backupFilePath)
+	if err != nil {
+		return failed("Failed to open backup file: %v", err)
+	}
+	defer backupFile.Close()
+	backupContent, err := backupfile.NewReader(ctx, backupFile, r.backupKeyWrapper)
+	if err != nil {
+		return failed("Failed to read backup file: %v", err)
+	}
+	defer backupContent.Close()
+	if err := scratchDriver.Restore(ctx, backupContent); err != nil {
+		return failed("Failed to restore backup: %v", err)
+	}
+
+	var tables []string
+	for table := range backup.Payload.TableRowCounts {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		var count int64
+		if err := scratchDriver.GetDB().QueryRowContext(ctx, fmt.Sprintf("SELECT COUNT(*) FROM %s;", table)).Scan(&count); err != nil {
+			return failed("Failed to count rows of table %s in the restored database: %v", table, err)
+		}
+		if expected := backup.Payload.TableRowCounts[table]; count != expected {
+			return failed("Table %s has %d rows in the restored database, but %d rows were recorded when taking the backup", table, count, expected)
+		}
+	}
+
+	verification.Passed = true
+	verification.Detail = fmt.Sprintf("Restored the backup and checked the row counts of %d tables", len(tables))
+	return verification, nil
+}
+
+// recordBackupVerification saves the verification result to the backup, and raises or resolves the anomaly of the database.
+func (r *Runner) recordBackupVerification(ctx context.Context, database *store.DatabaseMessage, backup *store.BackupMessage, verification *api.BackupVerification) {
+	backupPayload := backup.Payload
+	backupPayload.Verification = verification
+	payloadBytes, err := json.Marshal(backupPayload)
+	if err != nil {
+		log.Error("Failed to marshal backup payload", zap.String("backup", backup.Name), zap.Error(err))
+		return
+	}
+	payload := string(payloadBytes)
+	if _, err := r.store.UpdateBackupV2(ctx, &store.UpdateBackupMessage{
+		UID:       backup.UID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payload,
+	}); err != nil {
+		log.Error("Failed to save backup verification result", zap.String("backup", backup.Name), zap.Error(err))
+	}
+
+	if verification.Passed {
+		if err := r.store.ArchiveAnomalyV2(ctx, &store.ArchiveAnomalyMessage{
+			DatabaseUID: &database.UID,
+			Type:        api.AnomalyDatabaseBackupVerificationFailed,
+		}); err != nil && common.ErrorCode(err) != common.NotFound {
+			log.Error("Failed to close anomaly",
+				zap.String("instance", database.InstanceID),
+				zap.String("database", database.DatabaseName),
+				zap.String("type", string(api.AnomalyDatabaseBackupVerificationFailed)),
+				zap.Error(err))
+		}
+		return
+	}
+
+	anomalyPayload, err := json.Marshal(api.AnomalyDatabaseBackupVerificationFailedPayload{
+		BackupName: backup.Name,
+		VerifiedTs: verification.VerifiedTs,
+		Detail:     verification.Detail,
+	})
+	if err != nil {
+		log.Error("Failed to marshal anomaly payload", zap.String("backup", backup.Name), zap.Error(err))
+		return
+	}
+	if _, err := r.store.UpsertActiveAnomalyV2(ctx, api.SystemBotID, &store.AnomalyMessage{
+		InstanceID:  database.InstanceID,
+		DatabaseUID: &database.UID,
+		Type:        api.AnomalyDatabaseBackupVerificationFailed,
+		Payload:     string(anomalyPayload),
+	}); err != nil {
+		log.Error("Failed to create anomaly",
+			zap.String("instance", database.InstanceID),
+			zap.String("database", database.DatabaseName),
+			zap.String("type", string(api.AnomalyDatabaseBackupVerificationFailed)),
+			zap.Error(err))
+	}
+}
+
+// getBackupContentChecksum returns the checksum of the backup content, decompressing and decrypting the backup file if needed.
+func getBackupContentChecksum(ctx context.Context, path string, keyWrapper backupfile.KeyWrapper) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	content, err := backupfile.NewReader(ctx, f, keyWrapper)
+	if err != nil {
+		return "", err
+	}
+	defer content.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, content); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func createScratchDatabase(ctx context.Context, driver db.Driver, engine db.Type, databaseName string) error {
+	// Clean up the database left by an interrupted verification.
+	if err := dropScratchDatabase(ctx, driver, engine, databaseName); err != nil {
+		return err
+	}
+	stmt := fmt.Sprintf("CREATE DATABASE `%s`;", databaseName)
+	if engine == db.Postgres {
+		stmt = fmt.Sprintf(`CREATE DATABASE "%s";`, databaseName)
+	}
+	if _, err := driver.GetDB().ExecContext(ctx, stmt); err != nil {
+		return errors.Wrapf(err, "failed to create database %q", databaseName)
+	}
+	return nil
+}
+
+func dropScratchDatabase(ctx context.Context, driver db.Driver, engine db.Type, databaseName string) error {
+	stmt := fmt.Sprintf("DROP DATABASE IF EXISTS `%s`;", databaseName)
+	if engine == db.Postgres {
+		stmt = fmt.Sprintf(`DROP DATABASE IF EXISTS "%s";`, databaseName)
+	}
+	if _, err := driver.GetDB().ExecContext(ctx, stmt); err != nil {
+		return errors.Wrapf(err, "failed to drop database %q", databaseName)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package backuprun
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupStatsWriter(t *testing.T) {
+	a := require.New(t)
+	dump := strings.Join([]string{
+		"CREATE TABLE `t1` (`id` int);",
+		"INSERT INTO `t1` VALUES (1);",
+		"INSERT INTO `t1` VALUES (2);",
+		"INSERT INTO public.t2 VALUES (1, 'INSERT INTO `t1` VALUES (3);');",
+		"  INSERT INTO `t1` VALUES (4);",
+		"INSERT INTO public.\"T3\" VALUES ('" + strings.Repeat("x", 2*maxInsertStatementPrefixSize) + "');",
+		"INSERT INTO `t1` VALUES (5);",
+	}, "\n")
+
+	w := NewBackupStatsWriter()
+	// Write the dump in small chunks to split the lines across writes.
+	for i := 0; i < len(dump); i += 7 {
+		end := i + 7
+		if end > len(dump) {
+			end = len(dump)
+		}
+		n, err := w.Write([]byte(dump[i:end]))
+		a.NoError(err)
+		a.Equal(end-i, n)
+	}
+
+	sum := sha256.Sum256([]byte(dump))
+	a.Equal(hex.EncodeToString(sum[:]), w.Checksum())
+	a.Equal(int64(len(dump)), w.Size())
+	a.Equal(map[string]int64{
+		"`t1`":          3,
+		"public.t2":     1,
+		"public.\"T3\"": 1,
+	}, w.TableRowCounts())
+}
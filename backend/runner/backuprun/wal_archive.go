@@ -0,0 +1,693 @@
+package backuprun
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/backupfile"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	// baseBackupInterval is the interval to take a base backup of the PostgreSQL instance for PITR.
+	// A newer base backup replays less WAL to recover.
+	baseBackupInterval = 24 * time.Hour
+	// walArchivedTimeout is the timeout to wait for the WAL segment to be archived.
+	walArchivedTimeout = time.Minute
+
+	receivingWALDirName    = "receiving"
+	archivedWALDirName     = "wal"
+	baseBackupDirName      = "base"
+	baseBackupMetaFileName = "meta.json"
+)
+
+// BaseBackup is a base backup of a PostgreSQL instance for PITR.
+type BaseBackup struct {
+	Name string
+	Meta *pg.BaseBackupMeta
+}
+
+// WALArchive is the archive of the base backups and the WAL segments of a PostgreSQL instance for PITR.
+// The WAL segments are received in the local receiving directory first, and then archived to the storage backend.
+//
+// The layout of the archive in the local directory or the cloud storage is:
+//
+//	backup/instance/{instance}/wal/{WAL segment file}
+//	backup/instance/{instance}/base/{base backup}/base.tar.gz
+//	backup/instance/{instance}/base/{base backup}/pg_wal.tar.gz
+//	backup/instance/{instance}/base/{base backup}/meta.json
+//
+// The cloud storage backend also keeps meta.json of the base backups locally to list them.
+// The base backups and the WAL segments in the cloud storage are encoded in the backup file format,
+// so they are encrypted if the backup encryption key is configured.
+type WALArchive struct {
+	dir            string
+	storageBackend api.BackupStorageBackend
+	storageClient  storage.Client
+	keyWrapper     backupfile.KeyWrapper
+}
+
+// NewWALArchive creates the WAL archive of the PostgreSQL instance.
+// The keyWrapper is only required to upload or download the base backups and the WAL segments.
+func NewWALArchive(dataDir string, instanceUID int, storageBackend api.BackupStorageBackend, storageClient storage.Client, keyWrapper backupfile.KeyWrapper) *WALArchive {
+	return &WALArchive{
+		dir:            common.GetBinlogAbsDir(dataDir, instanceUID),
+		storageBackend: storageBackend,
+		storageClient:  storageClient,
+		keyWrapper:     keyWrapper,
+	}
+}
+
+func (a *WALArchive) isLocal() bool {
+	return a.storageBackend == api.BackupStorageBackendLocal
+}
+
+func (a *WALArchive) receivingDir() string {
+	return filepath.Join(a.dir, receivingWALDirName)
+}
+
+func (a *WALArchive) cloudPath(elem ...string) string {
+	// Use path.Join to compose a path on cloud which always uses / as the separator.
+	return path.Join(append([]string{common.GetBinlogRelativeDir(a.dir)}, elem...)...)
+}
+
+// ListBaseBackups lists the base backups in the order of the stop time.
+func (a *WALArchive) ListBaseBackups(ctx context.Context) ([]*BaseBackup, error) {
+	baseBackupDir := filepath.Join(a.dir, baseBackupDirName)
+	if !a.isLocal() {
+		if err := a.syncBaseBackupMetaFromCloud(ctx); err != nil {
+			return nil, err
+		}
+	}
+	entries, err := os.ReadDir(baseBackupDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read base backup directory %q", baseBackupDir)
+	}
+	var baseBackups []*BaseBackup
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		metaBytes, err := os.ReadFile(filepath.Join(baseBackupDir, entry.Name(), baseBackupMetaFileName))
+		if err != nil {
+			// The base backup without metadata is not finished.
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, errors.Wrapf(err, "failed to read the metadata of base backup %q", entry.Name())
+		}
+		meta := &pg.BaseBackupMeta{}
+		if err := json.Unmarshal(metaBytes, meta); err != nil {
+			return nil, errors.Wrapf(err, "failed to unmarshal the metadata of base backup %q", entry.Name())
+		}
+		baseBackups = append(baseBackups, &BaseBackup{Name: entry.Name(), Meta: meta})
+	}
+	sort.Slice(baseBackups, func(i, j int) bool {
+		return baseBackups[i].Meta.StopTs < baseBackups[j].Meta.StopTs
+	})
+	return baseBackups, nil
+}
+
+func (a *WALArchive) syncBaseBackupMetaFromCloud(ctx context.Context) error {
+	if a.storageClient == nil {
+		return errors.Errorf("cloud storage is not configured")
+	}
+	objects, err := a.storageClient.ListObjects(ctx, a.cloudPath(baseBackupDirName))
+	if err != nil {
+		return errors.Wrap(err, "failed to list base backups in the cloud storage")
+	}
+	for _, object := range objects {
+		if path.Base(object.Key) != baseBackupMetaFileName {
+			continue
+		}
+		name := path.Base(path.Dir(object.Key))
+		metaPathLocal := filepath.Join(a.dir, baseBackupDirName, name, baseBackupMetaFileName)
+		if _, err := os.Stat(metaPathLocal); err == nil {
+			continue
+		}
+		if err := os.MkdirAll(filepath.Dir(metaPathLocal), os.ModePerm); err != nil {
+			return errors.Wrapf(err, "failed to create base backup directory for %q", name)
+		}
+		if err := storage.DownloadFileFromCloud(ctx, a.storageClient, metaPathLocal, object.Key); err != nil {
+			return errors.Wrapf(err, "failed to download the metadata of base backup %q", name)
+		}
+	}
+	return nil
+}
+
+// FetchBaseBackup makes the base backup available locally, and returns the local directory of it.
+// The base backup in the cloud storage is downloaded to dir.
+func (a *WALArchive) FetchBaseBackup(ctx context.Context, name, dir string) (string, error) {
+	if a.isLocal() {
+		return filepath.Join(a.dir, baseBackupDirName, name), nil
+	}
+	if a.storageClient == nil {
+		return "", errors.Errorf("cloud storage is not configured, cannot download base backup %q", name)
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", errors.Wrapf(err, "failed to create directory %q", dir)
+	}
+	objects, err := a.storageClient.ListObjects(ctx, a.cloudPath(baseBackupDirName, name)+"/")
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to list base backup %q in the cloud storage", name)
+	}
+	for _, object := range objects {
+		if err := a.downloadFile(ctx, filepath.Join(dir, path.Base(object.Key)), object.Key); err != nil {
+			return "", errors.Wrapf(err, "failed to download base backup %q", name)
+		}
+	}
+	return dir, nil
+}
+
+// FetchWALFiles copies or downloads the archived WAL segments since startWALFile and the timeline history files to dir.
+// The WAL segments received but not archived yet are included.
+func (a *WALArchive) FetchWALFiles(ctx context.Context, startWALFile, dir string) error {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return errors.Wrapf(err, "failed to create directory %q", dir)
+	}
+	needed := func(name string) bool {
+		return pg.IsTimelineHistoryFileName(name) || (pg.IsWALFileName(name) && name >= startWALFile)
+	}
+	fetched := make(map[string]bool)
+	for _, localDir := range []string{a.receivingDir(), filepath.Join(a.dir, archivedWALDirName)} {
+		names, err := listWALFiles(localDir)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if !needed(name) || fetched[name] {
+				continue
+			}
+			if err := copyFile(filepath.Join(localDir, name), filepath.Join(dir, name)); err != nil {
+				return err
+			}
+			fetched[name] = true
+		}
+	}
+	if a.isLocal() {
+		return nil
+	}
+	if a.storageClient == nil {
+		return errors.Errorf("cloud storage is not configured, cannot download the WAL")
+	}
+	objects, err := a.storageClient.ListObjects(ctx, a.cloudPath(archivedWALDirName)+"/")
+	if err != nil {
+		return errors.Wrap(err, "failed to list the WAL in the cloud storage")
+	}
+	for _, object := range objects {
+		name := path.Base(object.Key)
+		if !needed(name) || fetched[name] {
+			continue
+		}
+		if err := a.downloadFile(ctx, filepath.Join(dir, name), object.Key); err != nil {
+			return errors.Wrapf(err, "failed to download WAL file %q", name)
+		}
+		fetched[name] = true
+	}
+	return nil
+}
+
+// WaitWALArchived waits until the WAL segment is received completely.
+func (a *WALArchive) WaitWALArchived(ctx context.Context, walFile string) error {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	timeout := time.After(walArchivedTimeout)
+	for {
+		names, err := listWALFiles(a.receivingDir())
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if pg.IsWALFileName(name) && name >= walFile {
+				return nil
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			return errors.Errorf("WAL file %q is not archived in %s, please make sure that the WAL archiving works", walFile, walArchivedTimeout)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// archiveReceivedWALFiles archives the WAL files received completely.
+// The latest WAL segment is kept in the receiving directory, from which pg_receivewal resumes streaming.
+func (a *WALArchive) archiveReceivedWALFiles(ctx context.Context) error {
+	names, err := listWALFiles(a.receivingDir())
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	archived := make(map[string]bool)
+	if a.isLocal() {
+		archivedNames, err := listWALFiles(filepath.Join(a.dir, archivedWALDirName))
+		if err != nil {
+			return err
+		}
+		for _, name := range archivedNames {
+			archived[name] = true
+		}
+	} else {
+		if a.storageClient == nil {
+			return errors.Errorf("cloud storage is not configured, cannot upload the WAL")
+		}
+		objects, err := a.storageClient.ListObjects(ctx, a.cloudPath(archivedWALDirName)+"/")
+		if err != nil {
+			return errors.Wrap(err, "failed to list the WAL in the cloud storage")
+		}
+		for _, object := range objects {
+			archived[path.Base(object.Key)] = true
+		}
+	}
+
+	latestWALFile := ""
+	for _, name := range names {
+		if pg.IsWALFileName(name) && name > latestWALFile {
+			latestWALFile = name
+		}
+	}
+	for _, name := range names {
+		filePath := filepath.Join(a.receivingDir(), name)
+		if !archived[name] {
+			if err := a.archiveFile(ctx, filePath, name); err != nil {
+				return err
+			}
+		}
+		if name == latestWALFile {
+			continue
+		}
+		if err := os.Remove(filePath); err != nil {
+			log.Warn("Failed to remove the archived WAL file", zap.String("path", filePath), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (a *WALArchive) archiveFile(ctx context.Context, filePath, name string) error {
+	if a.isLocal() {
+		archivedDir := filepath.Join(a.dir, archivedWALDirName)
+		if err := os.MkdirAll(archivedDir, os.ModePerm); err != nil {
+			return errors.Wrapf(err, "failed to create WAL archive directory %q", archivedDir)
+		}
+		return copyFile(filePath, filepath.Join(archivedDir, name))
+	}
+	if err := a.uploadFile(ctx, filePath, a.cloudPath(archivedWALDirName, name), true /* compress */); err != nil {
+		return errors.Wrapf(err, "failed to upload WAL file %q to the cloud storage", name)
+	}
+	return nil
+}
+
+// uploadFile encodes the file in the backup file format, and uploads it to the cloud storage as the object with path.
+func (a *WALArchive) uploadFile(ctx context.Context, filePath, objectPath string, compress bool) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %q", filePath)
+	}
+	defer f.Close()
+
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		pw.CloseWithError(encodeFile(ctx, pw, f, backupfile.Options{Compress: compress, KeyWrapper: a.keyWrapper}))
+	}()
+	err = a.storageClient.UploadObject(ctx, objectPath, pr)
+	// Unblock the encoding if the upload stops reading early.
+	_ = pr.CloseWithError(err)
+	<-done
+	return err
+}
+
+// downloadFile downloads the object with path from the cloud storage, and decodes it to filePathLocal.
+// The objects archived before the backup file format was used are plaintext, and are downloaded as is.
+func (a *WALArchive) downloadFile(ctx context.Context, filePathLocal, objectPath string) error {
+	encodedFilePath := filePathLocal + ".encoded"
+	if err := storage.DownloadFileFromCloud(ctx, a.storageClient, encodedFilePath, objectPath); err != nil {
+		return err
+	}
+	defer os.Remove(encodedFilePath)
+	f, err := os.Open(encodedFilePath)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %q", encodedFilePath)
+	}
+	defer f.Close()
+	content, err := backupfile.NewReader(ctx, f, a.keyWrapper)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+	return writeFile(content, filePathLocal)
+}
+
+func encodeFile(ctx context.Context, w io.Writer, r io.Reader, opts backupfile.Options) error {
+	encoder, err := backupfile.NewWriter(ctx, w, opts)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(encoder, r); err != nil {
+		return errors.Wrap(err, "failed to encode the file")
+	}
+	return encoder.Close()
+}
+
+// saveBaseBackup saves the base backup taken in the local base backup directory.
+// The metadata file is written at last to mark the base backup finished.
+func (a *WALArchive) saveBaseBackup(ctx context.Context, name string, meta *pg.BaseBackupMeta) error {
+	dir := filepath.Join(a.dir, baseBackupDirName, name)
+	metaBytes, err := json.Marshal(meta)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal the base backup metadata")
+	}
+	if a.isLocal() {
+		return os.WriteFile(filepath.Join(dir, baseBackupMetaFileName), metaBytes, 0600)
+	}
+
+	if a.storageClient == nil {
+		return errors.Errorf("cloud storage is not configured, cannot upload base backup %q", name)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read base backup directory %q", dir)
+	}
+	for _, entry := range entries {
+		// The base backup files are compressed by pg_basebackup already.
+		if err := a.uploadFile(ctx, filepath.Join(dir, entry.Name()), a.cloudPath(baseBackupDirName, name, entry.Name()), false /* compress */); err != nil {
+			return errors.Wrapf(err, "failed to upload %q of base backup %q to the cloud storage", entry.Name(), name)
+		}
+		if err := os.Remove(filepath.Join(dir, entry.Name())); err != nil {
+			return errors.Wrapf(err, "failed to remove %q of base backup %q", entry.Name(), name)
+		}
+	}
+	if err := a.storageClient.UploadObject(ctx, a.cloudPath(baseBackupDirName, name, baseBackupMetaFileName), strings.NewReader(string(metaBytes))); err != nil {
+		return errors.Wrapf(err, "failed to upload the metadata of base backup %q to the cloud storage", name)
+	}
+	return os.WriteFile(filepath.Join(dir, baseBackupMetaFileName), metaBytes, 0600)
+}
+
+// purge deletes the base backups and the WAL segments expired.
+// The latest base backup and the WAL segments it needs are always kept.
+func (a *WALArchive) purge(ctx context.Context, retentionPeriodTs int) error {
+	baseBackups, err := a.ListBaseBackups(ctx)
+	if err != nil {
+		return err
+	}
+	if len(baseBackups) == 0 {
+		return nil
+	}
+	expireTs := time.Now().Add(-time.Duration(retentionPeriodTs) * time.Second).Unix()
+	keepIndex := len(baseBackups) - 1
+	for i, baseBackup := range baseBackups {
+		if baseBackup.Meta.StopTs > expireTs {
+			keepIndex = i
+			break
+		}
+	}
+	for _, baseBackup := range baseBackups[:keepIndex] {
+		if !a.isLocal() {
+			objects, err := a.storageClient.ListObjects(ctx, a.cloudPath(baseBackupDirName, baseBackup.Name)+"/")
+			if err != nil {
+				return errors.Wrapf(err, "failed to list base backup %q in the cloud storage", baseBackup.Name)
+			}
+			var keys []string
+			for _, object := range objects {
+				keys = append(keys, object.Key)
+			}
+			if len(keys) > 0 {
+				if err := a.storageClient.DeleteObjects(ctx, keys...); err != nil {
+					return errors.Wrapf(err, "failed to delete base backup %q in the cloud storage", baseBackup.Name)
+				}
+			}
+		}
+		if err := os.RemoveAll(filepath.Join(a.dir, baseBackupDirName, baseBackup.Name)); err != nil {
+			return errors.Wrapf(err, "failed to delete base backup %q", baseBackup.Name)
+		}
+		log.Info("Deleted expired base backup.", zap.String("path", a.dir), zap.String("baseBackup", baseBackup.Name))
+	}
+
+	startWALFile := baseBackups[keepIndex].Meta.StartWALFile
+	if a.isLocal() {
+		archivedDir := filepath.Join(a.dir, archivedWALDirName)
+		names, err := listWALFiles(archivedDir)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if pg.IsWALFileName(name) && name < startWALFile {
+				if err := os.Remove(filepath.Join(archivedDir, name)); err != nil {
+					return errors.Wrapf(err, "failed to delete expired WAL file %q", name)
+				}
+			}
+		}
+		return nil
+	}
+	objects, err := a.storageClient.ListObjects(ctx, a.cloudPath(archivedWALDirName)+"/")
+	if err != nil {
+		return errors.Wrap(err, "failed to list the WAL in the cloud storage")
+	}
+	var keys []string
+	for _, object := range objects {
+		if name := path.Base(object.Key); pg.IsWALFileName(name) && name < startWALFile {
+			keys = append(keys, object.Key)
+		}
+	}
+	if len(keys) > 0 {
+		log.Debug("Deleting expired WAL files from the cloud storage.", zap.Int("count", len(keys)))
+		if err := a.storageClient.DeleteObjects(ctx, keys...); err != nil {
+			return errors.Wrapf(err, "failed to delete %d expired WAL files from the cloud storage", len(keys))
+		}
+	}
+	return nil
+}
+
+// listWALFiles lists the names of the WAL segment files and the timeline history files in dir.
+// The WAL segment being received with the .partial suffix is excluded.
+func listWALFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read WAL directory %q", dir)
+	}
+	var names []string
+	for _, entry := range entries {
+		if name := entry.Name(); pg.IsWALFileName(name) || pg.IsTimelineHistoryFileName(name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// copyFile copies the file to a temporary file first, and then renames it to the target file path.
+func copyFile(src, dst string) error {
+	srcFile, err := os.Open(src)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open %q", src)
+	}
+	defer srcFile.Close()
+	return writeFile(srcFile, dst)
+}
+
+// writeFile writes the content to a temporary file first, and then renames it to the target file path.
+func writeFile(r io.Reader, dst string) error {
+	dstTemp := dst + ".tmp"
+	dstFile, err := os.Create(dstTemp)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %q", dstTemp)
+	}
+	defer dstFile.Close()
+	if _, err := io.Copy(dstFile, r); err != nil {
+		return errors.Wrapf(err, "failed to write %q", dstTemp)
+	}
+	if err := dstFile.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close %q", dstTemp)
+	}
+	if err := os.Rename(dstTemp, dst); err != nil {
+		return errors.Wrapf(err, "failed to rename %q to %q", dstTemp, dst)
+	}
+	return nil
+}
+
+// archiveWAL archives the WAL of the PostgreSQL instances with PITR enabled and at least one database backup enabled.
+// It stops receiving the WAL of the instances once PITR is disabled.
+func (r *Runner) archiveWAL(ctx context.Context) {
+	instances, err := r.store.FindInstanceWithDatabaseBackupEnabled(ctx)
+	if err != nil {
+		log.Error("Failed to retrieve PostgreSQL instance list with at least one database backup enabled", zap.Error(err))
+		return
+	}
+
+	r.archiveWALMu.Lock()
+	defer r.archiveWALMu.Unlock()
+	enabled := make(map[int]bool)
+	for _, instance := range instances {
+		if instance.Engine != db.Postgres || !instance.Options.GetPitrEnabled() {
+			continue
+		}
+		enabled[instance.UID] = true
+		if _, ok := r.archiveWALInstanceIDs[instance.UID]; !ok {
+			r.archiveWALInstanceIDs[instance.UID] = true
+			r.archiveWALWg.Add(1)
+			go r.archiveWALForInstance(ctx, instance)
+		}
+	}
+	for instanceUID, cancel := range r.receiveWALCancels {
+		if !enabled[instanceUID] {
+			cancel()
+		}
+	}
+}
+
+func (r *Runner) archiveWALForInstance(ctx context.Context, instance *store.InstanceMessage) {
+	defer func() {
+		r.archiveWALMu.Lock()
+		delete(r.archiveWALInstanceIDs, instance.UID)
+		r.archiveWALMu.Unlock()
+		r.archiveWALWg.Done()
+	}()
+	driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
+	if err != nil {
+		if common.ErrorCode(err) == common.DbConnectionFailure {
+			log.Debug("Cannot connect to instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+			return
+		}
+		log.Error("Failed to get driver for PostgreSQL instance when archiving WAL", zap.String("instance", instance.ResourceID), zap.Error(err))
+		return
+	}
+	defer driver.Close(ctx)
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		log.Error("Failed to cast driver to pg.Driver", zap.String("instance", instance.ResourceID))
+		return
+	}
+	if err := pgDriver.CheckServerVersionForPITR(ctx); err != nil {
+		log.Debug("Skip archiving WAL for instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+		return
+	}
+	if err := pgDriver.CheckWALArchivingForPITR(ctx); err != nil {
+		log.Debug("Skip archiving WAL for instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+		return
+	}
+
+	archive := NewWALArchive(r.profile.DataDir, instance.UID, r.profile.BackupStorageBackend, r.storageClient, r.backupKeyWrapper)
+	r.startReceivingWAL(ctx, instance, archive)
+	if err := archive.archiveReceivedWALFiles(ctx); err != nil {
+		log.Error("Failed to archive WAL files for instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+		return
+	}
+	if err := r.createBaseBackupIfNeeded(ctx, instance, pgDriver, archive); err != nil {
+		log.Error("Failed to create base backup for instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+	}
+}
+
+// startReceivingWAL starts streaming the WAL of the instance in the background if it's not streaming.
+func (r *Runner) startReceivingWAL(ctx context.Context, instance *store.InstanceMessage, archive *WALArchive) {
+	r.archiveWALMu.Lock()
+	defer r.archiveWALMu.Unlock()
+	if _, ok := r.receiveWALCancels[instance.UID]; ok {
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	r.receiveWALCancels[instance.UID] = cancel
+	r.archiveWALWg.Add(1)
+	go func() {
+		defer func() {
+			r.archiveWALMu.Lock()
+			delete(r.receiveWALCancels, instance.UID)
+			r.archiveWALMu.Unlock()
+			cancel()
+			r.archiveWALWg.Done()
+		}()
+		driver, err := r.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
+		if err != nil {
+			log.Debug("Cannot connect to instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+			return
+		}
+		defer driver.Close(ctx)
+		pgDriver, ok := driver.(*pg.Driver)
+		if !ok {
+			log.Error("Failed to cast driver to pg.Driver", zap.String("instance", instance.ResourceID))
+			return
+		}
+		err = pgDriver.ReceiveWAL(ctx, archive.receivingDir())
+		if ctx.Err() != nil {
+			return
+		}
+		if errors.Is(err, pg.ErrWALRemoved) {
+			// The server has removed the WAL after the latest segment received, so the archived WAL has a gap.
+			// We restart streaming from the current WAL location, and take a new base backup to recover to the time after the gap.
+			log.Warn("The WAL to archive has been removed on the server, restart archiving with a new base backup", zap.String("instance", instance.ResourceID), zap.Error(err))
+			if err := archive.archiveReceivedWALFiles(ctx); err != nil {
+				log.Error("Failed to archive WAL files for instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+				return
+			}
+			if err := os.RemoveAll(archive.receivingDir()); err != nil {
+				log.Error("Failed to clean up the WAL receiving directory", zap.String("instance", instance.ResourceID), zap.Error(err))
+				return
+			}
+			r.archiveWALMu.Lock()
+			r.walRemovedInstanceIDs[instance.UID] = true
+			r.archiveWALMu.Unlock()
+			return
+		}
+		if err != nil {
+			log.Error("Failed to receive WAL for instance", zap.String("instance", instance.ResourceID), zap.Error(err))
+		}
+	}()
+}
+
+func (r *Runner) createBaseBackupIfNeeded(ctx context.Context, instance *store.InstanceMessage, driver *pg.Driver, archive *WALArchive) error {
+	r.archiveWALMu.Lock()
+	walRemoved := r.walRemovedInstanceIDs[instance.UID]
+	r.archiveWALMu.Unlock()
+	baseBackups, err := archive.ListBaseBackups(ctx)
+	if err != nil {
+		return err
+	}
+	if !walRemoved && len(baseBackups) > 0 && time.Since(time.Unix(baseBackups[len(baseBackups)-1].Meta.StartTs, 0)) < baseBackupInterval {
+		return nil
+	}
+
+	name := time.Now().UTC().Format("20060102T150405")
+	dir := filepath.Join(archive.dir, baseBackupDirName, name)
+	log.Debug("Creating base backup for instance", zap.String("instance", instance.ResourceID), zap.String("baseBackup", name))
+	meta, err := driver.CreateBaseBackup(ctx, dir)
+	if err != nil {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Warn("Failed to clean up the failed base backup", zap.String("path", dir), zap.Error(err))
+		}
+		return err
+	}
+	if err := archive.saveBaseBackup(ctx, name, meta); err != nil {
+		return err
+	}
+	r.archiveWALMu.Lock()
+	delete(r.walRemovedInstanceIDs, instance.UID)
+	r.archiveWALMu.Unlock()
+	log.Info("Created base backup for instance", zap.String("instance", instance.ResourceID), zap.String("baseBackup", name))
+	return nil
+}
@@ -0,0 +1,114 @@
+package backuprun
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/backupfile"
+)
+
+// fakeStorageClient keeps the objects in memory.
+type fakeStorageClient struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (c *fakeStorageClient) ListObjects(_ context.Context, prefix string) ([]*storage.Object, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var objects []*storage.Object
+	for key, content := range c.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, &storage.Object{Key: key, Size: int64(len(content))})
+		}
+	}
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Key < objects[j].Key })
+	return objects, nil
+}
+
+func (c *fakeStorageClient) UploadObject(_ context.Context, path string, body io.Reader) error {
+	content, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.objects[path] = content
+	return nil
+}
+
+func (c *fakeStorageClient) DownloadObject(_ context.Context, path string, w io.WriterAt) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	content, ok := c.objects[path]
+	if !ok {
+		return 0, os.ErrNotExist
+	}
+	n, err := w.WriteAt(content, 0)
+	return int64(n), err
+}
+
+func (c *fakeStorageClient) DeleteObjects(_ context.Context, pathList ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, path := range pathList {
+		delete(c.objects, path)
+	}
+	return nil
+}
+
+func (*fakeStorageClient) GetBucket() string {
+	return "bucket"
+}
+
+func TestWALArchiveEncryptsCloudObjects(t *testing.T) {
+	a := require.New(t)
+	ctx := context.Background()
+	key := make([]byte, 32)
+	_, err := rand.Read(key)
+	a.NoError(err)
+	keyWrapper, err := backupfile.NewWorkspaceKeyWrapper(key)
+	a.NoError(err)
+	client := &fakeStorageClient{objects: map[string][]byte{}}
+	archive := NewWALArchive(t.TempDir(), 1, api.BackupStorageBackendS3, client, keyWrapper)
+
+	const walFile1, walFile2, legacyWALFile = "000000010000000000000001", "000000010000000000000002", "000000010000000000000000"
+	content := bytes.Repeat([]byte("production row;"), 1000)
+	a.NoError(os.MkdirAll(archive.receivingDir(), os.ModePerm))
+	a.NoError(os.WriteFile(filepath.Join(archive.receivingDir(), walFile1), content, 0600))
+	a.NoError(os.WriteFile(filepath.Join(archive.receivingDir(), walFile2), content, 0600))
+	a.NoError(archive.archiveReceivedWALFiles(ctx))
+
+	// The WAL segments are encrypted in the cloud storage.
+	objects, err := client.ListObjects(ctx, archive.cloudPath(archivedWALDirName)+"/")
+	a.NoError(err)
+	a.Len(objects, 2)
+	for _, object := range objects {
+		a.NotContains(string(client.objects[object.Key]), "production row")
+	}
+	// The WAL segments archived before the encryption are plaintext.
+	client.objects[archive.cloudPath(archivedWALDirName, legacyWALFile)] = content
+
+	dir := t.TempDir()
+	a.NoError(archive.FetchWALFiles(ctx, legacyWALFile, dir))
+	for _, name := range []string{legacyWALFile, walFile1, walFile2} {
+		fetched, err := os.ReadFile(filepath.Join(dir, name))
+		a.NoError(err)
+		a.Equal(content, fetched, name)
+	}
+	entries, err := os.ReadDir(dir)
+	a.NoError(err)
+	a.Len(entries, 3)
+}
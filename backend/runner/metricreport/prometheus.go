@@ -0,0 +1,96 @@
+package metricreport
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/metric"
+)
+
+const (
+	// collectTimeout bounds the time of running the collectors on a Prometheus scrape.
+	collectTimeout = 10 * time.Second
+)
+
+var (
+	_ prometheus.Collector = (*Reporter)(nil)
+
+	metricNameReplacer = strings.NewReplacer(".", "_", "-", "_")
+)
+
+// Describe implements the prometheus.Collector interface.
+// The series of the collectors depend on the data, so the reporter is registered as an unchecked collector.
+func (*Reporter) Describe(chan<- *prometheus.Desc) {}
+
+// Collect implements the prometheus.Collector interface.
+// It exposes the metrics of the registered collectors as gauges, e.g. bb.instance.count becomes bb_instance_count.
+func (m *Reporter) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	for name, collector := range m.collectors {
+		metricList, err := collector.Collect(ctx)
+		if err != nil {
+			log.Error("Failed to collect metric", zap.String("collector", name), zap.Error(err))
+			continue
+		}
+		for _, gauge := range convertToGauges(metricList) {
+			ch <- gauge
+		}
+	}
+}
+
+// convertToGauges converts the metrics to Prometheus gauges.
+// Metrics with the same labels are summed up because Prometheus rejects duplicate series.
+func convertToGauges(metricList []*metric.Metric) []prometheus.Metric {
+	type series struct {
+		desc        *prometheus.Desc
+		labelValues []string
+		value       float64
+	}
+	var seriesList []*series
+	seriesMap := make(map[string]*series)
+	for _, m := range metricList {
+		var labelNames []string
+		for name := range m.Labels {
+			labelNames = append(labelNames, name)
+		}
+		sort.Strings(labelNames)
+		var labelValues []string
+		for _, name := range labelNames {
+			labelValues = append(labelValues, fmt.Sprint(m.Labels[name]))
+		}
+
+		key := fmt.Sprintf("%s\xff%s\xff%s", m.Name, strings.Join(labelNames, "\xff"), strings.Join(labelValues, "\xff"))
+		if s, ok := seriesMap[key]; ok {
+			s.value += float64(m.Value)
+			continue
+		}
+		name := metricNameReplacer.Replace(string(m.Name))
+		s := &series{
+			desc:        prometheus.NewDesc(name, fmt.Sprintf("The %s metric collected for product telemetry.", m.Name), labelNames, nil),
+			labelValues: labelValues,
+			value:       float64(m.Value),
+		}
+		seriesMap[key] = s
+		seriesList = append(seriesList, s)
+	}
+
+	var gauges []prometheus.Metric
+	for _, s := range seriesList {
+		gauge, err := prometheus.NewConstMetric(s.desc, prometheus.GaugeValue, s.value, s.labelValues...)
+		if err != nil {
+			log.Error("Failed to convert metric", zap.String("metric", s.desc.String()), zap.Error(err))
+			continue
+		}
+		gauges = append(gauges, gauge)
+	}
+	return gauges
+}
@@ -0,0 +1,61 @@
+package metricreport
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/plugin/metric"
+)
+
+func TestConvertToGauges(t *testing.T) {
+	gauges := convertToGauges([]*metric.Metric{
+		{Name: "bb.instance.count", Value: 2, Labels: map[string]any{"engine": "MYSQL", "environment": "Prod"}},
+		{Name: "bb.instance.count", Value: 3, Labels: map[string]any{"engine": "MYSQL", "environment": "Prod"}},
+		{Name: "bb.instance.count", Value: 1, Labels: map[string]any{"engine": "POSTGRES", "environment": "Prod"}},
+		{Name: "bb.sheet.count", Value: 4, Labels: map[string]any{"shared": true}},
+	})
+	require.Len(t, gauges, 3)
+
+	type want struct {
+		name   string
+		value  float64
+		labels map[string]string
+	}
+	wants := []want{
+		{name: "bb_instance_count", value: 5, labels: map[string]string{"engine": "MYSQL", "environment": "Prod"}},
+		{name: "bb_instance_count", value: 1, labels: map[string]string{"engine": "POSTGRES", "environment": "Prod"}},
+		{name: "bb_sheet_count", value: 4, labels: map[string]string{"shared": "true"}},
+	}
+	for i, gauge := range gauges {
+		require.Contains(t, gauge.Desc().String(), `"`+wants[i].name+`"`)
+		m := &dto.Metric{}
+		require.NoError(t, gauge.Write(m))
+		require.Equal(t, wants[i].value, m.GetGauge().GetValue())
+		labels := make(map[string]string)
+		for _, label := range m.GetLabel() {
+			labels[label.GetName()] = label.GetValue()
+		}
+		require.Equal(t, wants[i].labels, labels)
+	}
+
+	// The gauges must be accepted by a registry, i.e. no duplicate series.
+	registry := prometheus.NewPedanticRegistry()
+	require.NoError(t, registry.Register(collectorFunc(func(ch chan<- prometheus.Metric) {
+		for _, gauge := range gauges {
+			ch <- gauge
+		}
+	})))
+	_, err := registry.Gather()
+	require.NoError(t, err)
+}
+
+type collectorFunc func(ch chan<- prometheus.Metric)
+
+func (collectorFunc) Describe(chan<- *prometheus.Desc) {}
+
+func (f collectorFunc) Collect(ch chan<- prometheus.Metric) {
+	f(ch)
+}
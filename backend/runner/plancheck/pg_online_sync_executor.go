@@ -0,0 +1,100 @@
+package plancheck
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// NewPGOnlineSyncExecutor creates a PostgreSQL online migration sync check executor.
+func NewPGOnlineSyncExecutor(store *store.Store, dbFactory *dbfactory.DBFactory) Executor {
+	return &PGOnlineSyncExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+	}
+}
+
+// PGOnlineSyncExecutor is the PostgreSQL online migration sync check executor.
+type PGOnlineSyncExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// Run runs the PostgreSQL online migration sync check executor.
+func (e *PGOnlineSyncExecutor) Run(ctx context.Context, planCheckRun *store.PlanCheckRunMessage) ([]*storepb.PlanCheckRunResult_Result, error) {
+	if planCheckRun.Config.DatabaseGroupUid != nil {
+		return nil, errors.Errorf("database group is not supported")
+	}
+
+	instanceUID := int(planCheckRun.Config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{InstanceID: &instance.ResourceID, DatabaseName: &planCheckRun.Config.DatabaseName})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get database %q", planCheckRun.Config.DatabaseName)
+	}
+	if database == nil {
+		return nil, errors.Errorf("database not found %q", planCheckRun.Config.DatabaseName)
+	}
+
+	sheetUID := int(planCheckRun.Config.SheetUid)
+	statement, err := e.store.GetSheetStatementByID(ctx, sheetUID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement %d", sheetUID)
+	}
+
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
+	renderedStatement := utils.RenderStatement(statement, materials)
+
+	migration, err := pg.NewOnlineMigration(renderedStatement)
+	if err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Title:   "Unsupported statement",
+				Content: err.Error(),
+				Code:    common.Internal.Int64(),
+			},
+		}, nil
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+
+	if err := migration.Check(ctx, driver.GetDB()); err != nil {
+		return []*storepb.PlanCheckRunResult_Result{
+			{
+				Status:  storepb.PlanCheckRunResult_Result_ERROR,
+				Title:   "Online migration dry run failed",
+				Content: err.Error(),
+				Code:    common.Internal.Int64(),
+			},
+		}, nil
+	}
+
+	return []*storepb.PlanCheckRunResult_Result{
+		{
+			Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+			Title:   "OK",
+			Content: "Online migration dry run succeeded",
+			Code:    common.Ok.Int64(),
+		},
+	}, nil
+}
@@ -0,0 +1,90 @@
+package plancheck
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/runner/backuprun"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// NewPITRPostgresExecutor creates a plan check PITR Postgres executor.
+func NewPITRPostgresExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, storageClient storage.Client, profile config.Profile) Executor {
+	return &PITRPostgresExecutor{
+		store:         store,
+		dbFactory:     dbFactory,
+		storageClient: storageClient,
+		profile:       profile,
+	}
+}
+
+// PITRPostgresExecutor is to check if the Postgres instance is ready for PITR.
+type PITRPostgresExecutor struct {
+	store         *store.Store
+	dbFactory     *dbfactory.DBFactory
+	storageClient storage.Client
+	profile       config.Profile
+}
+
+// Run runs the PITR Postgres executor.
+func (e *PITRPostgresExecutor) Run(ctx context.Context, planCheckRun *store.PlanCheckRunMessage) ([]*storepb.PlanCheckRunResult_Result, error) {
+	if planCheckRun.Config.DatabaseGroupUid != nil {
+		return nil, errors.Errorf("database group is not supported")
+	}
+
+	instanceUID := int(planCheckRun.Config.InstanceUid)
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &instanceUID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance UID %v", instanceUID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance not found UID %v", instanceUID)
+	}
+
+	if !instance.Options.GetPitrEnabled() {
+		return convertErrorToResult(errors.Errorf("point-in-time recovery is not enabled for instance %q", instance.Title)), nil
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		return nil, errors.Errorf("Failed to cast driver to pg.Driver")
+	}
+
+	if err := pgDriver.CheckServerVersionForPITR(ctx); err != nil {
+		return convertErrorToResult(err), nil
+	}
+
+	if err := pgDriver.CheckWALArchivingForPITR(ctx); err != nil {
+		return convertErrorToResult(err), nil
+	}
+
+	archive := backuprun.NewWALArchive(e.profile.DataDir, instance.UID, e.profile.BackupStorageBackend, e.storageClient, nil /* keyWrapper */)
+	baseBackups, err := archive.ListBaseBackups(ctx)
+	if err != nil {
+		return convertErrorToResult(err), nil
+	}
+	if len(baseBackups) == 0 {
+		return convertErrorToResult(errors.Errorf("no base backup of instance %q yet, please enable the automatic backup of the database and wait for the WAL archiving to take the first base backup", instance.Title)), nil
+	}
+
+	return []*storepb.PlanCheckRunResult_Result{
+		{
+			Status:  storepb.PlanCheckRunResult_Result_SUCCESS,
+			Code:    common.Ok.Int64(),
+			Title:   "OK",
+			Content: "Ready to do PITR",
+		},
+	}, nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	metricAPI "github.com/bytebase/bytebase/backend/metric"
 	"github.com/bytebase/bytebase/backend/store"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
 )
@@ -121,11 +122,14 @@ func (s *Scheduler) runPlanCheckRun(ctx context.Context, planCheckRun *store.Pla
 			s.stateCfg.InstanceOutstandingConnections[instanceUID]--
 			s.stateCfg.Unlock()
 		}()
+		start := time.Now()
 		results, err := runExecutorOnce(ctx, executor, planCheckRun)
 		if err != nil {
+			metricAPI.ObservePlanCheckRun(string(planCheckRun.Type), metricAPI.RunStatusFailed, time.Since(start))
 			s.markPlanCheckRunFailed(ctx, planCheckRun, err.Error())
 			return
 		}
+		metricAPI.ObservePlanCheckRun(string(planCheckRun.Type), metricAPI.RunStatusDone, time.Since(start))
 		s.markPlanCheckRunDone(ctx, planCheckRun, results)
 	}()
 }
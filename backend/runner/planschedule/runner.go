@@ -0,0 +1,457 @@
+// Package planschedule is the runner running the data changes of the scheduled plans repeatedly.
+package planschedule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const planScheduleRunnerInterval = 10 * time.Second
+
+// PlanCheckRunsGetter returns the plan check runs to create for the plan.
+type PlanCheckRunsGetter func(ctx context.Context, s *store.Store, plan *store.PlanMessage) ([]*store.PlanCheckRunMessage, error)
+
+// NewRunner creates a new plan schedule runner.
+func NewRunner(store *store.Store, stateCfg *state.State, activityManager *activity.Manager, getPlanCheckRuns PlanCheckRunsGetter) *Runner {
+	return &Runner{
+		store:            store,
+		stateCfg:         stateCfg,
+		activityManager:  activityManager,
+		getPlanCheckRuns: getPlanCheckRuns,
+	}
+}
+
+// Runner is the runner creating the occurrences of the scheduled plans.
+// Each occurrence runs the plan checks, then the data update tasks stage by stage, and collects the generated rollback statements.
+type Runner struct {
+	store            *store.Store
+	stateCfg         *state.State
+	activityManager  *activity.Manager
+	getPlanCheckRuns PlanCheckRunsGetter
+}
+
+// Run starts the plan schedule runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(planScheduleRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("Plan schedule runner started and will run every %v", planScheduleRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.runOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	plans, err := r.store.ListPlans(ctx, &store.FindPlanMessage{Scheduled: true})
+	if err != nil {
+		log.Error("Failed to list scheduled plans", zap.Error(err))
+		return
+	}
+	for _, plan := range plans {
+		if err := r.schedulePlan(ctx, plan); err != nil {
+			log.Error("Failed to schedule plan", zap.Int64("plan", plan.UID), zap.Error(err))
+		}
+	}
+}
+
+func (r *Runner) schedulePlan(ctx context.Context, plan *store.PlanMessage) error {
+	if plan.PipelineUID == nil {
+		return nil
+	}
+	// The skipped occurrences are scheduled after the active one, so the active one is looked up separately.
+	latest, err := r.getLatestOccurrence(ctx, plan.UID, nil)
+	if err != nil {
+		return err
+	}
+	active, err := r.getLatestOccurrence(ctx, plan.UID, &[]store.PlanScheduleOccurrenceStatus{store.PlanScheduleOccurrenceChecking, store.PlanScheduleOccurrenceRunning})
+	if err != nil {
+		return err
+	}
+	if active != nil {
+		stillActive, err := r.processOccurrence(ctx, plan, active)
+		if err != nil {
+			return errors.Wrapf(err, "failed to process occurrence %d", active.UID)
+		}
+		if !stillActive {
+			active = nil
+		}
+	}
+
+	next, err := getNextOccurrence(plan, latest, active, time.Now())
+	if err != nil {
+		return err
+	}
+	if next == nil {
+		return nil
+	}
+
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: plan.PipelineUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get issue")
+	}
+	if issue == nil || issue.Status != api.IssueOpen {
+		return nil
+	}
+	approved, err := utils.CheckIssueApproved(issue)
+	if err != nil {
+		return errors.Wrapf(err, "failed to check if the issue is approved")
+	}
+	if !approved {
+		return nil
+	}
+
+	if _, err := r.store.CreatePlanScheduleOccurrence(ctx, next); err != nil {
+		return errors.Wrapf(err, "failed to create occurrence")
+	}
+	if next.Status == store.PlanScheduleOccurrenceSkipped {
+		return nil
+	}
+	planCheckRuns, err := r.getPlanCheckRuns(ctx, r.store, plan)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get plan check runs for plan")
+	}
+	if err := r.store.CreatePlanCheckRuns(ctx, planCheckRuns...); err != nil {
+		return errors.Wrapf(err, "failed to create plan check runs")
+	}
+	return nil
+}
+
+// getLatestOccurrence returns the occurrence of the plan with the latest schedule time in the statuses, or nil if there is none.
+func (r *Runner) getLatestOccurrence(ctx context.Context, planUID int64, statuses *[]store.PlanScheduleOccurrenceStatus) (*store.PlanScheduleOccurrenceMessage, error) {
+	limit := 1
+	occurrences, err := r.store.ListPlanScheduleOccurrences(ctx, &store.FindPlanScheduleOccurrenceMessage{
+		PlanUID:  &planUID,
+		Statuses: statuses,
+		Limit:    &limit,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list plan schedule occurrences")
+	}
+	if len(occurrences) == 0 {
+		return nil, nil
+	}
+	return occurrences[0], nil
+}
+
+// getNextOccurrence returns the occurrence to create if the schedule is due after the latest occurrence, or nil otherwise.
+// The occurrence is skipped if the active occurrence is still running.
+func getNextOccurrence(plan *store.PlanMessage, latest, active *store.PlanScheduleOccurrenceMessage, now time.Time) (*store.PlanScheduleOccurrenceMessage, error) {
+	schedule := plan.Config.Schedule
+	if schedule.Paused {
+		return nil, nil
+	}
+	var after time.Time
+	if latest != nil {
+		after = time.Unix(latest.ScheduleTs, 0)
+	}
+	scheduleTime, ok, err := getDueScheduleTime(schedule, after, now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	if active != nil {
+		return &store.PlanScheduleOccurrenceMessage{
+			PlanUID:    plan.UID,
+			ScheduleTs: scheduleTime.Unix(),
+			Status:     store.PlanScheduleOccurrenceSkipped,
+			Payload: &storepb.PlanScheduleOccurrencePayload{
+				Detail: fmt.Sprintf("The previous occurrence scheduled at %s is still active.", time.Unix(active.ScheduleTs, 0).UTC().Format(time.RFC3339)),
+			},
+		}, nil
+	}
+	return &store.PlanScheduleOccurrenceMessage{
+		PlanUID:    plan.UID,
+		ScheduleTs: scheduleTime.Unix(),
+		Status:     store.PlanScheduleOccurrenceChecking,
+		Payload:    &storepb.PlanScheduleOccurrencePayload{},
+	}, nil
+}
+
+// processOccurrence moves the active occurrence forward, and returns whether the occurrence is still active.
+func (r *Runner) processOccurrence(ctx context.Context, plan *store.PlanMessage, occurrence *store.PlanScheduleOccurrenceMessage) (bool, error) {
+	if occurrence.Status == store.PlanScheduleOccurrenceChecking {
+		passed, detail, err := r.checkPlanCheckRuns(ctx, plan, occurrence)
+		if err != nil {
+			return true, err
+		}
+		if detail != "" {
+			occurrence.Payload.Detail = detail
+			return false, r.finishOccurrence(ctx, occurrence, store.PlanScheduleOccurrenceFailed)
+		}
+		if !passed {
+			return true, nil
+		}
+		status := store.PlanScheduleOccurrenceRunning
+		if err := r.store.UpdatePlanScheduleOccurrence(ctx, &store.UpdatePlanScheduleOccurrenceMessage{
+			UID:    occurrence.UID,
+			Status: &status,
+		}); err != nil {
+			return true, err
+		}
+		occurrence.Status = status
+	}
+
+	tasks, err := r.store.ListTasks(ctx, &api.TaskFind{PipelineID: plan.PipelineUID})
+	if err != nil {
+		return true, errors.Wrapf(err, "failed to list tasks")
+	}
+	var taskRuns []*store.TaskRunMessage
+	if len(occurrence.Payload.TaskRunUids) > 0 {
+		var taskRunUIDs []int
+		for _, uid := range occurrence.Payload.TaskRunUids {
+			taskRunUIDs = append(taskRunUIDs, int(uid))
+		}
+		taskRuns, err = r.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{UIDs: &taskRunUIDs})
+		if err != nil {
+			return true, errors.Wrapf(err, "failed to list task runs")
+		}
+	}
+
+	lastStageUID := 0
+	for _, taskRun := range taskRuns {
+		switch taskRun.Status {
+		case api.TaskRunPending, api.TaskRunRunning:
+			return true, nil
+		case api.TaskRunFailed, api.TaskRunCanceled:
+			if occurrence.Payload.Detail == "" {
+				occurrence.Payload.Detail = fmt.Sprintf("Task run %q is %s.", taskRun.Name, taskRun.Status)
+			}
+		}
+		if taskRun.StageUID > lastStageUID {
+			lastStageUID = taskRun.StageUID
+		}
+	}
+	if occurrence.Payload.Detail == "" {
+		started, err := r.runNextStage(ctx, plan, occurrence, tasks, lastStageUID)
+		if err != nil {
+			return true, err
+		}
+		if started {
+			return true, nil
+		}
+	}
+
+	// All the task runs are finished, wait for the rollback statements.
+	rollbacks, pending, err := r.getRollbacks(tasks, taskRuns)
+	if err != nil {
+		return true, err
+	}
+	if pending {
+		return true, nil
+	}
+	occurrence.Payload.Rollbacks = rollbacks
+	status := store.PlanScheduleOccurrenceDone
+	if occurrence.Payload.Detail != "" {
+		status = store.PlanScheduleOccurrenceFailed
+	}
+	return false, r.finishOccurrence(ctx, occurrence, status)
+}
+
+// checkPlanCheckRuns checks the plan check runs created for the occurrence.
+// It returns the failure detail if any of them fails, and whether all of them have passed.
+func (r *Runner) checkPlanCheckRuns(ctx context.Context, plan *store.PlanMessage, occurrence *store.PlanScheduleOccurrenceMessage) (bool, string, error) {
+	planCheckRuns, err := r.store.ListPlanCheckRuns(ctx, &store.FindPlanCheckRunMessage{PlanUID: &plan.UID})
+	if err != nil {
+		return false, "", errors.Wrapf(err, "failed to list plan check runs")
+	}
+	type key struct {
+		instanceUID  int
+		databaseName string
+		checkType    store.PlanCheckRunType
+	}
+	latestRun := map[key]*store.PlanCheckRunMessage{}
+	for _, run := range planCheckRuns {
+		// The plan check runs of the occurrence are created after it.
+		if run.CreatedTs < occurrence.CreatedTs {
+			continue
+		}
+		k := key{
+			instanceUID:  int(run.Config.InstanceUid),
+			databaseName: run.Config.DatabaseName,
+			checkType:    run.Type,
+		}
+		if latest, ok := latestRun[k]; !ok || latest.UID < run.UID {
+			latestRun[k] = run
+		}
+	}
+	passed := true
+	for _, run := range latestRun {
+		switch run.Status {
+		case store.PlanCheckRunStatusRunning:
+			passed = false
+		case store.PlanCheckRunStatusFailed, store.PlanCheckRunStatusCanceled:
+			return false, fmt.Sprintf("Plan check %s on database %q is %s.", run.Type, run.Config.DatabaseName, run.Status), nil
+		case store.PlanCheckRunStatusDone:
+			for _, result := range run.Result.Results {
+				if result.Status == storepb.PlanCheckRunResult_Result_ERROR {
+					return false, fmt.Sprintf("Plan check %s on database %q reports error: %s.", run.Type, run.Config.DatabaseName, result.Title), nil
+				}
+			}
+		}
+	}
+	return passed, "", nil
+}
+
+// runNextStage creates the task runs for the data update tasks of the first stage after lastStageUID, and returns false if there is no such stage.
+func (r *Runner) runNextStage(ctx context.Context, plan *store.PlanMessage, occurrence *store.PlanScheduleOccurrenceMessage, tasks []*store.TaskMessage, lastStageUID int) (bool, error) {
+	stageTasks := map[int][]*store.TaskMessage{}
+	var stageUIDs []int
+	for _, task := range tasks {
+		if task.StageID <= lastStageUID || task.Type != api.TaskDatabaseDataUpdate {
+			continue
+		}
+		payload := &api.TaskDatabaseDataUpdatePayload{}
+		if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+			return false, errors.Wrapf(err, "invalid database data update payload")
+		}
+		if payload.Skipped {
+			continue
+		}
+		if _, ok := stageTasks[task.StageID]; !ok {
+			stageUIDs = append(stageUIDs, task.StageID)
+		}
+		stageTasks[task.StageID] = append(stageTasks[task.StageID], task)
+	}
+	if len(stageUIDs) == 0 {
+		return false, nil
+	}
+	sort.Ints(stageUIDs)
+	tasks = stageTasks[stageUIDs[0]]
+
+	var creates []*store.TaskRunMessage
+	for _, task := range tasks {
+		if err := r.resetTaskPayload(ctx, task, time.Unix(occurrence.ScheduleTs, 0)); err != nil {
+			return false, err
+		}
+		creates = append(creates, &store.TaskRunMessage{
+			CreatorID: api.SystemBotID,
+			TaskUID:   task.ID,
+			Name:      fmt.Sprintf("%s %d", task.Name, time.Now().Unix()),
+		})
+	}
+	taskRunUIDs, err := r.store.CreateScheduledTaskRuns(ctx, creates...)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to create task runs")
+	}
+	for _, uid := range taskRunUIDs {
+		occurrence.Payload.TaskRunUids = append(occurrence.Payload.TaskRunUids, int32(uid))
+	}
+	if err := r.store.UpdatePlanScheduleOccurrence(ctx, &store.UpdatePlanScheduleOccurrenceMessage{
+		UID:     occurrence.UID,
+		Payload: occurrence.Payload,
+	}); err != nil {
+		return false, err
+	}
+
+	issue, err := r.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: plan.PipelineUID})
+	if err != nil {
+		log.Error("Failed to get issue", zap.Error(err))
+	} else if issue != nil {
+		if err := r.activityManager.BatchCreateActivitiesForRunTasks(ctx, tasks, issue, fmt.Sprintf("Scheduled at %s.", time.Unix(occurrence.ScheduleTs, 0).UTC().Format(time.RFC3339)), api.SystemBotID); err != nil {
+			log.Error("Failed to create activities for running tasks", zap.Error(err))
+		}
+	}
+	return true, nil
+}
+
+// resetTaskPayload clears the execution states of the previous occurrence in the task payload.
+func (r *Runner) resetTaskPayload(ctx context.Context, task *store.TaskMessage, scheduleTime time.Time) error {
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return errors.Wrapf(err, "invalid database data update payload")
+	}
+	// The migration history dedups the changes by version, so every occurrence needs a new one.
+	payload.SchemaVersion = fmt.Sprintf("%s-%d", scheduleTime.UTC().Format("20060102150405"), task.ID)
+	payload.ThreadID = ""
+	payload.TransactionID = ""
+	payload.MigrationID = ""
+	payload.BinlogFileStart = ""
+	payload.BinlogFileEnd = ""
+	payload.BinlogPosStart = 0
+	payload.BinlogPosEnd = 0
+	payload.RollbackSheetID = 0
+	payload.RollbackError = ""
+	payload.RollbackSQLStatus = ""
+	payload.BatchProgress = nil
+	if payload.RollbackEnabled {
+		payload.RollbackSQLStatus = api.RollbackSQLStatusPending
+	}
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal database data update payload")
+	}
+	payloadString := string(bytes)
+	if _, err := r.store.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to update task %d payload", task.ID)
+	}
+	return nil
+}
+
+// getRollbacks returns the generated rollback statements of the tasks having task runs, and whether any of them is still generating.
+func (r *Runner) getRollbacks(tasks []*store.TaskMessage, taskRuns []*store.TaskRunMessage) ([]*storepb.PlanScheduleOccurrencePayload_Rollback, bool, error) {
+	ran := map[int]bool{}
+	for _, taskRun := range taskRuns {
+		ran[taskRun.TaskUID] = true
+	}
+	var rollbacks []*storepb.PlanScheduleOccurrencePayload_Rollback
+	for _, task := range tasks {
+		if !ran[task.ID] {
+			continue
+		}
+		if _, ok := r.stateCfg.RollbackGenerate.Load(task.ID); ok {
+			return nil, true, nil
+		}
+		payload := &api.TaskDatabaseDataUpdatePayload{}
+		if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+			return nil, false, errors.Wrapf(err, "invalid database data update payload")
+		}
+		switch payload.RollbackSQLStatus {
+		case api.RollbackSQLStatusDone:
+			rollbacks = append(rollbacks, &storepb.PlanScheduleOccurrencePayload_Rollback{
+				TaskUid:  int32(task.ID),
+				SheetUid: int32(payload.RollbackSheetID),
+			})
+		case api.RollbackSQLStatusFailed:
+			rollbacks = append(rollbacks, &storepb.PlanScheduleOccurrencePayload_Rollback{
+				TaskUid: int32(task.ID),
+				Error:   payload.RollbackError,
+			})
+		}
+	}
+	return rollbacks, false, nil
+}
+
+func (r *Runner) finishOccurrence(ctx context.Context, occurrence *store.PlanScheduleOccurrenceMessage, status store.PlanScheduleOccurrenceStatus) error {
+	return r.store.UpdatePlanScheduleOccurrence(ctx, &store.UpdatePlanScheduleOccurrenceMessage{
+		UID:     occurrence.UID,
+		Status:  &status,
+		Payload: occurrence.Payload,
+	})
+}
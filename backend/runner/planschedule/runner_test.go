@@ -0,0 +1,51 @@
+package planschedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestGetNextOccurrence(t *testing.T) {
+	a := require.New(t)
+	plan := &store.PlanMessage{
+		UID:    1,
+		Config: &storepb.PlanConfig{Schedule: &storepb.PlanConfig_Schedule{Cron: "*/5 * * * *"}},
+	}
+	first := time.Date(2023, 10, 19, 12, 0, 0, 0, time.UTC)
+	now := time.Date(2023, 10, 19, 12, 11, 0, 0, time.UTC)
+
+	next, err := getNextOccurrence(plan, nil, nil, first.Add(30*time.Second))
+	a.NoError(err)
+	a.Equal(store.PlanScheduleOccurrenceChecking, next.Status)
+	a.Equal(first.Unix(), next.ScheduleTs)
+
+	// The occurrence scheduled at 12:00 is still running, and the one at 12:05 has been skipped.
+	// The schedule continues after the skipped occurrence, and the detail refers to the active one.
+	active := &store.PlanScheduleOccurrenceMessage{UID: 1, ScheduleTs: first.Unix(), Status: store.PlanScheduleOccurrenceRunning}
+	skipped := &store.PlanScheduleOccurrenceMessage{UID: 2, ScheduleTs: first.Add(5 * time.Minute).Unix(), Status: store.PlanScheduleOccurrenceSkipped}
+	next, err = getNextOccurrence(plan, skipped, active, now)
+	a.NoError(err)
+	a.Equal(store.PlanScheduleOccurrenceSkipped, next.Status)
+	a.Equal(first.Add(10*time.Minute).Unix(), next.ScheduleTs)
+	a.Contains(next.Payload.Detail, "2023-10-19T12:00:00Z")
+
+	// Once the active occurrence finishes, the next one runs.
+	next, err = getNextOccurrence(plan, skipped, nil, now)
+	a.NoError(err)
+	a.Equal(store.PlanScheduleOccurrenceChecking, next.Status)
+
+	// Nothing is due after the latest occurrence.
+	next, err = getNextOccurrence(plan, skipped, active, first.Add(6*time.Minute))
+	a.NoError(err)
+	a.Nil(next)
+
+	plan.Config.Schedule.Paused = true
+	next, err = getNextOccurrence(plan, skipped, nil, now)
+	a.NoError(err)
+	a.Nil(next)
+}
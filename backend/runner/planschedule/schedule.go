@@ -0,0 +1,83 @@
+package planschedule
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/robfig/cron/v3"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// missedScheduleTolerance is how late an occurrence can start after its schedule time.
+// The occurrences missed for longer, e.g. when the server is down or the issue is not approved yet, are not run.
+const missedScheduleTolerance = 10 * time.Minute
+
+// ValidateSchedule validates the cron expression and the time zone of the schedule.
+func ValidateSchedule(schedule *storepb.PlanConfig_Schedule) error {
+	_, err := parseSchedule(schedule)
+	return err
+}
+
+// GetNextScheduleTime returns the next schedule time of the schedule after the given time.
+func GetNextScheduleTime(schedule *storepb.PlanConfig_Schedule, after time.Time) (time.Time, error) {
+	s, err := parseSchedule(schedule)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if start := schedule.StartTime; start != nil && after.Before(start.AsTime()) {
+		after = start.AsTime()
+	}
+	return s.Next(after), nil
+}
+
+// getDueScheduleTime returns the latest schedule time in (after, now] within the missed schedule tolerance, and false if there is none.
+// Several missed schedule times are coalesced into the latest one.
+func getDueScheduleTime(schedule *storepb.PlanConfig_Schedule, after, now time.Time) (time.Time, bool, error) {
+	if earliest := now.Add(-missedScheduleTolerance); after.Before(earliest) {
+		after = earliest
+	}
+	next, err := GetNextScheduleTime(schedule, after)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if next.IsZero() || next.After(now) {
+		return time.Time{}, false, nil
+	}
+	s, err := parseSchedule(schedule)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	for {
+		following := s.Next(next)
+		if following.IsZero() || following.After(now) {
+			return next, true, nil
+		}
+		next = following
+	}
+}
+
+func parseSchedule(schedule *storepb.PlanConfig_Schedule) (cron.Schedule, error) {
+	location := time.UTC
+	if schedule.TimeZone != "" {
+		l, err := time.LoadLocation(schedule.TimeZone)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid time zone %q", schedule.TimeZone)
+		}
+		location = l
+	}
+	if strings.HasPrefix(schedule.Cron, "TZ=") || strings.HasPrefix(schedule.Cron, "CRON_TZ=") {
+		return nil, errors.Errorf("invalid cron expression %q, use the time zone of the schedule instead", schedule.Cron)
+	}
+	s, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid cron expression %q", schedule.Cron)
+	}
+	specSchedule, ok := s.(*cron.SpecSchedule)
+	if !ok {
+		return nil, errors.Errorf("unsupported cron expression %q", schedule.Cron)
+	}
+	specSchedule.Location = location
+	return specSchedule, nil
+}
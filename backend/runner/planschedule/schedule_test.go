@@ -0,0 +1,80 @@
+package planschedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestValidateSchedule(t *testing.T) {
+	tests := []struct {
+		schedule *storepb.PlanConfig_Schedule
+		wantErr  bool
+	}{
+		{schedule: &storepb.PlanConfig_Schedule{Cron: "0 2 * * *"}},
+		{schedule: &storepb.PlanConfig_Schedule{Cron: "*/15 * * * 1-5", TimeZone: "America/Los_Angeles"}},
+		{schedule: &storepb.PlanConfig_Schedule{Cron: "@daily"}},
+		{schedule: &storepb.PlanConfig_Schedule{Cron: "@every 1h"}, wantErr: true},
+		{schedule: &storepb.PlanConfig_Schedule{Cron: "CRON_TZ=Asia/Shanghai 0 2 * * *"}, wantErr: true},
+		{schedule: &storepb.PlanConfig_Schedule{Cron: "0 2 * *"}, wantErr: true},
+		{schedule: &storepb.PlanConfig_Schedule{Cron: "0 2 * * *", TimeZone: "Mars/Olympus_Mons"}, wantErr: true},
+	}
+	for _, test := range tests {
+		err := ValidateSchedule(test.schedule)
+		if test.wantErr {
+			require.Error(t, err, test.schedule.String())
+		} else {
+			require.NoError(t, err, test.schedule.String())
+		}
+	}
+}
+
+func TestGetNextScheduleTime(t *testing.T) {
+	a := require.New(t)
+	after := time.Date(2023, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	next, err := GetNextScheduleTime(&storepb.PlanConfig_Schedule{Cron: "0 2 * * *"}, after)
+	a.NoError(err)
+	a.Equal(time.Date(2023, 10, 20, 2, 0, 0, 0, time.UTC), next.UTC())
+
+	// 02:00 in Shanghai is 18:00 UTC on the previous day.
+	next, err = GetNextScheduleTime(&storepb.PlanConfig_Schedule{Cron: "0 2 * * *", TimeZone: "Asia/Shanghai"}, after)
+	a.NoError(err)
+	a.Equal(time.Date(2023, 10, 19, 18, 0, 0, 0, time.UTC), next.UTC())
+
+	// The schedule does not fire before its start time.
+	next, err = GetNextScheduleTime(&storepb.PlanConfig_Schedule{Cron: "0 2 * * *", StartTime: timestamppb.New(after.Add(48 * time.Hour))}, after)
+	a.NoError(err)
+	a.Equal(time.Date(2023, 10, 22, 2, 0, 0, 0, time.UTC), next.UTC())
+}
+
+func TestGetDueScheduleTime(t *testing.T) {
+	a := require.New(t)
+	schedule := &storepb.PlanConfig_Schedule{Cron: "*/5 * * * *"}
+	now := time.Date(2023, 10, 19, 12, 7, 0, 0, time.UTC)
+
+	// The missed schedule times are coalesced into the latest one.
+	due, ok, err := getDueScheduleTime(schedule, now.Add(-9*time.Minute), now)
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(time.Date(2023, 10, 19, 12, 5, 0, 0, time.UTC), due.UTC())
+
+	// The latest schedule time has been run.
+	_, ok, err = getDueScheduleTime(schedule, time.Date(2023, 10, 19, 12, 5, 0, 0, time.UTC), now)
+	a.NoError(err)
+	a.False(ok)
+
+	// The schedule times missed for longer than the tolerance are not run.
+	hourly := &storepb.PlanConfig_Schedule{Cron: "0 * * * *"}
+	_, ok, err = getDueScheduleTime(hourly, time.Time{}, now.Add(10*time.Minute))
+	a.NoError(err)
+	a.False(ok)
+	due, ok, err = getDueScheduleTime(hourly, time.Time{}, time.Date(2023, 10, 19, 12, 0, 30, 0, time.UTC))
+	a.NoError(err)
+	a.True(ok)
+	a.Equal(time.Date(2023, 10, 19, 12, 0, 0, 0, time.UTC), due.UTC())
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 	"sync"
@@ -130,8 +131,11 @@ func (r *Runner) checkExternalApproval(ctx context.Context, approval *store.Exte
 	if err != nil {
 		return errors.Wrapf(err, "failed to get external approval node %s", payload.ExternalApprovalNodeID)
 	}
+	if node == nil {
+		return errors.Errorf("external approval node %s not found", payload.ExternalApprovalNodeID)
+	}
 	id := payload.ID
-	resp, err := r.Client.GetApproval(node.Endpoint, id)
+	resp, err := r.Client.GetApproval(&relayplugin.Node{Endpoint: node.Endpoint, Secret: node.Secret}, id)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get external approval status, id: %v, endpoint: %s, id: %s", node.Id, node.Endpoint, id)
 	}
@@ -145,7 +149,8 @@ func (r *Runner) checkExternalApproval(ctx context.Context, approval *store.Exte
 		}); err != nil {
 			return err
 		}
-	} else if resp.Status == relayplugin.StatusRejected {
+	} else if resp.Status == relayplugin.StatusRejected || resp.Status == relayplugin.StatusCanceled {
+		// The approval canceled in the external system will never be approved, so we reject the node.
 		if err := r.rejectExternalApprovalNode(ctx, approval.IssueUID); err != nil {
 			return err
 		}
@@ -159,6 +164,57 @@ func (r *Runner) checkExternalApproval(ctx context.Context, approval *store.Exte
 	return nil
 }
 
+// ErrCallbackUnauthorized is the error of a callback failing the signature verification.
+var ErrCallbackUnauthorized = errors.New("unauthorized external approval callback")
+
+// HandleCallback handles the callback from the relay server of the external approval node, which notifies that an approval is decided.
+// We check the approval status via the relay client instead of trusting the status in the callback.
+func (r *Runner) HandleCallback(ctx context.Context, nodeID string, req *http.Request, body []byte) error {
+	node, err := getExternalApprovalByID(ctx, r.store, nodeID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get external approval node %s", nodeID)
+	}
+	if node == nil {
+		return errors.Errorf("external approval node %s not found", nodeID)
+	}
+	// Anyone knowing the node ID could call back without the secret, so the callbacks of the nodes without a secret are refused.
+	if node.Secret == "" {
+		return errors.Wrapf(ErrCallbackUnauthorized, "external approval node %s has no secret", nodeID)
+	}
+	if err := relayplugin.VerifyHeader(req.Header, node.Secret, time.Now(), req.Method, req.URL.RequestURI(), body); err != nil {
+		return errors.Wrap(ErrCallbackUnauthorized, err.Error())
+	}
+	callback := &relayplugin.ResponsePayload{}
+	if err := json.Unmarshal(body, callback); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal external approval callback")
+	}
+
+	externalApprovalType := api.ExternalApprovalTypeRelay
+	approvals, err := r.store.ListExternalApprovalV2(ctx, &store.ListExternalApprovalMessage{
+		Type: &externalApprovalType,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list external approvals")
+	}
+	for _, approval := range approvals {
+		payload := &api.ExternalApprovalPayloadRelay{}
+		if err := json.Unmarshal([]byte(approval.Payload), payload); err != nil {
+			return errors.Wrapf(err, "failed to unmarshal external approval payload")
+		}
+		if payload.ExternalApprovalNodeID != nodeID || payload.ID != callback.ID {
+			continue
+		}
+		msg := CheckExternalApprovalChanMessage{
+			ExternalApproval: approval,
+			ErrChan:          make(chan error, 1),
+		}
+		r.CheckExternalApprovalChan <- msg
+		return <-msg.ErrChan
+	}
+	// The approval has been archived, e.g. the issue is closed.
+	return nil
+}
+
 func getExternalApprovalByID(ctx context.Context, s *store.Store, externalApprovalID string) (*storepb.ExternalApprovalSetting_Node, error) {
 	setting, err := s.GetWorkspaceExternalApprovalSetting(ctx)
 	if err != nil {
@@ -207,8 +263,8 @@ func (r *Runner) cancelExternalApproval(ctx context.Context, issueUID int) {
 			continue
 		}
 		go func() {
-			if err := r.Client.UpdateApproval(node.Endpoint, payload.ID, &relayplugin.UpdatePayload{}); err != nil {
-				log.Error("failed to update external approval status", zap.String("endpoint", node.Endpoint), zap.String("id", payload.ID), zap.Error(err))
+			if err := r.Client.CancelApproval(&relayplugin.Node{Endpoint: node.Endpoint, Secret: node.Secret}, payload.ID); err != nil {
+				log.Error("failed to cancel external approval", zap.String("endpoint", node.Endpoint), zap.String("id", payload.ID), zap.Error(err))
 			}
 		}()
 	}
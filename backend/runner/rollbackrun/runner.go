@@ -132,6 +132,28 @@ func (r *Runner) generateRollbackSQL(ctx context.Context, task *store.TaskMessag
 		r.generateMySQLRollbackSQL(ctx, task, payload, instance, project)
 	case db.Oracle:
 		r.generateOracleRollbackSQL(ctx, task, payload, instance, project)
+	case db.Postgres:
+		r.generatePostgresRollbackSQL(ctx, task, payload)
+	}
+}
+
+// generatePostgresRollbackSQL handles the rollback SQL enabled after the task runs.
+// The rollback SQL of PostgreSQL is generated from the before-images captured while the task runs, so it cannot be generated afterwards.
+func (r *Runner) generatePostgresRollbackSQL(ctx context.Context, task *store.TaskMessage, payload *api.TaskDatabaseDataUpdatePayload) {
+	if payload.RollbackSheetID != 0 {
+		// The rollback SQL has been generated during the execution.
+		return
+	}
+	rollbackSQLStatus := api.RollbackSQLStatusFailed
+	rollbackError := "The rollback SQL of PostgreSQL is generated during the execution. Please enable it before running the task."
+	patch := &api.TaskPatch{
+		ID:                task.ID,
+		UpdaterID:         api.SystemBotID,
+		RollbackSQLStatus: &rollbackSQLStatus,
+		RollbackError:     &rollbackError,
+	}
+	if _, err := r.store.UpdateTaskV2(ctx, patch); err != nil {
+		log.Error("Failed to patch task with the rollback SQL error", zap.Int("taskID", task.ID))
 	}
 }
 
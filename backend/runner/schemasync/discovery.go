@@ -0,0 +1,164 @@
+package schemasync
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/classifier"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+const (
+	sampleTableTimeout = 10 * time.Second
+)
+
+// discoverSensitiveData detects the classifications of the columns without classification in their comments,
+// with the discovery of the data classification config of the database project.
+// The failures are logged rather than returned, so that they don't fail the schema sync.
+func discoverSensitiveData(ctx context.Context, stores *store.Store, database *store.DatabaseMessage, databaseMetadata *storepb.DatabaseSchemaMetadata, driver db.Driver) {
+	c, err := getClassifier(ctx, stores, database)
+	if err != nil {
+		log.Warn("Failed to get the classifier for sensitive data discovery",
+			zap.String("instance", database.InstanceID),
+			zap.String("database", database.DatabaseName),
+			zap.Error(err))
+		return
+	}
+	if c == nil {
+		return
+	}
+
+	for _, schema := range databaseMetadata.Schemas {
+		for _, table := range schema.Tables {
+			var columns []*storepb.ColumnMetadata
+			for _, column := range table.Columns {
+				if column.Classification == "" {
+					columns = append(columns, column)
+				}
+			}
+			if len(columns) == 0 {
+				continue
+			}
+			samples, err := sampleTable(ctx, driver, schema.Name, table.Name, columns, c.SampleSize())
+			if err != nil {
+				// Detect the columns by their names only.
+				log.Debug("Failed to sample table for sensitive data discovery",
+					zap.String("instance", database.InstanceID),
+					zap.String("database", database.DatabaseName),
+					zap.String("schema", schema.Name),
+					zap.String("table", table.Name),
+					zap.Error(err))
+			}
+			for _, column := range columns {
+				column.DiscoveredClassification = c.Classify(column.Name, samples[column.Name])
+				if c.Mode() == storepb.DataClassificationSetting_DataClassificationConfig_Discovery_APPLY {
+					column.Classification = column.DiscoveredClassification
+				}
+			}
+		}
+	}
+}
+
+func getClassifier(ctx context.Context, stores *store.Store, database *store.DatabaseMessage) (*classifier.Classifier, error) {
+	project, err := stores.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	if project == nil || project.DataClassificationConfigID == "" {
+		return nil, nil
+	}
+	setting, err := stores.GetDataClassificationSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range setting.Configs {
+		if config.Id == project.DataClassificationConfigID {
+			return classifier.NewClassifier(config)
+		}
+	}
+	return nil, nil
+}
+
+// sampleTable returns the sampled non-NULL values of the text columns keyed by the column name.
+// The values are only sampled from the engines supporting LIMIT.
+func sampleTable(ctx context.Context, driver db.Driver, schemaName string, tableName string, columns []*storepb.ColumnMetadata, sampleSize int) (map[string][]string, error) {
+	var quote func(string) string
+	var table string
+	switch driver.GetType() {
+	case db.MySQL, db.TiDB, db.MariaDB, db.OceanBase:
+		quote = func(identifier string) string {
+			return "`" + strings.ReplaceAll(identifier, "`", "``") + "`"
+		}
+		table = quote(tableName)
+	case db.Postgres:
+		quote = func(identifier string) string {
+			return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+		}
+		table = quote(schemaName) + "." + quote(tableName)
+	default:
+		return nil, nil
+	}
+
+	var textColumns []string
+	var quotedColumns []string
+	for _, column := range columns {
+		if !isTextType(column.Type) {
+			continue
+		}
+		textColumns = append(textColumns, column.Name)
+		quotedColumns = append(quotedColumns, quote(column.Name))
+	}
+	if len(textColumns) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sampleTableTimeout)
+	defer cancel()
+	statement := fmt.Sprintf("SELECT %s FROM %s LIMIT %d", strings.Join(quotedColumns, ", "), table, sampleSize)
+	rows, err := driver.GetDB().QueryContext(ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	samples := make(map[string][]string)
+	values := make([]sql.NullString, len(textColumns))
+	scanArgs := make([]any, len(textColumns))
+	for i := range values {
+		scanArgs[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(scanArgs...); err != nil {
+			return nil, err
+		}
+		for i, value := range values {
+			if value.Valid {
+				samples[textColumns[i]] = append(samples[textColumns[i]], value.String)
+			}
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return samples, nil
+}
+
+// isTextType returns true if the column type is a character type, which stores the sensitive data such as emails and phone numbers.
+func isTextType(columnType string) bool {
+	columnType = strings.ToLower(columnType)
+	for _, keyword := range []string{"char", "text", "string", "clob"} {
+		if strings.Contains(columnType, keyword) {
+			return true
+		}
+	}
+	return false
+}
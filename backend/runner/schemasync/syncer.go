@@ -19,6 +19,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	"github.com/bytebase/bytebase/backend/component/state"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	metricAPI "github.com/bytebase/bytebase/backend/metric"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
@@ -260,6 +261,8 @@ func (s *Syncer) SyncInstance(ctx context.Context, instance *store.InstanceMessa
 		}
 	}
 
+	metricAPI.ObserveInstanceSchemaSync(instance.ResourceID)
+
 	var databaseList []string
 	for _, database := range instanceMeta.Databases {
 		databaseList = append(databaseList, database.Name)
@@ -287,6 +290,7 @@ func (s *Syncer) SyncDatabaseSchema(ctx context.Context, database *store.Databas
 		return err
 	}
 	setClassificationAndUserCommentFromComment(databaseMetadata)
+	discoverSensitiveData(ctx, s.store, database, databaseMetadata, driver)
 
 	var patchSchemaVersion *string
 	if force {
@@ -354,6 +358,8 @@ func syncDBSchema(ctx context.Context, stores *store.Store, database *store.Data
 func equalDatabaseMetadata(x, y *storepb.DatabaseSchemaMetadata) bool {
 	return cmp.Equal(x, y, protocmp.Transform(),
 		protocmp.IgnoreFields(&storepb.TableMetadata{}, "row_count", "data_size", "index_size", "data_free"),
+		// The classifications detected by the sensitive data discovery don't change the schema.
+		protocmp.IgnoreFields(&storepb.ColumnMetadata{}, "classification", "discovered_classification"),
 	)
 }
 
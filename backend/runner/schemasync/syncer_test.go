@@ -128,6 +128,47 @@ func TestEqualDatabaseMetadata(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			x: &storepb.DatabaseSchemaMetadata{
+				Name: "hello",
+				Schemas: []*storepb.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []*storepb.TableMetadata{
+							{
+								Name: "students",
+								Columns: []*storepb.ColumnMetadata{
+									{
+										Name: "email",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			y: &storepb.DatabaseSchemaMetadata{
+				Name: "hello",
+				Schemas: []*storepb.SchemaMetadata{
+					{
+						Name: "public",
+						Tables: []*storepb.TableMetadata{
+							{
+								Name: "students",
+								Columns: []*storepb.ColumnMetadata{
+									{
+										Name:                     "email",
+										Classification:           "1-1",
+										DiscoveredClassification: "1-1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: true,
+		},
 	}
 	for _, test := range tests {
 		got := equalDatabaseMetadata(test.x, test.y)
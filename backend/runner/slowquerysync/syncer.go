@@ -119,8 +119,8 @@ func (s *Syncer) syncInstanceSlowQuery(ctx context.Context, instance *store.Inst
 	}
 
 	switch instance.Engine {
-	case db.MySQL:
-		return s.syncMySQLSlowQuery(ctx, instance)
+	case db.MySQL, db.MSSQL, db.Oracle, db.Snowflake:
+		return s.syncInstanceLevelSlowQuery(ctx, instance)
 	case db.Postgres:
 		return s.syncPostgreSQLSlowQuery(ctx, instance, project)
 	default:
@@ -288,7 +288,8 @@ func getLatestLogTime(logMap map[string]*storepb.SlowQueryStatistics) time.Time
 	return time.Time{}
 }
 
-func (s *Syncer) syncMySQLSlowQuery(ctx context.Context, instance *store.InstanceMessage) error {
+// syncInstanceLevelSlowQuery syncs the slow query logs of the whole instance day by day.
+func (s *Syncer) syncInstanceLevelSlowQuery(ctx context.Context, instance *store.InstanceMessage) error {
 	today := time.Now().UTC().Truncate(24 * time.Hour)
 
 	earliestDate := today.AddDate(0, 0, -retentionCycle)
@@ -0,0 +1,98 @@
+package taskcheck
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// NewPGOnlineSyncExecutor creates a task check PostgreSQL online migration sync executor.
+func NewPGOnlineSyncExecutor(store *store.Store, dbFactory *dbfactory.DBFactory) Executor {
+	return &PGOnlineSyncExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+	}
+}
+
+// PGOnlineSyncExecutor is the task check PostgreSQL online migration sync executor.
+type PGOnlineSyncExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+}
+
+// Run will run the task check PostgreSQL online migration sync executor once.
+func (e *PGOnlineSyncExecutor) Run(ctx context.Context, _ *store.TaskCheckRunMessage, task *store.TaskMessage) ([]api.TaskCheckResult, error) {
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return nil, err
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %d not found", task.InstanceID)
+	}
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &api.TaskDatabaseSchemaUpdatePGOnlineSyncPayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return nil, common.Wrapf(err, common.Internal, "invalid database schema update online migration sync payload")
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, payload.SheetID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get sheet statement by id: %d", payload.SheetID)
+	}
+
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	// To avoid leaking the rendered statement, the error message should use the original statement and not the rendered statement.
+	renderedStatement := utils.RenderStatement(statement, materials)
+
+	migration, err := pg.NewOnlineMigration(renderedStatement)
+	if err != nil {
+		return []api.TaskCheckResult{
+			{
+				Status:    api.TaskCheckStatusError,
+				Namespace: api.BBNamespace,
+				Code:      common.Internal.Int(),
+				Title:     "Unsupported statement",
+				Content:   err.Error(),
+			},
+		}, nil
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+
+	if err := migration.Check(ctx, driver.GetDB()); err != nil {
+		return []api.TaskCheckResult{
+			{
+				Status:    api.TaskCheckStatusError,
+				Namespace: api.BBNamespace,
+				Code:      common.Internal.Int(),
+				Title:     "Online migration dry run failed",
+				Content:   err.Error(),
+			},
+		}, nil
+	}
+
+	return []api.TaskCheckResult{
+		{
+			Status:    api.TaskCheckStatusSuccess,
+			Namespace: api.BBNamespace,
+			Code:      common.Ok.Int(),
+			Title:     "OK",
+			Content:   "Online migration dry run succeeded",
+		},
+	}, nil
+}
@@ -0,0 +1,105 @@
+package taskcheck
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/runner/backuprun"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+// NewPITRPostgresExecutor creates a task check PITR Postgres executor.
+func NewPITRPostgresExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, storageClient storage.Client, profile config.Profile) Executor {
+	return &PITRPostgresExecutor{
+		store:         store,
+		dbFactory:     dbFactory,
+		storageClient: storageClient,
+		profile:       profile,
+	}
+}
+
+// PITRPostgresExecutor is the task check PITR Postgres executor.
+type PITRPostgresExecutor struct {
+	store         *store.Store
+	dbFactory     *dbfactory.DBFactory
+	storageClient storage.Client
+	profile       config.Profile
+}
+
+// Run will run the task check PITR Postgres executor once.
+// The WAL is archived from the source instance, so it's checked even if the database is recovered to another instance.
+func (e *PITRPostgresExecutor) Run(ctx context.Context, _ *store.TaskCheckRunMessage, task *store.TaskMessage) (result []api.TaskCheckResult, err error) {
+	payload := api.TaskDatabasePITRRestorePayload{}
+	if err := json.Unmarshal([]byte(task.Payload), &payload); err != nil {
+		return nil, errors.Wrapf(err, "invalid PITR restore payload: %s", task.Payload)
+	}
+
+	if payload.BackupID != nil {
+		return []api.TaskCheckResult{
+			{
+				Status:    api.TaskCheckStatusSuccess,
+				Namespace: api.BBNamespace,
+				Code:      common.Ok.Int(),
+				Title:     "OK",
+				Content:   "Ready to do backup restore",
+			},
+		}, nil
+	}
+
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance by ID %d", task.InstanceID)
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance %d not found", task.InstanceID)
+	}
+
+	if !instance.Options.GetPitrEnabled() {
+		return wrapTaskCheckError(errors.Errorf("point-in-time recovery is not enabled for instance %q", instance.Title)), nil
+	}
+
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
+	if err != nil {
+		return nil, err
+	}
+	defer driver.Close(ctx)
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		return nil, errors.Errorf("Failed to cast driver to pg.Driver")
+	}
+
+	if err := pgDriver.CheckServerVersionForPITR(ctx); err != nil {
+		return wrapTaskCheckError(err), nil
+	}
+
+	if err := pgDriver.CheckWALArchivingForPITR(ctx); err != nil {
+		return wrapTaskCheckError(err), nil
+	}
+
+	archive := backuprun.NewWALArchive(e.profile.DataDir, instance.UID, e.profile.BackupStorageBackend, e.storageClient, nil /* keyWrapper */)
+	baseBackups, err := archive.ListBaseBackups(ctx)
+	if err != nil {
+		return wrapTaskCheckError(err), nil
+	}
+	if len(baseBackups) == 0 {
+		return wrapTaskCheckError(errors.Errorf("no base backup of instance %q yet, please enable the automatic backup of the database and wait for the WAL archiving to take the first base backup", instance.Title)), nil
+	}
+
+	return []api.TaskCheckResult{
+		{
+			Status:    api.TaskCheckStatusSuccess,
+			Namespace: api.BBNamespace,
+			Code:      common.Ok.Int(),
+			Title:     "OK",
+			Content:   "Ready to do PITR",
+		},
+	}, nil
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	metricAPI "github.com/bytebase/bytebase/backend/metric"
+	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/store"
 )
 
@@ -129,7 +131,13 @@ func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
 							s.stateCfg.InstanceOutstandingConnections[task.InstanceID]--
 							s.stateCfg.Unlock()
 						}()
+						start := time.Now()
 						checkResultList, err := runExecutorOnce(ctx, executor, taskCheckRun, task)
+						status := metricAPI.RunStatusDone
+						if err != nil {
+							status = metricAPI.RunStatusFailed
+						}
+						metricAPI.ObservePlanCheckRun(string(taskCheckRun.Type), status, time.Since(start))
 
 						if err == nil {
 							bytes, err := json.Marshal(api.TaskCheckRunResultPayload{
@@ -219,7 +227,7 @@ func (s *Scheduler) Register(taskType api.TaskCheckType, executor Executor) {
 func (s *Scheduler) getTaskCheck(ctx context.Context, task *store.TaskMessage, creatorID int) ([]*store.TaskCheckRunMessage, error) {
 	var createList []*store.TaskCheckRunMessage
 
-	create, err := s.getPITRTaskCheck(task, creatorID)
+	create, err := s.getPITRTaskCheck(ctx, task, creatorID)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to schedule backup/PITR task check")
 	}
@@ -227,7 +235,7 @@ func (s *Scheduler) getTaskCheck(ctx context.Context, task *store.TaskMessage, c
 		createList = append(createList, create...)
 	}
 
-	if task.Type != api.TaskDatabaseSchemaUpdate && task.Type != api.TaskDatabaseSchemaUpdateSDL && task.Type != api.TaskDatabaseDataUpdate && task.Type != api.TaskDatabaseSchemaUpdateGhostSync {
+	if task.Type != api.TaskDatabaseSchemaUpdate && task.Type != api.TaskDatabaseSchemaUpdateSDL && task.Type != api.TaskDatabaseDataUpdate && task.Type != api.TaskDatabaseSchemaUpdateGhostSync && task.Type != api.TaskDatabaseSchemaUpdatePGOnlineSync {
 		return createList, nil
 	}
 
@@ -350,27 +358,44 @@ func (*Scheduler) getGeneralTaskCheck(task *store.TaskMessage, creatorID int) ([
 }
 
 func (*Scheduler) getGhostTaskCheck(task *store.TaskMessage, creatorID int) ([]*store.TaskCheckRunMessage, error) {
-	if task.Type != api.TaskDatabaseSchemaUpdateGhostSync {
+	var checkType api.TaskCheckType
+	switch task.Type {
+	case api.TaskDatabaseSchemaUpdateGhostSync:
+		checkType = api.TaskCheckGhostSync
+	case api.TaskDatabaseSchemaUpdatePGOnlineSync:
+		checkType = api.TaskCheckPGOnlineSync
+	default:
 		return nil, nil
 	}
 	return []*store.TaskCheckRunMessage{
 		{
 			CreatorID: creatorID,
 			TaskID:    task.ID,
-			Type:      api.TaskCheckGhostSync,
+			Type:      checkType,
 		},
 	}, nil
 }
 
-func (*Scheduler) getPITRTaskCheck(task *store.TaskMessage, creatorID int) ([]*store.TaskCheckRunMessage, error) {
+func (s *Scheduler) getPITRTaskCheck(ctx context.Context, task *store.TaskMessage, creatorID int) ([]*store.TaskCheckRunMessage, error) {
 	if task.Type != api.TaskDatabaseRestorePITRRestore {
 		return nil, nil
 	}
+	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return nil, err
+	}
+	if instance == nil {
+		return nil, errors.Errorf("instance ID not found %v", task.InstanceID)
+	}
+	checkType := api.TaskCheckPITRMySQL
+	if instance.Engine == db.Postgres {
+		checkType = api.TaskCheckPITRPostgres
+	}
 	return []*store.TaskCheckRunMessage{
 		{
 			CreatorID: creatorID,
 			TaskID:    task.ID,
-			Type:      api.TaskCheckPITRMySQL,
+			Type:      checkType,
 		},
 	}, nil
 }
@@ -0,0 +1,282 @@
+package taskrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/mysql"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+const (
+	// batchThrottleInterval is the interval to check the throttle thresholds again while the batched execution is throttled.
+	batchThrottleInterval = 5 * time.Second
+)
+
+// batchDML is the common interface of the batch DML of MySQL and PostgreSQL.
+type batchDML interface {
+	TableName() string
+	Prepare(ctx context.Context, conn *sql.Conn) error
+	EstimateRows(ctx context.Context, conn *sql.Conn) (int64, error)
+	NextBoundary(ctx context.Context, conn *sql.Conn, lowerKey []string, chunkSize int64) ([]string, error)
+	ExecuteChunk(ctx context.Context, conn *sql.Conn, lowerKey, upperKey []string) (int64, error)
+	ThreadsRunning(ctx context.Context, conn *sql.Conn) (int64, error)
+}
+
+// runBatch runs the data update statement in primary key ranged chunks.
+// The progress is saved in the task payload after each chunk, so that a failed task resumes from the last executed chunk.
+func (exec *DataUpdateExecutor) runBatch(ctx context.Context, driverCtx context.Context, task *store.TaskMessage, payload *api.TaskDatabaseDataUpdatePayload, statement string) (bool, *api.TaskRunResultPayload, error) {
+	mi, err := getMigrationInfo(ctx, exec.store, exec.profile, task, db.Data, statement, payload.SchemaVersion, payload.VCSPushEvent)
+	if err != nil {
+		return true, nil, err
+	}
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get driver connection for instance %q", instance.ResourceID)
+	}
+	defer driver.Close(ctx)
+
+	var batchDetail string
+	migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, exec.store, driver, mi, statement, &payload.SheetID, func(execCtx context.Context, execStatement string) error {
+		detail, err := exec.executeBatch(execCtx, task, instance, database, driver, payload, execStatement)
+		if err != nil {
+			return err
+		}
+		batchDetail = detail
+		return nil
+	})
+	if err != nil {
+		return true, nil, err
+	}
+	terminated, result, err := postMigration(ctx, exec.store, exec.activityManager, exec.license, task, payload.VCSPushEvent, mi, migrationID, schema)
+	if err != nil {
+		return terminated, nil, err
+	}
+	if batchDetail != "" {
+		result.Detail = fmt.Sprintf("%s %s", result.Detail, batchDetail)
+	}
+	return terminated, result, nil
+}
+
+func (exec *DataUpdateExecutor) executeBatch(ctx context.Context, task *store.TaskMessage, instance *store.InstanceMessage, database *store.DatabaseMessage, driver db.Driver, payload *api.TaskDatabaseDataUpdatePayload, statement string) (string, error) {
+	config := payload.BatchConfig
+	if config.ChunkSize <= 0 {
+		return "", errors.Errorf("invalid chunk size %d", config.ChunkSize)
+	}
+	var dml batchDML
+	var getReplicaLag func(ctx context.Context, conn *sql.Conn) (time.Duration, error)
+	switch instance.Engine {
+	case db.MySQL:
+		mysqlDML, err := mysql.NewBatchDML(statement)
+		if err != nil {
+			return "", err
+		}
+		dml = mysqlDML
+		if config.MaxReplicaLagMs > 0 {
+			// The replica lag is only visible on the replica, which is connected by the read-only data source.
+			if utils.DataSourceFromInstanceWithType(instance, api.RO) == nil {
+				return "", errors.Errorf("the max replica lag requires a read-only data source connected to the replica of instance %q", instance.ResourceID)
+			}
+			readOnlyDriver, err := exec.dbFactory.GetReadOnlyDatabaseDriver(ctx, instance, database)
+			if err != nil {
+				return "", errors.Wrapf(err, "failed to get the read-only driver connection for instance %q", instance.ResourceID)
+			}
+			defer readOnlyDriver.Close(ctx)
+			getReplicaLag = func(ctx context.Context, _ *sql.Conn) (time.Duration, error) {
+				lag, isReplica, err := mysql.GetReplicaLag(ctx, readOnlyDriver.GetDB())
+				if err != nil {
+					return 0, err
+				}
+				if !isReplica {
+					return 0, errors.Errorf("the read-only data source of instance %q is not connected to a replica", instance.ResourceID)
+				}
+				return lag, nil
+			}
+		}
+	case db.Postgres:
+		pgDML, err := pg.NewBatchDML(statement)
+		if err != nil {
+			return "", err
+		}
+		dml = pgDML
+		getReplicaLag = pg.ReplicaLag
+	default:
+		return "", errors.Errorf("batched execution is not supported for engine %s", instance.Engine)
+	}
+
+	conn, err := driver.GetDB().Conn(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to create connection")
+	}
+	defer conn.Close()
+	if err := dml.Prepare(ctx, conn); err != nil {
+		return "", err
+	}
+	totalRows, err := dml.EstimateRows(ctx, conn)
+	if err != nil {
+		log.Warn("failed to estimate row count", zap.String("table", dml.TableName()), zap.Error(err))
+	}
+	totalChunks := (totalRows + config.ChunkSize - 1) / config.ChunkSize
+
+	progress := &api.DataUpdateBatchProgress{}
+	if payload.BatchProgress != nil {
+		progress = payload.BatchProgress
+		log.Info("resume the batched execution",
+			zap.Int("task", task.ID),
+			zap.Strings("lastKey", progress.LastKey),
+			zap.Int64("chunkCount", progress.ChunkCount),
+		)
+	}
+	createdTs := time.Now().Unix()
+	for {
+		if err := exec.throttleBatch(ctx, task, conn, dml, config, getReplicaLag); err != nil {
+			return "", err
+		}
+		upperKey, err := dml.NextBoundary(ctx, conn, progress.LastKey, config.ChunkSize)
+		if err != nil {
+			return "", err
+		}
+		rowsAffected, err := dml.ExecuteChunk(ctx, conn, progress.LastKey, upperKey)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to execute chunk %d", progress.ChunkCount+1)
+		}
+		progress.LastKey = upperKey
+		progress.ChunkCount++
+		progress.AffectedRows += rowsAffected
+
+		done := upperKey == nil
+		// The chunk has been committed on the database, so it's executed again if the task fails before the progress is saved.
+		// NewBatchDML only accepts the statements having the same effect when executed twice.
+		if err := exec.saveBatchProgress(ctx, task, progress, done); err != nil {
+			return "", err
+		}
+		exec.stateCfg.TaskProgress.Store(task.ID, api.Progress{
+			TotalUnit:     max(totalChunks, progress.ChunkCount),
+			CompletedUnit: progress.ChunkCount,
+			CreatedTs:     createdTs,
+			UpdatedTs:     time.Now().Unix(),
+		})
+		if done {
+			break
+		}
+		exec.updateTaskRunDetail(ctx, task, fmt.Sprintf("Executed chunk %d of about %d, %d rows affected.", progress.ChunkCount, max(totalChunks, progress.ChunkCount), progress.AffectedRows))
+
+		if config.PauseMs > 0 {
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-time.After(time.Duration(config.PauseMs) * time.Millisecond):
+			}
+		}
+	}
+	return fmt.Sprintf("Executed %d chunks, %d rows affected.", progress.ChunkCount, progress.AffectedRows), nil
+}
+
+// throttleBatch blocks while the replica lag or the running threads exceed the thresholds.
+func (exec *DataUpdateExecutor) throttleBatch(ctx context.Context, task *store.TaskMessage, conn *sql.Conn, dml batchDML, config *api.DataUpdateBatchConfig, getReplicaLag func(ctx context.Context, conn *sql.Conn) (time.Duration, error)) error {
+	for {
+		var reason string
+		if config.MaxReplicaLagMs > 0 {
+			lag, err := getReplicaLag(ctx, conn)
+			if err != nil {
+				return errors.Wrap(err, "failed to check the replica lag")
+			}
+			if maxLag := time.Duration(config.MaxReplicaLagMs) * time.Millisecond; lag > maxLag {
+				reason = fmt.Sprintf("the replica lag %s exceeds %s", lag, maxLag)
+			}
+		}
+		if reason == "" && config.MaxThreadsRunning > 0 {
+			threads, err := dml.ThreadsRunning(ctx, conn)
+			if err != nil {
+				return errors.Wrap(err, "failed to check the running threads")
+			}
+			if threads > config.MaxThreadsRunning {
+				reason = fmt.Sprintf("%d running threads exceed %d", threads, config.MaxThreadsRunning)
+			}
+		}
+		if reason == "" {
+			return nil
+		}
+
+		exec.updateTaskRunDetail(ctx, task, fmt.Sprintf("Throttled because %s.", reason))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(batchThrottleInterval):
+		}
+	}
+}
+
+// saveBatchProgress saves the progress in the task payload, and clears it once all chunks are executed.
+func (exec *DataUpdateExecutor) saveBatchProgress(ctx context.Context, task *store.TaskMessage, progress *api.DataUpdateBatchProgress, done bool) error {
+	updatedTask, err := exec.store.GetTaskV2ByID(ctx, task.ID)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get task by id %d", task.ID)
+	}
+	payload := &api.TaskDatabaseDataUpdatePayload{}
+	if err := json.Unmarshal([]byte(updatedTask.Payload), payload); err != nil {
+		return errors.Wrap(err, "invalid database data update payload")
+	}
+	payload.BatchProgress = progress
+	if done {
+		payload.BatchProgress = nil
+	}
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal task payload")
+	}
+	payloadString := string(payloadBytes)
+	if _, err := exec.store.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:        task.ID,
+		UpdaterID: api.SystemBotID,
+		Payload:   &payloadString,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to save the batch progress of task %d", task.ID)
+	}
+	return nil
+}
+
+// updateTaskRunDetail updates the detail of the running task run to report the progress.
+func (exec *DataUpdateExecutor) updateTaskRunDetail(ctx context.Context, task *store.TaskMessage, detail string) {
+	taskRuns, err := exec.store.ListTaskRunsV2(ctx, &store.FindTaskRunMessage{
+		TaskUID: &task.ID,
+		Status:  &[]api.TaskRunStatus{api.TaskRunRunning},
+	})
+	if err != nil || len(taskRuns) == 0 {
+		log.Warn("failed to find the running task run", zap.Int("task", task.ID), zap.Error(err))
+		return
+	}
+	result, err := json.Marshal(&api.TaskRunResultPayload{Detail: detail})
+	if err != nil {
+		log.Warn("failed to marshal task run result", zap.Int("task", task.ID), zap.Error(err))
+		return
+	}
+	resultString := string(result)
+	if _, err := exec.store.UpdateTaskRunStatus(ctx, &store.TaskRunStatusPatch{
+		ID:        taskRuns[0].ID,
+		UpdaterID: api.SystemBotID,
+		Status:    api.TaskRunRunning,
+		Result:    &resultString,
+	}); err != nil {
+		log.Warn("failed to update the task run detail", zap.Int("task", task.ID), zap.Error(err))
+	}
+}
@@ -49,5 +49,12 @@ func (exec *DataUpdateExecutor) RunOnce(ctx context.Context, driverCtx context.C
 	if err != nil {
 		return true, nil, err
 	}
+	if payload.BatchConfig != nil {
+		if payload.RollbackEnabled {
+			// The chunks don't capture the before-images of the changed rows, so the rollback SQL cannot be generated.
+			return true, nil, errors.New("cannot enable both the rollback SQL and the batched execution")
+		}
+		return exec.runBatch(ctx, driverCtx, task, payload, statement)
+	}
 	return runMigration(ctx, driverCtx, exec.store, exec.dbFactory, exec.activityManager, exec.license, exec.stateCfg, exec.profile, task, db.Data, statement, payload.SchemaVersion, &payload.SheetID, payload.VCSPushEvent)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 
@@ -15,8 +16,10 @@ import (
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	metricAPI "github.com/bytebase/bytebase/backend/metric"
 	"github.com/bytebase/bytebase/backend/plugin/db"
-	bbs3 "github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/backupfile"
 	"github.com/bytebase/bytebase/backend/runner/backuprun"
 	"github.com/bytebase/bytebase/backend/store"
 )
@@ -27,21 +30,23 @@ const (
 )
 
 // NewDatabaseBackupExecutor creates a new database backup task executor.
-func NewDatabaseBackupExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile) Executor {
+func NewDatabaseBackupExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, storageClient storage.Client, backupKeyWrapper backupfile.KeyWrapper, profile config.Profile) Executor {
 	return &DatabaseBackupExecutor{
-		store:     store,
-		dbFactory: dbFactory,
-		s3Client:  s3Client,
-		profile:   profile,
+		store:            store,
+		dbFactory:        dbFactory,
+		storageClient:    storageClient,
+		backupKeyWrapper: backupKeyWrapper,
+		profile:          profile,
 	}
 }
 
 // DatabaseBackupExecutor is the task executor for database backup.
 type DatabaseBackupExecutor struct {
-	store     *store.Store
-	dbFactory *dbfactory.DBFactory
-	s3Client  *bbs3.Client
-	profile   config.Profile
+	store            *store.Store
+	dbFactory        *dbfactory.DBFactory
+	storageClient    storage.Client
+	backupKeyWrapper backupfile.KeyWrapper
+	profile          config.Profile
 }
 
 // RunOnce will run database backup once.
@@ -81,7 +86,8 @@ func (exec *DatabaseBackupExecutor) RunOnce(ctx context.Context, _ context.Conte
 		}
 	}
 	log.Debug("Start database backup.", zap.String("instance", instance.Title), zap.String("database", database.DatabaseName), zap.String("backup", backup.Name))
-	backupPayload, backupErr := exec.backupDatabase(ctx, exec.dbFactory, exec.s3Client, exec.profile, instance, database, backup)
+	backupPayload, backupErr := exec.backupDatabase(ctx, exec.dbFactory, exec.storageClient, exec.profile, instance, database, backup)
+	metricAPI.ObserveDatabaseBackup(instance.ResourceID, database.DatabaseName, backupErr == nil)
 	backupStatus := string(api.BackupStatusDone)
 	comment := ""
 	if backupErr != nil {
@@ -138,21 +144,49 @@ func getAvailableFSSpace(path string) (uint64, error) {
 	return stat.Bavail * uint64(stat.Bsize), nil
 }
 
-func dumpBackupFile(ctx context.Context, driver db.Driver, backupFilePath string) (string, error) {
+func dumpBackupFile(ctx context.Context, driver db.Driver, backupFilePath string, keyWrapper backupfile.KeyWrapper) (string, error) {
 	backupFile, err := os.Create(backupFilePath)
 	if err != nil {
 		return "", errors.Errorf("failed to open backup path %q", backupFilePath)
 	}
 	defer backupFile.Close()
-	payload, err := driver.Dump(ctx, backupFile, false /* schemaOnly */)
+	// Backups are always compressed, and encrypted if the backup encryption key is configured.
+	backupWriter, err := backupfile.NewWriter(ctx, backupFile, backupfile.Options{Compress: true, KeyWrapper: keyWrapper})
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to create backup file writer for %q", backupFilePath)
+	}
+	statsWriter := backuprun.NewBackupStatsWriter()
+	payload, err := driver.Dump(ctx, io.MultiWriter(backupWriter, statsWriter), false /* schemaOnly */)
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to dump database to local backup file %q", backupFilePath)
 	}
-	return payload, nil
+	if err := backupWriter.Close(); err != nil {
+		return "", errors.Wrapf(err, "failed to write local backup file %q", backupFilePath)
+	}
+
+	// Record the checksum and the table row counts so that the backup verification can check the restored data.
+	var backupPayload api.BackupPayload
+	if payload != "" {
+		if err := json.Unmarshal([]byte(payload), &backupPayload); err != nil {
+			return "", errors.Wrapf(err, "failed to unmarshal backup payload %q", payload)
+		}
+	}
+	backupPayload.Checksum = statsWriter.Checksum()
+	backupPayload.TableRowCounts = statsWriter.TableRowCounts()
+	backupPayload.Compression = backupfile.CompressionZstd
+	if keyWrapper != nil {
+		backupPayload.EncryptionKeyID = keyWrapper.KeyID()
+	}
+	backupPayload.ContentSize = statsWriter.Size()
+	payloadBytes, err := json.Marshal(backupPayload)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal backup payload")
+	}
+	return string(payloadBytes), nil
 }
 
 // backupDatabase will take a backup of a database.
-func (*DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, instance *store.InstanceMessage, database *store.DatabaseMessage, backup *store.BackupMessage) (string, error) {
+func (exec *DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, storageClient storage.Client, profile config.Profile, instance *store.InstanceMessage, database *store.DatabaseMessage, backup *store.BackupMessage) (string, error) {
 	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
 	if err != nil {
 		return "", err
@@ -160,7 +194,7 @@ func (*DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *db
 	defer driver.Close(ctx)
 
 	backupFilePathLocal := filepath.Join(profile.DataDir, backup.Path)
-	payload, err := dumpBackupFile(ctx, driver, backupFilePathLocal)
+	payload, err := dumpBackupFile(ctx, driver, backupFilePathLocal, exec.backupKeyWrapper)
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to dump backup file %q", backupFilePathLocal)
 	}
@@ -168,23 +202,23 @@ func (*DatabaseBackupExecutor) backupDatabase(ctx context.Context, dbFactory *db
 	switch backup.StorageBackend {
 	case api.BackupStorageBackendLocal:
 		return payload, nil
-	case api.BackupStorageBackendS3:
-		log.Debug("Uploading backup to s3 bucket.", zap.String("bucket", s3Client.GetBucket()), zap.String("path", backupFilePathLocal))
+	case api.BackupStorageBackendS3, api.BackupStorageBackendGCS, api.BackupStorageBackendOSS:
+		log.Debug("Uploading backup to cloud storage.", zap.String("storageBackend", string(backup.StorageBackend)), zap.String("bucket", storageClient.GetBucket()), zap.String("path", backupFilePathLocal))
 		bucketFileToUpload, err := os.Open(backupFilePathLocal)
 		if err != nil {
-			return "", errors.Wrapf(err, "failed to open backup file %q for uploading to s3 bucket", backupFilePathLocal)
+			return "", errors.Wrapf(err, "failed to open backup file %q for uploading to cloud storage", backupFilePathLocal)
 		}
 		defer bucketFileToUpload.Close()
 
-		if _, err := s3Client.UploadObject(ctx, backup.Path, bucketFileToUpload); err != nil {
-			return "", errors.Wrapf(err, "failed to upload backup to AWS S3")
+		if err := storageClient.UploadObject(ctx, backup.Path, bucketFileToUpload); err != nil {
+			return "", errors.Wrapf(err, "failed to upload backup to %s", backup.StorageBackend)
 		}
-		log.Debug("Successfully uploaded backup to s3 bucket.")
+		log.Debug("Successfully uploaded backup to cloud storage.")
 
 		if err := os.Remove(backupFilePathLocal); err != nil {
-			log.Warn("Failed to remove the local backup file after uploading to s3 bucket.", zap.String("path", backupFilePathLocal), zap.Error(err))
+			log.Warn("Failed to remove the local backup file after uploading to cloud storage.", zap.String("path", backupFilePathLocal), zap.Error(err))
 		} else {
-			log.Debug("Successfully removed the local backup file after uploading to s3 bucket.", zap.String("path", backupFilePathLocal))
+			log.Debug("Successfully removed the local backup file after uploading to cloud storage.", zap.String("path", backupFilePathLocal))
 		}
 		return payload, nil
 	default:
@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gosimple/slug"
 	"github.com/pkg/errors"
@@ -23,8 +24,10 @@ import (
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	api "github.com/bytebase/bytebase/backend/legacyapi"
+	metricAPI "github.com/bytebase/bytebase/backend/metric"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	"github.com/bytebase/bytebase/backend/plugin/db/mysql"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
 	parser "github.com/bytebase/bytebase/backend/plugin/parser/sql"
 	"github.com/bytebase/bytebase/backend/plugin/parser/sql/transform"
 	vcsPlugin "github.com/bytebase/bytebase/backend/plugin/vcs"
@@ -49,6 +52,19 @@ type Executor interface {
 
 // RunExecutorOnce wraps a TaskExecutor.RunOnce call with panic recovery.
 func RunExecutorOnce(ctx context.Context, driverCtx context.Context, exec Executor, task *store.TaskMessage) (terminated bool, result *api.TaskRunResultPayload, err error) {
+	start := time.Now()
+	defer func() {
+		status := metricAPI.RunStatusDone
+		switch {
+		case driverCtx.Err() != nil:
+			status = metricAPI.RunStatusCanceled
+		case !terminated && err != nil:
+			status = metricAPI.RunStatusRetry
+		case err != nil:
+			status = metricAPI.RunStatusFailed
+		}
+		metricAPI.ObserveTaskRun(string(task.Type), status, time.Since(start))
+	}()
 	defer func() {
 		if r := recover(); r != nil {
 			panicErr, ok := r.(error)
@@ -259,6 +275,16 @@ func executeMigration(ctx context.Context, driverCtx context.Context, stores *st
 		opts.EndTransactionFunc = getSetOracleTransactionIDFunc(ctx, task, stores)
 	}
 
+	if task.Type == api.TaskDatabaseDataUpdate && instance.Engine == db.Postgres {
+		payload := &api.TaskDatabaseDataUpdatePayload{}
+		if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+			return "", "", errors.Wrap(err, "invalid database data update payload")
+		}
+		if payload.RollbackEnabled {
+			return executePostgresMigrationWithRollback(ctx, driverCtx, stores, driver, task, database, statement, sheetID, mi)
+		}
+	}
+
 	migrationID, schema, err := utils.ExecuteMigrationDefault(ctx, driverCtx, stores, driver, mi, statement, sheetID, opts)
 	if err != nil {
 		return "", "", err
@@ -348,6 +374,93 @@ func getSetOracleTransactionIDFunc(ctx context.Context, task *store.TaskMessage,
 	}
 }
 
+// executePostgresMigrationWithRollback executes the data change and saves the statements reverting it.
+// The before-images of the changed rows are captured during the execution, so the rollback SQL must be enabled before the task runs.
+// If the statement doesn't support generating the rollback SQL, it runs as usual and the rollback SQL fails with the reason.
+func executePostgresMigrationWithRollback(ctx context.Context, driverCtx context.Context, stores *store.Store, driver db.Driver, task *store.TaskMessage, database *store.DatabaseMessage, statement string, sheetID *int, mi *db.MigrationInfo) (string, string, error) {
+	pgDriver, ok := driver.(*pg.Driver)
+	if !ok {
+		return "", "", errors.Errorf("failed to cast driver to pg.Driver")
+	}
+	var rollbackStatement string
+	var rollbackErr error
+	migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, stores, driver, mi, statement, sheetID, func(ctx context.Context, execStatement string) error {
+		generator, err := getPostgresRollbackGenerator(ctx, pgDriver, execStatement)
+		if err != nil {
+			rollbackErr = err
+			_, err := driver.Execute(ctx, execStatement, false /* createDatabase */, db.ExecuteOptions{})
+			return err
+		}
+		if _, err := pgDriver.ExecuteWithRollback(ctx, generator); err != nil {
+			return err
+		}
+		rollbackStatement, rollbackErr = generator.RollbackStatement()
+		return nil
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	if rollbackErr != nil {
+		rollbackSQLStatus := api.RollbackSQLStatusFailed
+		rollbackError := rollbackErr.Error()
+		if _, err := stores.UpdateTaskV2(ctx, &api.TaskPatch{
+			ID:                task.ID,
+			UpdaterID:         api.SystemBotID,
+			RollbackSQLStatus: &rollbackSQLStatus,
+			RollbackError:     &rollbackError,
+		}); err != nil {
+			return "", "", errors.Wrapf(err, "failed to patch task %d with the rollback error", task.ID)
+		}
+		return migrationID, schema, nil
+	}
+
+	project, err := stores.GetProjectV2(ctx, &store.FindProjectMessage{ResourceID: &database.ProjectID})
+	if err != nil {
+		return "", "", errors.Wrapf(err, "failed to get project %q", database.ProjectID)
+	}
+	sheet, err := stores.CreateSheet(ctx, &store.SheetMessage{
+		CreatorID:  api.SystemBotID,
+		ProjectUID: project.UID,
+		Name:       fmt.Sprintf("Sheet for rolling back task %d", task.ID),
+		Statement:  rollbackStatement,
+		Visibility: store.ProjectSheet,
+		Source:     store.SheetFromBytebaseArtifact,
+		Type:       store.SheetForSQL,
+		Payload:    "{}",
+	})
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to create the rollback sheet")
+	}
+	rollbackSQLStatus := api.RollbackSQLStatusDone
+	rollbackError := ""
+	if _, err := stores.UpdateTaskV2(ctx, &api.TaskPatch{
+		ID:                task.ID,
+		UpdaterID:         api.SystemBotID,
+		RollbackSQLStatus: &rollbackSQLStatus,
+		RollbackSheetID:   &sheet.UID,
+		RollbackError:     &rollbackError,
+	}); err != nil {
+		return "", "", errors.Wrapf(err, "failed to patch task %d with the rollback SQL", task.ID)
+	}
+	return migrationID, schema, nil
+}
+
+func getPostgresRollbackGenerator(ctx context.Context, driver *pg.Driver, statement string) (*pg.RollbackGenerator, error) {
+	// We cannot support rollback SQL generation for sheets because it can take lots of resources.
+	if len(statement) > common.MaxSheetSizeForRollback {
+		return nil, errors.Errorf("rollback SQL isn't supported for large sheet")
+	}
+	generator, err := pg.NewRollbackGenerator(statement, common.MaxSheetSizeForRollback)
+	if err != nil {
+		return nil, err
+	}
+	if err := generator.Prepare(ctx, driver.GetDB()); err != nil {
+		return nil, err
+	}
+	return generator, nil
+}
+
 func setThreadIDAndStartBinlogCoordinate(ctx context.Context, conn *sql.Conn, task *store.TaskMessage, store *store.Store) (*store.TaskMessage, error) {
 	payload := &api.TaskDatabaseDataUpdatePayload{}
 	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
@@ -578,7 +691,7 @@ func postMigration(ctx context.Context, stores *store.Store, activityManager *ac
 }
 
 func isWriteBack(ctx context.Context, stores *store.Store, license enterpriseAPI.LicenseService, project *store.ProjectMessage, repo *store.RepositoryMessage, task *store.TaskMessage, vcsPushEvent *vcsPlugin.PushEvent) (string, error) {
-	if task.Type != api.TaskDatabaseSchemaBaseline && task.Type != api.TaskDatabaseSchemaUpdate && task.Type != api.TaskDatabaseSchemaUpdateGhostCutover {
+	if task.Type != api.TaskDatabaseSchemaBaseline && task.Type != api.TaskDatabaseSchemaUpdate && task.Type != api.TaskDatabaseSchemaUpdateGhostCutover && task.Type != api.TaskDatabaseSchemaUpdatePGOnlineCutover {
 		return "", nil
 	}
 	if repo == nil || repo.SchemaPathTemplate == "" {
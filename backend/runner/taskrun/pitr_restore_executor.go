@@ -23,7 +23,8 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/db/mysql"
 	"github.com/bytebase/bytebase/backend/plugin/db/pg"
 	"github.com/bytebase/bytebase/backend/plugin/db/util"
-	bbs3 "github.com/bytebase/bytebase/backend/plugin/storage/s3"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/backupfile"
 	"github.com/bytebase/bytebase/backend/runner/backuprun"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/store"
@@ -31,25 +32,27 @@ import (
 )
 
 // NewPITRRestoreExecutor creates a PITR restore task executor.
-func NewPITRRestoreExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, schemaSyncer *schemasync.Syncer, stateCfg *state.State, profile config.Profile) Executor {
+func NewPITRRestoreExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, storageClient storage.Client, backupKeyWrapper backupfile.KeyWrapper, schemaSyncer *schemasync.Syncer, stateCfg *state.State, profile config.Profile) Executor {
 	return &PITRRestoreExecutor{
-		store:        store,
-		dbFactory:    dbFactory,
-		s3Client:     s3Client,
-		schemaSyncer: schemaSyncer,
-		stateCfg:     stateCfg,
-		profile:      profile,
+		store:            store,
+		dbFactory:        dbFactory,
+		storageClient:    storageClient,
+		backupKeyWrapper: backupKeyWrapper,
+		schemaSyncer:     schemaSyncer,
+		stateCfg:         stateCfg,
+		profile:          profile,
 	}
 }
 
 // PITRRestoreExecutor is the PITR restore task executor.
 type PITRRestoreExecutor struct {
-	store        *store.Store
-	dbFactory    *dbfactory.DBFactory
-	s3Client     *bbs3.Client
-	schemaSyncer *schemasync.Syncer
-	stateCfg     *state.State
-	profile      config.Profile
+	store            *store.Store
+	dbFactory        *dbfactory.DBFactory
+	storageClient    storage.Client
+	backupKeyWrapper backupfile.KeyWrapper
+	schemaSyncer     *schemasync.Syncer
+	stateCfg         *state.State
+	profile          config.Profile
 }
 
 // RunOnce will run the PITR restore task executor once.
@@ -61,8 +64,14 @@ func (exec *PITRRestoreExecutor) RunOnce(ctx context.Context, _ context.Context,
 		return true, nil, errors.Wrapf(err, "invalid PITR restore payload: %s", task.Payload)
 	}
 
-	if (payload.BackupID == nil) == (payload.PointInTimeTs == nil) {
-		return true, nil, errors.Errorf("only one of BackupID and time point can be set")
+	setCount := 0
+	for _, set := range []bool{payload.BackupID != nil, payload.PointInTimeTs != nil, payload.PointInTimeLSN != nil} {
+		if set {
+			setCount++
+		}
+	}
+	if setCount != 1 {
+		return true, nil, errors.Errorf("only one of BackupID, time point and LSN can be set")
 	}
 
 	if !((payload.DatabaseName == nil) == (payload.TargetInstanceID == nil)) {
@@ -75,15 +84,15 @@ func (exec *PITRRestoreExecutor) RunOnce(ctx context.Context, _ context.Context,
 
 	if payload.BackupID != nil {
 		// Restore Backup
-		resultPayload, err := exec.doBackupRestore(ctx, exec.store, exec.dbFactory, exec.s3Client, exec.schemaSyncer, exec.profile, task, payload)
+		resultPayload, err := exec.doBackupRestore(ctx, exec.store, exec.dbFactory, exec.storageClient, exec.schemaSyncer, exec.profile, task, payload)
 		return true, resultPayload, err
 	}
 
-	resultPayload, err := exec.doPITRRestore(ctx, exec.dbFactory, exec.s3Client, exec.profile, task, payload)
+	resultPayload, err := exec.doPITRRestore(ctx, exec.dbFactory, exec.storageClient, exec.profile, task, payload)
 	return true, resultPayload, err
 }
 
-func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, schemaSyncer *schemasync.Syncer, profile config.Profile, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
+func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, storageClient storage.Client, schemaSyncer *schemasync.Syncer, profile config.Profile, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
 	instance, err := stores.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to find database for the backup")
@@ -141,7 +150,7 @@ func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *st
 	)
 
 	// Restore the database to the target database.
-	if err := exec.restoreDatabase(ctx, dbFactory, s3Client, profile, targetInstance, targetDatabase, backup); err != nil {
+	if err := exec.restoreDatabase(ctx, dbFactory, storageClient, profile, targetInstance, targetDatabase, backup); err != nil {
 		return nil, err
 	}
 	// TODO(zp): This should be done in the same transaction as restoreDatabase to guarantee consistency.
@@ -180,7 +189,7 @@ func (exec *PITRRestoreExecutor) doBackupRestore(ctx context.Context, stores *st
 	}, nil
 }
 
-func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
+func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *dbfactory.DBFactory, storageClient storage.Client, profile config.Profile, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
 	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
 		return nil, err
@@ -190,6 +199,20 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 		return nil, err
 	}
 
+	issue, err := exec.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
+	if err != nil {
+		return nil, err
+	}
+	if issue == nil {
+		return nil, errors.Errorf("issue not found for pipeline %v", task.PipelineID)
+	}
+	if instance.Engine == db.Postgres {
+		return exec.doPITRRestorePostgres(ctx, instance, database, issue, task, payload)
+	}
+	if payload.PointInTimeLSN != nil {
+		return nil, errors.Errorf("PITR to the LSN is only supported for PostgreSQL")
+	}
+
 	sourceDriver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
 	if err != nil {
 		return nil, err
@@ -209,14 +232,6 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	// DB.Close is idempotent, so we can feel free to assign sourceDriver to targetDriver first.
 	defer targetDriver.Close(ctx)
 
-	issue, err := exec.store.GetIssueV2(ctx, &store.FindIssueMessage{PipelineID: &task.PipelineID})
-	if err != nil {
-		return nil, err
-	}
-	if issue == nil {
-		return nil, errors.Errorf("issue not found for pipeline %v", task.PipelineID)
-	}
-
 	backupStatus := api.BackupStatusDone
 	backupList, err := exec.store.ListBackupV2(ctx, &store.FindBackupMessage{DatabaseUID: task.DatabaseID, Status: &backupStatus})
 	if err != nil {
@@ -232,13 +247,13 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	}
 
 	log.Debug("Downloading all binlog files")
-	if err := mysqlSourceDriver.FetchAllBinlogFiles(ctx, true /* downloadLatestBinlogFile */, s3Client); err != nil {
+	if err := mysqlSourceDriver.FetchAllBinlogFiles(ctx, true /* downloadLatestBinlogFile */, storageClient); err != nil {
 		return nil, err
 	}
 
 	targetTs := *payload.PointInTimeTs
 	log.Debug("Getting latest backup before or equal to targetTs", zap.Int64("targetTs", targetTs))
-	backup, targetBinlogInfo, err := mysqlSourceDriver.GetLatestBackupBeforeOrEqualTs(ctx, backupList, targetTs, s3Client)
+	backup, targetBinlogInfo, err := mysqlSourceDriver.GetLatestBackupBeforeOrEqualTs(ctx, backupList, targetTs, storageClient)
 	if err != nil {
 		targetTsHuman := time.Unix(targetTs, 0).Format(time.RFC822)
 		log.Error("Failed to get backup before or equal to time",
@@ -252,14 +267,14 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	log.Debug("Got latest backup before or equal to targetTs", zap.String("backup", backup.Name))
 
 	backupAbsPathLocal := backuprun.GetBackupAbsFilePath(profile.DataDir, backup.DatabaseUID, backup.Name)
-	if backup.StorageBackend == api.BackupStorageBackendS3 {
-		if err := downloadBackupFileFromCloud(ctx, s3Client, backup.Path, backupAbsPathLocal); err != nil {
-			return nil, errors.Wrapf(err, "failed to download backup %q from S3", backup.Path)
+	if backup.StorageBackend != api.BackupStorageBackendLocal {
+		if err := downloadBackupFileFromCloud(ctx, storageClient, backup.Path, backupAbsPathLocal); err != nil {
+			return nil, errors.Wrapf(err, "failed to download backup %q from %s", backup.Path, backup.StorageBackend)
 		}
 		defer os.Remove(backupAbsPathLocal)
-		replayBinlogPathList, err := downloadBinlogFilesFromCloud(ctx, s3Client, startBinlogInfo, *targetBinlogInfo, binlogDir)
+		replayBinlogPathList, err := downloadBinlogFilesFromCloud(ctx, storageClient, startBinlogInfo, *targetBinlogInfo, binlogDir)
 		if err != nil {
-			return nil, errors.Wrapf(err, "failed to download binlog files from %s to %s from S3", startBinlogInfo.FileName, targetBinlogInfo.FileName)
+			return nil, errors.Wrapf(err, "failed to download binlog files from %s to %s from %s", startBinlogInfo.FileName, targetBinlogInfo.FileName, backup.StorageBackend)
 		}
 		defer func() {
 			for _, binlogPath := range replayBinlogPathList {
@@ -275,20 +290,35 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 		return nil, errors.Wrapf(err, "failed to open backup file %q", backupAbsPathLocal)
 	}
 	defer backupFile.Close()
+	backupContent, err := backupfile.NewReader(ctx, backupFile, exec.backupKeyWrapper)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read backup file %q", backupAbsPathLocal)
+	}
+	defer backupContent.Close()
 	log.Debug("Successfully opened backup file", zap.String("filename", backupAbsPathLocal))
 
+	// The driver counts the restored bytes of the backup content, which differs from the file size if the backup file is compressed or encrypted.
+	backupBytes := backup.Payload.ContentSize
+	if backupBytes == 0 {
+		backupFileInfo, err := backupFile.Stat()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get stat of backup file %q", backupAbsPathLocal)
+		}
+		backupBytes = backupFileInfo.Size()
+	}
+
 	log.Debug("Start creating and restoring PITR database",
 		zap.String("instance", instance.ResourceID),
 		zap.String("database", database.DatabaseName),
 	)
 
-	if err := exec.updateProgress(ctx, mysqlTargetDriver, task.ID, backupFile, startBinlogInfo, *targetBinlogInfo, binlogDir); err != nil {
+	if err := exec.updateProgress(ctx, mysqlTargetDriver, task.ID, backupBytes, startBinlogInfo, *targetBinlogInfo, binlogDir); err != nil {
 		return nil, errors.Wrap(err, "failed to setup progress update process")
 	}
 
 	if payload.DatabaseName != nil {
 		// case 1: PITR to a new database.
-		if err := mysqlTargetDriver.RestoreBackupToDatabase(ctx, backupFile, *payload.DatabaseName); err != nil {
+		if err := mysqlTargetDriver.RestoreBackupToDatabase(ctx, backupContent, *payload.DatabaseName); err != nil {
 			log.Error("failed to restore full backup in the new database",
 				zap.Int("issueID", issue.UID),
 				zap.String("databaseName", *payload.DatabaseName),
@@ -304,7 +334,7 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 		}
 	} else {
 		// case 2: in-place PITR.
-		if err := mysqlTargetDriver.RestoreBackupToPITRDatabase(ctx, backupFile, database.DatabaseName, issue.CreatedTime.Unix()); err != nil {
+		if err := mysqlTargetDriver.RestoreBackupToPITRDatabase(ctx, backupContent, database.DatabaseName, issue.CreatedTime.Unix()); err != nil {
 			log.Error("failed to restore full backup in the PITR database",
 				zap.Int("issueID", issue.UID),
 				zap.String("databaseName", database.DatabaseName),
@@ -330,7 +360,7 @@ func (exec *PITRRestoreExecutor) doPITRRestore(ctx context.Context, dbFactory *d
 	}, nil
 }
 
-func downloadBinlogFilesFromCloud(ctx context.Context, client *bbs3.Client, startBinlogInfo, targetBinlogInfo api.BinlogInfo, binlogDir string) ([]string, error) {
+func downloadBinlogFilesFromCloud(ctx context.Context, client storage.Client, startBinlogInfo, targetBinlogInfo api.BinlogInfo, binlogDir string) ([]string, error) {
 	replayBinlogPathList, err := mysql.GetBinlogReplayList(startBinlogInfo, targetBinlogInfo, binlogDir)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get binlog replay list in directory %s", binlogDir)
@@ -338,14 +368,14 @@ func downloadBinlogFilesFromCloud(ctx context.Context, client *bbs3.Client, star
 	for _, binlogFilePath := range replayBinlogPathList {
 		// Use path.Join to compose a path on cloud which always uses / as the separator.
 		filePathOnCloud := path.Join(common.GetBinlogRelativeDir(binlogDir), filepath.Base(binlogFilePath))
-		if err := client.DownloadFileFromCloud(ctx, binlogFilePath, filePathOnCloud); err != nil {
+		if err := storage.DownloadFileFromCloud(ctx, client, binlogFilePath, filePathOnCloud); err != nil {
 			return nil, errors.Wrapf(err, "failed to download binlog file %s from the cloud storage", binlogFilePath)
 		}
 	}
 	return replayBinlogPathList, nil
 }
 
-func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, profile config.Profile, issue *store.IssueMessage, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
+func (exec *PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores *store.Store, dbFactory *dbfactory.DBFactory, profile config.Profile, issue *store.IssueMessage, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
 	if payload.BackupID == nil {
 		return nil, errors.Errorf("PITR for Postgres is not implemented")
 	}
@@ -409,7 +439,12 @@ func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores
 		return nil, err
 	}
 	defer pitrDBDriver.Close(ctx)
-	if err := pitrDBDriver.Restore(ctx, backupFile); err != nil {
+	backupContent, err := backupfile.NewReader(ctx, backupFile, exec.backupKeyWrapper)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read backup file %q", backupFileName)
+	}
+	defer backupContent.Close()
+	if err := pitrDBDriver.Restore(ctx, backupContent); err != nil {
 		return nil, errors.Wrapf(err, "failed to restore backup to the PITR database %q", pitrDatabaseName)
 	}
 	return &api.TaskRunResultPayload{
@@ -417,12 +452,7 @@ func (*PITRRestoreExecutor) doRestoreInPlacePostgres(ctx context.Context, stores
 	}, nil
 }
 
-func (exec *PITRRestoreExecutor) updateProgress(ctx context.Context, driver *mysql.Driver, taskID int, backupFile *os.File, startBinlogInfo, targetBinlogInfo api.BinlogInfo, binlogDir string) error {
-	backupFileInfo, err := backupFile.Stat()
-	if err != nil {
-		return errors.Wrapf(err, "failed to get stat of backup file %q", backupFile.Name())
-	}
-	backupFileBytes := backupFileInfo.Size()
+func (exec *PITRRestoreExecutor) updateProgress(ctx context.Context, driver *mysql.Driver, taskID int, backupBytes int64, startBinlogInfo, targetBinlogInfo api.BinlogInfo, binlogDir string) error {
 	replayBinlogPaths, err := mysql.GetBinlogReplayList(startBinlogInfo, targetBinlogInfo, binlogDir)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get binlog replay list from %s to %s in binlog directory %q", startBinlogInfo.FileName, targetBinlogInfo.FileName, binlogDir)
@@ -436,7 +466,7 @@ func (exec *PITRRestoreExecutor) updateProgress(ctx context.Context, driver *mys
 		ticker := time.NewTicker(1 * time.Second)
 		defer ticker.Stop()
 		createdTs := time.Now().Unix()
-		totalUnit := backupFileBytes + totalBinlogBytes
+		totalUnit := backupBytes + totalBinlogBytes
 		exec.stateCfg.TaskProgress.Store(taskID, api.Progress{
 			TotalUnit:     totalUnit,
 			CompletedUnit: 0,
@@ -462,7 +492,7 @@ func (exec *PITRRestoreExecutor) updateProgress(ctx context.Context, driver *mys
 }
 
 // restoreDatabase will restore the database to the instance from the backup.
-func (*PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, s3Client *bbs3.Client, profile config.Profile, instance *store.InstanceMessage, database *store.DatabaseMessage, backup *store.BackupMessage) error {
+func (exec *PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfactory.DBFactory, storageClient storage.Client, profile config.Profile, instance *store.InstanceMessage, database *store.DatabaseMessage, backup *store.BackupMessage) error {
 	driver, err := dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
 	if err != nil {
 		return err
@@ -471,9 +501,9 @@ func (*PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfa
 
 	backupAbsPathLocal := filepath.Join(profile.DataDir, backup.Path)
 
-	if backup.StorageBackend == api.BackupStorageBackendS3 {
-		if err := downloadBackupFileFromCloud(ctx, s3Client, backup.Path, backupAbsPathLocal); err != nil {
-			return errors.Wrapf(err, "failed to download backup %q from S3", backup.Path)
+	if backup.StorageBackend != api.BackupStorageBackendLocal {
+		if err := downloadBackupFileFromCloud(ctx, storageClient, backup.Path, backupAbsPathLocal); err != nil {
+			return errors.Wrapf(err, "failed to download backup %q from %s", backup.Path, backup.StorageBackend)
 		}
 		defer os.Remove(backupAbsPathLocal)
 	}
@@ -483,25 +513,28 @@ func (*PITRRestoreExecutor) restoreDatabase(ctx context.Context, dbFactory *dbfa
 		return errors.Wrapf(err, "failed to open backup file at %s", backupAbsPathLocal)
 	}
 	defer backupFileLocal.Close()
+	backupContent, err := backupfile.NewReader(ctx, backupFileLocal, exec.backupKeyWrapper)
+	if err != nil {
+		return errors.Wrapf(err, "failed to read backup file at %s", backupAbsPathLocal)
+	}
+	defer backupContent.Close()
 
-	if err := driver.Restore(ctx, backupFileLocal); err != nil {
+	if err := driver.Restore(ctx, backupContent); err != nil {
 		return errors.Wrap(err, "failed to restore backup")
 	}
 
 	return nil
 }
 
-func downloadBackupFileFromCloud(ctx context.Context, s3Client *bbs3.Client, backupPath, backupAbsPathLocal string) error {
-	log.Debug("Downloading backup file from s3 bucket.", zap.String("path", backupPath))
-	backupFileDownload, err := os.Create(backupAbsPathLocal)
-	if err != nil {
-		return errors.Wrapf(err, "failed to create local backup file %q for downloading from s3 bucket", backupAbsPathLocal)
+func downloadBackupFileFromCloud(ctx context.Context, storageClient storage.Client, backupPath, backupAbsPathLocal string) error {
+	if storageClient == nil {
+		return errors.Errorf("cloud storage is not configured, cannot download backup file %q", backupPath)
 	}
-	defer backupFileDownload.Close()
-	if _, err := s3Client.DownloadObject(ctx, backupPath, backupFileDownload); err != nil {
-		return errors.Wrapf(err, "failed to download backup file %q from s3 bucket", backupPath)
+	log.Debug("Downloading backup file from cloud storage.", zap.String("bucket", storageClient.GetBucket()), zap.String("path", backupPath))
+	if err := storage.DownloadFileFromCloud(ctx, storageClient, backupAbsPathLocal, backupPath); err != nil {
+		return errors.Wrapf(err, "failed to download backup file %q from cloud storage", backupPath)
 	}
-	log.Debug("Successfully downloaded backup file from s3 bucket.")
+	log.Debug("Successfully downloaded backup file from cloud storage.")
 	return nil
 }
 
@@ -0,0 +1,307 @@
+package taskrun
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/plugin/db/util"
+	"github.com/bytebase/bytebase/backend/resources/postgres"
+	"github.com/bytebase/bytebase/backend/runner/backuprun"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+const (
+	// recoveryTimeout is the timeout for the temporary instance to replay the WAL.
+	recoveryTimeout = 6 * time.Hour
+	// recoveryLogTailSize is the size of the recovery log tail reported when the recovery fails.
+	recoveryLogTailSize = 2048
+)
+
+// doPITRRestorePostgres recovers the database to the point in time by replaying the archived WAL on a base backup.
+// The base backup is recovered by a temporary instance of the bundled PostgreSQL, and the database is dumped from it and restored to the target database.
+func (exec *PITRRestoreExecutor) doPITRRestorePostgres(ctx context.Context, instance *store.InstanceMessage, database *store.DatabaseMessage, issue *store.IssueMessage, task *store.TaskMessage, payload api.TaskDatabasePITRRestorePayload) (*api.TaskRunResultPayload, error) {
+	target := pg.RecoveryTarget{}
+	if payload.PointInTimeLSN != nil {
+		if _, err := pg.ParseLSN(*payload.PointInTimeLSN); err != nil {
+			return nil, err
+		}
+		target.LSN = *payload.PointInTimeLSN
+	} else {
+		target.Time = time.Unix(*payload.PointInTimeTs, 0)
+	}
+
+	sourceDriver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, nil /* database */)
+	if err != nil {
+		return nil, err
+	}
+	defer sourceDriver.Close(ctx)
+	pgSourceDriver, ok := sourceDriver.(*pg.Driver)
+	if !ok {
+		log.Error("Failed to cast driver to pg.Driver")
+		return nil, errors.Errorf("[internal] cast driver to pg.Driver failed")
+	}
+
+	// Archive the WAL up to now, so that the recovery is able to reach the target.
+	archive := backuprun.NewWALArchive(exec.profile.DataDir, instance.UID, exec.profile.BackupStorageBackend, exec.storageClient, exec.backupKeyWrapper)
+	walFile, err := pgSourceDriver.SwitchWAL(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := archive.WaitWALArchived(ctx, walFile); err != nil {
+		return nil, err
+	}
+
+	baseBackups, err := archive.ListBaseBackups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	baseBackup, err := getLatestBaseBackupBeforeTarget(baseBackups, target)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("Got latest base backup before the recovery target", zap.String("baseBackup", baseBackup.Name))
+
+	workDir := filepath.Join(exec.profile.DataDir, "pitr", strconv.Itoa(task.ID))
+	if err := os.RemoveAll(workDir); err != nil {
+		return nil, errors.Wrapf(err, "failed to clean up PITR directory %q", workDir)
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			log.Warn("Failed to remove PITR directory", zap.String("path", workDir), zap.Error(err))
+		}
+	}()
+	baseBackupDir, err := archive.FetchBaseBackup(ctx, baseBackup.Name, filepath.Join(workDir, "base"))
+	if err != nil {
+		return nil, err
+	}
+	walDir := filepath.Join(workDir, "wal")
+	if err := archive.FetchWALFiles(ctx, baseBackup.Meta.StartWALFile, walDir); err != nil {
+		return nil, err
+	}
+	dataDir := filepath.Join(workDir, "data")
+	if err := pg.ExtractBaseBackup(baseBackupDir, dataDir); err != nil {
+		return nil, err
+	}
+	if err := pg.WriteRecoveryConfig(dataDir, walDir, target); err != nil {
+		return nil, err
+	}
+
+	dumpFile, err := os.CreateTemp(workDir, "dump-*.sql")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create the dump file")
+	}
+	defer dumpFile.Close()
+	if err := recoverAndDumpDatabase(ctx, pgSourceDriver.GetDbBinDir(), dataDir, filepath.Join(workDir, "recovery.log"), baseBackup.Meta.Superuser, database.DatabaseName, dumpFile); err != nil {
+		return nil, err
+	}
+	if _, err := dumpFile.Seek(0, 0); err != nil {
+		return nil, errors.Wrap(err, "failed to seek the dump file")
+	}
+
+	targetDatabaseName := database.DatabaseName
+	var targetDriver db.Driver
+	if payload.DatabaseName != nil {
+		// case 1: PITR to a new database, which is created by the previous task.
+		targetInstance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: payload.TargetInstanceID})
+		if err != nil {
+			return nil, err
+		}
+		if targetInstance == nil {
+			return nil, errors.Errorf("target instance %d not found", *payload.TargetInstanceID)
+		}
+		targetDatabase, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{
+			InstanceID:          &targetInstance.ResourceID,
+			DatabaseName:        payload.DatabaseName,
+			IgnoreCaseSensitive: store.IgnoreDatabaseAndTableCaseSensitive(targetInstance),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to find target database %q in instance %q", *payload.DatabaseName, targetInstance.Title)
+		}
+		if targetDatabase == nil {
+			return nil, errors.Errorf("target database %q not found in instance %q", *payload.DatabaseName, targetInstance.Title)
+		}
+		targetDatabaseName = targetDatabase.DatabaseName
+		if targetDriver, err = exec.dbFactory.GetAdminDatabaseDriver(ctx, targetInstance, targetDatabase); err != nil {
+			return nil, err
+		}
+	} else {
+		// case 2: in-place PITR, the cutover task renames the PITR database to the original one.
+		if targetDatabaseName, err = createPITRDatabasePostgres(ctx, pgSourceDriver, database.DatabaseName, issue.CreatedTime.Unix()); err != nil {
+			return nil, err
+		}
+		adminDataSource := utils.DataSourceFromInstanceWithType(instance, api.Admin)
+		if adminDataSource == nil {
+			return nil, common.Errorf(common.Internal, "admin data source not found for instance %q", instance.Title)
+		}
+		if targetDriver, err = exec.dbFactory.GetDataSourceDriver(ctx, instance.Engine, adminDataSource, targetDatabaseName, instance.ResourceID, instance.UID, false /* datashare */, false /* readOnly */, false /* schemaTenantMode */); err != nil {
+			return nil, err
+		}
+	}
+	defer targetDriver.Close(ctx)
+
+	if err := targetDriver.Restore(ctx, dumpFile); err != nil {
+		log.Error("failed to restore the recovered database",
+			zap.Int("issueID", issue.UID),
+			zap.String("databaseName", targetDatabaseName),
+			zap.Error(err))
+		return nil, errors.Wrapf(err, "failed to restore the recovered database to %q", targetDatabaseName)
+	}
+
+	log.Info("PITR restore success", zap.String("target database", targetDatabaseName))
+	return &api.TaskRunResultPayload{
+		Detail: fmt.Sprintf("PITR restore success for target database %q from base backup %q", targetDatabaseName, baseBackup.Name),
+	}, nil
+}
+
+// getLatestBaseBackupBeforeTarget returns the latest base backup which is able to recover to the target.
+func getLatestBaseBackupBeforeTarget(baseBackups []*backuprun.BaseBackup, target pg.RecoveryTarget) (*backuprun.BaseBackup, error) {
+	var targetLSN uint64
+	if target.LSN != "" {
+		lsn, err := pg.ParseLSN(target.LSN)
+		if err != nil {
+			return nil, err
+		}
+		targetLSN = lsn
+	}
+	for i := len(baseBackups) - 1; i >= 0; i-- {
+		baseBackup := baseBackups[i]
+		if target.LSN != "" {
+			stopLSN, err := pg.ParseLSN(baseBackup.Meta.StopLSN)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid stop LSN of base backup %q", baseBackup.Name)
+			}
+			if stopLSN <= targetLSN {
+				return baseBackup, nil
+			}
+			continue
+		}
+		if baseBackup.Meta.StopTs <= target.Time.Unix() {
+			return baseBackup, nil
+		}
+	}
+	if target.LSN != "" {
+		return nil, errors.Errorf("no base backup before LSN %s, the earliest point to recover is after the first base backup", target.LSN)
+	}
+	return nil, errors.Errorf("no base backup before %s, the earliest point to recover is after the first base backup", target.Time.Format(time.RFC822))
+}
+
+// recoverAndDumpDatabase starts a temporary instance on the data directory to recover, and dumps the database after the recovery finishes.
+func recoverAndDumpDatabase(ctx context.Context, binDir, dataDir, logFile, superuser, databaseName string, out *os.File) error {
+	port, err := getFreePort()
+	if err != nil {
+		return err
+	}
+	log.Debug("Starting the temporary instance to recover", zap.String("dataDir", dataDir), zap.Int("port", port))
+	if err := postgres.StartForRecovery(port, binDir, dataDir, logFile); err != nil {
+		return errors.Wrapf(err, "failed to start the instance to recover, log: %s", readLogTail(logFile))
+	}
+	defer func() {
+		if err := postgres.Stop(binDir, dataDir); err != nil {
+			log.Warn("Failed to stop the temporary instance to recover", zap.String("dataDir", dataDir), zap.Error(err))
+		}
+	}()
+
+	driver, err := waitRecoveryFinished(ctx, port, binDir, dataDir, logFile, superuser, databaseName)
+	if err != nil {
+		return err
+	}
+	defer driver.Close(ctx)
+	if _, err := driver.Dump(ctx, out, false /* schemaOnly */); err != nil {
+		return errors.Wrapf(err, "failed to dump the recovered database %q", databaseName)
+	}
+	return nil
+}
+
+func waitRecoveryFinished(ctx context.Context, port int, binDir, dataDir, logFile, superuser, databaseName string) (db.Driver, error) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	timeout := time.After(recoveryTimeout)
+	for {
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			return nil, errors.Errorf("the recovery does not finish in %s", recoveryTimeout)
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		// The postmaster exits if the recovery fails, e.g. the recovery target is not reached.
+		if _, err := os.Stat(filepath.Join(dataDir, "postmaster.pid")); os.IsNotExist(err) {
+			return nil, errors.Errorf("the recovery failed, log: %s", readLogTail(logFile))
+		}
+		driver, err := db.Open(
+			ctx,
+			db.Postgres,
+			db.DriverConfig{DbBinDir: binDir},
+			db.ConnectionConfig{
+				Username: superuser,
+				Host:     common.GetPostgresSocketDir(),
+				Port:     strconv.Itoa(port),
+				Database: databaseName,
+			},
+			db.ConnectionContext{},
+		)
+		if err != nil {
+			// The instance doesn't accept connections during the recovery, because hot_standby is off.
+			continue
+		}
+		var inRecovery bool
+		if err := driver.GetDB().QueryRowContext(ctx, "SELECT pg_is_in_recovery()").Scan(&inRecovery); err != nil || inRecovery {
+			driver.Close(ctx)
+			continue
+		}
+		return driver, nil
+	}
+}
+
+// createPITRDatabasePostgres creates the PITR database owned by the owner of the original database.
+func createPITRDatabasePostgres(ctx context.Context, driver *pg.Driver, databaseName string, suffixTs int64) (string, error) {
+	var owner string
+	if err := driver.GetDB().QueryRowContext(ctx, "SELECT pg_get_userbyid(datdba) FROM pg_database WHERE datname = $1", databaseName).Scan(&owner); err != nil {
+		return "", errors.Wrapf(err, "failed to get the OWNER of database %q", databaseName)
+	}
+	pitrDatabaseName := util.GetPITRDatabaseName(databaseName, suffixTs)
+	// If there's already a PITR database, it means there's a failed trial before this task execution.
+	// We need to clean up the dirty state and start clean for idempotent task execution.
+	if _, err := driver.GetDB().ExecContext(ctx, fmt.Sprintf(`DROP DATABASE IF EXISTS "%s";`, pitrDatabaseName)); err != nil {
+		return "", errors.Wrapf(err, "failed to drop the dirty PITR database %q left from a former task execution", pitrDatabaseName)
+	}
+	if _, err := driver.GetDB().ExecContext(ctx, fmt.Sprintf(`CREATE DATABASE "%s" WITH OWNER "%s";`, pitrDatabaseName, owner)); err != nil {
+		return "", errors.Wrapf(err, "failed to create the PITR database %q", pitrDatabaseName)
+	}
+	return pitrDatabaseName, nil
+}
+
+func getFreePort() (int, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to find a free port")
+	}
+	defer listener.Close()
+	return listener.Addr().(*net.TCPAddr).Port, nil
+}
+
+func readLogTail(logFile string) string {
+	content, err := os.ReadFile(logFile)
+	if err != nil {
+		return ""
+	}
+	if len(content) > recoveryLogTailSize {
+		content = content[len(content)-recoveryLogTailSize:]
+	}
+	return strings.TrimSpace(string(content))
+}
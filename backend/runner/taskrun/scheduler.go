@@ -37,7 +37,8 @@ const (
 
 var (
 	taskCancellationImplemented = map[api.TaskType]bool{
-		api.TaskDatabaseSchemaUpdateGhostSync: true,
+		api.TaskDatabaseSchemaUpdateGhostSync:    true,
+		api.TaskDatabaseSchemaUpdatePGOnlineSync: true,
 	}
 	applicableTaskStatusTransition = map[api.TaskStatus][]api.TaskStatus{
 		api.TaskPendingApproval: {api.TaskPending, api.TaskDone},
@@ -395,6 +396,20 @@ func (s *Scheduler) PatchTask(ctx context.Context, task *store.TaskMessage, task
 				)
 			}
 		}
+		if taskPatched.Type == api.TaskDatabaseSchemaUpdatePGOnlineSync {
+			if err := s.store.CreateTaskCheckRun(ctx, &store.TaskCheckRunMessage{
+				CreatorID: taskPatched.CreatorID,
+				TaskID:    task.ID,
+				Type:      api.TaskCheckPGOnlineSync,
+			}); err != nil {
+				// It's OK if we failed to trigger a check, just emit an error log
+				log.Error("Failed to trigger online migration dry run after changing the task statement",
+					zap.Int("task_id", task.ID),
+					zap.String("task_name", task.Name),
+					zap.Error(err),
+				)
+			}
+		}
 
 		instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 		if err != nil {
@@ -673,11 +688,12 @@ func (s *Scheduler) ClearRunningTasks(ctx context.Context) error {
 
 var (
 	allowedStatementUpdateTaskTypes = map[api.TaskType]bool{
-		api.TaskDatabaseCreate:                true,
-		api.TaskDatabaseSchemaUpdate:          true,
-		api.TaskDatabaseSchemaUpdateSDL:       true,
-		api.TaskDatabaseDataUpdate:            true,
-		api.TaskDatabaseSchemaUpdateGhostSync: true,
+		api.TaskDatabaseCreate:                   true,
+		api.TaskDatabaseSchemaUpdate:             true,
+		api.TaskDatabaseSchemaUpdateSDL:          true,
+		api.TaskDatabaseDataUpdate:               true,
+		api.TaskDatabaseSchemaUpdateGhostSync:    true,
+		api.TaskDatabaseSchemaUpdatePGOnlineSync: true,
 	}
 	allowedPatchStatementStatus = map[api.TaskStatus]bool{
 		api.TaskPendingApproval: true,
@@ -110,6 +110,14 @@ func (s *SchedulerV2) scheduleAutoRolloutTask(ctx context.Context, taskUID int)
 	if err != nil {
 		return errors.Wrapf(err, "failed to get task")
 	}
+	// The tasks of the scheduled plans are run by the plan schedule runner.
+	scheduled, err := s.isPipelineScheduled(ctx, task.PipelineID)
+	if err != nil {
+		return err
+	}
+	if scheduled {
+		return nil
+	}
 
 	instance, err := s.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
 	if err != nil {
@@ -596,7 +604,12 @@ func (s *SchedulerV2) ListenTaskSkippedOrDone(ctx context.Context) {
 					log.Error("failed to create ActivityPipelineStageStatusUpdate activity", zap.Error(err))
 				}
 
-				if pipelineDone {
+				// The issue of a scheduled plan stays open for the following occurrences.
+				scheduled, err := s.isPipelineScheduled(ctx, task.PipelineID)
+				if err != nil {
+					return err
+				}
+				if pipelineDone && !scheduled {
 					// Every task in the pipeline has finished.
 					// Resolve the issue.
 					if err := func() error {
@@ -708,6 +721,15 @@ func (s *SchedulerV2) ClearRunningTaskRuns(ctx context.Context) error {
 	return nil
 }
 
+// isPipelineScheduled returns whether the pipeline belongs to a plan with a recurring schedule.
+func (s *SchedulerV2) isPipelineScheduled(ctx context.Context, pipelineUID int) (bool, error) {
+	plan, err := s.store.GetPlan(ctx, &store.FindPlanMessage{PipelineID: &pipelineUID})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to get plan")
+	}
+	return plan != nil && plan.Config.Schedule != nil, nil
+}
+
 func tasksSkippedOrDone(tasks []*store.TaskMessage) (bool, error) {
 	for _, task := range tasks {
 		skipped, err := utils.GetTaskSkipped(task)
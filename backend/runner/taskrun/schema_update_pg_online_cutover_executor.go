@@ -0,0 +1,108 @@
+package taskrun
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/activity"
+	"github.com/bytebase/bytebase/backend/component/config"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/runner/schemasync"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// NewSchemaUpdatePGOnlineCutoverExecutor creates a schema update (PostgreSQL online migration) cutover task executor.
+func NewSchemaUpdatePGOnlineCutoverExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, activityManager *activity.Manager, license enterpriseAPI.LicenseService, schemaSyncer *schemasync.Syncer, profile config.Profile) Executor {
+	return &SchemaUpdatePGOnlineCutoverExecutor{
+		store:           store,
+		dbFactory:       dbFactory,
+		activityManager: activityManager,
+		license:         license,
+		schemaSyncer:    schemaSyncer,
+		profile:         profile,
+	}
+}
+
+// SchemaUpdatePGOnlineCutoverExecutor is the schema update (PostgreSQL online migration) cutover task executor.
+type SchemaUpdatePGOnlineCutoverExecutor struct {
+	store           *store.Store
+	dbFactory       *dbfactory.DBFactory
+	activityManager *activity.Manager
+	license         enterpriseAPI.LicenseService
+	schemaSyncer    *schemasync.Syncer
+	profile         config.Profile
+}
+
+// RunOnce will run SchemaUpdatePGOnlineCutover task once.
+func (e *SchemaUpdatePGOnlineCutoverExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage) (bool, *api.TaskRunResultPayload, error) {
+	if len(task.BlockedBy) != 1 {
+		return true, nil, errors.Errorf("failed to find task dag for ToTask %v", task.ID)
+	}
+	syncTaskID := task.BlockedBy[0]
+
+	instance, err := e.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	database, err := e.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+
+	syncTask, err := e.store.GetTaskV2ByID(ctx, syncTaskID)
+	if err != nil {
+		return true, nil, errors.Wrap(err, "failed to get schema update online migration sync task for cutover task")
+	}
+	payload := &api.TaskDatabaseSchemaUpdatePGOnlineSyncPayload{}
+	if err := json.Unmarshal([]byte(syncTask.Payload), payload); err != nil {
+		return true, nil, errors.Wrap(err, "invalid database schema update online migration sync payload")
+	}
+	statement, err := e.store.GetSheetStatementByID(ctx, payload.SheetID)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get sheet statement by id: %d", payload.SheetID)
+	}
+	statement = strings.TrimSpace(statement)
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	migration, err := pg.NewOnlineMigration(utils.RenderStatement(statement, materials))
+	if err != nil {
+		return true, nil, err
+	}
+
+	mi, err := getMigrationInfo(ctx, e.store, e.profile, task, db.Migrate, statement, payload.SchemaVersion, payload.VCSPushEvent)
+	if err != nil {
+		return true, nil, err
+	}
+	driver, err := e.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return true, nil, err
+	}
+	defer driver.Close(ctx)
+
+	execFunc := func(execCtx context.Context, _ string) error {
+		return migration.Cutover(execCtx, driver.GetDB())
+	}
+	// Not using the rendered statement here because we want to avoid leaking the rendered statement.
+	migrationID, schema, err := utils.ExecuteMigrationWithFunc(ctx, driverCtx, e.store, driver, mi, statement, &payload.SheetID, execFunc)
+	if err := e.schemaSyncer.SyncDatabaseSchema(ctx, database, true /* force */); err != nil {
+		log.Error("failed to sync database schema",
+			zap.String("instanceName", instance.ResourceID),
+			zap.String("databaseName", database.DatabaseName),
+			zap.Error(err),
+		)
+	}
+	if err != nil {
+		return true, nil, err
+	}
+
+	return postMigration(ctx, e.store, e.activityManager, e.license, task, payload.VCSPushEvent, mi, migrationID, schema)
+}
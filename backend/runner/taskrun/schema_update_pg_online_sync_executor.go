@@ -0,0 +1,98 @@
+package taskrun
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/state"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/plugin/db/pg"
+	"github.com/bytebase/bytebase/backend/store"
+	"github.com/bytebase/bytebase/backend/utils"
+)
+
+// NewSchemaUpdatePGOnlineSyncExecutor creates a schema update (PostgreSQL online migration) sync task executor.
+func NewSchemaUpdatePGOnlineSyncExecutor(store *store.Store, dbFactory *dbfactory.DBFactory, stateCfg *state.State) Executor {
+	return &SchemaUpdatePGOnlineSyncExecutor{
+		store:     store,
+		dbFactory: dbFactory,
+		stateCfg:  stateCfg,
+	}
+}
+
+// SchemaUpdatePGOnlineSyncExecutor is the schema update (PostgreSQL online migration) sync task executor.
+// It creates the shadow table with the trigger replaying changes, and copies the existing rows into the shadow table.
+type SchemaUpdatePGOnlineSyncExecutor struct {
+	store     *store.Store
+	dbFactory *dbfactory.DBFactory
+	stateCfg  *state.State
+}
+
+// RunOnce will run SchemaUpdatePGOnlineSync task once.
+func (exec *SchemaUpdatePGOnlineSyncExecutor) RunOnce(ctx context.Context, driverCtx context.Context, task *store.TaskMessage) (terminated bool, result *api.TaskRunResultPayload, err error) {
+	payload := &api.TaskDatabaseSchemaUpdatePGOnlineSyncPayload{}
+	if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+		return true, nil, errors.Wrap(err, "invalid database schema update online migration sync payload")
+	}
+	instance, err := exec.store.GetInstanceV2(ctx, &store.FindInstanceMessage{UID: &task.InstanceID})
+	if err != nil {
+		return true, nil, err
+	}
+	if instance == nil {
+		return true, nil, errors.Errorf("instance %d not found", task.InstanceID)
+	}
+	database, err := exec.store.GetDatabaseV2(ctx, &store.FindDatabaseMessage{UID: task.DatabaseID})
+	if err != nil {
+		return true, nil, err
+	}
+	if database == nil {
+		return true, nil, errors.Errorf("database %d not found", *task.DatabaseID)
+	}
+	statement, err := exec.store.GetSheetStatementByID(ctx, payload.SheetID)
+	if err != nil {
+		return true, nil, errors.Wrapf(err, "failed to get sheet statement by id: %d", payload.SheetID)
+	}
+	materials := utils.GetSecretMapFromDatabaseMessage(database)
+	migration, err := pg.NewOnlineMigration(utils.RenderStatement(statement, materials))
+	if err != nil {
+		return true, nil, err
+	}
+
+	driver, err := exec.dbFactory.GetAdminDatabaseDriver(ctx, instance, database)
+	if err != nil {
+		return true, nil, err
+	}
+	defer driver.Close(ctx)
+	sqlDB := driver.GetDB()
+
+	if err := migration.Prepare(driverCtx, sqlDB); err != nil {
+		return true, nil, errors.Wrap(err, "failed to prepare the shadow table")
+	}
+	totalUnit, err := migration.EstimateRowCount(driverCtx, sqlDB)
+	if err != nil {
+		log.Warn("failed to estimate row count", zap.String("table", migration.TableName()), zap.Error(err))
+	}
+	createdTs := time.Now().Unix()
+	if err := migration.Backfill(driverCtx, sqlDB, func(copied int64) {
+		exec.stateCfg.TaskProgress.Store(task.ID, api.Progress{
+			TotalUnit:     max(totalUnit, copied),
+			CompletedUnit: copied,
+			CreatedTs:     createdTs,
+			UpdatedTs:     time.Now().Unix(),
+		})
+	}); err != nil {
+		// Use the task context because the driver context may have been canceled.
+		if cleanupErr := migration.Cleanup(ctx, sqlDB); cleanupErr != nil {
+			log.Error("failed to clean up the shadow table", zap.String("table", migration.TableName()), zap.Error(cleanupErr))
+		}
+		return true, nil, errors.Wrap(err, "failed to copy rows into the shadow table")
+	}
+
+	return true, &api.TaskRunResultPayload{Detail: "sync done"}, nil
+}
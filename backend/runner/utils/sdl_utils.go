@@ -40,13 +40,25 @@ func ComputeDatabaseSchemaDiff(ctx context.Context, instance *store.InstanceMess
 		engine = parser.Postgres
 	case db.MySQL, db.TiDB, db.MariaDB, db.OceanBase:
 		engine = parser.MySQL
+	case db.Oracle:
+		engine = parser.Oracle
+	case db.Snowflake:
+		engine = parser.Snowflake
+	case db.MSSQL:
+		engine = parser.MSSQL
 	default:
 		return "", errors.Errorf("unsupported database engine %q", instance.Engine)
 	}
 
-	sdlFormat, err := transform.SchemaTransform(engine, schema.String())
-	if err != nil {
-		return "", errors.Wrapf(err, "failed to transform SDL format")
+	sdlFormat := schema.String()
+	switch engine {
+	case parser.Oracle, parser.Snowflake, parser.MSSQL:
+		// The differs parse the dumped schema directly, so there is no need to transform it to the SDL format.
+	default:
+		sdlFormat, err = transform.SchemaTransform(engine, sdlFormat)
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to transform SDL format")
+		}
 	}
 	diff, err := differ.SchemaDiff(engine, sdlFormat, newSchema, store.IgnoreDatabaseAndTableCaseSensitive(instance))
 	if err != nil {
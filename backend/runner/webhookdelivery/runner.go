@@ -0,0 +1,144 @@
+// Package webhookdelivery is the runner posting the queued project webhook events.
+package webhookdelivery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/bytebase/bytebase/backend/common/log"
+	"github.com/bytebase/bytebase/backend/plugin/webhook"
+	"github.com/bytebase/bytebase/backend/store"
+)
+
+const (
+	webhookDeliveryRunnerInterval = 1 * time.Second
+	// batchSize is the max number of deliveries posted in one round.
+	batchSize = 100
+	// maxAttempt is the max number of attempts of a delivery before it's marked as FAILED.
+	maxAttempt = 8
+	// baseBackoff is the delay before the second attempt, which doubles on every following attempt.
+	baseBackoff = 10 * time.Second
+)
+
+// NewRunner creates a new webhook delivery runner.
+func NewRunner(store *store.Store) *Runner {
+	return &Runner{
+		store: store,
+	}
+}
+
+// Runner is the runner posting the pending webhook deliveries with exponential backoff.
+type Runner struct {
+	store *store.Store
+}
+
+// Run starts the webhook delivery runner.
+func (r *Runner) Run(ctx context.Context, wg *sync.WaitGroup) {
+	ticker := time.NewTicker(webhookDeliveryRunnerInterval)
+	defer ticker.Stop()
+	defer wg.Done()
+	log.Debug(fmt.Sprintf("Webhook delivery runner started and will run every %v", webhookDeliveryRunnerInterval))
+	for {
+		select {
+		case <-ticker.C:
+			r.runOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	status := store.WebhookDeliveryPending
+	now := time.Now().Unix()
+	limit := batchSize
+	deliveries, err := r.store.ListWebhookDeliveries(ctx, &store.FindWebhookDeliveryMessage{
+		Status:            &status,
+		NextAttemptBefore: &now,
+		Limit:             &limit,
+	})
+	if err != nil {
+		log.Error("Failed to list pending webhook deliveries", zap.Error(err))
+		return
+	}
+
+	// Wait for all the posts, so that a slow receiver will not get the same delivery twice.
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *store.WebhookDeliveryMessage) {
+			defer wg.Done()
+			r.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+}
+
+func (r *Runner) deliver(ctx context.Context, delivery *store.WebhookDeliveryMessage) {
+	postErr := r.post(ctx, delivery)
+
+	attempt := delivery.Attempt + 1
+	update := &store.UpdateWebhookDeliveryMessage{
+		UID:     delivery.UID,
+		Attempt: &attempt,
+	}
+	if postErr == nil {
+		status, lastError := store.WebhookDeliveryDone, ""
+		update.Status, update.LastError = &status, &lastError
+	} else {
+		// The external webhook endpoint might be invalid which is out of our code control, so we just emit a warning.
+		log.Warn("Failed to post webhook event on activity",
+			zap.Int("delivery", delivery.UID),
+			zap.Int("webhook", delivery.WebhookUID),
+			zap.String("activity type", delivery.ActivityType),
+			zap.Int("attempt", attempt),
+			zap.Error(postErr))
+		lastError := postErr.Error()
+		update.LastError = &lastError
+		if attempt >= maxAttempt {
+			status := store.WebhookDeliveryFailed
+			update.Status = &status
+		} else {
+			nextAttemptTs := getNextAttemptTs(time.Now(), attempt)
+			update.NextAttemptTs = &nextAttemptTs
+		}
+	}
+	if err := r.store.UpdateWebhookDelivery(ctx, update); err != nil {
+		log.Error("Failed to update webhook delivery", zap.Int("delivery", delivery.UID), zap.Error(err))
+	}
+}
+
+func (r *Runner) post(ctx context.Context, delivery *store.WebhookDeliveryMessage) error {
+	// Always use the latest URL and secret of the webhook, so that the retries pick up the fix of a misconfigured webhook.
+	hook, err := r.store.GetProjectWebhookV2(ctx, &store.FindProjectWebhookMessage{ID: &delivery.WebhookUID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to get webhook %d", delivery.WebhookUID)
+	}
+	if hook == nil {
+		return errors.Errorf("webhook %d not found", delivery.WebhookUID)
+	}
+
+	var webhookCtx webhook.Context
+	if err := json.Unmarshal([]byte(delivery.Payload), &webhookCtx); err != nil {
+		return errors.Wrapf(err, "failed to unmarshal payload of webhook delivery %d", delivery.UID)
+	}
+	webhookCtx.URL = hook.URL
+	webhookCtx.Secret = hook.Secret
+	webhookCtx.DeliveryID = strconv.Itoa(delivery.UID)
+	return webhook.Post(hook.Type, webhookCtx)
+}
+
+// getNextAttemptTs returns the time of the next attempt after the given number of failed attempts.
+func getNextAttemptTs(now time.Time, attempt int) int64 {
+	if attempt < 1 {
+		attempt = 1
+	}
+	return now.Add(baseBackoff << (attempt - 1)).Unix()
+}
@@ -0,0 +1,25 @@
+package webhookdelivery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetNextAttemptTs(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 0, want: 10 * time.Second},
+		{attempt: 1, want: 10 * time.Second},
+		{attempt: 2, want: 20 * time.Second},
+		{attempt: 3, want: 40 * time.Second},
+		{attempt: maxAttempt - 1, want: 640 * time.Second},
+	}
+	for _, test := range tests {
+		require.Equal(t, now.Add(test.want).Unix(), getNextAttemptTs(now, test.attempt), "attempt %d", test.attempt)
+	}
+}
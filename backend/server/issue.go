@@ -1140,7 +1140,11 @@ func (s *Server) getPipelineCreateForDatabaseSchemaAndDataUpdate(ctx context.Con
 					if err != nil {
 						return nil, err
 					}
-					taskCreateList, taskIndexDAGList, err := createGhostTaskList(database, instance, c.VCSPushEvent, migrationDetail, schemaVersion)
+					createTaskList := createGhostTaskList
+					if instance.Engine == db.Postgres {
+						createTaskList = createPGOnlineTaskList
+					}
+					taskCreateList, taskIndexDAGList, err := createTaskList(database, instance, c.VCSPushEvent, migrationDetail, schemaVersion)
 					if err != nil {
 						return nil, err
 					}
@@ -1828,6 +1832,52 @@ func createGhostTaskList(database *store.DatabaseMessage, instance *store.Instan
 	return taskCreateList, taskIndexDAGList, nil
 }
 
+// creates PostgreSQL online migration TaskCreate list and dependency.
+func createPGOnlineTaskList(database *store.DatabaseMessage, instance *store.InstanceMessage, vcsPushEvent *vcs.PushEvent, detail *api.MigrationDetail, schemaVersion string) ([]*store.TaskMessage, []store.TaskIndexDAG, error) {
+	var taskCreateList []*store.TaskMessage
+	// task "sync"
+	payloadSync := api.TaskDatabaseSchemaUpdatePGOnlineSyncPayload{
+		SheetID:       detail.SheetID,
+		SchemaVersion: schemaVersion,
+		VCSPushEvent:  vcsPushEvent,
+	}
+	bytesSync, err := json.Marshal(payloadSync)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to marshal database schema update online migration sync payload, error: %v", err))
+	}
+	taskCreateList = append(taskCreateList, &store.TaskMessage{
+		Name:              fmt.Sprintf("Update schema online migration sync for database %q", database.DatabaseName),
+		InstanceID:        instance.UID,
+		DatabaseID:        &database.UID,
+		Status:            api.TaskPendingApproval,
+		Type:              api.TaskDatabaseSchemaUpdatePGOnlineSync,
+		EarliestAllowedTs: detail.EarliestAllowedTs,
+		Payload:           string(bytesSync),
+	})
+
+	// task "cutover"
+	payloadCutover := api.TaskDatabaseSchemaUpdatePGOnlineCutoverPayload{}
+	bytesCutover, err := json.Marshal(payloadCutover)
+	if err != nil {
+		return nil, nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to marshal database schema update online migration cutover payload, error: %v", err))
+	}
+	taskCreateList = append(taskCreateList, &store.TaskMessage{
+		Name:              fmt.Sprintf("Update schema online migration cutover for database %q", database.DatabaseName),
+		InstanceID:        instance.UID,
+		DatabaseID:        &database.UID,
+		Status:            api.TaskPendingApproval,
+		Type:              api.TaskDatabaseSchemaUpdatePGOnlineCutover,
+		EarliestAllowedTs: detail.EarliestAllowedTs,
+		Payload:           string(bytesCutover),
+	})
+
+	// task "sync" blocks task "cutover".
+	taskIndexDAGList := []store.TaskIndexDAG{
+		{FromIndex: 0, ToIndex: 1},
+	}
+	return taskCreateList, taskIndexDAGList, nil
+}
+
 // checkCharacterSetCollationOwner checks if the character set, collation and owner are legal according to the dbType.
 func checkCharacterSetCollationOwner(dbType db.Type, characterSet, collation, owner string) error {
 	switch dbType {
@@ -224,6 +224,12 @@ func schemaDiff(c echo.Context) error {
 		engine = parser.MySQL
 	case parser.EngineType(db.TiDB):
 		engine = parser.TiDB
+	case parser.EngineType(db.Oracle):
+		engine = parser.Oracle
+	case parser.EngineType(db.MSSQL):
+		engine = parser.MSSQL
+	case parser.EngineType(db.Snowflake):
+		engine = parser.Snowflake
 	default:
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid database engine %s", request.EngineType))
 	}
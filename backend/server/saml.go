@@ -0,0 +1,148 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	grpcRuntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/api/auth"
+	v1 "github.com/bytebase/bytebase/backend/api/v1"
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/plugin/idp/saml"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+// registerSAMLRoutes registers the SAML 2.0 service provider endpoints. The identity provider
+// posts the SAML response to the assertion consumer service through the browser, which cannot
+// be handled by the frontend, thus the login is completed here.
+func (s *Server) registerSAMLRoutes(g *echo.Group, authService *v1.AuthService, gatewayModifier *auth.GatewayResponseModifier) {
+	// The service provider metadata URL is also the service provider entity ID.
+	g.GET("/metadata/:idp", func(c echo.Context) error {
+		samlIDP, err := s.getSAMLIdentityProvider(c.Request().Context(), c.Param("idp"))
+		if err != nil {
+			return err
+		}
+		spMetadata, err := samlIDP.Metadata()
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate SAML metadata").SetInternal(err)
+		}
+		return c.Blob(http.StatusOK, "application/samlmetadata+xml", spMetadata)
+	})
+
+	// The service provider initiated login redirects the user to the identity provider with a signed AuthnRequest.
+	g.GET("/sso/:idp", func(c echo.Context) error {
+		samlIDP, err := s.getSAMLIdentityProvider(c.Request().Context(), c.Param("idp"))
+		if err != nil {
+			return err
+		}
+		redirectURL, err := samlIDP.AuthnRequestURL(getSAMLRelayState(c.QueryParam("redirect")))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate SAML AuthnRequest").SetInternal(err)
+		}
+		return c.Redirect(http.StatusFound, redirectURL)
+	})
+
+	g.POST("/acs/:idp", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		samlResponse := c.FormValue("SAMLResponse")
+		if samlResponse == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "Missing SAMLResponse")
+		}
+		redirect := getSAMLRelayState(c.FormValue("RelayState"))
+
+		// Login through the auth service so that the user provisioning, MFA and token generation
+		// are the same as the other identity providers. The headers carrying the tokens are
+		// captured and converted to cookies the same way as the grpc-gateway does.
+		stream := &samlLoginTransportStream{}
+		response, err := authService.Login(grpc.NewContextWithServerTransportStream(ctx, stream), &v1pb.LoginRequest{
+			IdpName: fmt.Sprintf("%s%s", common.IdentityProviderNamePrefix, c.Param("idp")),
+			IdpContext: &v1pb.IdentityProviderContext{
+				Context: &v1pb.IdentityProviderContext_SamlContext{
+					SamlContext: &v1pb.SAMLIdentityProviderContext{
+						SamlResponse: samlResponse,
+					},
+				},
+			},
+			Web: true,
+		})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("Failed to login with SAML: %s", status.Convert(err).Message())).SetInternal(err)
+		}
+		if response.MfaTempToken != nil {
+			query := url.Values{}
+			query.Set("mfaTempToken", *response.MfaTempToken)
+			query.Set("redirect", redirect)
+			return c.Redirect(http.StatusFound, fmt.Sprintf("/auth/mfa?%s", query.Encode()))
+		}
+
+		gatewayCtx := grpcRuntime.NewServerMetadataContext(ctx, grpcRuntime.ServerMetadata{HeaderMD: stream.header})
+		if err := gatewayModifier.Modify(gatewayCtx, c.Response(), response); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to set login cookies").SetInternal(err)
+		}
+		return c.Redirect(http.StatusFound, redirect)
+	})
+}
+
+func (s *Server) getSAMLIdentityProvider(ctx context.Context, identityProviderID string) (*saml.IdentityProvider, error) {
+	idp, err := s.store.GetIdentityProvider(ctx, &store.FindIdentityProviderMessage{
+		ResourceID: &identityProviderID,
+	})
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to find identity provider %q", identityProviderID)).SetInternal(err)
+	}
+	if idp == nil || idp.Type != storepb.IdentityProviderType_SAML {
+		return nil, echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("SAML identity provider %q not found", identityProviderID))
+	}
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, "Failed to get workspace setting").SetInternal(err)
+	}
+	if setting.ExternalUrl == "" {
+		return nil, echo.NewHTTPError(http.StatusPreconditionFailed, "External URL is not configured")
+	}
+	samlIDP, err := v1.NewSAMLIdentityProvider(setting.ExternalUrl, idp.ResourceID, idp.Config.GetSamlConfig())
+	if err != nil {
+		return nil, echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to create SAML identity provider %q", identityProviderID)).SetInternal(err)
+	}
+	return samlIDP, nil
+}
+
+// getSAMLRelayState only allows the relative path of Bytebase as the relay state to avoid open redirect.
+func getSAMLRelayState(redirect string) string {
+	if !strings.HasPrefix(redirect, "/") || strings.HasPrefix(redirect, "//") || strings.HasPrefix(redirect, "/\\") {
+		return "/"
+	}
+	return redirect
+}
+
+// samlLoginTransportStream captures the headers set by the auth service login.
+type samlLoginTransportStream struct {
+	header metadata.MD
+}
+
+func (*samlLoginTransportStream) Method() string {
+	return v1pb.AuthService_Login_FullMethodName
+}
+
+func (s *samlLoginTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *samlLoginTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (*samlLoginTransportStream) SetTrailer(metadata.MD) error {
+	return nil
+}
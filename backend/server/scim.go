@@ -0,0 +1,1243 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/bytebase/bytebase/backend/common"
+	"github.com/bytebase/bytebase/backend/common/log"
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	"github.com/bytebase/bytebase/backend/store"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+	v1pb "github.com/bytebase/bytebase/proto/generated-go/v1"
+)
+
+const (
+	scimUserSchema                  = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema                 = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimServiceProviderConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimListResponseSchema          = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema                 = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimContentType                 = "application/scim+json"
+	// scimMaxResults is the maximum number of the resources returned in a list response.
+	scimMaxResults = 200
+)
+
+// scimFilterRegexp matches the equality filter in the form of `attribute eq "value"`, which is used by the identity providers
+// to look up the existing resources before provisioning.
+var scimFilterRegexp = regexp.MustCompile(`^\s*([A-Za-z][\w.]*)\s+(?i:eq)\s+("(?:[^"\\]|\\.)*")\s*$`)
+
+// scimError is the SCIM error response, see https://datatracker.ietf.org/doc/html/rfc7644#section-3.12.
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	SCIMType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+
+	code int
+}
+
+func (e *scimError) Error() string {
+	return e.Detail
+}
+
+func newSCIMError(code int, scimType string, format string, args ...any) *scimError {
+	return &scimError{
+		Schemas:  []string{scimErrorSchema},
+		Status:   strconv.Itoa(code),
+		SCIMType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+		code:     code,
+	}
+}
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+	Location     string `json:"location,omitempty"`
+}
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimUser struct {
+	Schemas     []string    `json:"schemas"`
+	ID          string      `json:"id,omitempty"`
+	UserName    string      `json:"userName"`
+	Name        *scimName   `json:"name,omitempty"`
+	DisplayName string      `json:"displayName,omitempty"`
+	Emails      []scimEmail `json:"emails,omitempty"`
+	Active      *bool       `json:"active,omitempty"`
+	Meta        *scimMeta   `json:"meta,omitempty"`
+}
+
+type scimMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []scimMember `json:"members,omitempty"`
+	Meta        *scimMeta    `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string `json:"schemas"`
+	TotalResults int      `json:"totalResults"`
+	StartIndex   int      `json:"startIndex"`
+	ItemsPerPage int      `json:"itemsPerPage"`
+	Resources    []any    `json:"Resources"`
+}
+
+type scimPatchRequest struct {
+	Operations []*scimPatchOperation `json:"Operations"`
+}
+
+type scimPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// registerSCIMRoutes registers the SCIM 2.0 endpoints used by the identity provider to provision the users and groups.
+// The users are deactivated instead of deleted, and the groups are mapped to the workspace roles or the project roles
+// by the SCIM setting.
+func (s *Server) registerSCIMRoutes(g *echo.Group) {
+	g.Use(s.scimMiddleware)
+
+	g.GET("/ServiceProviderConfig", func(c echo.Context) error {
+		return writeSCIMResponse(c, http.StatusOK, map[string]any{
+			"schemas":        []string{scimServiceProviderConfigSchema},
+			"patch":          map[string]any{"supported": true},
+			"bulk":           map[string]any{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+			"filter":         map[string]any{"supported": true, "maxResults": scimMaxResults},
+			"changePassword": map[string]any{"supported": false},
+			"sort":           map[string]any{"supported": false},
+			"etag":           map[string]any{"supported": false},
+			"authenticationSchemes": []map[string]any{
+				{
+					"type":        "oauthbearertoken",
+					"name":        "OAuth Bearer Token",
+					"description": "Authentication scheme using the bearer token in the SCIM setting",
+					"primary":     true,
+				},
+			},
+		})
+	})
+
+	g.GET("/Users", s.listSCIMUsers)
+	g.POST("/Users", s.createSCIMUser)
+	g.GET("/Users/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user, err := s.getSCIMUser(ctx, c.Param("id"))
+		if err != nil {
+			return err
+		}
+		return writeSCIMResponse(c, http.StatusOK, s.convertToSCIMUser(ctx, user))
+	})
+	g.PUT("/Users/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user, err := s.getSCIMUser(ctx, c.Param("id"))
+		if err != nil {
+			return err
+		}
+		replace := &scimUser{}
+		if err := readSCIMRequest(c, replace); err != nil {
+			return err
+		}
+		user, err = s.updateSCIMUser(ctx, user, replace)
+		if err != nil {
+			return err
+		}
+		return writeSCIMResponse(c, http.StatusOK, s.convertToSCIMUser(ctx, user))
+	})
+	g.PATCH("/Users/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user, err := s.getSCIMUser(ctx, c.Param("id"))
+		if err != nil {
+			return err
+		}
+		patch := &scimPatchRequest{}
+		if err := readSCIMRequest(c, patch); err != nil {
+			return err
+		}
+		updated := s.convertToSCIMUser(ctx, user)
+		if err := applySCIMUserPatch(updated, patch.Operations); err != nil {
+			return err
+		}
+		user, err = s.updateSCIMUser(ctx, user, updated)
+		if err != nil {
+			return err
+		}
+		return writeSCIMResponse(c, http.StatusOK, s.convertToSCIMUser(ctx, user))
+	})
+	// Users are never deleted because they are referenced by the issues and the audit logs, so they are deactivated.
+	g.DELETE("/Users/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		user, err := s.getSCIMUser(ctx, c.Param("id"))
+		if err != nil {
+			return err
+		}
+		active := false
+		if _, err := s.updateSCIMUser(ctx, user, &scimUser{UserName: user.Email, Active: &active}); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusNoContent)
+	})
+
+	g.GET("/Groups", s.listSCIMGroups)
+	g.POST("/Groups", s.createSCIMGroup)
+	g.GET("/Groups/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		group, err := s.getSCIMGroup(ctx, c.Param("id"))
+		if err != nil {
+			return err
+		}
+		return writeSCIMResponse(c, http.StatusOK, s.convertToSCIMGroup(ctx, group))
+	})
+	g.PUT("/Groups/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		replace := &scimGroup{}
+		if err := readSCIMRequest(c, replace); err != nil {
+			return err
+		}
+		memberIDs, err := s.getSCIMMemberIDs(ctx, replace.Members)
+		if err != nil {
+			return err
+		}
+		group, err := s.updateSCIMGroup(ctx, c.Param("id"), func(group *store.SCIMGroupMessage) error {
+			group.DisplayName = replace.DisplayName
+			group.ExternalID = replace.ExternalID
+			group.MemberIDs = memberIDs
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		return writeSCIMResponse(c, http.StatusOK, s.convertToSCIMGroup(ctx, group))
+	})
+	g.PATCH("/Groups/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		patch := &scimPatchRequest{}
+		if err := readSCIMRequest(c, patch); err != nil {
+			return err
+		}
+		group, err := s.updateSCIMGroup(ctx, c.Param("id"), func(group *store.SCIMGroupMessage) error {
+			return s.applySCIMGroupPatch(ctx, group, patch.Operations)
+		})
+		if err != nil {
+			return err
+		}
+		return writeSCIMResponse(c, http.StatusOK, s.convertToSCIMGroup(ctx, group))
+	})
+	g.DELETE("/Groups/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		s.scimGroupMutex.Lock()
+		defer s.scimGroupMutex.Unlock()
+
+		group, err := s.getSCIMGroup(ctx, c.Param("id"))
+		if err != nil {
+			return err
+		}
+		if err := s.store.DeleteSCIMGroup(ctx, group.UID); err != nil {
+			return err
+		}
+		// Revoke the roles mapped from the deleted group.
+		if err := s.syncSCIMGroupMappings(ctx, []string{group.DisplayName}, group.MemberIDs); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+// scimMiddleware authenticates the SCIM requests by the bearer token in the SCIM setting, and converts the errors
+// to the SCIM error responses.
+func (s *Server) scimMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		err := func() error {
+			if err := s.licenseService.IsFeatureEnabled(api.FeatureSSO); err != nil {
+				return newSCIMError(http.StatusForbidden, "", err.Error())
+			}
+			setting, err := s.store.GetSCIMSetting(c.Request().Context())
+			if err != nil {
+				return err
+			}
+			if setting.Token == "" {
+				return newSCIMError(http.StatusForbidden, "", "SCIM provisioning is not enabled")
+			}
+			token, err := extractTokenFromHeader(c)
+			if err != nil || subtle.ConstantTimeCompare([]byte(token), []byte(setting.Token)) != 1 {
+				return newSCIMError(http.StatusUnauthorized, "", "invalid SCIM bearer token")
+			}
+			return next(c)
+		}()
+		if err == nil {
+			return nil
+		}
+
+		var scimErr *scimError
+		if !errors.As(err, &scimErr) {
+			var httpErr *echo.HTTPError
+			if errors.As(err, &httpErr) {
+				scimErr = newSCIMError(httpErr.Code, "", "%v", httpErr.Message)
+			} else {
+				log.Error("Failed to handle SCIM request", zap.String("method", c.Request().Method), zap.String("path", c.Request().URL.Path), zap.Error(err))
+				scimErr = newSCIMError(http.StatusInternalServerError, "", "internal server error")
+			}
+		}
+		return writeSCIMResponse(c, scimErr.code, scimErr)
+	}
+}
+
+func (s *Server) listSCIMUsers(c echo.Context) error {
+	ctx := c.Request().Context()
+	endUser := api.EndUser
+	users, err := s.store.ListUsers(ctx, &store.FindUserMessage{Type: &endUser, ShowDeleted: true})
+	if err != nil {
+		return err
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return users[i].ID < users[j].ID
+	})
+
+	if filter := c.QueryParam("filter"); filter != "" {
+		attribute, value, err := parseSCIMFilter(filter)
+		if err != nil {
+			return err
+		}
+		var match func(*store.UserMessage) bool
+		switch strings.ToLower(attribute) {
+		case "id":
+			match = func(user *store.UserMessage) bool { return strconv.Itoa(user.ID) == value }
+		// The user name is the email.
+		case "username", "emails.value":
+			match = func(user *store.UserMessage) bool { return user.Email == strings.ToLower(value) }
+		default:
+			return newSCIMError(http.StatusBadRequest, "invalidFilter", "unsupported filter attribute %q", attribute)
+		}
+		var filtered []*store.UserMessage
+		for _, user := range users {
+			if match(user) {
+				filtered = append(filtered, user)
+			}
+		}
+		users = filtered
+	}
+
+	var resources []any
+	for _, user := range users {
+		resources = append(resources, s.convertToSCIMUser(ctx, user))
+	}
+	return writeSCIMListResponse(c, resources)
+}
+
+func (s *Server) createSCIMUser(c echo.Context) error {
+	ctx := c.Request().Context()
+	create := &scimUser{}
+	if err := readSCIMRequest(c, create); err != nil {
+		return err
+	}
+	email := strings.ToLower(create.UserName)
+	if email == "" {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "userName is required")
+	}
+	title := getSCIMDisplayName(create)
+	if title == "" {
+		title, _, _ = strings.Cut(email, "@")
+	}
+	// The user signs in with SSO, so the password is never used.
+	password, err := common.RandomString(20)
+	if err != nil {
+		return errors.Wrapf(err, "failed to generate random password")
+	}
+
+	// Create the user as the workspace owner through the auth service, so that the user limit and the validations
+	// are the same as creating the user in the workspace.
+	ownerCtx := context.WithValue(ctx, common.RoleContextKey, api.Owner)
+	ownerCtx = context.WithValue(ownerCtx, common.PrincipalIDContextKey, api.SystemBotID)
+	v1User, err := s.authService.CreateUser(ownerCtx, &v1pb.CreateUserRequest{
+		User: &v1pb.User{
+			Email:    email,
+			Title:    title,
+			UserType: v1pb.UserType_USER,
+			Password: password,
+		},
+	})
+	if err != nil {
+		return convertGRPCErrorToSCIMError(err)
+	}
+	userID, err := common.GetUserID(v1User.Name)
+	if err != nil {
+		return err
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get user %d", userID)
+	}
+	if user == nil {
+		return errors.Errorf("user %d not found", userID)
+	}
+	if create.Active != nil && !*create.Active {
+		if user, err = s.updateSCIMUser(ctx, user, &scimUser{UserName: user.Email, Active: create.Active}); err != nil {
+			return err
+		}
+	}
+	return writeSCIMResponse(c, http.StatusCreated, s.convertToSCIMUser(ctx, user))
+}
+
+func (s *Server) getSCIMUser(ctx context.Context, id string) (*store.UserMessage, error) {
+	userID, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+	}
+	user, err := s.store.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get user %d", userID)
+	}
+	// Only the end users are provisioned, the system bot and the service accounts are managed in Bytebase.
+	if user == nil || user.Type != api.EndUser {
+		return nil, newSCIMError(http.StatusNotFound, "", "user %q not found", id)
+	}
+	return user, nil
+}
+
+// updateSCIMUser updates the email, the name and the state of the user. Deactivating the user revokes the signed in
+// sessions immediately, while the existing SQL proxy connections are rejected on their next query when the user is
+// looked up again. The last active workspace owner can be neither deactivated nor renamed to another email.
+func (s *Server) updateSCIMUser(ctx context.Context, user *store.UserMessage, update *scimUser) (*store.UserMessage, error) {
+	patch := &store.UpdateUserMessage{}
+	if email := strings.ToLower(update.UserName); email != user.Email {
+		if email == "" {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "userName is required")
+		}
+		if _, err := mail.ParseAddress(email); err != nil {
+			return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "userName %q is not a valid email", update.UserName)
+		}
+		existingUser, err := s.store.GetUser(ctx, &store.FindUserMessage{Email: &email, ShowDeleted: true})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get user %q", email)
+		}
+		if existingUser != nil {
+			return nil, newSCIMError(http.StatusConflict, "uniqueness", "userName %q already exists", update.UserName)
+		}
+		patch.Email = &email
+	}
+	if name := getSCIMDisplayName(update); name != "" && name != user.Name {
+		patch.Name = &name
+	}
+	if update.Active != nil && *update.Active == user.MemberDeleted {
+		deleted := !*update.Active
+		patch.Delete = &deleted
+	}
+	if patch.Email == nil && patch.Name == nil && patch.Delete == nil {
+		return user, nil
+	}
+	if patch.Email != nil || (patch.Delete != nil && *patch.Delete) {
+		// Do not lock everyone out of the workspace by deactivating the last workspace owner or taking over its email.
+		lastOwner, err := s.isLastWorkspaceOwner(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+		if lastOwner {
+			return nil, newSCIMError(http.StatusBadRequest, "mutability", "user %q is the last active workspace owner", user.Email)
+		}
+	}
+
+	updatedUser, err := s.store.UpdateUser(ctx, user.ID, patch, api.SystemBotID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to update user %d", user.ID)
+	}
+	if patch.Delete != nil {
+		activityType := api.ActivityMemberActivate
+		if *patch.Delete {
+			activityType = api.ActivityMemberDeactivate
+		}
+		log.Info("SCIM updated user state", zap.String("email", updatedUser.Email), zap.String("activity", string(activityType)))
+		if err := s.createSCIMMemberActivity(ctx, updatedUser, activityType, api.ActivityMemberActivateDeactivatePayload{
+			PrincipalID:    updatedUser.ID,
+			PrincipalName:  updatedUser.Name,
+			PrincipalEmail: updatedUser.Email,
+			Role:           updatedUser.Role,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return updatedUser, nil
+}
+
+func (s *Server) convertToSCIMUser(ctx context.Context, user *store.UserMessage) *scimUser {
+	active := !user.MemberDeleted
+	scimUser := &scimUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          strconv.Itoa(user.ID),
+		UserName:    user.Email,
+		Name:        &scimName{Formatted: user.Name},
+		DisplayName: user.Name,
+		Emails:      []scimEmail{{Value: user.Email, Type: "work", Primary: true}},
+		Active:      &active,
+		Meta:        &scimMeta{ResourceType: "User"},
+	}
+	if baseURL := s.getSCIMBaseURL(ctx); baseURL != "" {
+		scimUser.Meta.Location = fmt.Sprintf("%s/Users/%d", baseURL, user.ID)
+	}
+	return scimUser
+}
+
+func (s *Server) listSCIMGroups(c echo.Context) error {
+	ctx := c.Request().Context()
+	find := &store.FindSCIMGroupMessage{}
+	if filter := c.QueryParam("filter"); filter != "" {
+		attribute, value, err := parseSCIMFilter(filter)
+		if err != nil {
+			return err
+		}
+		switch strings.ToLower(attribute) {
+		case "id":
+			uid, err := strconv.Atoi(value)
+			if err != nil {
+				return writeSCIMListResponse(c, nil)
+			}
+			find.UID = &uid
+		case "displayname":
+			find.DisplayName = &value
+		case "externalid":
+			find.ExternalID = &value
+		default:
+			return newSCIMError(http.StatusBadRequest, "invalidFilter", "unsupported filter attribute %q", attribute)
+		}
+	}
+	groups, err := s.store.ListSCIMGroups(ctx, find)
+	if err != nil {
+		return err
+	}
+
+	excludeMembers := slices.Contains(strings.Split(c.QueryParam("excludedAttributes"), ","), "members")
+	var resources []any
+	for _, group := range groups {
+		scimGroup := s.convertToSCIMGroup(ctx, group)
+		if excludeMembers {
+			scimGroup.Members = nil
+		}
+		resources = append(resources, scimGroup)
+	}
+	return writeSCIMListResponse(c, resources)
+}
+
+func (s *Server) createSCIMGroup(c echo.Context) error {
+	ctx := c.Request().Context()
+	create := &scimGroup{}
+	if err := readSCIMRequest(c, create); err != nil {
+		return err
+	}
+	if create.DisplayName == "" {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	memberIDs, err := s.getSCIMMemberIDs(ctx, create.Members)
+	if err != nil {
+		return err
+	}
+
+	s.scimGroupMutex.Lock()
+	defer s.scimGroupMutex.Unlock()
+	existingGroup, err := s.store.GetSCIMGroup(ctx, &store.FindSCIMGroupMessage{DisplayName: &create.DisplayName})
+	if err != nil {
+		return err
+	}
+	if existingGroup != nil {
+		return newSCIMError(http.StatusConflict, "uniqueness", "group %q already exists", create.DisplayName)
+	}
+	group, err := s.store.CreateSCIMGroup(ctx, &store.SCIMGroupMessage{
+		ExternalID:  create.ExternalID,
+		DisplayName: create.DisplayName,
+		MemberIDs:   memberIDs,
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.syncSCIMGroupMappings(ctx, []string{group.DisplayName}, group.MemberIDs); err != nil {
+		return err
+	}
+	return writeSCIMResponse(c, http.StatusCreated, s.convertToSCIMGroup(ctx, group))
+}
+
+func (s *Server) getSCIMGroup(ctx context.Context, id string) (*store.SCIMGroupMessage, error) {
+	uid, err := strconv.Atoi(id)
+	if err != nil {
+		return nil, newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+	}
+	group, err := s.store.GetSCIMGroup(ctx, &store.FindSCIMGroupMessage{UID: &uid})
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+	}
+	return group, nil
+}
+
+// updateSCIMGroup updates the group by the modify function, and syncs the roles mapped from the group for all the old
+// and new members, so that re-pushing the group from the identity provider reconciles the roles.
+func (s *Server) updateSCIMGroup(ctx context.Context, id string, modify func(*store.SCIMGroupMessage) error) (*store.SCIMGroupMessage, error) {
+	s.scimGroupMutex.Lock()
+	defer s.scimGroupMutex.Unlock()
+
+	group, err := s.getSCIMGroup(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	oldDisplayName, oldMemberIDs := group.DisplayName, group.MemberIDs
+	group.MemberIDs = slices.Clone(group.MemberIDs)
+	if err := modify(group); err != nil {
+		return nil, err
+	}
+	if group.DisplayName == "" {
+		return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "displayName is required")
+	}
+	if group.DisplayName != oldDisplayName {
+		existingGroup, err := s.store.GetSCIMGroup(ctx, &store.FindSCIMGroupMessage{DisplayName: &group.DisplayName})
+		if err != nil {
+			return nil, err
+		}
+		if existingGroup != nil {
+			return nil, newSCIMError(http.StatusConflict, "uniqueness", "group %q already exists", group.DisplayName)
+		}
+	}
+
+	updatedGroup, err := s.store.UpdateSCIMGroup(ctx, &store.UpdateSCIMGroupMessage{
+		UID:         group.UID,
+		ExternalID:  &group.ExternalID,
+		DisplayName: &group.DisplayName,
+		MemberIDs:   &group.MemberIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if updatedGroup == nil {
+		return nil, newSCIMError(http.StatusNotFound, "", "group %q not found", id)
+	}
+	groupNames := []string{oldDisplayName}
+	if updatedGroup.DisplayName != oldDisplayName {
+		groupNames = append(groupNames, updatedGroup.DisplayName)
+	}
+	if err := s.syncSCIMGroupMappings(ctx, groupNames, mergeSCIMMemberIDs(oldMemberIDs, updatedGroup.MemberIDs)); err != nil {
+		return nil, err
+	}
+	return updatedGroup, nil
+}
+
+func (s *Server) applySCIMGroupPatch(ctx context.Context, group *store.SCIMGroupMessage, operations []*scimPatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		if op != "add" && op != "replace" && op != "remove" {
+			return newSCIMError(http.StatusBadRequest, "invalidSyntax", "unsupported patch operation %q", operation.Op)
+		}
+
+		path := strings.TrimSpace(operation.Path)
+		if path == "" {
+			// The value is a partial group with the attributes to replace.
+			var value scimGroup
+			if err := json.Unmarshal(operation.Value, &value); err != nil {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid patch value: %v", err)
+			}
+			if value.DisplayName != "" {
+				group.DisplayName = value.DisplayName
+			}
+			if value.ExternalID != "" {
+				group.ExternalID = value.ExternalID
+			}
+			if value.Members != nil {
+				memberIDs, err := s.getSCIMMemberIDs(ctx, value.Members)
+				if err != nil {
+					return err
+				}
+				if op == "add" {
+					memberIDs = mergeSCIMMemberIDs(group.MemberIDs, memberIDs)
+				}
+				group.MemberIDs = memberIDs
+			}
+			continue
+		}
+
+		// The member is removed by the path in the form of `members[value eq "{id}"]`.
+		if strings.HasPrefix(strings.ToLower(path), "members[") && strings.HasSuffix(path, "]") {
+			if op != "remove" {
+				return newSCIMError(http.StatusBadRequest, "invalidPath", "unsupported patch path %q for operation %q", path, operation.Op)
+			}
+			attribute, value, err := parseSCIMFilter(path[len("members[") : len(path)-1])
+			if err != nil {
+				return err
+			}
+			if strings.ToLower(attribute) != "value" {
+				return newSCIMError(http.StatusBadRequest, "invalidPath", "unsupported patch path %q", path)
+			}
+			group.MemberIDs = removeSCIMMemberIDs(group.MemberIDs, value)
+			continue
+		}
+
+		switch strings.ToLower(path) {
+		case "members":
+			var members []scimMember
+			if len(operation.Value) > 0 {
+				if err := json.Unmarshal(operation.Value, &members); err != nil {
+					return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid members: %v", err)
+				}
+			}
+			switch op {
+			case "remove":
+				// Remove all the members if the value is not specified.
+				if len(members) == 0 {
+					group.MemberIDs = []int{}
+				}
+				for _, member := range members {
+					group.MemberIDs = removeSCIMMemberIDs(group.MemberIDs, member.Value)
+				}
+			default:
+				memberIDs, err := s.getSCIMMemberIDs(ctx, members)
+				if err != nil {
+					return err
+				}
+				if op == "add" {
+					memberIDs = mergeSCIMMemberIDs(group.MemberIDs, memberIDs)
+				}
+				group.MemberIDs = memberIDs
+			}
+		case "displayname":
+			if op == "remove" {
+				return newSCIMError(http.StatusBadRequest, "mutability", "displayName is required")
+			}
+			if err := json.Unmarshal(operation.Value, &group.DisplayName); err != nil {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid displayName: %v", err)
+			}
+		case "externalid":
+			if op == "remove" {
+				group.ExternalID = ""
+				continue
+			}
+			if err := json.Unmarshal(operation.Value, &group.ExternalID); err != nil {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid externalId: %v", err)
+			}
+		default:
+			return newSCIMError(http.StatusBadRequest, "invalidPath", "unsupported patch path %q", path)
+		}
+	}
+	return nil
+}
+
+// getSCIMMemberIDs returns the user IDs of the group members, the members must be the provisioned users.
+func (s *Server) getSCIMMemberIDs(ctx context.Context, members []scimMember) ([]int, error) {
+	memberIDs := []int{}
+	for _, member := range members {
+		if _, err := s.getSCIMUser(ctx, member.Value); err != nil {
+			var scimErr *scimError
+			if errors.As(err, &scimErr) {
+				return nil, newSCIMError(http.StatusBadRequest, "invalidValue", "member %q is not a provisioned user", member.Value)
+			}
+			return nil, err
+		}
+		userID, _ := strconv.Atoi(member.Value)
+		if !slices.Contains(memberIDs, userID) {
+			memberIDs = append(memberIDs, userID)
+		}
+	}
+	return memberIDs, nil
+}
+
+func (s *Server) convertToSCIMGroup(ctx context.Context, group *store.SCIMGroupMessage) *scimGroup {
+	scimGroup := &scimGroup{
+		Schemas:     []string{scimGroupSchema},
+		ID:          strconv.Itoa(group.UID),
+		ExternalID:  group.ExternalID,
+		DisplayName: group.DisplayName,
+		Meta:        &scimMeta{ResourceType: "Group"},
+	}
+	for _, memberID := range group.MemberIDs {
+		member := scimMember{Value: strconv.Itoa(memberID)}
+		if user, err := s.store.GetUserByID(ctx, memberID); err == nil && user != nil {
+			member.Display = user.Email
+		}
+		scimGroup.Members = append(scimGroup.Members, member)
+	}
+	if baseURL := s.getSCIMBaseURL(ctx); baseURL != "" {
+		scimGroup.Meta.Location = fmt.Sprintf("%s/Groups/%d", baseURL, group.UID)
+	}
+	return scimGroup
+}
+
+// syncSCIMGroupMappings grants or revokes the roles mapped from the groups for the users. The workspace role of the user
+// is the highest workspace role mapped from the groups the user belongs to, and the user is bound to the project role
+// if the user belongs to any of the groups mapped to the project role.
+func (s *Server) syncSCIMGroupMappings(ctx context.Context, groupNames []string, userIDs []int) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	setting, err := s.store.GetSCIMSetting(ctx)
+	if err != nil {
+		return err
+	}
+	var mappings []*storepb.SCIMSetting_GroupMapping
+	for _, mapping := range setting.GroupMappings {
+		if slices.Contains(groupNames, mapping.Group) {
+			mappings = append(mappings, mapping)
+		}
+	}
+	if len(mappings) == 0 {
+		return nil
+	}
+
+	var users []*store.UserMessage
+	for _, userID := range userIDs {
+		user, err := s.store.GetUserByID(ctx, userID)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get user %d", userID)
+		}
+		if user == nil || user.Type != api.EndUser {
+			continue
+		}
+		users = append(users, user)
+	}
+	groups, err := s.store.ListSCIMGroups(ctx, &store.FindSCIMGroupMessage{})
+	if err != nil {
+		return err
+	}
+	// userGroups is the map from the user ID to the names of the groups the user belongs to.
+	userGroups := make(map[int]map[string]bool)
+	for _, group := range groups {
+		for _, memberID := range group.MemberIDs {
+			if userGroups[memberID] == nil {
+				userGroups[memberID] = make(map[string]bool)
+			}
+			userGroups[memberID][group.DisplayName] = true
+		}
+	}
+
+	// projectRoles is the map from the project to the project roles mapped from the groups.
+	projectRoles := make(map[string][]string)
+	syncWorkspaceRole := false
+	for _, mapping := range mappings {
+		if mapping.Project == "" {
+			syncWorkspaceRole = true
+			continue
+		}
+		if !slices.Contains(projectRoles[mapping.Project], mapping.Role) {
+			projectRoles[mapping.Project] = append(projectRoles[mapping.Project], mapping.Role)
+		}
+	}
+
+	if syncWorkspaceRole {
+		for _, user := range users {
+			role := getSCIMWorkspaceRole(setting.GroupMappings, userGroups[user.ID])
+			if err := s.updateSCIMWorkspaceRole(ctx, user, role); err != nil {
+				return err
+			}
+		}
+	}
+	var projects []string
+	for project := range projectRoles {
+		projects = append(projects, project)
+	}
+	sort.Strings(projects)
+	for _, project := range projects {
+		if err := s.syncSCIMProjectIAMPolicy(ctx, project, projectRoles[project], setting.GroupMappings, userGroups, users); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) updateSCIMWorkspaceRole(ctx context.Context, user *store.UserMessage, role api.Role) error {
+	if user.Role == role {
+		return nil
+	}
+	// Do not demote the last workspace owner, otherwise no one is able to manage the workspace.
+	lastOwner, err := s.isLastWorkspaceOwner(ctx, user)
+	if err != nil {
+		return err
+	}
+	if lastOwner {
+		log.Warn("SCIM skipped demoting the last workspace owner", zap.String("email", user.Email), zap.String("role", string(role)))
+		return nil
+	}
+
+	updatedUser, err := s.store.UpdateUser(ctx, user.ID, &store.UpdateUserMessage{Role: &role}, api.SystemBotID)
+	if err != nil {
+		return errors.Wrapf(err, "failed to update the role of user %d", user.ID)
+	}
+	return s.createSCIMMemberActivity(ctx, updatedUser, api.ActivityMemberRoleUpdate, api.ActivityMemberRoleUpdatePayload{
+		PrincipalID:    updatedUser.ID,
+		PrincipalName:  updatedUser.Name,
+		PrincipalEmail: updatedUser.Email,
+		OldRole:        user.Role,
+		NewRole:        updatedUser.Role,
+	})
+}
+
+// isLastWorkspaceOwner returns true if the user is the only active workspace owner.
+func (s *Server) isLastWorkspaceOwner(ctx context.Context, user *store.UserMessage) (bool, error) {
+	if user.Role != api.Owner || user.MemberDeleted {
+		return false, nil
+	}
+	owner := api.Owner
+	owners, err := s.store.ListUsers(ctx, &store.FindUserMessage{Role: &owner})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list workspace owners")
+	}
+	return len(owners) <= 1, nil
+}
+
+// syncSCIMProjectIAMPolicy updates the unconditional bindings of the roles in the project for the users through the
+// project service, as if the project IAM policy is set by the system bot.
+func (s *Server) syncSCIMProjectIAMPolicy(ctx context.Context, project string, roles []string, mappings []*storepb.SCIMSetting_GroupMapping, userGroups map[int]map[string]bool, users []*store.UserMessage) error {
+	ctx = context.WithValue(ctx, common.PrincipalIDContextKey, api.SystemBotID)
+	policy, err := s.projectService.GetIamPolicy(ctx, &v1pb.GetIamPolicyRequest{Project: project})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			log.Warn("SCIM skipped the group mapping to the project not found", zap.String("project", project))
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get the IAM policy of project %q", project)
+	}
+
+	changed := false
+	for _, role := range roles {
+		var binding *v1pb.Binding
+		for _, b := range policy.Bindings {
+			if b.Role == role && (b.Condition == nil || b.Condition.Expression == "") {
+				binding = b
+				break
+			}
+		}
+		if binding == nil {
+			binding = &v1pb.Binding{Role: role}
+			policy.Bindings = append(policy.Bindings, binding)
+		}
+		for _, user := range users {
+			member := fmt.Sprintf("user:%s", user.Email)
+			granted := false
+			for _, mapping := range mappings {
+				if mapping.Project == project && mapping.Role == role && userGroups[user.ID][mapping.Group] {
+					granted = true
+					break
+				}
+			}
+			index := slices.Index(binding.Members, member)
+			if granted && index < 0 {
+				binding.Members = append(binding.Members, member)
+				changed = true
+			} else if !granted && index >= 0 {
+				binding.Members = slices.Delete(binding.Members, index, index+1)
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	var bindings []*v1pb.Binding
+	for _, binding := range policy.Bindings {
+		if len(binding.Members) > 0 {
+			bindings = append(bindings, binding)
+		}
+	}
+	policy.Bindings = bindings
+	if _, err := s.projectService.SetIamPolicy(ctx, &v1pb.SetIamPolicyRequest{Project: project, Policy: policy}); err != nil {
+		// The policy is invalid if the last project owner is revoked, which has to be fixed in the project.
+		if status.Code(err) == codes.InvalidArgument {
+			log.Warn("SCIM skipped the invalid project IAM policy", zap.String("project", project), zap.Error(err))
+			return nil
+		}
+		return errors.Wrapf(err, "failed to set the IAM policy of project %q", project)
+	}
+	return nil
+}
+
+func (s *Server) createSCIMMemberActivity(ctx context.Context, user *store.UserMessage, activityType api.ActivityType, payload any) error {
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal activity payload")
+	}
+	if _, err := s.store.CreateActivityV2(ctx, &store.ActivityMessage{
+		CreatorUID:   api.SystemBotID,
+		ContainerUID: user.ID,
+		Type:         activityType,
+		Level:        api.ActivityInfo,
+		Comment:      "Updated by SCIM provisioning.",
+		Payload:      string(bytes),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to create activity")
+	}
+	return nil
+}
+
+// getSCIMBaseURL returns the base URL of the SCIM endpoints for the resource locations, or empty if the external URL is not set.
+func (s *Server) getSCIMBaseURL(ctx context.Context) string {
+	setting, err := s.store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil || setting.ExternalUrl == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%s", setting.ExternalUrl, scimAPIPrefix)
+}
+
+// getSCIMWorkspaceRole returns the highest workspace role mapped from the groups, or developer if there is none.
+func getSCIMWorkspaceRole(mappings []*storepb.SCIMSetting_GroupMapping, groups map[string]bool) api.Role {
+	rank := map[api.Role]int{api.Developer: 0, api.DBA: 1, api.Owner: 2}
+	role := api.Developer
+	for _, mapping := range mappings {
+		if mapping.Project != "" || !groups[mapping.Group] {
+			continue
+		}
+		roleID, err := common.GetRoleID(mapping.Role)
+		if err != nil {
+			continue
+		}
+		if r := api.Role(roleID); rank[r] > rank[role] {
+			role = r
+		}
+	}
+	return role
+}
+
+// getSCIMDisplayName returns the display name of the user, or the name composed of the given name and the family name.
+func getSCIMDisplayName(user *scimUser) string {
+	if user.DisplayName != "" {
+		return user.DisplayName
+	}
+	if user.Name == nil {
+		return ""
+	}
+	if user.Name.Formatted != "" {
+		return user.Name.Formatted
+	}
+	return strings.TrimSpace(fmt.Sprintf("%s %s", user.Name.GivenName, user.Name.FamilyName))
+}
+
+// applySCIMUserPatch applies the patch operations to the user. The attributes not stored in Bytebase are ignored,
+// because the identity providers push all the mapped attributes.
+func applySCIMUserPatch(user *scimUser, operations []*scimPatchOperation) error {
+	for _, operation := range operations {
+		op := strings.ToLower(operation.Op)
+		if op != "add" && op != "replace" && op != "remove" {
+			return newSCIMError(http.StatusBadRequest, "invalidSyntax", "unsupported patch operation %q", operation.Op)
+		}
+		if operation.Path != "" {
+			value := operation.Value
+			if op == "remove" {
+				value = nil
+			}
+			if err := setSCIMUserAttribute(user, operation.Path, value); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// The value is a partial user with the attributes to replace.
+		var attributes map[string]json.RawMessage
+		if err := json.Unmarshal(operation.Value, &attributes); err != nil {
+			return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid patch value: %v", err)
+		}
+		var paths []string
+		for path := range attributes {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			if err := setSCIMUserAttribute(user, path, attributes[path]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setSCIMUserAttribute sets the attribute of the user to the value, or clears the attribute if the value is nil.
+func setSCIMUserAttribute(user *scimUser, path string, value json.RawMessage) error {
+	if user.Name == nil {
+		user.Name = &scimName{}
+	}
+	var target *string
+	switch strings.ToLower(path) {
+	case "username":
+		if value == nil {
+			return newSCIMError(http.StatusBadRequest, "mutability", "userName is required")
+		}
+		target = &user.UserName
+	case "displayname":
+		target = &user.DisplayName
+	case "name.formatted":
+		target = &user.Name.Formatted
+	case "name.givenname":
+		target = &user.Name.GivenName
+	case "name.familyname":
+		target = &user.Name.FamilyName
+	case "name":
+		name := &scimName{}
+		if value != nil {
+			if err := json.Unmarshal(value, name); err != nil {
+				return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid name: %v", err)
+			}
+		}
+		user.Name = name
+		// The display name is derived from the name if it is not specified.
+		if name.Formatted != "" || name.GivenName != "" || name.FamilyName != "" {
+			user.DisplayName = ""
+		}
+		return nil
+	case "active":
+		if value == nil {
+			return nil
+		}
+		active, err := parseSCIMBool(value)
+		if err != nil {
+			return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid active %s", string(value))
+		}
+		user.Active = &active
+		return nil
+	default:
+		return nil
+	}
+
+	if value == nil {
+		*target = ""
+		return nil
+	}
+	if err := json.Unmarshal(value, target); err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidValue", "invalid %s: %v", path, err)
+	}
+	return nil
+}
+
+// parseSCIMBool parses the boolean value, which is sent as a string like "False" by some identity providers.
+func parseSCIMBool(value json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(value, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(value, &s); err != nil {
+		return false, err
+	}
+	return strconv.ParseBool(strings.ToLower(s))
+}
+
+// parseSCIMFilter parses the filter in the form of `attribute eq "value"` and returns the attribute and the value.
+func parseSCIMFilter(filter string) (string, string, error) {
+	matches := scimFilterRegexp.FindStringSubmatch(filter)
+	if matches == nil {
+		return "", "", newSCIMError(http.StatusBadRequest, "invalidFilter", "unsupported filter %q, only the equality filter is supported", filter)
+	}
+	var value string
+	if err := json.Unmarshal([]byte(matches[2]), &value); err != nil {
+		return "", "", newSCIMError(http.StatusBadRequest, "invalidFilter", "invalid filter value %s", matches[2])
+	}
+	return matches[1], value, nil
+}
+
+func mergeSCIMMemberIDs(a, b []int) []int {
+	result := slices.Clone(a)
+	for _, id := range b {
+		if !slices.Contains(result, id) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func removeSCIMMemberIDs(memberIDs []int, id string) []int {
+	var result []int
+	for _, memberID := range memberIDs {
+		if strconv.Itoa(memberID) != id {
+			result = append(result, memberID)
+		}
+	}
+	if result == nil {
+		return []int{}
+	}
+	return result
+}
+
+func readSCIMRequest(c echo.Context, v any) error {
+	if err := json.NewDecoder(c.Request().Body).Decode(v); err != nil {
+		return newSCIMError(http.StatusBadRequest, "invalidSyntax", "malformed request body: %v", err)
+	}
+	return nil
+}
+
+func writeSCIMListResponse(c echo.Context, resources []any) error {
+	startIndex, err := strconv.Atoi(c.QueryParam("startIndex"))
+	if err != nil || startIndex < 1 {
+		startIndex = 1
+	}
+	count, err := strconv.Atoi(c.QueryParam("count"))
+	if err != nil || count > scimMaxResults {
+		count = scimMaxResults
+	}
+	if count < 0 {
+		count = 0
+	}
+
+	total := len(resources)
+	page := []any{}
+	if startIndex <= total {
+		end := startIndex - 1 + count
+		if end > total {
+			end = total
+		}
+		page = resources[startIndex-1 : end]
+	}
+	return writeSCIMResponse(c, http.StatusOK, &scimListResponse{
+		Schemas:      []string{scimListResponseSchema},
+		TotalResults: total,
+		StartIndex:   startIndex,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	})
+}
+
+func writeSCIMResponse(c echo.Context, code int, v any) error {
+	c.Response().Header().Set(echo.HeaderContentType, scimContentType)
+	c.Response().WriteHeader(code)
+	return json.NewEncoder(c.Response()).Encode(v)
+}
+
+func convertGRPCErrorToSCIMError(err error) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.AlreadyExists:
+		return newSCIMError(http.StatusConflict, "uniqueness", st.Message())
+	case codes.InvalidArgument:
+		return newSCIMError(http.StatusBadRequest, "invalidValue", st.Message())
+	case codes.PermissionDenied, codes.ResourceExhausted:
+		return newSCIMError(http.StatusForbidden, "", st.Message())
+	case codes.NotFound:
+		return newSCIMError(http.StatusNotFound, "", st.Message())
+	default:
+		return err
+	}
+}
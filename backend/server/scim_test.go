@@ -0,0 +1,184 @@
+package server
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	api "github.com/bytebase/bytebase/backend/legacyapi"
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+func TestParseSCIMFilter(t *testing.T) {
+	tests := []struct {
+		filter    string
+		attribute string
+		value     string
+		wantErr   bool
+	}{
+		{
+			filter:    `userName eq "Alice@Example.com"`,
+			attribute: "userName",
+			value:     "Alice@Example.com",
+		},
+		{
+			filter:    ` emails.value EQ "bob@example.com" `,
+			attribute: "emails.value",
+			value:     "bob@example.com",
+		},
+		{
+			filter:    `displayName eq "DBA \"team\""`,
+			attribute: "displayName",
+			value:     `DBA "team"`,
+		},
+		{
+			filter:  `userName sw "alice"`,
+			wantErr: true,
+		},
+		{
+			filter:  `userName eq "alice" and active eq true`,
+			wantErr: true,
+		},
+		{
+			filter:  `userName eq alice`,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		attribute, value, err := parseSCIMFilter(test.filter)
+		if test.wantErr {
+			require.Error(t, err, test.filter)
+			continue
+		}
+		require.NoError(t, err, test.filter)
+		require.Equal(t, test.attribute, attribute, test.filter)
+		require.Equal(t, test.value, value, test.filter)
+	}
+}
+
+func TestParseSCIMBool(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    bool
+		wantErr bool
+	}{
+		{value: `true`, want: true},
+		{value: `false`, want: false},
+		{value: `"False"`, want: false},
+		{value: `"True"`, want: true},
+		{value: `"yes"`, wantErr: true},
+		{value: `1`, wantErr: true},
+	}
+
+	for _, test := range tests {
+		got, err := parseSCIMBool(json.RawMessage(test.value))
+		if test.wantErr {
+			require.Error(t, err, test.value)
+			continue
+		}
+		require.NoError(t, err, test.value)
+		require.Equal(t, test.want, got, test.value)
+	}
+}
+
+func TestApplySCIMUserPatch(t *testing.T) {
+	active := true
+	newUser := func() *scimUser {
+		return &scimUser{
+			UserName:    "alice@example.com",
+			DisplayName: "Alice",
+			Name:        &scimName{Formatted: "Alice"},
+			Active:      &active,
+		}
+	}
+
+	tests := []struct {
+		operations  string
+		userName    string
+		displayName string
+		active      bool
+		wantErr     bool
+	}{
+		// Azure AD deactivates the user with the string value.
+		{
+			operations:  `[{"op": "Replace", "path": "active", "value": "False"}]`,
+			userName:    "alice@example.com",
+			displayName: "Alice",
+			active:      false,
+		},
+		// Okta deactivates the user without the path.
+		{
+			operations:  `[{"op": "replace", "value": {"active": false}}]`,
+			userName:    "alice@example.com",
+			displayName: "Alice",
+			active:      false,
+		},
+		{
+			operations:  `[{"op": "replace", "path": "userName", "value": "alice.smith@example.com"}, {"op": "replace", "path": "displayName", "value": "Alice Smith"}]`,
+			userName:    "alice.smith@example.com",
+			displayName: "Alice Smith",
+			active:      true,
+		},
+		{
+			operations:  `[{"op": "replace", "path": "name", "value": {"givenName": "Alice", "familyName": "Smith"}}]`,
+			userName:    "alice@example.com",
+			displayName: "Alice Smith",
+			active:      true,
+		},
+		// The attributes not stored are ignored.
+		{
+			operations:  `[{"op": "add", "path": "title", "value": "Engineer"}, {"op": "replace", "value": {"externalId": "00u1", "displayName": "Alice S."}}]`,
+			userName:    "alice@example.com",
+			displayName: "Alice S.",
+			active:      true,
+		},
+		{
+			operations: `[{"op": "remove", "path": "userName"}]`,
+			wantErr:    true,
+		},
+		{
+			operations: `[{"op": "move", "path": "displayName", "value": "Alice"}]`,
+			wantErr:    true,
+		},
+	}
+
+	for _, test := range tests {
+		var operations []*scimPatchOperation
+		require.NoError(t, json.Unmarshal([]byte(test.operations), &operations))
+		user := newUser()
+		err := applySCIMUserPatch(user, operations)
+		if test.wantErr {
+			require.Error(t, err, test.operations)
+			continue
+		}
+		require.NoError(t, err, test.operations)
+		require.Equal(t, test.userName, user.UserName, test.operations)
+		require.Equal(t, test.displayName, getSCIMDisplayName(user), test.operations)
+		require.NotNil(t, user.Active, test.operations)
+		require.Equal(t, test.active, *user.Active, test.operations)
+	}
+}
+
+func TestGetSCIMWorkspaceRole(t *testing.T) {
+	mappings := []*storepb.SCIMSetting_GroupMapping{
+		{Group: "dba", Role: "roles/DBA"},
+		{Group: "admin", Role: "roles/OWNER"},
+		{Group: "engineering", Project: "projects/engineering", Role: "roles/OWNER"},
+	}
+	tests := []struct {
+		groups map[string]bool
+		want   api.Role
+	}{
+		{groups: nil, want: api.Developer},
+		{groups: map[string]bool{"dba": true}, want: api.DBA},
+		{groups: map[string]bool{"dba": true, "admin": true}, want: api.Owner},
+		// The project role mappings do not change the workspace role.
+		{groups: map[string]bool{"engineering": true}, want: api.Developer},
+	}
+
+	for _, test := range tests {
+		require.Equal(t, test.want, getSCIMWorkspaceRole(mappings, test.groups))
+	}
+}
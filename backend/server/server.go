@@ -28,6 +28,7 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"github.com/pkg/errors"
+	prometheusclient "github.com/prometheus/client_golang/prometheus"
 	scas "github.com/qiangmzsx/string-adapter/v2"
 	echoSwagger "github.com/swaggo/echo-swagger"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
@@ -50,6 +51,7 @@ import (
 	"github.com/bytebase/bytebase/backend/component/activity"
 	"github.com/bytebase/bytebase/backend/component/config"
 	"github.com/bytebase/bytebase/backend/component/dbfactory"
+	"github.com/bytebase/bytebase/backend/component/sqlproxy"
 	"github.com/bytebase/bytebase/backend/component/state"
 	enterpriseAPI "github.com/bytebase/bytebase/backend/enterprise/api"
 	enterpriseService "github.com/bytebase/bytebase/backend/enterprise/service"
@@ -62,6 +64,10 @@ import (
 	"github.com/bytebase/bytebase/backend/plugin/app/feishu"
 	"github.com/bytebase/bytebase/backend/plugin/db"
 	metricPlugin "github.com/bytebase/bytebase/backend/plugin/metric"
+	"github.com/bytebase/bytebase/backend/plugin/storage"
+	"github.com/bytebase/bytebase/backend/plugin/storage/backupfile"
+	"github.com/bytebase/bytebase/backend/plugin/storage/gcs"
+	"github.com/bytebase/bytebase/backend/plugin/storage/oss"
 	bbs3 "github.com/bytebase/bytebase/backend/plugin/storage/s3"
 	"github.com/bytebase/bytebase/backend/resources/mongoutil"
 	"github.com/bytebase/bytebase/backend/resources/mysqlutil"
@@ -73,12 +79,14 @@ import (
 	"github.com/bytebase/bytebase/backend/runner/mail"
 	"github.com/bytebase/bytebase/backend/runner/metricreport"
 	"github.com/bytebase/bytebase/backend/runner/plancheck"
+	"github.com/bytebase/bytebase/backend/runner/planschedule"
 	"github.com/bytebase/bytebase/backend/runner/relay"
 	"github.com/bytebase/bytebase/backend/runner/rollbackrun"
 	"github.com/bytebase/bytebase/backend/runner/schemasync"
 	"github.com/bytebase/bytebase/backend/runner/slowquerysync"
 	"github.com/bytebase/bytebase/backend/runner/taskcheck"
 	"github.com/bytebase/bytebase/backend/runner/taskrun"
+	"github.com/bytebase/bytebase/backend/runner/webhookdelivery"
 	"github.com/bytebase/bytebase/backend/store"
 	_ "github.com/bytebase/bytebase/docs/openapi" // initial the swagger doc
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -131,6 +139,12 @@ import (
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/differ/mysql"
 	// Register postgres differ driver.
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/differ/pg"
+	// Register oracle differ driver.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/differ/oracle"
+	// Register mssql differ driver.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/differ/mssql"
+	// Register snowflake differ driver.
+	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/differ/snowflake"
 	// Register mysql edit driver.
 	_ "github.com/bytebase/bytebase/backend/plugin/parser/sql/edit/mysql"
 	// Register postgres edit driver.
@@ -146,6 +160,10 @@ const (
 	internalAPIPrefix = "/api"
 	// webhookAPIPrefix is the API prefix for Bytebase webhook.
 	webhookAPIPrefix = "/hook"
+	// samlAPIPrefix is the API prefix for Bytebase as the SAML service provider.
+	samlAPIPrefix = "/saml"
+	// scimAPIPrefix is the API prefix for the SCIM 2.0 user and group provisioning.
+	scimAPIPrefix = "/scim/v2"
 	// openAPIPrefix is the API prefix for Bytebase OpenAPI.
 	openAPIPrefix          = "/v1"
 	maxStacksize           = 8 * 1024
@@ -155,21 +173,24 @@ const (
 // Server is the Bytebase server.
 type Server struct {
 	// Asynchronous runners.
-	TaskScheduler      *taskrun.Scheduler
-	TaskSchedulerV2    *taskrun.SchedulerV2
-	TaskCheckScheduler *taskcheck.Scheduler
-	PlanCheckScheduler *plancheck.Scheduler
-	MetricReporter     *metricreport.Reporter
-	SchemaSyncer       *schemasync.Syncer
-	SlowQuerySyncer    *slowquerysync.Syncer
-	MailSender         *mail.SlowQueryWeeklyMailSender
-	BackupRunner       *backuprun.Runner
-	AnomalyScanner     *anomaly.Scanner
-	ApplicationRunner  *apprun.Runner
-	RollbackRunner     *rollbackrun.Runner
-	ApprovalRunner     *approval.Runner
-	RelayRunner        *relay.Runner
-	runnerWG           sync.WaitGroup
+	TaskScheduler         *taskrun.Scheduler
+	TaskSchedulerV2       *taskrun.SchedulerV2
+	PlanScheduleRunner    *planschedule.Runner
+	TaskCheckScheduler    *taskcheck.Scheduler
+	PlanCheckScheduler    *plancheck.Scheduler
+	MetricReporter        *metricreport.Reporter
+	SchemaSyncer          *schemasync.Syncer
+	SlowQuerySyncer       *slowquerysync.Syncer
+	MailSender            *mail.SlowQueryWeeklyMailSender
+	BackupRunner          *backuprun.Runner
+	AnomalyScanner        *anomaly.Scanner
+	ApplicationRunner     *apprun.Runner
+	RollbackRunner        *rollbackrun.Runner
+	ApprovalRunner        *approval.Runner
+	RelayRunner           *relay.Runner
+	WebhookDeliveryRunner *webhookdelivery.Runner
+	SQLProxy              *sqlproxy.Proxy
+	runnerWG              sync.WaitGroup
 
 	ActivityManager *activity.Manager
 
@@ -191,6 +212,11 @@ type Server struct {
 	// Stubs.
 	rolloutService *v1.RolloutService
 	issueService   *v1.IssueService
+	authService    *v1.AuthService
+	projectService *v1.ProjectService
+
+	// scimGroupMutex serializes the SCIM group updates so that the mapped roles are synced in order.
+	scimGroupMutex sync.Mutex
 
 	// MySQL utility binaries
 	mysqlBinDir string
@@ -199,8 +225,10 @@ type Server struct {
 	// Postgres utility binaries
 	pgBinDir string
 
-	s3Client       *bbs3.Client
+	storageClient  storage.Client
 	feishuProvider *feishu.Provider
+	// backupKeyWrapper wraps the data keys encrypting the backups. Backups are not encrypted if it is nil.
+	backupKeyWrapper backupfile.KeyWrapper
 
 	// stateCfg is the shared in-momory state within the server.
 	stateCfg *state.State
@@ -260,6 +288,7 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	log.Info(fmt.Sprintf("backupBucket=%s", profile.BackupBucket))
 	log.Info(fmt.Sprintf("backupRegion=%s", profile.BackupRegion))
 	log.Info(fmt.Sprintf("backupCredentialFile=%s", profile.BackupCredentialFile))
+	log.Info(fmt.Sprintf("backupEncryptionKeyFile=%s", profile.BackupEncryptionKeyFile))
 	log.Info("-----Config END-------")
 
 	serverStarted := false
@@ -448,15 +477,18 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	s.e = e
 
 	if profile.BackupBucket != "" {
-		credentials, err := bbs3.GetCredentialsFromFile(ctx, profile.BackupCredentialFile)
+		storageClient, err := newStorageClient(ctx, &profile)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to get credentials from file")
+			return nil, err
 		}
-		s3Client, err := bbs3.NewClient(ctx, profile.BackupRegion, profile.BackupBucket, credentials)
+		s.storageClient = storageClient
+	}
+	if profile.BackupEncryptionKeyFile != "" {
+		backupKeyWrapper, err := backupfile.LoadWorkspaceKeyFile(profile.BackupEncryptionKeyFile)
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to create AWS S3 client")
+			return nil, errors.Wrap(err, "failed to load backup encryption key")
 		}
-		s.s3Client = s3Client
+		s.backupKeyWrapper = backupKeyWrapper
 	}
 
 	s.MetricReporter = metricreport.NewReporter(s.store, s.licenseService, &s.profile, false)
@@ -466,7 +498,7 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		// TODO(p0ny): enable Feishu provider only when it is needed.
 		s.feishuProvider = feishu.NewProvider(profile.FeishuAPIURL)
 		s.ApplicationRunner = apprun.NewRunner(storeInstance, s.ActivityManager, s.feishuProvider, profile, s.licenseService)
-		s.BackupRunner = backuprun.NewRunner(storeInstance, s.dbFactory, s.s3Client, s.stateCfg, &profile)
+		s.BackupRunner = backuprun.NewRunner(storeInstance, s.dbFactory, s.storageClient, s.backupKeyWrapper, s.stateCfg, &profile)
 
 		if profile.DevelopmentUseV2Scheduler {
 			s.TaskSchedulerV2 = taskrun.NewSchedulerV2(storeInstance, s.stateCfg, s.ActivityManager)
@@ -476,11 +508,14 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 			s.TaskSchedulerV2.Register(api.TaskDatabaseSchemaUpdate, taskrun.NewSchemaUpdateExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.stateCfg, s.SchemaSyncer, profile))
 			s.TaskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateSDL, taskrun.NewSchemaUpdateSDLExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.stateCfg, s.SchemaSyncer, profile))
 			s.TaskSchedulerV2.Register(api.TaskDatabaseDataUpdate, taskrun.NewDataUpdateExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.stateCfg, profile))
-			s.TaskSchedulerV2.Register(api.TaskDatabaseBackup, taskrun.NewDatabaseBackupExecutor(storeInstance, s.dbFactory, s.s3Client, profile))
+			s.TaskSchedulerV2.Register(api.TaskDatabaseBackup, taskrun.NewDatabaseBackupExecutor(storeInstance, s.dbFactory, s.storageClient, s.backupKeyWrapper, profile))
 			s.TaskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostSync, taskrun.NewSchemaUpdateGhostSyncExecutor(storeInstance, s.stateCfg, s.secret))
 			s.TaskSchedulerV2.Register(api.TaskDatabaseSchemaUpdateGhostCutover, taskrun.NewSchemaUpdateGhostCutoverExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.stateCfg, s.SchemaSyncer, profile))
-			s.TaskSchedulerV2.Register(api.TaskDatabaseRestorePITRRestore, taskrun.NewPITRRestoreExecutor(storeInstance, s.dbFactory, s.s3Client, s.SchemaSyncer, s.stateCfg, profile))
+			s.TaskSchedulerV2.Register(api.TaskDatabaseSchemaUpdatePGOnlineSync, taskrun.NewSchemaUpdatePGOnlineSyncExecutor(storeInstance, s.dbFactory, s.stateCfg))
+			s.TaskSchedulerV2.Register(api.TaskDatabaseSchemaUpdatePGOnlineCutover, taskrun.NewSchemaUpdatePGOnlineCutoverExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.SchemaSyncer, profile))
+			s.TaskSchedulerV2.Register(api.TaskDatabaseRestorePITRRestore, taskrun.NewPITRRestoreExecutor(storeInstance, s.dbFactory, s.storageClient, s.backupKeyWrapper, s.SchemaSyncer, s.stateCfg, profile))
 			s.TaskSchedulerV2.Register(api.TaskDatabaseRestorePITRCutover, taskrun.NewPITRCutoverExecutor(storeInstance, s.dbFactory, s.SchemaSyncer, s.BackupRunner, s.ActivityManager, profile))
+			s.PlanScheduleRunner = planschedule.NewRunner(storeInstance, s.stateCfg, s.ActivityManager, v1.GetPlanCheckRunsFromPlan)
 		}
 		s.TaskScheduler = taskrun.NewScheduler(storeInstance, s.ApplicationRunner, s.SchemaSyncer, s.ActivityManager, s.licenseService, s.stateCfg, profile, s.MetricReporter)
 		s.TaskScheduler.Register(api.TaskGeneral, taskrun.NewDefaultExecutor())
@@ -489,16 +524,19 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.TaskScheduler.Register(api.TaskDatabaseSchemaUpdate, taskrun.NewSchemaUpdateExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.stateCfg, s.SchemaSyncer, profile))
 		s.TaskScheduler.Register(api.TaskDatabaseSchemaUpdateSDL, taskrun.NewSchemaUpdateSDLExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.stateCfg, s.SchemaSyncer, profile))
 		s.TaskScheduler.Register(api.TaskDatabaseDataUpdate, taskrun.NewDataUpdateExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.stateCfg, profile))
-		s.TaskScheduler.Register(api.TaskDatabaseBackup, taskrun.NewDatabaseBackupExecutor(storeInstance, s.dbFactory, s.s3Client, profile))
+		s.TaskScheduler.Register(api.TaskDatabaseBackup, taskrun.NewDatabaseBackupExecutor(storeInstance, s.dbFactory, s.storageClient, s.backupKeyWrapper, profile))
 		s.TaskScheduler.Register(api.TaskDatabaseSchemaUpdateGhostSync, taskrun.NewSchemaUpdateGhostSyncExecutor(storeInstance, s.stateCfg, s.secret))
 		s.TaskScheduler.Register(api.TaskDatabaseSchemaUpdateGhostCutover, taskrun.NewSchemaUpdateGhostCutoverExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.stateCfg, s.SchemaSyncer, profile))
-		s.TaskScheduler.Register(api.TaskDatabaseRestorePITRRestore, taskrun.NewPITRRestoreExecutor(storeInstance, s.dbFactory, s.s3Client, s.SchemaSyncer, s.stateCfg, profile))
+		s.TaskScheduler.Register(api.TaskDatabaseSchemaUpdatePGOnlineSync, taskrun.NewSchemaUpdatePGOnlineSyncExecutor(storeInstance, s.dbFactory, s.stateCfg))
+		s.TaskScheduler.Register(api.TaskDatabaseSchemaUpdatePGOnlineCutover, taskrun.NewSchemaUpdatePGOnlineCutoverExecutor(storeInstance, s.dbFactory, s.ActivityManager, s.licenseService, s.SchemaSyncer, profile))
+		s.TaskScheduler.Register(api.TaskDatabaseRestorePITRRestore, taskrun.NewPITRRestoreExecutor(storeInstance, s.dbFactory, s.storageClient, s.backupKeyWrapper, s.SchemaSyncer, s.stateCfg, profile))
 		s.TaskScheduler.Register(api.TaskDatabaseRestorePITRCutover, taskrun.NewPITRCutoverExecutor(storeInstance, s.dbFactory, s.SchemaSyncer, s.BackupRunner, s.ActivityManager, profile))
 
 		s.RollbackRunner = rollbackrun.NewRunner(&profile, storeInstance, s.dbFactory, s.stateCfg)
 		s.MailSender = mail.NewSender(s.store, s.stateCfg)
 		s.RelayRunner = relay.NewRunner(storeInstance, s.ActivityManager, s.TaskScheduler, s.stateCfg)
 		s.ApprovalRunner = approval.NewRunner(storeInstance, s.dbFactory, s.stateCfg, s.ActivityManager, s.TaskScheduler, s.RelayRunner, s.licenseService)
+		s.WebhookDeliveryRunner = webhookdelivery.NewRunner(storeInstance)
 
 		s.TaskCheckScheduler = taskcheck.NewScheduler(storeInstance, s.licenseService, s.stateCfg)
 		statementCompositeExecutor := taskcheck.NewStatementAdvisorCompositeExecutor(storeInstance, s.dbFactory, s.licenseService)
@@ -509,8 +547,12 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.TaskCheckScheduler.Register(api.TaskCheckDatabaseConnect, databaseConnectExecutor)
 		ghostSyncExecutor := taskcheck.NewGhostSyncExecutor(storeInstance, s.secret)
 		s.TaskCheckScheduler.Register(api.TaskCheckGhostSync, ghostSyncExecutor)
+		pgOnlineSyncExecutor := taskcheck.NewPGOnlineSyncExecutor(storeInstance, s.dbFactory)
+		s.TaskCheckScheduler.Register(api.TaskCheckPGOnlineSync, pgOnlineSyncExecutor)
 		pitrMySQLExecutor := taskcheck.NewPITRMySQLExecutor(storeInstance, s.dbFactory)
 		s.TaskCheckScheduler.Register(api.TaskCheckPITRMySQL, pitrMySQLExecutor)
+		pitrPostgresExecutor := taskcheck.NewPITRPostgresExecutor(storeInstance, s.dbFactory, s.storageClient, profile)
+		s.TaskCheckScheduler.Register(api.TaskCheckPITRPostgres, pitrPostgresExecutor)
 		statementTypeReportExecutor := taskcheck.NewStatementTypeReportExecutor(storeInstance)
 		s.TaskCheckScheduler.Register(api.TaskCheckDatabaseStatementTypeReport, statementTypeReportExecutor)
 		statementAffectedRowsExecutor := taskcheck.NewStatementAffectedRowsReportExecutor(storeInstance, s.dbFactory)
@@ -526,8 +568,12 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 			s.PlanCheckScheduler.Register(store.PlanCheckDatabaseStatementAdvise, statementAdviseExecutor)
 			ghostSyncExecutor := plancheck.NewGhostSyncExecutor(storeInstance, s.secret)
 			s.PlanCheckScheduler.Register(store.PlanCheckDatabaseGhostSync, ghostSyncExecutor)
+			pgOnlineSyncExecutor := plancheck.NewPGOnlineSyncExecutor(storeInstance, s.dbFactory)
+			s.PlanCheckScheduler.Register(store.PlanCheckDatabasePGOnlineSync, pgOnlineSyncExecutor)
 			pitrMySQLExecutor := plancheck.NewPITRMySQLExecutor(storeInstance, s.dbFactory)
 			s.PlanCheckScheduler.Register(store.PlanCheckDatabasePITRMySQL, pitrMySQLExecutor)
+			pitrPostgresExecutor := plancheck.NewPITRPostgresExecutor(storeInstance, s.dbFactory, s.storageClient, profile)
+			s.PlanCheckScheduler.Register(store.PlanCheckDatabasePITRPostgres, pitrPostgresExecutor)
 			statementReportExecutor := plancheck.NewStatementReportExecutor(storeInstance, s.dbFactory)
 			s.PlanCheckScheduler.Register(store.PlanCheckDatabaseStatementSummaryReport, statementReportExecutor)
 		}
@@ -621,7 +667,7 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 			recoveryStreamInterceptor,
 		),
 	)
-	v1pb.RegisterAuthServiceServer(s.grpcServer, v1.NewAuthService(s.store, s.secret, refreshTokenDuration, s.licenseService, s.MetricReporter, &profile,
+	s.authService = v1.NewAuthService(s.store, s.secret, refreshTokenDuration, s.licenseService, s.MetricReporter, &profile,
 		func(ctx context.Context, user *store.UserMessage, firstEndUser bool) error {
 			if s.profile.TestOnlySkipOnboardingData {
 				return nil
@@ -635,7 +681,8 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 				}
 			}
 			return nil
-		}))
+		})
+	v1pb.RegisterAuthServiceServer(s.grpcServer, s.authService)
 	v1pb.RegisterActuatorServiceServer(s.grpcServer, v1.NewActuatorService(s.store, &s.profile, &s.errorRecordRing))
 	v1pb.RegisterSubscriptionServiceServer(s.grpcServer, v1.NewSubscriptionService(
 		s.store,
@@ -651,19 +698,23 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 		s.stateCfg,
 		s.dbFactory,
 		s.SchemaSyncer))
-	v1pb.RegisterProjectServiceServer(s.grpcServer, v1.NewProjectService(s.store, s.ActivityManager, s.licenseService))
-	v1pb.RegisterDatabaseServiceServer(s.grpcServer, v1.NewDatabaseService(s.store, s.BackupRunner, s.SchemaSyncer, s.licenseService))
+	s.projectService = v1.NewProjectService(s.store, s.ActivityManager, s.licenseService)
+	v1pb.RegisterProjectServiceServer(s.grpcServer, s.projectService)
+	v1pb.RegisterDatabaseServiceServer(s.grpcServer, v1.NewDatabaseService(s.store, s.BackupRunner, s.SchemaSyncer, s.dbFactory, s.licenseService))
 	v1pb.RegisterInstanceRoleServiceServer(s.grpcServer, v1.NewInstanceRoleService(s.store, s.dbFactory))
 	v1pb.RegisterOrgPolicyServiceServer(s.grpcServer, v1.NewOrgPolicyService(s.store, s.licenseService))
 	v1pb.RegisterIdentityProviderServiceServer(s.grpcServer, v1.NewIdentityProviderService(s.store, s.licenseService))
 	v1pb.RegisterSettingServiceServer(s.grpcServer, v1.NewSettingService(s.store, &s.profile, s.licenseService, s.stateCfg, s.feishuProvider))
 	v1pb.RegisterAnomalyServiceServer(s.grpcServer, v1.NewAnomalyService(s.store))
-	v1pb.RegisterSQLServiceServer(s.grpcServer, v1.NewSQLService(s.store, s.SchemaSyncer, s.dbFactory, s.ActivityManager, s.licenseService))
+	sqlService := v1.NewSQLService(s.store, s.SchemaSyncer, s.dbFactory, s.ActivityManager, s.licenseService)
+	v1pb.RegisterSQLServiceServer(s.grpcServer, sqlService)
+	// The SQL proxy runs the statements from the native clients in the same query pipeline as the SQL editor.
+	s.SQLProxy = sqlproxy.NewProxy(s.store, s.secret, sqlService, &profile)
 	v1pb.RegisterExternalVersionControlServiceServer(s.grpcServer, v1.NewExternalVersionControlService(s.store))
 	v1pb.RegisterRiskServiceServer(s.grpcServer, v1.NewRiskService(s.store, s.licenseService))
 	s.issueService = v1.NewIssueService(s.store, s.ActivityManager, s.TaskScheduler, s.RelayRunner, s.stateCfg, s.licenseService)
 	v1pb.RegisterIssueServiceServer(s.grpcServer, s.issueService)
-	s.rolloutService = v1.NewRolloutService(s.store, s.licenseService, s.dbFactory, s.PlanCheckScheduler, s.stateCfg, s.ActivityManager)
+	s.rolloutService = v1.NewRolloutService(s.store, s.licenseService, s.dbFactory, s.PlanCheckScheduler, s.stateCfg, s.ActivityManager, &profile)
 	v1pb.RegisterRolloutServiceServer(s.grpcServer, s.rolloutService)
 	v1pb.RegisterRoleServiceServer(s.grpcServer, v1.NewRoleService(s.store, s.licenseService))
 	v1pb.RegisterSheetServiceServer(s.grpcServer, v1.NewSheetService(s.store, s.licenseService))
@@ -685,6 +736,10 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	// the user has to restart the server to take the latest value.
 	gatewayModifier := auth.GatewayResponseModifier{ExternalURL: externalURL, RefreshTokenDuration: refreshTokenDuration}
 	mux := grpcRuntime.NewServeMux(grpcRuntime.WithForwardResponseOption(gatewayModifier.Modify))
+	samlGroup := e.Group(samlAPIPrefix)
+	s.registerSAMLRoutes(samlGroup, s.authService, &gatewayModifier)
+	scimGroup := e.Group(scimAPIPrefix)
+	s.registerSCIMRoutes(scimGroup)
 	if err := v1pb.RegisterAuthServiceHandler(ctx, mux, grpcConn); err != nil {
 		return nil, err
 	}
@@ -774,11 +829,49 @@ func NewServer(ctx context.Context, profile config.Profile) (*Server, error) {
 	// Register prometheus metrics endpoint.
 	p := prometheus.NewPrometheus("api", nil)
 	p.Use(e)
+	// Expose the metric collectors on the prometheus metrics endpoint as well.
+	if err := prometheusclient.Register(s.MetricReporter); err != nil {
+		log.Warn("Failed to register the metric collectors to prometheus", zap.Error(err))
+	}
 
 	serverStarted = true
 	return s, nil
 }
 
+// newStorageClient creates the cloud storage client for the backup storage backend in the profile.
+func newStorageClient(ctx context.Context, profile *config.Profile) (storage.Client, error) {
+	switch profile.BackupStorageBackend {
+	case api.BackupStorageBackendS3:
+		credentials, err := bbs3.GetCredentialsFromFile(ctx, profile.BackupCredentialFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get credentials from file")
+		}
+		client, err := bbs3.NewClient(ctx, profile.BackupRegion, profile.BackupBucket, credentials)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create AWS S3 client")
+		}
+		return client, nil
+	case api.BackupStorageBackendGCS:
+		client, err := gcs.NewClient(ctx, profile.BackupBucket, profile.BackupCredentialFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create Google Cloud Storage client")
+		}
+		return client, nil
+	case api.BackupStorageBackendOSS:
+		credentials, err := oss.GetCredentialsFromFile(profile.BackupCredentialFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get credentials from file")
+		}
+		client, err := oss.NewClient(profile.BackupRegion, profile.BackupBucket, credentials)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create Alibaba Cloud OSS client")
+		}
+		return client, nil
+	default:
+		return nil, errors.Errorf("unsupported backup storage backend %s", profile.BackupStorageBackend)
+	}
+}
+
 func (s *Server) registerOpenAPIRoutes(e *echo.Echo) {
 	e.POST("/v1/sql/advise", s.sqlCheckController)
 	e.POST("/v1/sql/schema/diff", schemaDiff)
@@ -993,6 +1086,8 @@ func (s *Server) Run(ctx context.Context, port int) error {
 			}
 			s.runnerWG.Add(1)
 			go s.TaskSchedulerV2.Run(ctx, &s.runnerWG)
+			s.runnerWG.Add(1)
+			go s.PlanScheduleRunner.Run(ctx, &s.runnerWG)
 		} else {
 			if err := s.TaskScheduler.ClearRunningTasks(ctx); err != nil {
 				return errors.Wrap(err, "failed to clear existing RUNNING tasks before starting the task scheduler")
@@ -1020,12 +1115,17 @@ func (s *Server) Run(ctx context.Context, port int) error {
 		go s.ApprovalRunner.Run(ctx, &s.runnerWG)
 		s.runnerWG.Add(1)
 		go s.RelayRunner.Run(ctx, &s.runnerWG)
+		s.runnerWG.Add(1)
+		go s.WebhookDeliveryRunner.Run(ctx, &s.runnerWG)
 
 		s.runnerWG.Add(1)
 		go s.MetricReporter.Run(ctx, &s.runnerWG)
 
 		s.runnerWG.Add(1)
 		go s.PlanCheckScheduler.Run(ctx, &s.runnerWG)
+
+		s.runnerWG.Add(1)
+		go s.SQLProxy.Run(ctx, &s.runnerWG)
 	}
 
 	listen, err := net.Listen("tcp", fmt.Sprintf(":%d", port+1))
@@ -1047,6 +1147,7 @@ func (s *Server) Shutdown(ctx context.Context) error {
 
 	// Close the metric reporter
 	if s.MetricReporter != nil {
+		prometheusclient.Unregister(s.MetricReporter)
 		s.MetricReporter.Close()
 	}
 
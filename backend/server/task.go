@@ -1,6 +1,7 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -62,8 +63,8 @@ func (s *Server) registerTaskRoutes(g *echo.Group) {
 					return echo.NewHTTPError(http.StatusForbidden, err.Error())
 				}
 			}
-			// Skip gh-ost cutover task as this task has no statement.
-			if task.Type == api.TaskDatabaseSchemaUpdateGhostCutover {
+			// Skip gh-ost and online migration cutover tasks as these tasks have no statement.
+			if task.Type == api.TaskDatabaseSchemaUpdateGhostCutover || task.Type == api.TaskDatabaseSchemaUpdatePGOnlineCutover {
 				continue
 			}
 			taskPatch := *taskPatch
@@ -152,6 +153,15 @@ func (s *Server) registerTaskRoutes(g *echo.Group) {
 		if taskPatch.RollbackEnabled != nil && task.Type != api.TaskDatabaseDataUpdate {
 			return echo.NewHTTPError(http.StatusBadRequest, "cannot generate rollback SQL statement for a non-DML task")
 		}
+		if taskPatch.RollbackEnabled != nil && *taskPatch.RollbackEnabled {
+			payload := &api.TaskDatabaseDataUpdatePayload{}
+			if err := json.Unmarshal([]byte(task.Payload), payload); err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to unmarshal task payload").SetInternal(err)
+			}
+			if payload.BatchConfig != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "cannot generate rollback SQL statement for a task executed in batches")
+			}
+		}
 
 		if err := s.TaskScheduler.PatchTask(ctx, task, taskPatch, issue); err != nil {
 			return err
@@ -41,8 +41,10 @@ import (
 	// Register azure plugin.
 	"github.com/bytebase/bytebase/backend/plugin/vcs/azure"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/bitbucket"
+	"github.com/bytebase/bytebase/backend/plugin/vcs/gitea"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/github"
 	"github.com/bytebase/bytebase/backend/plugin/vcs/gitlab"
+	"github.com/bytebase/bytebase/backend/runner/relay"
 	"github.com/bytebase/bytebase/backend/store"
 	"github.com/bytebase/bytebase/backend/utils"
 	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
@@ -190,6 +192,70 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
 	})
 
+	g.POST("/gitea/:id", func(c echo.Context) error {
+		ctx := c.Request().Context()
+
+		// This shouldn't happen as we only setup webhook to receive push event, just in case.
+		eventType := gitea.WebhookType(c.Request().Header.Get("X-Gitea-Event"))
+		if eventType != gitea.WebhookPush {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid webhook event type, got %s, want %s", eventType, gitea.WebhookPush))
+		}
+
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read webhook request").SetInternal(err)
+		}
+		var pushEvent gitea.WebhookPushEvent
+		if err := json.Unmarshal(body, &pushEvent); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Malformed push event").SetInternal(err)
+		}
+		repositoryID := pushEvent.Repository.FullName
+
+		nonBytebaseCommitList := filterGiteaBytebaseCommit(pushEvent.Commits)
+		if len(nonBytebaseCommitList) == 0 {
+			var commitList []string
+			for _, commit := range pushEvent.Commits {
+				commitList = append(commitList, commit.ID)
+			}
+			log.Debug("all commits are created by Bytebase",
+				zap.String("repoURL", pushEvent.Repository.HTMLURL),
+				zap.String("repoName", pushEvent.Repository.FullName),
+				zap.String("commits", strings.Join(commitList, ", ")),
+			)
+			return c.String(http.StatusOK, "OK")
+		}
+		pushEvent.Commits = nonBytebaseCommitList
+
+		filter := func(repo *store.RepositoryMessage) (bool, error) {
+			// Gitea signs the payload in the same way as GitHub, but without the "sha256=" prefix.
+			ok, err := validateGitHubWebhookSignature256(c.Request().Header.Get("X-Gitea-Signature"), repo.WebhookSecretToken, body)
+			if err != nil {
+				return false, echo.NewHTTPError(http.StatusInternalServerError, "Failed to validate Gitea webhook signature").SetInternal(err)
+			}
+			if !ok {
+				return false, nil
+			}
+
+			return s.isWebhookEventBranch(pushEvent.Ref, repo.BranchFilter)
+		}
+		repositoryList, err := s.filterRepository(ctx, c.Param("id"), repositoryID, filter)
+		if err != nil {
+			return err
+		}
+		if len(repositoryList) == 0 {
+			log.Debug("Empty handle repo list. Ignore this push event.")
+			return c.String(http.StatusOK, "OK")
+		}
+
+		baseVCSPushEvent := pushEvent.ToVCS()
+
+		createdMessages, err := s.processPushEvent(ctx, repositoryList, baseVCSPushEvent)
+		if err != nil {
+			return err
+		}
+		return c.String(http.StatusOK, strings.Join(createdMessages, "\n"))
+	})
+
 	g.POST("/bitbucket/:id", func(c echo.Context) error {
 		ctx := c.Request().Context()
 
@@ -695,7 +761,7 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 
 		response := &api.VCSSQLReviewResult{}
 		switch repo.vcs.Type {
-		case vcs.GitHub:
+		case vcs.GitHub, vcs.Gitea:
 			response = convertSQLAdviceToGitHubActionResult(sqlFileName2Advice)
 		case vcs.GitLab:
 			response = convertSQLAdviceToGitLabCIResult(sqlFileName2Advice)
@@ -713,6 +779,25 @@ func (s *Server) registerWebhookRoutes(g *echo.Group) {
 
 		return c.JSON(http.StatusOK, response)
 	})
+
+	// The callback from the relay server of the external approval node. See plugin/app/relay/README.md for the protocol.
+	g.POST("/external-approval/:nodeID", func(c echo.Context) error {
+		ctx := c.Request().Context()
+		if s.RelayRunner == nil {
+			return echo.NewHTTPError(http.StatusServiceUnavailable, "External approval is not available on the readonly server")
+		}
+		body, err := io.ReadAll(c.Request().Body)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, "Failed to read external approval callback").SetInternal(err)
+		}
+		if err := s.RelayRunner.HandleCallback(ctx, c.Param("nodeID"), c.Request(), body); err != nil {
+			if errors.Is(err, relay.ErrCallbackUnauthorized) {
+				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid external approval callback signature").SetInternal(err)
+			}
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to handle external approval callback").SetInternal(err)
+		}
+		return c.String(http.StatusOK, "OK")
+	})
 }
 
 func (s *Server) sqlAdviceForMybatisMapperFiles(ctx context.Context, mybatisMapperContent map[string]string, commitID string, repoInfo *repoInfo) (map[string][]advisor.Advice, error) {
@@ -2444,6 +2529,17 @@ func filterGitHubBytebaseCommit(list []github.WebhookCommit) []github.WebhookCom
 	return result
 }
 
+func filterGiteaBytebaseCommit(list []gitea.WebhookCommit) []gitea.WebhookCommit {
+	var result []gitea.WebhookCommit
+	for _, commit := range list {
+		if commit.Author.Name == vcs.BytebaseAuthorName && commit.Author.Email == vcs.BytebaseAuthorEmail {
+			continue
+		}
+		result = append(result, commit)
+	}
+	return result
+}
+
 func filterGitLabBytebaseCommit(list []gitlab.WebhookCommit) []gitlab.WebhookCommit {
 	var result []gitlab.WebhookCommit
 	for _, commit := range list {
@@ -32,6 +32,8 @@ type FindPlanMessage struct {
 	UID        *int64
 	ProjectID  *string
 	PipelineID *int
+	// Scheduled finds the plans with a recurring schedule.
+	Scheduled bool
 
 	Limit  *int
 	Offset *int
@@ -129,6 +131,9 @@ func (s *Store) ListPlans(ctx context.Context, find *FindPlanMessage) ([]*PlanMe
 	if v := find.PipelineID; v != nil {
 		where, args = append(where, fmt.Sprintf("plan.pipeline_id = $%d", len(args)+1)), append(args, *v)
 	}
+	if find.Scheduled {
+		where = append(where, "plan.config->'schedule' IS NOT NULL")
+	}
 	query := fmt.Sprintf(`
 		SELECT
 			plan.id,	
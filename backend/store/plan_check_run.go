@@ -29,8 +29,12 @@ const (
 	PlanCheckDatabaseConnect PlanCheckRunType = "bb.plan-check.database.connect"
 	// PlanCheckDatabaseGhostSync is the plan check type for the gh-ost sync task.
 	PlanCheckDatabaseGhostSync PlanCheckRunType = "bb.plan-check.database.ghost.sync"
+	// PlanCheckDatabasePGOnlineSync is the plan check type for the PostgreSQL online migration sync task.
+	PlanCheckDatabasePGOnlineSync PlanCheckRunType = "bb.plan-check.database.pg-online.sync"
 	// PlanCheckDatabasePITRMySQL is the plan check type for MySQL PITR.
 	PlanCheckDatabasePITRMySQL PlanCheckRunType = "bb.plan-check.database.pitr.mysql"
+	// PlanCheckDatabasePITRPostgres is the plan check type for PostgreSQL PITR.
+	PlanCheckDatabasePITRPostgres PlanCheckRunType = "bb.plan-check.database.pitr.postgres"
 )
 
 // PlanCheckRunStatus is the status of a plan check run.
@@ -0,0 +1,185 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	storepb "github.com/bytebase/bytebase/proto/generated-go/store"
+)
+
+// PlanScheduleOccurrenceStatus is the status of a plan schedule occurrence.
+type PlanScheduleOccurrenceStatus string
+
+const (
+	// PlanScheduleOccurrenceChecking is the plan schedule occurrence status for CHECKING, which waits for the plan checks.
+	PlanScheduleOccurrenceChecking PlanScheduleOccurrenceStatus = "CHECKING"
+	// PlanScheduleOccurrenceRunning is the plan schedule occurrence status for RUNNING, which waits for the task runs.
+	PlanScheduleOccurrenceRunning PlanScheduleOccurrenceStatus = "RUNNING"
+	// PlanScheduleOccurrenceDone is the plan schedule occurrence status for DONE.
+	PlanScheduleOccurrenceDone PlanScheduleOccurrenceStatus = "DONE"
+	// PlanScheduleOccurrenceFailed is the plan schedule occurrence status for FAILED.
+	PlanScheduleOccurrenceFailed PlanScheduleOccurrenceStatus = "FAILED"
+	// PlanScheduleOccurrenceSkipped is the plan schedule occurrence status for SKIPPED, which is skipped because the previous occurrence is still active.
+	PlanScheduleOccurrenceSkipped PlanScheduleOccurrenceStatus = "SKIPPED"
+)
+
+// PlanScheduleOccurrenceMessage is the message for a plan schedule occurrence.
+type PlanScheduleOccurrenceMessage struct {
+	PlanUID int64
+	// ScheduleTs is the cron fire time of the occurrence.
+	ScheduleTs int64
+	Status     PlanScheduleOccurrenceStatus
+	Payload    *storepb.PlanScheduleOccurrencePayload
+
+	// Output only fields.
+	UID       int64
+	CreatedTs int64
+	UpdatedTs int64
+}
+
+// FindPlanScheduleOccurrenceMessage is the message for finding plan schedule occurrences.
+type FindPlanScheduleOccurrenceMessage struct {
+	UID      *int64
+	PlanUID  *int64
+	Statuses *[]PlanScheduleOccurrenceStatus
+
+	Limit  *int
+	Offset *int
+}
+
+// UpdatePlanScheduleOccurrenceMessage is the message for updating a plan schedule occurrence.
+type UpdatePlanScheduleOccurrenceMessage struct {
+	UID int64
+
+	Status  *PlanScheduleOccurrenceStatus
+	Payload *storepb.PlanScheduleOccurrencePayload
+}
+
+// CreatePlanScheduleOccurrence creates a plan schedule occurrence.
+func (s *Store) CreatePlanScheduleOccurrence(ctx context.Context, create *PlanScheduleOccurrenceMessage) (*PlanScheduleOccurrenceMessage, error) {
+	payload, err := protojson.Marshal(create.Payload)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to marshal payload")
+	}
+	row := s.db.db.QueryRowContext(ctx, `
+		INSERT INTO plan_schedule_occurrence (
+			plan_id,
+			schedule_ts,
+			status,
+			payload
+		) VALUES ($1, $2, $3, $4)
+		RETURNING id, created_ts, updated_ts, plan_id, schedule_ts, status, payload
+	`, create.PlanUID, create.ScheduleTs, create.Status, payload)
+	occurrence, err := scanPlanScheduleOccurrence(row)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create plan schedule occurrence")
+	}
+	return occurrence, nil
+}
+
+// ListPlanScheduleOccurrences lists plan schedule occurrences, the latest schedule time first.
+func (s *Store) ListPlanScheduleOccurrences(ctx context.Context, find *FindPlanScheduleOccurrenceMessage) ([]*PlanScheduleOccurrenceMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.PlanUID; v != nil {
+		where, args = append(where, fmt.Sprintf("plan_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Statuses; v != nil {
+		where, args = append(where, fmt.Sprintf("status = ANY($%d)", len(args)+1)), append(args, *v)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			plan_id,
+			schedule_ts,
+			status,
+			payload
+		FROM plan_schedule_occurrence
+		WHERE %s
+		ORDER BY schedule_ts DESC, id DESC
+	`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
+
+	rows, err := s.db.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list plan schedule occurrences")
+	}
+	defer rows.Close()
+
+	var occurrences []*PlanScheduleOccurrenceMessage
+	for rows.Next() {
+		occurrence, err := scanPlanScheduleOccurrence(rows)
+		if err != nil {
+			return nil, err
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return occurrences, nil
+}
+
+// UpdatePlanScheduleOccurrence updates a plan schedule occurrence.
+func (s *Store) UpdatePlanScheduleOccurrence(ctx context.Context, update *UpdatePlanScheduleOccurrenceMessage) error {
+	set, args := []string{}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Payload; v != nil {
+		payload, err := protojson.Marshal(v)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal payload")
+		}
+		set, args = append(set, fmt.Sprintf("payload = $%d", len(args)+1)), append(args, payload)
+	}
+	if len(set) == 0 {
+		return errors.New("no update field specified")
+	}
+	args = append(args, update.UID)
+
+	if _, err := s.db.db.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE plan_schedule_occurrence
+		SET %s
+		WHERE id = $%d
+	`, strings.Join(set, ", "), len(args)), args...); err != nil {
+		return errors.Wrapf(err, "failed to update plan schedule occurrence %d", update.UID)
+	}
+	return nil
+}
+
+func scanPlanScheduleOccurrence(rows interface{ Scan(...any) error }) (*PlanScheduleOccurrenceMessage, error) {
+	occurrence := PlanScheduleOccurrenceMessage{
+		Payload: &storepb.PlanScheduleOccurrencePayload{},
+	}
+	var payload []byte
+	if err := rows.Scan(
+		&occurrence.UID,
+		&occurrence.CreatedTs,
+		&occurrence.UpdatedTs,
+		&occurrence.PlanUID,
+		&occurrence.ScheduleTs,
+		&occurrence.Status,
+		&payload,
+	); err != nil {
+		return nil, err
+	}
+	if err := protojson.Unmarshal(payload, occurrence.Payload); err != nil {
+		return nil, errors.Wrapf(err, "failed to unmarshal payload")
+	}
+	return &occurrence, nil
+}
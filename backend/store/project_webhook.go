@@ -23,6 +23,8 @@ type ProjectWebhookMessage struct {
 	URL string
 	// ActivityList is the list of activities that the webhook is interested in.
 	ActivityList []string
+	// Secret is used to sign the payload of the webhook.
+	Secret string
 	// Output only fields.
 	//
 	// ID is the unique identifier of the project webhook.
@@ -50,6 +52,8 @@ type UpdateProjectWebhookMessage struct {
 	URL *string
 	// ActivityList is the list of activities that the webhook is interested in.
 	ActivityList []string
+	// Secret is used to sign the payload of the webhook.
+	Secret *string
 }
 
 // FindProjectWebhookMessage is the message for finding project webhooks,
@@ -71,10 +75,11 @@ func (s *Store) CreateProjectWebhookV2(ctx context.Context, principalUID int, pr
 			type,
 			name,
 			url,
-			activity_list
+			activity_list,
+			secret
 		)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
-		RETURNING id, project_id, type, name, url, activity_list
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id, project_id, type, name, url, activity_list, secret
 	`
 	var projectWebhook ProjectWebhookMessage
 	var txtArray pgtype.TextArray
@@ -92,6 +97,7 @@ func (s *Store) CreateProjectWebhookV2(ctx context.Context, principalUID int, pr
 		create.Title,
 		create.URL,
 		create.ActivityList,
+		create.Secret,
 	).Scan(
 		&projectWebhook.ID,
 		&projectWebhook.ProjectID,
@@ -99,6 +105,7 @@ func (s *Store) CreateProjectWebhookV2(ctx context.Context, principalUID int, pr
 		&projectWebhook.Title,
 		&projectWebhook.URL,
 		&txtArray,
+		&projectWebhook.Secret,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, common.FormatDBErrorEmptyRowWithQuery(query)
@@ -177,6 +184,9 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, principalUID int, pr
 	if v := update.ActivityList; v != nil {
 		set, args = append(set, fmt.Sprintf("activity_list = $%d", len(args)+1)), append(args, v)
 	}
+	if v := update.Secret; v != nil {
+		set, args = append(set, fmt.Sprintf("secret = $%d", len(args)+1)), append(args, *v)
+	}
 
 	args = append(args, projectWebhookID)
 
@@ -187,7 +197,7 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, principalUID int, pr
 	UPDATE project_webhook
 	SET `+strings.Join(set, ", ")+`
 	WHERE id = $%d
-	RETURNING id, project_id, type, name, url, activity_list
+	RETURNING id, project_id, type, name, url, activity_list, secret
 `, len(args)),
 		args...,
 	).Scan(
@@ -197,6 +207,7 @@ func (s *Store) UpdateProjectWebhookV2(ctx context.Context, principalUID int, pr
 		&projectWebhook.Title,
 		&projectWebhook.URL,
 		&txtArray,
+		&projectWebhook.Secret,
 	); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, &common.Error{Code: common.NotFound, Err: errors.Errorf("project hook ID not found: %d", projectWebhookID)}
@@ -250,10 +261,12 @@ func (*Store) findProjectWebhookImplV2(ctx context.Context, tx *Tx, find *FindPr
 	rows, err := tx.QueryContext(ctx, `
 		SELECT
 			id,
+			project_id,
 			type,
 			name,
 			url,
-			activity_list
+			activity_list,
+			secret
 		FROM project_webhook
 		WHERE `+strings.Join(where, " AND "),
 		args...,
@@ -270,10 +283,12 @@ func (*Store) findProjectWebhookImplV2(ctx context.Context, tx *Tx, find *FindPr
 
 		if err := rows.Scan(
 			&projectWebhook.ID,
+			&projectWebhook.ProjectID,
 			&projectWebhook.Type,
 			&projectWebhook.Title,
 			&projectWebhook.URL,
 			&txtArray,
+			&projectWebhook.Secret,
 		); err != nil {
 			return nil, err
 		}
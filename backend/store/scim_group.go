@@ -0,0 +1,189 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgtype"
+	"github.com/pkg/errors"
+)
+
+// SCIMGroupMessage is the message for a group provisioned by the identity provider through SCIM.
+type SCIMGroupMessage struct {
+	// ExternalID is the identifier of the group in the identity provider.
+	ExternalID  string
+	DisplayName string
+	// MemberIDs are the principal IDs of the group members.
+	MemberIDs []int
+
+	// Output only fields.
+	UID       int
+	CreatedTs int64
+	UpdatedTs int64
+}
+
+// FindSCIMGroupMessage is the message for finding SCIM groups.
+type FindSCIMGroupMessage struct {
+	UID         *int
+	DisplayName *string
+	ExternalID  *string
+	MemberID    *int
+}
+
+// UpdateSCIMGroupMessage is the message for updating a SCIM group.
+type UpdateSCIMGroupMessage struct {
+	UID int
+
+	ExternalID  *string
+	DisplayName *string
+	MemberIDs   *[]int
+}
+
+// CreateSCIMGroup creates a SCIM group.
+func (s *Store) CreateSCIMGroup(ctx context.Context, create *SCIMGroupMessage) (*SCIMGroupMessage, error) {
+	memberIDs := create.MemberIDs
+	if memberIDs == nil {
+		memberIDs = []int{}
+	}
+	row := s.db.db.QueryRowContext(ctx, `
+		INSERT INTO scim_group (
+			external_id,
+			display_name,
+			member_ids
+		) VALUES ($1, $2, $3)
+		RETURNING id, created_ts, updated_ts, external_id, display_name, member_ids
+	`, create.ExternalID, create.DisplayName, memberIDs)
+	group, err := scanSCIMGroup(row)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create SCIM group %q", create.DisplayName)
+	}
+	return group, nil
+}
+
+// GetSCIMGroup gets a SCIM group.
+func (s *Store) GetSCIMGroup(ctx context.Context, find *FindSCIMGroupMessage) (*SCIMGroupMessage, error) {
+	groups, err := s.ListSCIMGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	if len(groups) > 1 {
+		return nil, errors.Errorf("found %d SCIM groups with filter %+v, expect 1", len(groups), find)
+	}
+	return groups[0], nil
+}
+
+// ListSCIMGroups lists SCIM groups.
+func (s *Store) ListSCIMGroups(ctx context.Context, find *FindSCIMGroupMessage) ([]*SCIMGroupMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.DisplayName; v != nil {
+		where, args = append(where, fmt.Sprintf("display_name = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.ExternalID; v != nil {
+		where, args = append(where, fmt.Sprintf("external_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.MemberID; v != nil {
+		where, args = append(where, fmt.Sprintf("$%d = ANY(member_ids)", len(args)+1)), append(args, *v)
+	}
+
+	rows, err := s.db.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			external_id,
+			display_name,
+			member_ids
+		FROM scim_group
+		WHERE %s
+		ORDER BY id ASC
+	`, strings.Join(where, " AND ")), args...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list SCIM groups")
+	}
+	defer rows.Close()
+
+	var groups []*SCIMGroupMessage
+	for rows.Next() {
+		group, err := scanSCIMGroup(rows)
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+// UpdateSCIMGroup updates a SCIM group.
+func (s *Store) UpdateSCIMGroup(ctx context.Context, update *UpdateSCIMGroupMessage) (*SCIMGroupMessage, error) {
+	set, args := []string{}, []any{}
+	if v := update.ExternalID; v != nil {
+		set, args = append(set, fmt.Sprintf("external_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.DisplayName; v != nil {
+		set, args = append(set, fmt.Sprintf("display_name = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.MemberIDs; v != nil {
+		memberIDs := *v
+		if memberIDs == nil {
+			memberIDs = []int{}
+		}
+		set, args = append(set, fmt.Sprintf("member_ids = $%d", len(args)+1)), append(args, memberIDs)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no update field specified")
+	}
+	args = append(args, update.UID)
+
+	row := s.db.db.QueryRowContext(ctx, fmt.Sprintf(`
+		UPDATE scim_group
+		SET %s
+		WHERE id = $%d
+		RETURNING id, created_ts, updated_ts, external_id, display_name, member_ids
+	`, strings.Join(set, ", "), len(args)), args...)
+	group, err := scanSCIMGroup(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to update SCIM group %d", update.UID)
+	}
+	return group, nil
+}
+
+// DeleteSCIMGroup deletes a SCIM group.
+func (s *Store) DeleteSCIMGroup(ctx context.Context, uid int) error {
+	if _, err := s.db.db.ExecContext(ctx, `DELETE FROM scim_group WHERE id = $1`, uid); err != nil {
+		return errors.Wrapf(err, "failed to delete SCIM group %d", uid)
+	}
+	return nil
+}
+
+func scanSCIMGroup(rows interface{ Scan(...any) error }) (*SCIMGroupMessage, error) {
+	var group SCIMGroupMessage
+	var memberIDs pgtype.Int4Array
+	if err := rows.Scan(
+		&group.UID,
+		&group.CreatedTs,
+		&group.UpdatedTs,
+		&group.ExternalID,
+		&group.DisplayName,
+		&memberIDs,
+	); err != nil {
+		return nil, err
+	}
+	if err := memberIDs.AssignTo(&group.MemberIDs); err != nil {
+		return nil, err
+	}
+	return &group, nil
+}
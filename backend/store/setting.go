@@ -173,6 +173,66 @@ func (s *Store) GetDataClassificationSetting(ctx context.Context) (*storepb.Data
 	return payload, nil
 }
 
+// GetSemanticCategorySetting gets the semantic category setting.
+func (s *Store) GetSemanticCategorySetting(ctx context.Context) (*storepb.SemanticCategorySetting, error) {
+	settingName := api.SettingSemanticCategory
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.SemanticCategorySetting{}, nil
+	}
+
+	payload := new(storepb.SemanticCategorySetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetBackupVerificationSetting gets the backup verification setting.
+func (s *Store) GetBackupVerificationSetting(ctx context.Context) (*storepb.BackupVerificationSetting, error) {
+	settingName := api.SettingBackupVerification
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.BackupVerificationSetting{}, nil
+	}
+
+	payload := new(storepb.BackupVerificationSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
+// GetSCIMSetting gets the SCIM setting.
+func (s *Store) GetSCIMSetting(ctx context.Context) (*storepb.SCIMSetting, error) {
+	settingName := api.SettingSCIM
+	setting, err := s.GetSettingV2(ctx, &FindSettingMessage{
+		Name: &settingName,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get setting %s", settingName)
+	}
+	if setting == nil {
+		return &storepb.SCIMSetting{}, nil
+	}
+
+	payload := new(storepb.SCIMSetting)
+	if err := protojson.Unmarshal([]byte(setting.Value), payload); err != nil {
+		return nil, err
+	}
+	return payload, nil
+}
+
 // DeleteCache deletes the cache.
 func (s *Store) DeleteCache() {
 	s.settingCache = sync.Map{}
@@ -38,6 +38,8 @@ const (
 	SheetFromGitHub SheetSource = "GITHUB"
 	// SheetFromBitbucket is the sheet synced from Bitbucket.
 	SheetFromBitbucket SheetSource = "BITBUCKET"
+	// SheetFromGitea is the sheet synced from Gitea (for both Gitea and Forgejo).
+	SheetFromGitea SheetSource = "GITEA"
 )
 
 // SheetType is the type of sheet.
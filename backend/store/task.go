@@ -3,6 +3,7 @@ package store
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -456,6 +457,14 @@ func (s *Store) UpdateTaskV2(ctx context.Context, patch *api.TaskPatch) (*TaskMe
 	if (patch.RollbackEnabled != nil || patch.RollbackSQLStatus != nil || patch.RollbackSheetID != nil || patch.RollbackError != nil) && patch.Payload != nil {
 		return nil, errors.Errorf("cannot set both rollbackEnabled/rollbackSQLStatus/rollbackSheetID/rollbackError payload for TaskPatch")
 	}
+	if patch.BatchConfig != nil && patch.Payload != nil {
+		return nil, errors.Errorf("cannot set both batchConfig and payload for TaskPatch")
+	}
+	// The batch progress is based on the statement and the chunks, so it's reset when either changes.
+	payloadBase := "payload"
+	if patch.SheetID != nil || patch.BatchConfig != nil {
+		payloadBase = "(payload - 'batchProgress')"
+	}
 	var payloadSet []string
 	if v := patch.SheetID; v != nil {
 		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('sheetId', to_jsonb($%d::INT))`, len(args)+1)), append(args, *v)
@@ -475,8 +484,21 @@ func (s *Store) UpdateTaskV2(ctx context.Context, patch *api.TaskPatch) (*TaskMe
 	if v := patch.RollbackError; v != nil {
 		payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('rollbackError', to_jsonb($%d::TEXT))`, len(args)+1)), append(args, *v)
 	}
+	if v := patch.BatchConfig; v != nil {
+		if *v == (api.DataUpdateBatchConfig{}) {
+			payloadBase = fmt.Sprintf("(%s - 'batchConfig')", payloadBase)
+		} else {
+			batchConfig, err := json.Marshal(v)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to marshal batch config")
+			}
+			payloadSet, args = append(payloadSet, fmt.Sprintf(`jsonb_build_object('batchConfig', $%d::JSONB)`, len(args)+1)), append(args, string(batchConfig))
+		}
+	}
 	if len(payloadSet) != 0 {
-		set = append(set, fmt.Sprintf(`payload = payload || %s`, strings.Join(payloadSet, "||")))
+		set = append(set, fmt.Sprintf(`payload = %s || %s`, payloadBase, strings.Join(payloadSet, "||")))
+	} else if payloadBase != "payload" {
+		set = append(set, fmt.Sprintf(`payload = %s`, payloadBase))
 	}
 	if v := patch.Payload; v != nil {
 		payload := "{}"
@@ -562,7 +584,7 @@ func (s *Store) UpdateTaskStatusV2(ctx context.Context, patch *api.TaskStatusPat
 	}
 	if taskRun == nil {
 		if patch.Status == api.TaskRunning {
-			if err := s.createTaskRunImpl(ctx, tx,
+			if _, err := s.createTaskRunImpl(ctx, tx,
 				&TaskRunMessage{
 					TaskUID: task.ID,
 					Name:    fmt.Sprintf("%s %d", task.Name, time.Now().Unix()),
@@ -248,13 +248,49 @@ func (s *Store) CreatePendingTaskRuns(ctx context.Context, creates ...*TaskRunMe
 func (s *Store) createPendingTaskRunsTx(ctx context.Context, tx *Tx, creates ...*TaskRunMessage) error {
 	// TODO(p0ny): batch create.
 	for _, create := range creates {
-		if err := s.createTaskRunImpl(ctx, tx, create, api.TaskRunPending, create.CreatorID); err != nil {
+		if _, err := s.createTaskRunImpl(ctx, tx, create, api.TaskRunPending, create.CreatorID); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
+// CreateScheduledTaskRuns creates pending task runs for an occurrence of a scheduled plan and returns their IDs.
+// Unlike CreatePendingTaskRuns, the tasks may have done task runs of the previous occurrences.
+func (s *Store) CreateScheduledTaskRuns(ctx context.Context, creates ...*TaskRunMessage) ([]int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to begin tx")
+	}
+	defer tx.Rollback()
+
+	var taskIDs []int
+	for _, create := range creates {
+		taskIDs = append(taskIDs, create.TaskUID)
+	}
+	exist, err := s.checkTaskRunsExist(ctx, tx, taskIDs, []api.TaskRunStatus{api.TaskRunPending, api.TaskRunRunning})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to check if task runs exist")
+	}
+	if exist {
+		return nil, errors.Errorf("cannot create scheduled task runs because some of the tasks are pending or running")
+	}
+
+	var ids []int
+	for _, create := range creates {
+		id, err := s.createTaskRunImpl(ctx, tx, create, api.TaskRunPending, create.CreatorID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to create scheduled task run")
+		}
+		ids = append(ids, id)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, errors.Wrapf(err, "failed to commit tx")
+	}
+	return ids, nil
+}
+
 func (*Store) checkTaskRunsExist(ctx context.Context, tx *Tx, taskIDs []int, statuses []api.TaskRunStatus) (bool, error) {
 	query := `
 	SELECT EXISTS (
@@ -271,8 +307,8 @@ func (*Store) checkTaskRunsExist(ctx context.Context, tx *Tx, taskIDs []int, sta
 	return exist, nil
 }
 
-// createTaskRunImpl creates a new taskRun.
-func (*Store) createTaskRunImpl(ctx context.Context, tx *Tx, create *TaskRunMessage, status api.TaskRunStatus, creatorID int) error {
+// createTaskRunImpl creates a new taskRun and returns its ID.
+func (*Store) createTaskRunImpl(ctx context.Context, tx *Tx, create *TaskRunMessage, status api.TaskRunStatus, creatorID int) (int, error) {
 	query := `
 		INSERT INTO task_run (
 			creator_id,
@@ -281,17 +317,19 @@ func (*Store) createTaskRunImpl(ctx context.Context, tx *Tx, create *TaskRunMess
 			name,
 			status
 		) VALUES ($1, $2, $3, $4, $5)
+		RETURNING id
 	`
-	if _, err := tx.ExecContext(ctx, query,
+	var id int
+	if err := tx.QueryRowContext(ctx, query,
 		creatorID,
 		creatorID,
 		create.TaskUID,
 		create.Name,
 		status,
-	); err != nil {
-		return err
+	).Scan(&id); err != nil {
+		return 0, err
 	}
-	return nil
+	return id, nil
 }
 
 func (s *Store) getTaskRunTx(ctx context.Context, tx *Tx, find *TaskRunFind) (*TaskRunMessage, error) {
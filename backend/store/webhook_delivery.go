@@ -0,0 +1,243 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// WebhookDeliveryStatus is the status of a webhook delivery.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryPending is the webhook delivery status for PENDING, which is waiting for the next attempt.
+	WebhookDeliveryPending WebhookDeliveryStatus = "PENDING"
+	// WebhookDeliveryDone is the webhook delivery status for DONE.
+	WebhookDeliveryDone WebhookDeliveryStatus = "DONE"
+	// WebhookDeliveryFailed is the webhook delivery status for FAILED, which has run out of attempts.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+// WebhookDeliveryMessage is the message for a webhook delivery.
+type WebhookDeliveryMessage struct {
+	WebhookUID   int
+	ActivityType string
+	// Payload is the JSON encoded webhook context of the event.
+	Payload string
+
+	// Output only fields.
+	UID           int
+	CreatedTs     int64
+	UpdatedTs     int64
+	Status        WebhookDeliveryStatus
+	Attempt       int
+	NextAttemptTs int64
+	LastError     string
+}
+
+// FindWebhookDeliveryMessage is the message for finding webhook deliveries.
+type FindWebhookDeliveryMessage struct {
+	UID        *int
+	WebhookUID *int
+	Status     *WebhookDeliveryStatus
+	// NextAttemptBefore finds the deliveries whose next attempt is due before the timestamp.
+	NextAttemptBefore *int64
+
+	Limit  *int
+	Offset *int
+}
+
+// UpdateWebhookDeliveryMessage is the message for updating a webhook delivery.
+type UpdateWebhookDeliveryMessage struct {
+	UID int
+
+	Status        *WebhookDeliveryStatus
+	Attempt       *int
+	NextAttemptTs *int64
+	LastError     *string
+}
+
+// CreateWebhookDeliveries creates pending webhook deliveries, which will be posted by the webhook delivery runner.
+func (s *Store) CreateWebhookDeliveries(ctx context.Context, creates ...*WebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	if len(creates) == 0 {
+		return nil, nil
+	}
+
+	var query strings.Builder
+	var values []any
+	if _, err := query.WriteString(`INSERT INTO webhook_delivery (
+		webhook_id,
+		activity_type,
+		status,
+		payload
+	) VALUES
+	`); err != nil {
+		return nil, err
+	}
+	for i, create := range creates {
+		values = append(values,
+			create.WebhookUID,
+			create.ActivityType,
+			WebhookDeliveryPending,
+			create.Payload,
+		)
+		if i != 0 {
+			if _, err := query.WriteString(","); err != nil {
+				return nil, err
+			}
+		}
+		count := 4
+		if _, err := query.WriteString(getPlaceholders(i*count+1, count)); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := query.WriteString(`
+		RETURNING id, created_ts, updated_ts, webhook_id, activity_type, payload, status, attempt, next_attempt_ts, last_error
+	`); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.db.QueryContext(ctx, query.String(), values...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create webhook deliveries")
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// GetWebhookDelivery gets a webhook delivery.
+func (s *Store) GetWebhookDelivery(ctx context.Context, find *FindWebhookDeliveryMessage) (*WebhookDeliveryMessage, error) {
+	deliveries, err := s.ListWebhookDeliveries(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	if len(deliveries) > 1 {
+		return nil, errors.Errorf("expected find one webhook delivery with %+v, but found %d", find, len(deliveries))
+	}
+	return deliveries[0], nil
+}
+
+// ListWebhookDeliveries lists webhook deliveries, the most recent first.
+func (s *Store) ListWebhookDeliveries(ctx context.Context, find *FindWebhookDeliveryMessage) ([]*WebhookDeliveryMessage, error) {
+	where, args := []string{"TRUE"}, []any{}
+	if v := find.UID; v != nil {
+		where, args = append(where, fmt.Sprintf("id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.WebhookUID; v != nil {
+		where, args = append(where, fmt.Sprintf("webhook_id = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.Status; v != nil {
+		where, args = append(where, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := find.NextAttemptBefore; v != nil {
+		where, args = append(where, fmt.Sprintf("next_attempt_ts <= $%d", len(args)+1)), append(args, *v)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			id,
+			created_ts,
+			updated_ts,
+			webhook_id,
+			activity_type,
+			payload,
+			status,
+			attempt,
+			next_attempt_ts,
+			last_error
+		FROM webhook_delivery
+		WHERE %s
+		ORDER BY id DESC
+	`, strings.Join(where, " AND "))
+	if v := find.Limit; v != nil {
+		query += fmt.Sprintf(" LIMIT %d", *v)
+	}
+	if v := find.Offset; v != nil {
+		query += fmt.Sprintf(" OFFSET %d", *v)
+	}
+
+	rows, err := s.db.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []*WebhookDeliveryMessage
+	for rows.Next() {
+		delivery, err := scanWebhookDelivery(rows)
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery updates a webhook delivery.
+func (s *Store) UpdateWebhookDelivery(ctx context.Context, update *UpdateWebhookDeliveryMessage) error {
+	set, args := []string{}, []any{}
+	if v := update.Status; v != nil {
+		set, args = append(set, fmt.Sprintf("status = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.Attempt; v != nil {
+		set, args = append(set, fmt.Sprintf("attempt = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.NextAttemptTs; v != nil {
+		set, args = append(set, fmt.Sprintf("next_attempt_ts = $%d", len(args)+1)), append(args, *v)
+	}
+	if v := update.LastError; v != nil {
+		set, args = append(set, fmt.Sprintf("last_error = $%d", len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return errors.New("no update field specified")
+	}
+	args = append(args, update.UID)
+
+	if _, err := s.db.db.ExecContext(ctx, fmt.Sprintf(`
+		UPDATE webhook_delivery
+		SET %s
+		WHERE id = $%d
+	`, strings.Join(set, ", "), len(args)), args...); err != nil {
+		return errors.Wrapf(err, "failed to update webhook delivery %d", update.UID)
+	}
+	return nil
+}
+
+func scanWebhookDelivery(rows interface{ Scan(...any) error }) (*WebhookDeliveryMessage, error) {
+	var delivery WebhookDeliveryMessage
+	if err := rows.Scan(
+		&delivery.UID,
+		&delivery.CreatedTs,
+		&delivery.UpdatedTs,
+		&delivery.WebhookUID,
+		&delivery.ActivityType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempt,
+		&delivery.NextAttemptTs,
+		&delivery.LastError,
+	); err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
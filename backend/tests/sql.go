@@ -37,6 +37,25 @@ func (ctl *controller) adminQuery(ctx context.Context, instance *v1pb.Instance,
 	return resp.Results, nil
 }
 
+// export exports the query result and returns the concatenated content of the export stream.
+func (ctl *controller) export(ctx context.Context, request *v1pb.ExportRequest) ([]byte, error) {
+	c, err := ctl.sqlServiceClient.Export(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	var content []byte
+	for {
+		resp, err := c.Recv()
+		if err == io.EOF {
+			return content, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		content = append(content, resp.Content...)
+	}
+}
+
 // GetSQLReviewResult will wait for next task SQL review task check to finish and return the task check result.
 func (ctl *controller) GetSQLReviewResult(ctx context.Context, plan *v1pb.Plan) (*v1pb.PlanCheckRun, error) {
 	ticker := time.NewTicker(100 * time.Millisecond)
@@ -173,7 +173,7 @@ func TestSQLExport(t *testing.T) {
 			a.NoError(err)
 			checkResults(a, tt.databaseName, statement, tt.affectedRows, results)
 
-			export, err := ctl.sqlServiceClient.Export(ctx, &v1pb.ExportRequest{
+			export, err := ctl.export(ctx, &v1pb.ExportRequest{
 				Admin:              true,
 				ConnectionDatabase: databaseNameQuery,
 				Format:             v1pb.ExportRequest_SQL,
@@ -188,7 +188,7 @@ func TestSQLExport(t *testing.T) {
 			a.NoError(err)
 			checkResults(a, tt.databaseName, statement, tt.affectedRows, results)
 
-			statement = string(export.Content)
+			statement = string(export)
 			results, err = ctl.adminQuery(ctx, instance, tt.databaseName, statement)
 			a.NoError(err)
 			checkResults(a, tt.databaseName, statement, tt.affectedRows, results)
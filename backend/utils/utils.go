@@ -414,8 +414,8 @@ func PassAllCheck(task *store.TaskMessage, allowedStatus api.TaskCheckStatus, ta
 			runs = append(runs, run)
 		}
 	}
-	// schema update, data update and gh-ost sync task have required task check.
-	if task.Type == api.TaskDatabaseSchemaUpdate || task.Type == api.TaskDatabaseSchemaUpdateSDL || task.Type == api.TaskDatabaseDataUpdate || task.Type == api.TaskDatabaseSchemaUpdateGhostSync {
+	// schema update, data update, gh-ost sync and online migration sync task have required task check.
+	if task.Type == api.TaskDatabaseSchemaUpdate || task.Type == api.TaskDatabaseSchemaUpdateSDL || task.Type == api.TaskDatabaseDataUpdate || task.Type == api.TaskDatabaseSchemaUpdateGhostSync || task.Type == api.TaskDatabaseSchemaUpdatePGOnlineSync {
 		pass, err := passCheck(runs, api.TaskCheckDatabaseConnect, allowedStatus)
 		if err != nil {
 			return false, err
@@ -455,6 +455,16 @@ func PassAllCheck(task *store.TaskMessage, allowedStatus api.TaskCheckStatus, ta
 		}
 	}
 
+	if task.Type == api.TaskDatabaseSchemaUpdatePGOnlineSync {
+		ok, err := passCheck(runs, api.TaskCheckPGOnlineSync, allowedStatus)
+		if err != nil {
+			return false, err
+		}
+		if !ok {
+			return false, nil
+		}
+	}
+
 	return true, nil
 }
 
@@ -778,7 +788,7 @@ func handleApprovalNodeExternalNode(ctx context.Context, s *store.Store, relayCl
 	if node == nil {
 		return errors.Errorf("external approval node %s not found", externalNodeID)
 	}
-	id, err := relayClient.Create(node.Endpoint, &relay.CreatePayload{
+	id, err := relayClient.Create(&relay.Node{Endpoint: node.Endpoint, Secret: node.Secret}, &relay.CreatePayload{
 		IssueID:     fmt.Sprintf("%d", issue.UID),
 		Title:       issue.Title,
 		Description: issue.Description,
@@ -916,6 +926,8 @@ func convertVcsPushEventType(vcsType vcs.Type) storepb.VcsType {
 		return storepb.VcsType_GITHUB
 	case "BITBUCKET":
 		return storepb.VcsType_BITBUCKET
+	case "GITEA":
+		return storepb.VcsType_GITEA
 	default:
 		return storepb.VcsType_VCS_TYPE_UNSPECIFIED
 	}
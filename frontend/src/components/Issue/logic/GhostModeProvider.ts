@@ -23,7 +23,12 @@ import {
   Sheet_Source,
   Sheet_Type,
 } from "@/types/proto/v1/sheet_service";
-import { extractSheetUID, sheetIdOfTask } from "@/utils";
+import {
+  extractSheetUID,
+  isOnlineMigrationCutoverTask,
+  isOnlineMigrationSyncTask,
+  sheetIdOfTask,
+} from "@/utils";
 import {
   flattenTaskList,
   maybeFormatStatementOnSave,
@@ -72,7 +77,7 @@ export default defineComponent({
         // In standard pipeline, each ghost-sync task can hold its own
         // statement
         const task = selectedTask.value;
-        if (task.type === "bb.task.database.schema.update.ghost.sync") {
+        if (isOnlineMigrationSyncTask(task)) {
           if (create.value) {
             let statement = (task as TaskCreate).statement;
             if ((task as TaskCreate).sheetId !== UNKNOWN_ID) {
@@ -218,7 +223,7 @@ export default defineComponent({
         // createContext.detailList by its databaseId accordingly.
         const createContext = issueCreate.createContext as MigrationContext;
         const syncTaskList = flattenTaskList<TaskCreate>(issueCreate).filter(
-          (task) => task.type === "bb.task.database.schema.update.ghost.sync"
+          (task) => isOnlineMigrationSyncTask(task)
         );
         const detailList = createContext.detailList;
         for (const task of syncTaskList) {
@@ -255,7 +260,7 @@ export default defineComponent({
       to: TaskStatus
     ): boolean => {
       if (
-        task.type === "bb.task.database.schema.update.ghost.cutover" &&
+        isOnlineMigrationCutoverTask(task) &&
         task.status === "FAILED"
       ) {
         if (to === "PENDING" || to === "RUNNING") {
@@ -264,7 +269,7 @@ export default defineComponent({
         }
       }
       if (
-        task.type === "bb.task.database.schema.update.ghost.sync" &&
+        isOnlineMigrationSyncTask(task) &&
         to === "CANCELED"
       ) {
         // CANCELing gh-ost sync task is allowed.
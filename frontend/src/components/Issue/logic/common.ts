@@ -346,6 +346,7 @@ export const TaskTypeWithStatement: TaskType[] = [
   "bb.task.database.schema.update",
   "bb.task.database.schema.update-sdl",
   "bb.task.database.schema.update.ghost.sync",
+  "bb.task.database.schema.update.pg-online.sync",
 ];
 
 export const IssueTypeWithStatement: IssueType[] = [
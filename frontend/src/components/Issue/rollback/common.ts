@@ -69,6 +69,10 @@ export const useRollbackLogic = () => {
       case Engine.ORACLE:
         // We don't have a check for oracle similar to the MySQL version check.
         break;
+      case Engine.POSTGRES:
+        // The rollback SQL is generated from the rows captured during the execution,
+        // so it needs to be enabled before the task runs.
+        break;
       default:
         return "NONE";
     }
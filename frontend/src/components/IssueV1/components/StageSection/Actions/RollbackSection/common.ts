@@ -58,6 +58,10 @@ export const useRollbackContext = () => {
       case Engine.ORACLE:
         // We don't have a check for oracle similar to the MySQL version check.
         break;
+      case Engine.POSTGRES:
+        // The rollback SQL is generated from the rows captured during the execution,
+        // so it needs to be enabled before the task runs.
+        break;
       default:
         return "NONE";
     }
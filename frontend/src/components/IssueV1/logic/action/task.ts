@@ -33,6 +33,7 @@ export const CancelableTaskTypeList: Task_Type[] = [
   Task_Type.DATABASE_SCHEMA_UPDATE,
   Task_Type.DATABASE_SCHEMA_UPDATE_SDL,
   // Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_SYNC, // on the way
+  Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC,
 ];
 
 export const TaskRolloutActionMap: Record<Task_Status, TaskRolloutAction[]> = {
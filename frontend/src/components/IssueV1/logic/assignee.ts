@@ -247,6 +247,8 @@ export const taskTypeToDeploymentType = (
       return DeploymentType.DATABASE_DDL;
     case Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_CUTOVER:
     case Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_SYNC:
+    case Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER:
+    case Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC:
       return DeploymentType.DATABASE_DDL_GHOST;
     case Task_Type.DATABASE_DATA_UPDATE:
       return DeploymentType.DATABASE_DML;
@@ -144,6 +144,8 @@ export const useBaseIssueContext = (
       return [
         Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_SYNC,
         Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_CUTOVER,
+        Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC,
+        Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER,
       ].includes(task.type);
     });
   });
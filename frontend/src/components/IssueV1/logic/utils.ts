@@ -31,6 +31,7 @@ export const databaseForTask = (issue: ComposedIssue, task: Task) => {
       task.databaseSchemaUpdate ||
       task.databaseRestoreRestore ||
       task.type === Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_CUTOVER ||
+      task.type === Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER ||
       task.type === Task_Type.DATABASE_SCHEMA_BASELINE
     ) {
       return useDatabaseV1Store().getDatabaseByName(task.target);
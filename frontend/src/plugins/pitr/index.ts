@@ -22,6 +22,10 @@ export const MIN_PITR_SUPPORT_MYSQL_VERSION = "8.0.0";
 
 export const isPITRAvailableOnInstanceV1 = (instance: Instance): boolean => {
   const { engine, engineVersion } = instance;
+  if (engine === Engine.POSTGRES) {
+    // The WAL archiving and the server version are verified by the PITR check.
+    return instance.options?.pitrEnabled ?? false;
+  }
   return (
     engine === Engine.MYSQL &&
     semverCompare(engineVersion, MIN_PITR_SUPPORT_MYSQL_VERSION)
@@ -44,6 +48,17 @@ export const usePITRLogic = (database: Ref<ComposedDatabase>) => {
 
   const pitrAvailable = computed((): { result: boolean; message: string } => {
     const { engine, engineVersion } = database.value.instanceEntity;
+    if (engine === Engine.POSTGRES) {
+      // PostgreSQL recovers from the base backups taken by the WAL archiving,
+      // which are verified by the PITR check.
+      if (!database.value.instanceEntity.options?.pitrEnabled) {
+        return {
+          result: false,
+          message: t("database.pitr.pitr-not-enabled"),
+        };
+      }
+      return { result: true, message: "ok" };
+    }
     if (
       engine === Engine.MYSQL &&
       semverCompare(engineVersion, MIN_PITR_SUPPORT_MYSQL_VERSION)
@@ -1,8 +1,9 @@
+import type { ExportFormat } from "@/components/DataExportButton.vue";
 import { getExportRequestFormat, useSQLStore } from "@/store";
 import { extractDatabaseResourceName } from "@/utils";
 
 export type ExportDataParams = {
-  format: "CSV" | "JSON" | "SQL" | "XLSX";
+  format: ExportFormat;
   statement: string;
   limit: number;
   database: string; // instances/{instance}/databases/{database}
@@ -18,7 +19,7 @@ export const useExportData = () => {
       ? extractDatabaseResourceName(params.database).database
       : "";
 
-    const { content } = await sqlStore.exportData({
+    return await sqlStore.exportData({
       name: params.instance,
       connectionDatabase,
       statement: params.statement,
@@ -26,8 +27,6 @@ export const useExportData = () => {
       format: getExportRequestFormat(params.format),
       admin: params.admin ?? false,
     });
-
-    return content;
   };
 
   return {
@@ -1,5 +1,6 @@
 import { ClientError, Status } from "nice-grpc-common";
 import { defineStore } from "pinia";
+import type { ExportFormat } from "@/components/DataExportButton.vue";
 import { sqlServiceClient } from "@/grpcweb";
 import { SQLResultSetV1 } from "@/types";
 import {
@@ -38,11 +39,17 @@ export const useSQLStore = defineStore("sql", () => {
     }
   };
 
-  const exportData = async (params: ExportRequest) => {
-    return await sqlServiceClient.export(params, {
+  const exportData = async (params: ExportRequest): Promise<Blob> => {
+    const stream = sqlServiceClient.export(params, {
       // Won't jump to 403 page when permission denied.
       ignoredCodes: [Status.PERMISSION_DENIED],
     });
+    // The export content is streamed in chunks.
+    const chunks: Uint8Array[] = [];
+    for await (const response of stream) {
+      chunks.push(response.content);
+    }
+    return new Blob(chunks);
   };
 
   return {
@@ -52,7 +59,7 @@ export const useSQLStore = defineStore("sql", () => {
 });
 
 export const getExportRequestFormat = (
-  format: "CSV" | "JSON" | "SQL" | "XLSX"
+  format: ExportFormat
 ): ExportRequest_Format => {
   switch (format) {
     case "CSV":
@@ -63,12 +70,16 @@ export const getExportRequestFormat = (
       return ExportRequest_Format.SQL;
     case "XLSX":
       return ExportRequest_Format.XLSX;
+    case "PARQUET":
+      return ExportRequest_Format.PARQUET;
+    case "NDJSON":
+      return ExportRequest_Format.NDJSON;
     default:
       return ExportRequest_Format.FORMAT_UNSPECIFIED;
   }
 };
 
-export const getExportFileType = (format: "CSV" | "JSON" | "SQL" | "XLSX") => {
+export const getExportFileType = (format: ExportFormat) => {
   switch (format) {
     case "CSV":
       return "text/csv";
@@ -78,5 +89,9 @@ export const getExportFileType = (format: "CSV" | "JSON" | "SQL" | "XLSX") => {
       return "application/sql";
     case "XLSX":
       return "application/vnd.ms-excel";
+    case "PARQUET":
+      return "application/vnd.apache.parquet";
+    case "NDJSON":
+      return "application/x-ndjson";
   }
 };
@@ -26,6 +26,8 @@ export type TaskType =
   | "bb.task.database.restore"
   | "bb.task.database.schema.update.ghost.sync"
   | "bb.task.database.schema.update.ghost.cutover"
+  | "bb.task.database.schema.update.pg-online.sync"
+  | "bb.task.database.schema.update.pg-online.cutover"
   | "bb.task.database.restore.pitr.restore"
   | "bb.task.database.restore.pitr.cutover";
 
@@ -96,6 +98,19 @@ export type TaskDatabaseSchemaUpdateGhostCutoverPayload = {
   skippedReason: string;
 };
 
+export type TaskDatabaseSchemaUpdatePGOnlineSyncPayload = {
+  skipped: boolean;
+  skippedReason: string;
+  statement: string;
+  sheetId: SheetId;
+  pushEvent?: VCSPushEvent;
+};
+
+export type TaskDatabaseSchemaUpdatePGOnlineCutoverPayload = {
+  skipped: boolean;
+  skippedReason: string;
+};
+
 export type TaskDatabasePITRRestorePayload = {
   skipped: boolean;
   skippedReason: string;
@@ -144,6 +159,8 @@ export type TaskPayload =
   | TaskDatabaseSchemaUpdatePayload
   | TaskDatabaseSchemaUpdateGhostSyncPayload
   | TaskDatabaseSchemaUpdateGhostCutoverPayload
+  | TaskDatabaseSchemaUpdatePGOnlineSyncPayload
+  | TaskDatabaseSchemaUpdatePGOnlineCutoverPayload
   | TaskDatabaseDataUpdatePayload
   | TaskDatabaseRestorePayload
   | TaskEarliestAllowedTimePayload
@@ -273,8 +290,10 @@ export type TaskCheckType =
   | "bb.task-check.database.statement.type"
   | "bb.task-check.database.connect"
   | "bb.task-check.database.ghost.sync"
+  | "bb.task-check.database.pg-online.sync"
   | "bb.task-check.issue.lgtm"
   | "bb.task-check.pitr.mysql"
+  | "bb.task-check.pitr.postgres"
   | "bb.task-check.database.statement.type.report"
   | "bb.task-check.database.statement.affected-rows.report";
 
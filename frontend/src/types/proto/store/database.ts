@@ -280,6 +280,11 @@ export interface ColumnMetadata {
   classification: string;
   /** The user_comment is the user comment of a table parsed from the comment. */
   userComment: string;
+  /**
+   * The discovered_classification is the classification detected by the sensitive data discovery of the project data classification config.
+   * It is only detected for the columns without classification in their comments.
+   */
+  discoveredClassification: string;
 }
 
 /** ViewMetadata is the metadata for views. */
@@ -1447,6 +1452,7 @@ function createBaseColumnMetadata(): ColumnMetadata {
     comment: "",
     classification: "",
     userComment: "",
+    discoveredClassification: "",
   };
 }
 
@@ -1482,6 +1488,9 @@ export const ColumnMetadata = {
     if (message.userComment !== "") {
       writer.uint32(82).string(message.userComment);
     }
+    if (message.discoveredClassification !== "") {
+      writer.uint32(90).string(message.discoveredClassification);
+    }
     return writer;
   },
 
@@ -1562,6 +1571,13 @@ export const ColumnMetadata = {
 
           message.userComment = reader.string();
           continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.discoveredClassification = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1583,6 +1599,7 @@ export const ColumnMetadata = {
       comment: isSet(object.comment) ? String(object.comment) : "",
       classification: isSet(object.classification) ? String(object.classification) : "",
       userComment: isSet(object.userComment) ? String(object.userComment) : "",
+      discoveredClassification: isSet(object.discoveredClassification) ? String(object.discoveredClassification) : "",
     };
   },
 
@@ -1598,6 +1615,7 @@ export const ColumnMetadata = {
     message.comment !== undefined && (obj.comment = message.comment);
     message.classification !== undefined && (obj.classification = message.classification);
     message.userComment !== undefined && (obj.userComment = message.userComment);
+    message.discoveredClassification !== undefined && (obj.discoveredClassification = message.discoveredClassification);
     return obj;
   },
 
@@ -1617,6 +1635,7 @@ export const ColumnMetadata = {
     message.comment = object.comment ?? "";
     message.classification = object.classification ?? "";
     message.userComment = object.userComment ?? "";
+    message.discoveredClassification = object.discoveredClassification ?? "";
     return message;
   },
 };
@@ -13,7 +13,14 @@ export interface InstanceOptions {
    */
   schemaTenantMode: boolean;
   /** How often the instance is synced. */
-  syncInterval?: Duration | undefined;
+  syncInterval?:
+    | Duration
+    | undefined;
+  /**
+   * pitr_enabled enables the point-in-time recovery of the PostgreSQL instance.
+   * Bytebase streams the WAL and takes a daily base backup of the instance if it's enabled.
+   */
+  pitrEnabled: boolean;
 }
 
 /** InstanceMetadata is the metadata for instances. */
@@ -27,7 +34,7 @@ export interface InstanceMetadata {
 }
 
 function createBaseInstanceOptions(): InstanceOptions {
-  return { schemaTenantMode: false, syncInterval: undefined };
+  return { schemaTenantMode: false, syncInterval: undefined, pitrEnabled: false };
 }
 
 export const InstanceOptions = {
@@ -38,6 +45,9 @@ export const InstanceOptions = {
     if (message.syncInterval !== undefined) {
       Duration.encode(message.syncInterval, writer.uint32(18).fork()).ldelim();
     }
+    if (message.pitrEnabled === true) {
+      writer.uint32(24).bool(message.pitrEnabled);
+    }
     return writer;
   },
 
@@ -62,6 +72,13 @@ export const InstanceOptions = {
 
           message.syncInterval = Duration.decode(reader, reader.uint32());
           continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.pitrEnabled = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -75,6 +92,7 @@ export const InstanceOptions = {
     return {
       schemaTenantMode: isSet(object.schemaTenantMode) ? Boolean(object.schemaTenantMode) : false,
       syncInterval: isSet(object.syncInterval) ? Duration.fromJSON(object.syncInterval) : undefined,
+      pitrEnabled: isSet(object.pitrEnabled) ? Boolean(object.pitrEnabled) : false,
     };
   },
 
@@ -83,6 +101,7 @@ export const InstanceOptions = {
     message.schemaTenantMode !== undefined && (obj.schemaTenantMode = message.schemaTenantMode);
     message.syncInterval !== undefined &&
       (obj.syncInterval = message.syncInterval ? Duration.toJSON(message.syncInterval) : undefined);
+    message.pitrEnabled !== undefined && (obj.pitrEnabled = message.pitrEnabled);
     return obj;
   },
 
@@ -96,6 +115,7 @@ export const InstanceOptions = {
     message.syncInterval = (object.syncInterval !== undefined && object.syncInterval !== null)
       ? Duration.fromPartial(object.syncInterval)
       : undefined;
+    message.pitrEnabled = object.pitrEnabled ?? false;
     return message;
   },
 };
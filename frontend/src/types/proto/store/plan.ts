@@ -1,11 +1,31 @@
 /* eslint-disable */
 import * as _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
 import { Timestamp } from "../google/protobuf/timestamp";
 
 export const protobufPackage = "bytebase.store";
 
 export interface PlanConfig {
   steps: PlanConfig_Step[];
+  /** schedule is present if the data changes of the plan run repeatedly on a cron schedule. */
+  schedule?: PlanConfig_Schedule | undefined;
+}
+
+export interface PlanConfig_Schedule {
+  /** cron is the standard 5-field cron expression, e.g. "0 2 * * *". */
+  cron: string;
+  /**
+   * time_zone is the IANA time zone name the cron expression is evaluated in, e.g. "America/Los_Angeles".
+   * Empty means UTC.
+   */
+  timeZone: string;
+  /** paused stops the schedule from creating new occurrences. */
+  paused: boolean;
+  /**
+   * start_time is the time after which the occurrences are scheduled.
+   * It is reset when the schedule is resumed so that the missed occurrences are not run.
+   */
+  startTime?: Date | undefined;
 }
 
 export interface PlanConfig_Step {
@@ -85,6 +105,11 @@ export interface PlanConfig_ChangeDatabaseConfig {
   /** If RollbackEnabled, build the RollbackSheetID of the task. */
   rollbackEnabled: boolean;
   rollbackDetail?: PlanConfig_ChangeDatabaseConfig_RollbackDetail | undefined;
+  /**
+   * batch_config is present if the DML statement runs in primary key ranged chunks.
+   * It only applies to the data changes on MySQL and PostgreSQL.
+   */
+  batchConfig?: PlanConfig_ChangeDatabaseConfig_BatchConfig | undefined;
 }
 
 /** Type is the database change type. */
@@ -174,6 +199,23 @@ export interface PlanConfig_ChangeDatabaseConfig_RollbackDetail {
   rollbackFromIssue: string;
 }
 
+export interface PlanConfig_ChangeDatabaseConfig_BatchConfig {
+  /** chunk_size is the number of rows in the primary key range of each chunk. */
+  chunkSize: number;
+  /** pause is the time to pause between chunks. */
+  pause?: Duration | undefined;
+  /**
+   * max_replica_lag pauses the execution while the replica lag exceeds it.
+   * Zero means no limit.
+   */
+  maxReplicaLag?: Duration | undefined;
+  /**
+   * max_threads_running pauses the execution while the number of running threads exceeds it.
+   * Zero means no limit.
+   */
+  maxThreadsRunning: number;
+}
+
 export interface PlanConfig_RestoreDatabaseConfig {
   /**
    * The resource name of the target to restore.
@@ -192,11 +234,35 @@ export interface PlanConfig_RestoreDatabaseConfig {
     | string
     | undefined;
   /** After the PITR operations, the database will be recovered to the state at this time. */
-  pointInTime?: Date | undefined;
+  pointInTime?:
+    | Date
+    | undefined;
+  /**
+   * After the PITR operations, the database will be recovered to the state at this WAL location.
+   * Only supported for PostgreSQL.
+   * Format: {hex}/{hex}, e.g. 0/16B3748
+   */
+  lsn?: string | undefined;
+}
+
+export interface PlanScheduleOccurrencePayload {
+  /** task_run_uids are the task runs created for the occurrence. */
+  taskRunUids: number[];
+  /** rollbacks are the rollback statements generated for the task runs of the occurrence. */
+  rollbacks: PlanScheduleOccurrencePayload_Rollback[];
+  /** detail is the reason why the occurrence is failed or skipped. */
+  detail: string;
+}
+
+export interface PlanScheduleOccurrencePayload_Rollback {
+  taskUid: number;
+  /** sheet_uid is the sheet of the generated rollback statement. */
+  sheetUid: number;
+  error: string;
 }
 
 function createBasePlanConfig(): PlanConfig {
-  return { steps: [] };
+  return { steps: [], schedule: undefined };
 }
 
 export const PlanConfig = {
@@ -204,6 +270,9 @@ export const PlanConfig = {
     for (const v of message.steps) {
       PlanConfig_Step.encode(v!, writer.uint32(10).fork()).ldelim();
     }
+    if (message.schedule !== undefined) {
+      PlanConfig_Schedule.encode(message.schedule, writer.uint32(18).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -221,6 +290,13 @@ export const PlanConfig = {
 
           message.steps.push(PlanConfig_Step.decode(reader, reader.uint32()));
           continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.schedule = PlanConfig_Schedule.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -231,7 +307,10 @@ export const PlanConfig = {
   },
 
   fromJSON(object: any): PlanConfig {
-    return { steps: Array.isArray(object?.steps) ? object.steps.map((e: any) => PlanConfig_Step.fromJSON(e)) : [] };
+    return {
+      steps: Array.isArray(object?.steps) ? object.steps.map((e: any) => PlanConfig_Step.fromJSON(e)) : [],
+      schedule: isSet(object.schedule) ? PlanConfig_Schedule.fromJSON(object.schedule) : undefined,
+    };
   },
 
   toJSON(message: PlanConfig): unknown {
@@ -241,6 +320,8 @@ export const PlanConfig = {
     } else {
       obj.steps = [];
     }
+    message.schedule !== undefined &&
+      (obj.schedule = message.schedule ? PlanConfig_Schedule.toJSON(message.schedule) : undefined);
     return obj;
   },
 
@@ -251,6 +332,106 @@ export const PlanConfig = {
   fromPartial(object: DeepPartial<PlanConfig>): PlanConfig {
     const message = createBasePlanConfig();
     message.steps = object.steps?.map((e) => PlanConfig_Step.fromPartial(e)) || [];
+    message.schedule = (object.schedule !== undefined && object.schedule !== null)
+      ? PlanConfig_Schedule.fromPartial(object.schedule)
+      : undefined;
+    return message;
+  },
+};
+
+function createBasePlanConfig_Schedule(): PlanConfig_Schedule {
+  return { cron: "", timeZone: "", paused: false, startTime: undefined };
+}
+
+export const PlanConfig_Schedule = {
+  encode(message: PlanConfig_Schedule, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.cron !== "") {
+      writer.uint32(10).string(message.cron);
+    }
+    if (message.timeZone !== "") {
+      writer.uint32(18).string(message.timeZone);
+    }
+    if (message.paused === true) {
+      writer.uint32(24).bool(message.paused);
+    }
+    if (message.startTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.startTime), writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanConfig_Schedule {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanConfig_Schedule();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.cron = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.timeZone = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.paused = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.startTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanConfig_Schedule {
+    return {
+      cron: isSet(object.cron) ? String(object.cron) : "",
+      timeZone: isSet(object.timeZone) ? String(object.timeZone) : "",
+      paused: isSet(object.paused) ? Boolean(object.paused) : false,
+      startTime: isSet(object.startTime) ? fromJsonTimestamp(object.startTime) : undefined,
+    };
+  },
+
+  toJSON(message: PlanConfig_Schedule): unknown {
+    const obj: any = {};
+    message.cron !== undefined && (obj.cron = message.cron);
+    message.timeZone !== undefined && (obj.timeZone = message.timeZone);
+    message.paused !== undefined && (obj.paused = message.paused);
+    message.startTime !== undefined && (obj.startTime = message.startTime.toISOString());
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanConfig_Schedule>): PlanConfig_Schedule {
+    return PlanConfig_Schedule.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<PlanConfig_Schedule>): PlanConfig_Schedule {
+    const message = createBasePlanConfig_Schedule();
+    message.cron = object.cron ?? "";
+    message.timeZone = object.timeZone ?? "";
+    message.paused = object.paused ?? false;
+    message.startTime = object.startTime ?? undefined;
     return message;
   },
 };
@@ -727,7 +908,15 @@ export const PlanConfig_CreateDatabaseConfig_LabelsEntry = {
 };
 
 function createBasePlanConfig_ChangeDatabaseConfig(): PlanConfig_ChangeDatabaseConfig {
-  return { target: "", sheet: "", type: 0, schemaVersion: "", rollbackEnabled: false, rollbackDetail: undefined };
+  return {
+    target: "",
+    sheet: "",
+    type: 0,
+    schemaVersion: "",
+    rollbackEnabled: false,
+    rollbackDetail: undefined,
+    batchConfig: undefined,
+  };
 }
 
 export const PlanConfig_ChangeDatabaseConfig = {
@@ -750,6 +939,9 @@ export const PlanConfig_ChangeDatabaseConfig = {
     if (message.rollbackDetail !== undefined) {
       PlanConfig_ChangeDatabaseConfig_RollbackDetail.encode(message.rollbackDetail, writer.uint32(50).fork()).ldelim();
     }
+    if (message.batchConfig !== undefined) {
+      PlanConfig_ChangeDatabaseConfig_BatchConfig.encode(message.batchConfig, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -802,6 +994,13 @@ export const PlanConfig_ChangeDatabaseConfig = {
 
           message.rollbackDetail = PlanConfig_ChangeDatabaseConfig_RollbackDetail.decode(reader, reader.uint32());
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.batchConfig = PlanConfig_ChangeDatabaseConfig_BatchConfig.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -821,6 +1020,9 @@ export const PlanConfig_ChangeDatabaseConfig = {
       rollbackDetail: isSet(object.rollbackDetail)
         ? PlanConfig_ChangeDatabaseConfig_RollbackDetail.fromJSON(object.rollbackDetail)
         : undefined,
+      batchConfig: isSet(object.batchConfig)
+        ? PlanConfig_ChangeDatabaseConfig_BatchConfig.fromJSON(object.batchConfig)
+        : undefined,
     };
   },
 
@@ -834,6 +1036,9 @@ export const PlanConfig_ChangeDatabaseConfig = {
     message.rollbackDetail !== undefined && (obj.rollbackDetail = message.rollbackDetail
       ? PlanConfig_ChangeDatabaseConfig_RollbackDetail.toJSON(message.rollbackDetail)
       : undefined);
+    message.batchConfig !== undefined && (obj.batchConfig = message.batchConfig
+      ? PlanConfig_ChangeDatabaseConfig_BatchConfig.toJSON(message.batchConfig)
+      : undefined);
     return obj;
   },
 
@@ -851,6 +1056,9 @@ export const PlanConfig_ChangeDatabaseConfig = {
     message.rollbackDetail = (object.rollbackDetail !== undefined && object.rollbackDetail !== null)
       ? PlanConfig_ChangeDatabaseConfig_RollbackDetail.fromPartial(object.rollbackDetail)
       : undefined;
+    message.batchConfig = (object.batchConfig !== undefined && object.batchConfig !== null)
+      ? PlanConfig_ChangeDatabaseConfig_BatchConfig.fromPartial(object.batchConfig)
+      : undefined;
     return message;
   },
 };
@@ -933,8 +1141,110 @@ export const PlanConfig_ChangeDatabaseConfig_RollbackDetail = {
   },
 };
 
+function createBasePlanConfig_ChangeDatabaseConfig_BatchConfig(): PlanConfig_ChangeDatabaseConfig_BatchConfig {
+  return { chunkSize: 0, pause: undefined, maxReplicaLag: undefined, maxThreadsRunning: 0 };
+}
+
+export const PlanConfig_ChangeDatabaseConfig_BatchConfig = {
+  encode(message: PlanConfig_ChangeDatabaseConfig_BatchConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.chunkSize !== 0) {
+      writer.uint32(8).int32(message.chunkSize);
+    }
+    if (message.pause !== undefined) {
+      Duration.encode(message.pause, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.maxReplicaLag !== undefined) {
+      Duration.encode(message.maxReplicaLag, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.maxThreadsRunning !== 0) {
+      writer.uint32(32).int32(message.maxThreadsRunning);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanConfig_ChangeDatabaseConfig_BatchConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanConfig_ChangeDatabaseConfig_BatchConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.chunkSize = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pause = Duration.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.maxReplicaLag = Duration.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.maxThreadsRunning = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanConfig_ChangeDatabaseConfig_BatchConfig {
+    return {
+      chunkSize: isSet(object.chunkSize) ? Number(object.chunkSize) : 0,
+      pause: isSet(object.pause) ? Duration.fromJSON(object.pause) : undefined,
+      maxReplicaLag: isSet(object.maxReplicaLag) ? Duration.fromJSON(object.maxReplicaLag) : undefined,
+      maxThreadsRunning: isSet(object.maxThreadsRunning) ? Number(object.maxThreadsRunning) : 0,
+    };
+  },
+
+  toJSON(message: PlanConfig_ChangeDatabaseConfig_BatchConfig): unknown {
+    const obj: any = {};
+    message.chunkSize !== undefined && (obj.chunkSize = Math.round(message.chunkSize));
+    message.pause !== undefined && (obj.pause = message.pause ? Duration.toJSON(message.pause) : undefined);
+    message.maxReplicaLag !== undefined &&
+      (obj.maxReplicaLag = message.maxReplicaLag ? Duration.toJSON(message.maxReplicaLag) : undefined);
+    message.maxThreadsRunning !== undefined && (obj.maxThreadsRunning = Math.round(message.maxThreadsRunning));
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanConfig_ChangeDatabaseConfig_BatchConfig>): PlanConfig_ChangeDatabaseConfig_BatchConfig {
+    return PlanConfig_ChangeDatabaseConfig_BatchConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<PlanConfig_ChangeDatabaseConfig_BatchConfig>): PlanConfig_ChangeDatabaseConfig_BatchConfig {
+    const message = createBasePlanConfig_ChangeDatabaseConfig_BatchConfig();
+    message.chunkSize = object.chunkSize ?? 0;
+    message.pause = (object.pause !== undefined && object.pause !== null)
+      ? Duration.fromPartial(object.pause)
+      : undefined;
+    message.maxReplicaLag = (object.maxReplicaLag !== undefined && object.maxReplicaLag !== null)
+      ? Duration.fromPartial(object.maxReplicaLag)
+      : undefined;
+    message.maxThreadsRunning = object.maxThreadsRunning ?? 0;
+    return message;
+  },
+};
+
 function createBasePlanConfig_RestoreDatabaseConfig(): PlanConfig_RestoreDatabaseConfig {
-  return { target: "", createDatabaseConfig: undefined, backup: undefined, pointInTime: undefined };
+  return { target: "", createDatabaseConfig: undefined, backup: undefined, pointInTime: undefined, lsn: undefined };
 }
 
 export const PlanConfig_RestoreDatabaseConfig = {
@@ -951,6 +1261,9 @@ export const PlanConfig_RestoreDatabaseConfig = {
     if (message.pointInTime !== undefined) {
       Timestamp.encode(toTimestamp(message.pointInTime), writer.uint32(34).fork()).ldelim();
     }
+    if (message.lsn !== undefined) {
+      writer.uint32(42).string(message.lsn);
+    }
     return writer;
   },
 
@@ -989,6 +1302,13 @@ export const PlanConfig_RestoreDatabaseConfig = {
 
           message.pointInTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.lsn = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1006,6 +1326,7 @@ export const PlanConfig_RestoreDatabaseConfig = {
         : undefined,
       backup: isSet(object.backup) ? String(object.backup) : undefined,
       pointInTime: isSet(object.pointInTime) ? fromJsonTimestamp(object.pointInTime) : undefined,
+      lsn: isSet(object.lsn) ? String(object.lsn) : undefined,
     };
   },
 
@@ -1017,6 +1338,7 @@ export const PlanConfig_RestoreDatabaseConfig = {
       : undefined);
     message.backup !== undefined && (obj.backup = message.backup);
     message.pointInTime !== undefined && (obj.pointInTime = message.pointInTime.toISOString());
+    message.lsn !== undefined && (obj.lsn = message.lsn);
     return obj;
   },
 
@@ -1032,6 +1354,197 @@ export const PlanConfig_RestoreDatabaseConfig = {
       : undefined;
     message.backup = object.backup ?? undefined;
     message.pointInTime = object.pointInTime ?? undefined;
+    message.lsn = object.lsn ?? undefined;
+    return message;
+  },
+};
+
+function createBasePlanScheduleOccurrencePayload(): PlanScheduleOccurrencePayload {
+  return { taskRunUids: [], rollbacks: [], detail: "" };
+}
+
+export const PlanScheduleOccurrencePayload = {
+  encode(message: PlanScheduleOccurrencePayload, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    writer.uint32(10).fork();
+    for (const v of message.taskRunUids) {
+      writer.int32(v);
+    }
+    writer.ldelim();
+    for (const v of message.rollbacks) {
+      PlanScheduleOccurrencePayload_Rollback.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.detail !== "") {
+      writer.uint32(26).string(message.detail);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanScheduleOccurrencePayload {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanScheduleOccurrencePayload();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag === 8) {
+            message.taskRunUids.push(reader.int32());
+
+            continue;
+          }
+
+          if (tag === 10) {
+            const end2 = reader.uint32() + reader.pos;
+            while (reader.pos < end2) {
+              message.taskRunUids.push(reader.int32());
+            }
+
+            continue;
+          }
+
+          break;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.rollbacks.push(PlanScheduleOccurrencePayload_Rollback.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.detail = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanScheduleOccurrencePayload {
+    return {
+      taskRunUids: Array.isArray(object?.taskRunUids) ? object.taskRunUids.map((e: any) => Number(e)) : [],
+      rollbacks: Array.isArray(object?.rollbacks)
+        ? object.rollbacks.map((e: any) => PlanScheduleOccurrencePayload_Rollback.fromJSON(e))
+        : [],
+      detail: isSet(object.detail) ? String(object.detail) : "",
+    };
+  },
+
+  toJSON(message: PlanScheduleOccurrencePayload): unknown {
+    const obj: any = {};
+    if (message.taskRunUids) {
+      obj.taskRunUids = message.taskRunUids.map((e) => Math.round(e));
+    } else {
+      obj.taskRunUids = [];
+    }
+    if (message.rollbacks) {
+      obj.rollbacks = message.rollbacks.map((e) => e ? PlanScheduleOccurrencePayload_Rollback.toJSON(e) : undefined);
+    } else {
+      obj.rollbacks = [];
+    }
+    message.detail !== undefined && (obj.detail = message.detail);
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanScheduleOccurrencePayload>): PlanScheduleOccurrencePayload {
+    return PlanScheduleOccurrencePayload.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<PlanScheduleOccurrencePayload>): PlanScheduleOccurrencePayload {
+    const message = createBasePlanScheduleOccurrencePayload();
+    message.taskRunUids = object.taskRunUids?.map((e) => e) || [];
+    message.rollbacks = object.rollbacks?.map((e) => PlanScheduleOccurrencePayload_Rollback.fromPartial(e)) || [];
+    message.detail = object.detail ?? "";
+    return message;
+  },
+};
+
+function createBasePlanScheduleOccurrencePayload_Rollback(): PlanScheduleOccurrencePayload_Rollback {
+  return { taskUid: 0, sheetUid: 0, error: "" };
+}
+
+export const PlanScheduleOccurrencePayload_Rollback = {
+  encode(message: PlanScheduleOccurrencePayload_Rollback, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.taskUid !== 0) {
+      writer.uint32(8).int32(message.taskUid);
+    }
+    if (message.sheetUid !== 0) {
+      writer.uint32(16).int32(message.sheetUid);
+    }
+    if (message.error !== "") {
+      writer.uint32(26).string(message.error);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanScheduleOccurrencePayload_Rollback {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanScheduleOccurrencePayload_Rollback();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.taskUid = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.sheetUid = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanScheduleOccurrencePayload_Rollback {
+    return {
+      taskUid: isSet(object.taskUid) ? Number(object.taskUid) : 0,
+      sheetUid: isSet(object.sheetUid) ? Number(object.sheetUid) : 0,
+      error: isSet(object.error) ? String(object.error) : "",
+    };
+  },
+
+  toJSON(message: PlanScheduleOccurrencePayload_Rollback): unknown {
+    const obj: any = {};
+    message.taskUid !== undefined && (obj.taskUid = Math.round(message.taskUid));
+    message.sheetUid !== undefined && (obj.sheetUid = Math.round(message.sheetUid));
+    message.error !== undefined && (obj.error = message.error);
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanScheduleOccurrencePayload_Rollback>): PlanScheduleOccurrencePayload_Rollback {
+    return PlanScheduleOccurrencePayload_Rollback.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<PlanScheduleOccurrencePayload_Rollback>): PlanScheduleOccurrencePayload_Rollback {
+    const message = createBasePlanScheduleOccurrencePayload_Rollback();
+    message.taskUid = object.taskUid ?? 0;
+    message.sheetUid = object.sheetUid ?? 0;
+    message.error = object.error ?? "";
     return message;
   },
 };
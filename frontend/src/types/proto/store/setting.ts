@@ -61,6 +61,11 @@ export interface ExternalApprovalSetting_Node {
   title: string;
   /** The external endpoint for the relay service, e.g. "http://hello:1234". */
   endpoint: string;
+  /**
+   * The shared secret to sign the requests between Bytebase and the relay service with HMAC-SHA256.
+   * The requests are not signed and the callbacks are refused if the secret is empty.
+   */
+  secret: string;
 }
 
 export interface SMTPMailDeliverySetting {
@@ -215,6 +220,11 @@ export interface DataClassificationSetting_DataClassificationConfig {
    * The id should in [0-9]+-[0-9]+-[0-9]+ format.
    */
   classification: { [key: string]: DataClassificationSetting_DataClassificationConfig_DataClassification };
+  /**
+   * discovery is the configuration of the sensitive data discovery for the databases of the projects using the config.
+   * The discovery is disabled if it is not set.
+   */
+  discovery?: DataClassificationSetting_DataClassificationConfig_Discovery | undefined;
 }
 
 export interface DataClassificationSetting_DataClassificationConfig_Level {
@@ -237,6 +247,153 @@ export interface DataClassificationSetting_DataClassificationConfig_Classificati
   value?: DataClassificationSetting_DataClassificationConfig_DataClassification | undefined;
 }
 
+/** Discovery detects the classifications of the columns by their names and sampled values during the schema sync. */
+export interface DataClassificationSetting_DataClassificationConfig_Discovery {
+  mode: DataClassificationSetting_DataClassificationConfig_Discovery_Mode;
+  /**
+   * sample_size is the number of the rows sampled from each table, 100 by default.
+   * The values are only sampled from MySQL, TiDB, MariaDB, OceanBase and PostgreSQL databases, the columns of the other databases are detected by their names.
+   */
+  sampleSize: number;
+  /**
+   * match_ratio is the minimum ratio of the sampled non-NULL values matched by a detector to detect the column, 0.8 by default.
+   */
+  matchRatio: number;
+  /** detectors are evaluated in order, and the first detector matching the column decides its classification. */
+  detectors: DataClassificationSetting_DataClassificationConfig_Detector[];
+}
+
+export enum DataClassificationSetting_DataClassificationConfig_Discovery_Mode {
+  MODE_UNSPECIFIED = 0,
+  /**
+   * PROPOSE - PROPOSE records the detected classifications as the discovered_classification of the columns for review.
+   */
+  PROPOSE = 1,
+  /** APPLY - APPLY also applies the detected classifications as the classification of the columns. */
+  APPLY = 2,
+  UNRECOGNIZED = -1,
+}
+
+export function dataClassificationSetting_DataClassificationConfig_Discovery_ModeFromJSON(object: any): DataClassificationSetting_DataClassificationConfig_Discovery_Mode {
+  switch (object) {
+    case 0:
+    case "MODE_UNSPECIFIED":
+      return DataClassificationSetting_DataClassificationConfig_Discovery_Mode.MODE_UNSPECIFIED;
+    case 1:
+    case "PROPOSE":
+      return DataClassificationSetting_DataClassificationConfig_Discovery_Mode.PROPOSE;
+    case 2:
+    case "APPLY":
+      return DataClassificationSetting_DataClassificationConfig_Discovery_Mode.APPLY;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return DataClassificationSetting_DataClassificationConfig_Discovery_Mode.UNRECOGNIZED;
+  }
+}
+
+export function dataClassificationSetting_DataClassificationConfig_Discovery_ModeToJSON(object: DataClassificationSetting_DataClassificationConfig_Discovery_Mode): string {
+  switch (object) {
+    case DataClassificationSetting_DataClassificationConfig_Discovery_Mode.MODE_UNSPECIFIED:
+      return "MODE_UNSPECIFIED";
+    case DataClassificationSetting_DataClassificationConfig_Discovery_Mode.PROPOSE:
+      return "PROPOSE";
+    case DataClassificationSetting_DataClassificationConfig_Discovery_Mode.APPLY:
+      return "APPLY";
+    case DataClassificationSetting_DataClassificationConfig_Discovery_Mode.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+/** Detector detects the columns of a classification. */
+export interface DataClassificationSetting_DataClassificationConfig_Detector {
+  type: DataClassificationSetting_DataClassificationConfig_Detector_Type;
+  /** classification_id is the id of the classification of the detected columns. */
+  classificationId: string;
+  /**
+   * column_name_pattern is the regular expression matching the names of the detected columns, e.g. "(?i)e_?mail".
+   * The columns with matched names are detected regardless of their values.
+   */
+  columnNamePattern: string;
+  /** value_pattern is the regular expression for REGEX. */
+  valuePattern: string;
+  /** words are the dictionary for DICTIONARY. */
+  words: string[];
+}
+
+export enum DataClassificationSetting_DataClassificationConfig_Detector_Type {
+  /** TYPE_UNSPECIFIED - TYPE_UNSPECIFIED detects the columns by column_name_pattern only. */
+  TYPE_UNSPECIFIED = 0,
+  /** EMAIL - EMAIL matches the email addresses. */
+  EMAIL = 1,
+  /**
+   * NATIONAL_ID - NATIONAL_ID matches the resident identity card numbers of China with valid check digits, and the social security numbers of the US.
+   */
+  NATIONAL_ID = 2,
+  /** CARD_NUMBER - CARD_NUMBER matches the payment card numbers of 13 to 19 digits passing the Luhn check. */
+  CARD_NUMBER = 3,
+  /** PHONE - PHONE matches the phone numbers of 7 to 15 digits, optionally with a leading "+" and separators. */
+  PHONE = 4,
+  /** REGEX - REGEX matches the values fully matching the value_pattern. */
+  REGEX = 5,
+  /** DICTIONARY - DICTIONARY matches the values in the words case-insensitively. */
+  DICTIONARY = 6,
+  UNRECOGNIZED = -1,
+}
+
+export function dataClassificationSetting_DataClassificationConfig_Detector_TypeFromJSON(object: any): DataClassificationSetting_DataClassificationConfig_Detector_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return DataClassificationSetting_DataClassificationConfig_Detector_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "EMAIL":
+      return DataClassificationSetting_DataClassificationConfig_Detector_Type.EMAIL;
+    case 2:
+    case "NATIONAL_ID":
+      return DataClassificationSetting_DataClassificationConfig_Detector_Type.NATIONAL_ID;
+    case 3:
+    case "CARD_NUMBER":
+      return DataClassificationSetting_DataClassificationConfig_Detector_Type.CARD_NUMBER;
+    case 4:
+    case "PHONE":
+      return DataClassificationSetting_DataClassificationConfig_Detector_Type.PHONE;
+    case 5:
+    case "REGEX":
+      return DataClassificationSetting_DataClassificationConfig_Detector_Type.REGEX;
+    case 6:
+    case "DICTIONARY":
+      return DataClassificationSetting_DataClassificationConfig_Detector_Type.DICTIONARY;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return DataClassificationSetting_DataClassificationConfig_Detector_Type.UNRECOGNIZED;
+  }
+}
+
+export function dataClassificationSetting_DataClassificationConfig_Detector_TypeToJSON(object: DataClassificationSetting_DataClassificationConfig_Detector_Type): string {
+  switch (object) {
+    case DataClassificationSetting_DataClassificationConfig_Detector_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case DataClassificationSetting_DataClassificationConfig_Detector_Type.EMAIL:
+      return "EMAIL";
+    case DataClassificationSetting_DataClassificationConfig_Detector_Type.NATIONAL_ID:
+      return "NATIONAL_ID";
+    case DataClassificationSetting_DataClassificationConfig_Detector_Type.CARD_NUMBER:
+      return "CARD_NUMBER";
+    case DataClassificationSetting_DataClassificationConfig_Detector_Type.PHONE:
+      return "PHONE";
+    case DataClassificationSetting_DataClassificationConfig_Detector_Type.REGEX:
+      return "REGEX";
+    case DataClassificationSetting_DataClassificationConfig_Detector_Type.DICTIONARY:
+      return "DICTIONARY";
+    case DataClassificationSetting_DataClassificationConfig_Detector_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 export interface SemanticCategorySetting {
   categories: SemanticCategorySetting_SemanticCategory[];
 }
@@ -248,6 +405,195 @@ export interface SemanticCategorySetting_SemanticCategory {
   title: string;
   /** the description of the category item, it can be empty. */
   description: string;
+  /**
+   * full_mask_algorithm is the algorithm masking the values of the columns in the category with the FULL masking level.
+   * The values are replaced with "******" if it is not set.
+   */
+  fullMaskAlgorithm?:
+    | MaskingAlgorithm
+    | undefined;
+  /**
+   * partial_mask_algorithm is the algorithm masking the values of the columns in the category with the PARTIAL masking level.
+   * The values are replaced with "******" if it is not set.
+   */
+  partialMaskAlgorithm?: MaskingAlgorithm | undefined;
+}
+
+/**
+ * MaskingAlgorithm is the algorithm masking the values of the sensitive columns.
+ * The masked values are always strings, and NULL values are replaced with "******".
+ */
+export interface MaskingAlgorithm {
+  type: MaskingAlgorithm_Type;
+  /** substitution is the string replacing the value for FULL, or the string replacing each masked character for KEEP. */
+  substitution: string;
+  /** prefix_length is the number of the leading characters kept by KEEP. */
+  prefixLength: number;
+  /** suffix_length is the number of the trailing characters kept by KEEP, or the number of the trailing digits kept by PHONE. */
+  suffixLength: number;
+  /** salt is the key of the HMAC for HASH. */
+  salt: string;
+  /** date_unit is the unit truncated to for DATE_TRUNCATE. */
+  dateUnit: MaskingAlgorithm_DateUnit;
+  /** range_width is the width of the ranges for RANGE, it must be positive. */
+  rangeWidth: number;
+}
+
+export enum MaskingAlgorithm_Type {
+  TYPE_UNSPECIFIED = 0,
+  /** FULL - FULL replaces the value with the substitution, "******" by default. */
+  FULL = 1,
+  /** KEEP - KEEP keeps the first prefix_length and the last suffix_length characters, and replaces each of the others with the substitution, "*" by default. */
+  KEEP = 2,
+  /**
+   * HASH - HASH replaces the value with the hex encoded HMAC-SHA256 of the value keyed by the salt.
+   * The same value is always masked to the same result, so the masked columns can still be joined and grouped by.
+   */
+  HASH = 3,
+  /** DATE_TRUNCATE - DATE_TRUNCATE truncates the date or time value to the date_unit, keeping its format. */
+  DATE_TRUNCATE = 4,
+  /** RANGE - RANGE replaces the numeric value with the range of range_width containing it, e.g. "[20, 30)" for 25 with the range width 10. */
+  RANGE = 5,
+  /** EMAIL - EMAIL keeps the first character of the local part and the domain of the email address, e.g. "j*******@example.com". */
+  EMAIL = 6,
+  /** PHONE - PHONE keeps the format and the last suffix_length digits of the phone number, 4 by default, e.g. "+* (***) ***-4567". */
+  PHONE = 7,
+  UNRECOGNIZED = -1,
+}
+
+export function maskingAlgorithm_TypeFromJSON(object: any): MaskingAlgorithm_Type {
+  switch (object) {
+    case 0:
+    case "TYPE_UNSPECIFIED":
+      return MaskingAlgorithm_Type.TYPE_UNSPECIFIED;
+    case 1:
+    case "FULL":
+      return MaskingAlgorithm_Type.FULL;
+    case 2:
+    case "KEEP":
+      return MaskingAlgorithm_Type.KEEP;
+    case 3:
+    case "HASH":
+      return MaskingAlgorithm_Type.HASH;
+    case 4:
+    case "DATE_TRUNCATE":
+      return MaskingAlgorithm_Type.DATE_TRUNCATE;
+    case 5:
+    case "RANGE":
+      return MaskingAlgorithm_Type.RANGE;
+    case 6:
+    case "EMAIL":
+      return MaskingAlgorithm_Type.EMAIL;
+    case 7:
+    case "PHONE":
+      return MaskingAlgorithm_Type.PHONE;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return MaskingAlgorithm_Type.UNRECOGNIZED;
+  }
+}
+
+export function maskingAlgorithm_TypeToJSON(object: MaskingAlgorithm_Type): string {
+  switch (object) {
+    case MaskingAlgorithm_Type.TYPE_UNSPECIFIED:
+      return "TYPE_UNSPECIFIED";
+    case MaskingAlgorithm_Type.FULL:
+      return "FULL";
+    case MaskingAlgorithm_Type.KEEP:
+      return "KEEP";
+    case MaskingAlgorithm_Type.HASH:
+      return "HASH";
+    case MaskingAlgorithm_Type.DATE_TRUNCATE:
+      return "DATE_TRUNCATE";
+    case MaskingAlgorithm_Type.RANGE:
+      return "RANGE";
+    case MaskingAlgorithm_Type.EMAIL:
+      return "EMAIL";
+    case MaskingAlgorithm_Type.PHONE:
+      return "PHONE";
+    case MaskingAlgorithm_Type.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export enum MaskingAlgorithm_DateUnit {
+  DATE_UNIT_UNSPECIFIED = 0,
+  YEAR = 1,
+  MONTH = 2,
+  DAY = 3,
+  HOUR = 4,
+  UNRECOGNIZED = -1,
+}
+
+export function maskingAlgorithm_DateUnitFromJSON(object: any): MaskingAlgorithm_DateUnit {
+  switch (object) {
+    case 0:
+    case "DATE_UNIT_UNSPECIFIED":
+      return MaskingAlgorithm_DateUnit.DATE_UNIT_UNSPECIFIED;
+    case 1:
+    case "YEAR":
+      return MaskingAlgorithm_DateUnit.YEAR;
+    case 2:
+    case "MONTH":
+      return MaskingAlgorithm_DateUnit.MONTH;
+    case 3:
+    case "DAY":
+      return MaskingAlgorithm_DateUnit.DAY;
+    case 4:
+    case "HOUR":
+      return MaskingAlgorithm_DateUnit.HOUR;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return MaskingAlgorithm_DateUnit.UNRECOGNIZED;
+  }
+}
+
+export function maskingAlgorithm_DateUnitToJSON(object: MaskingAlgorithm_DateUnit): string {
+  switch (object) {
+    case MaskingAlgorithm_DateUnit.DATE_UNIT_UNSPECIFIED:
+      return "DATE_UNIT_UNSPECIFIED";
+    case MaskingAlgorithm_DateUnit.YEAR:
+      return "YEAR";
+    case MaskingAlgorithm_DateUnit.MONTH:
+      return "MONTH";
+    case MaskingAlgorithm_DateUnit.DAY:
+      return "DAY";
+    case MaskingAlgorithm_DateUnit.HOUR:
+      return "HOUR";
+    case MaskingAlgorithm_DateUnit.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export interface SCIMSetting {
+  /**
+   * token is the bearer token used by the identity provider to call the SCIM endpoints.
+   * The SCIM provisioning is disabled if the token is empty.
+   */
+  token: string;
+  /** group_mappings maps the SCIM groups to the workspace roles or the project roles. */
+  groupMappings: SCIMSetting_GroupMapping[];
+}
+
+export interface SCIMSetting_GroupMapping {
+  /** group is the display name of the SCIM group. */
+  group: string;
+  /**
+   * project is the resource name of the project where the group members are granted the role.
+   * Format: projects/{project}
+   * The role is a workspace role if the project is empty.
+   */
+  project: string;
+  /**
+   * role is the role granted to the group members.
+   * Format: roles/{role}
+   * The workspace role must be one of roles/OWNER, roles/DBA and roles/DEVELOPER.
+   */
+  role: string;
 }
 
 function createBaseWorkspaceProfileSetting(): WorkspaceProfileSetting {
@@ -677,7 +1023,7 @@ export const ExternalApprovalSetting = {
 };
 
 function createBaseExternalApprovalSetting_Node(): ExternalApprovalSetting_Node {
-  return { id: "", title: "", endpoint: "" };
+  return { id: "", title: "", endpoint: "", secret: "" };
 }
 
 export const ExternalApprovalSetting_Node = {
@@ -691,6 +1037,9 @@ export const ExternalApprovalSetting_Node = {
     if (message.endpoint !== "") {
       writer.uint32(26).string(message.endpoint);
     }
+    if (message.secret !== "") {
+      writer.uint32(34).string(message.secret);
+    }
     return writer;
   },
 
@@ -722,6 +1071,13 @@ export const ExternalApprovalSetting_Node = {
 
           message.endpoint = reader.string();
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.secret = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -736,6 +1092,7 @@ export const ExternalApprovalSetting_Node = {
       id: isSet(object.id) ? String(object.id) : "",
       title: isSet(object.title) ? String(object.title) : "",
       endpoint: isSet(object.endpoint) ? String(object.endpoint) : "",
+      secret: isSet(object.secret) ? String(object.secret) : "",
     };
   },
 
@@ -744,6 +1101,7 @@ export const ExternalApprovalSetting_Node = {
     message.id !== undefined && (obj.id = message.id);
     message.title !== undefined && (obj.title = message.title);
     message.endpoint !== undefined && (obj.endpoint = message.endpoint);
+    message.secret !== undefined && (obj.secret = message.secret);
     return obj;
   },
 
@@ -756,6 +1114,7 @@ export const ExternalApprovalSetting_Node = {
     message.id = object.id ?? "";
     message.title = object.title ?? "";
     message.endpoint = object.endpoint ?? "";
+    message.secret = object.secret ?? "";
     return message;
   },
 };
@@ -1290,7 +1649,7 @@ export const DataClassificationSetting = {
 };
 
 function createBaseDataClassificationSetting_DataClassificationConfig(): DataClassificationSetting_DataClassificationConfig {
-  return { id: "", title: "", levels: [], classification: {} };
+  return { id: "", title: "", levels: [], classification: {}, discovery: undefined };
 }
 
 export const DataClassificationSetting_DataClassificationConfig = {
@@ -1313,6 +1672,9 @@ export const DataClassificationSetting_DataClassificationConfig = {
         writer.uint32(34).fork(),
       ).ldelim();
     });
+    if (message.discovery !== undefined) {
+      DataClassificationSetting_DataClassificationConfig_Discovery.encode(message.discovery, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1357,6 +1719,13 @@ export const DataClassificationSetting_DataClassificationConfig = {
             message.classification[entry4.key] = entry4.value;
           }
           continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.discovery = DataClassificationSetting_DataClassificationConfig_Discovery.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1381,6 +1750,9 @@ export const DataClassificationSetting_DataClassificationConfig = {
           return acc;
         }, {})
         : {},
+      discovery: isSet(object.discovery)
+        ? DataClassificationSetting_DataClassificationConfig_Discovery.fromJSON(object.discovery)
+        : undefined,
     };
   },
 
@@ -1401,6 +1773,9 @@ export const DataClassificationSetting_DataClassificationConfig = {
         obj.classification[k] = DataClassificationSetting_DataClassificationConfig_DataClassification.toJSON(v);
       });
     }
+    message.discovery !== undefined && (obj.discovery = message.discovery
+      ? DataClassificationSetting_DataClassificationConfig_Discovery.toJSON(message.discovery)
+      : undefined);
     return obj;
   },
 
@@ -1426,6 +1801,9 @@ export const DataClassificationSetting_DataClassificationConfig = {
       }
       return acc;
     }, {});
+    message.discovery = (object.discovery !== undefined && object.discovery !== null)
+      ? DataClassificationSetting_DataClassificationConfig_Discovery.fromPartial(object.discovery)
+      : undefined;
     return message;
   },
 };
@@ -1734,6 +2112,237 @@ export const DataClassificationSetting_DataClassificationConfig_ClassificationEn
   },
 };
 
+function createBaseDataClassificationSetting_DataClassificationConfig_Discovery(): DataClassificationSetting_DataClassificationConfig_Discovery {
+  return { mode: 0, sampleSize: 0, matchRatio: 0, detectors: [] };
+}
+
+export const DataClassificationSetting_DataClassificationConfig_Discovery = {
+  encode(
+    message: DataClassificationSetting_DataClassificationConfig_Discovery,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.mode !== 0) {
+      writer.uint32(8).int32(message.mode);
+    }
+    if (message.sampleSize !== 0) {
+      writer.uint32(16).int32(message.sampleSize);
+    }
+    if (message.matchRatio !== 0) {
+      writer.uint32(25).double(message.matchRatio);
+    }
+    for (const v of message.detectors) {
+      DataClassificationSetting_DataClassificationConfig_Detector.encode(v!, writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataClassificationSetting_DataClassificationConfig_Discovery {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDataClassificationSetting_DataClassificationConfig_Discovery();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.mode = reader.int32() as any;
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.sampleSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 25) {
+            break;
+          }
+
+          message.matchRatio = reader.double();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.detectors.push(DataClassificationSetting_DataClassificationConfig_Detector.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DataClassificationSetting_DataClassificationConfig_Discovery {
+    return {
+      mode: isSet(object.mode) ? dataClassificationSetting_DataClassificationConfig_Discovery_ModeFromJSON(object.mode) : 0,
+      sampleSize: isSet(object.sampleSize) ? Number(object.sampleSize) : 0,
+      matchRatio: isSet(object.matchRatio) ? Number(object.matchRatio) : 0,
+      detectors: Array.isArray(object?.detectors)
+        ? object.detectors.map((e: any) => DataClassificationSetting_DataClassificationConfig_Detector.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: DataClassificationSetting_DataClassificationConfig_Discovery): unknown {
+    const obj: any = {};
+    message.mode !== undefined && (obj.mode = dataClassificationSetting_DataClassificationConfig_Discovery_ModeToJSON(message.mode));
+    message.sampleSize !== undefined && (obj.sampleSize = Math.round(message.sampleSize));
+    message.matchRatio !== undefined && (obj.matchRatio = message.matchRatio);
+    if (message.detectors) {
+      obj.detectors = message.detectors.map((e) => e ? DataClassificationSetting_DataClassificationConfig_Detector.toJSON(e) : undefined);
+    } else {
+      obj.detectors = [];
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<DataClassificationSetting_DataClassificationConfig_Discovery>,
+  ): DataClassificationSetting_DataClassificationConfig_Discovery {
+    return DataClassificationSetting_DataClassificationConfig_Discovery.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<DataClassificationSetting_DataClassificationConfig_Discovery>,
+  ): DataClassificationSetting_DataClassificationConfig_Discovery {
+    const message = createBaseDataClassificationSetting_DataClassificationConfig_Discovery();
+    message.mode = object.mode ?? 0;
+    message.sampleSize = object.sampleSize ?? 0;
+    message.matchRatio = object.matchRatio ?? 0;
+    message.detectors = object.detectors?.map((e) => DataClassificationSetting_DataClassificationConfig_Detector.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseDataClassificationSetting_DataClassificationConfig_Detector(): DataClassificationSetting_DataClassificationConfig_Detector {
+  return { type: 0, classificationId: "", columnNamePattern: "", valuePattern: "", words: [] };
+}
+
+export const DataClassificationSetting_DataClassificationConfig_Detector = {
+  encode(
+    message: DataClassificationSetting_DataClassificationConfig_Detector,
+    writer: _m0.Writer = _m0.Writer.create(),
+  ): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+    if (message.classificationId !== "") {
+      writer.uint32(18).string(message.classificationId);
+    }
+    if (message.columnNamePattern !== "") {
+      writer.uint32(26).string(message.columnNamePattern);
+    }
+    if (message.valuePattern !== "") {
+      writer.uint32(34).string(message.valuePattern);
+    }
+    for (const v of message.words) {
+      writer.uint32(42).string(v!);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): DataClassificationSetting_DataClassificationConfig_Detector {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseDataClassificationSetting_DataClassificationConfig_Detector();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.classificationId = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.columnNamePattern = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.valuePattern = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.words.push(reader.string());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): DataClassificationSetting_DataClassificationConfig_Detector {
+    return {
+      type: isSet(object.type) ? dataClassificationSetting_DataClassificationConfig_Detector_TypeFromJSON(object.type) : 0,
+      classificationId: isSet(object.classificationId) ? String(object.classificationId) : "",
+      columnNamePattern: isSet(object.columnNamePattern) ? String(object.columnNamePattern) : "",
+      valuePattern: isSet(object.valuePattern) ? String(object.valuePattern) : "",
+      words: Array.isArray(object?.words) ? object.words.map((e: any) => String(e)) : [],
+    };
+  },
+
+  toJSON(message: DataClassificationSetting_DataClassificationConfig_Detector): unknown {
+    const obj: any = {};
+    message.type !== undefined && (obj.type = dataClassificationSetting_DataClassificationConfig_Detector_TypeToJSON(message.type));
+    message.classificationId !== undefined && (obj.classificationId = message.classificationId);
+    message.columnNamePattern !== undefined && (obj.columnNamePattern = message.columnNamePattern);
+    message.valuePattern !== undefined && (obj.valuePattern = message.valuePattern);
+    if (message.words) {
+      obj.words = message.words.map((e) => e);
+    } else {
+      obj.words = [];
+    }
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<DataClassificationSetting_DataClassificationConfig_Detector>,
+  ): DataClassificationSetting_DataClassificationConfig_Detector {
+    return DataClassificationSetting_DataClassificationConfig_Detector.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<DataClassificationSetting_DataClassificationConfig_Detector>,
+  ): DataClassificationSetting_DataClassificationConfig_Detector {
+    const message = createBaseDataClassificationSetting_DataClassificationConfig_Detector();
+    message.type = object.type ?? 0;
+    message.classificationId = object.classificationId ?? "";
+    message.columnNamePattern = object.columnNamePattern ?? "";
+    message.valuePattern = object.valuePattern ?? "";
+    message.words = object.words?.map((e) => e) || [];
+    return message;
+  },
+};
+
 function createBaseSemanticCategorySetting(): SemanticCategorySetting {
   return { categories: [] };
 }
@@ -1801,7 +2410,7 @@ export const SemanticCategorySetting = {
 };
 
 function createBaseSemanticCategorySetting_SemanticCategory(): SemanticCategorySetting_SemanticCategory {
-  return { id: "", title: "", description: "" };
+  return { id: "", title: "", description: "", fullMaskAlgorithm: undefined, partialMaskAlgorithm: undefined };
 }
 
 export const SemanticCategorySetting_SemanticCategory = {
@@ -1815,6 +2424,12 @@ export const SemanticCategorySetting_SemanticCategory = {
     if (message.description !== "") {
       writer.uint32(26).string(message.description);
     }
+    if (message.fullMaskAlgorithm !== undefined) {
+      MaskingAlgorithm.encode(message.fullMaskAlgorithm, writer.uint32(34).fork()).ldelim();
+    }
+    if (message.partialMaskAlgorithm !== undefined) {
+      MaskingAlgorithm.encode(message.partialMaskAlgorithm, writer.uint32(42).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1846,6 +2461,20 @@ export const SemanticCategorySetting_SemanticCategory = {
 
           message.description = reader.string();
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.fullMaskAlgorithm = MaskingAlgorithm.decode(reader, reader.uint32());
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.partialMaskAlgorithm = MaskingAlgorithm.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1860,6 +2489,10 @@ export const SemanticCategorySetting_SemanticCategory = {
       id: isSet(object.id) ? String(object.id) : "",
       title: isSet(object.title) ? String(object.title) : "",
       description: isSet(object.description) ? String(object.description) : "",
+      fullMaskAlgorithm: isSet(object.fullMaskAlgorithm) ? MaskingAlgorithm.fromJSON(object.fullMaskAlgorithm) : undefined,
+      partialMaskAlgorithm: isSet(object.partialMaskAlgorithm)
+        ? MaskingAlgorithm.fromJSON(object.partialMaskAlgorithm)
+        : undefined,
     };
   },
 
@@ -1868,6 +2501,11 @@ export const SemanticCategorySetting_SemanticCategory = {
     message.id !== undefined && (obj.id = message.id);
     message.title !== undefined && (obj.title = message.title);
     message.description !== undefined && (obj.description = message.description);
+    message.fullMaskAlgorithm !== undefined &&
+      (obj.fullMaskAlgorithm = message.fullMaskAlgorithm ? MaskingAlgorithm.toJSON(message.fullMaskAlgorithm) : undefined);
+    message.partialMaskAlgorithm !== undefined && (obj.partialMaskAlgorithm = message.partialMaskAlgorithm
+      ? MaskingAlgorithm.toJSON(message.partialMaskAlgorithm)
+      : undefined);
     return obj;
   },
 
@@ -1880,6 +2518,309 @@ export const SemanticCategorySetting_SemanticCategory = {
     message.id = object.id ?? "";
     message.title = object.title ?? "";
     message.description = object.description ?? "";
+    message.fullMaskAlgorithm = (object.fullMaskAlgorithm !== undefined && object.fullMaskAlgorithm !== null)
+      ? MaskingAlgorithm.fromPartial(object.fullMaskAlgorithm)
+      : undefined;
+    message.partialMaskAlgorithm = (object.partialMaskAlgorithm !== undefined && object.partialMaskAlgorithm !== null)
+      ? MaskingAlgorithm.fromPartial(object.partialMaskAlgorithm)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseMaskingAlgorithm(): MaskingAlgorithm {
+  return { type: 0, substitution: "", prefixLength: 0, suffixLength: 0, salt: "", dateUnit: 0, rangeWidth: 0 };
+}
+
+export const MaskingAlgorithm = {
+  encode(message: MaskingAlgorithm, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.type !== 0) {
+      writer.uint32(8).int32(message.type);
+    }
+    if (message.substitution !== "") {
+      writer.uint32(18).string(message.substitution);
+    }
+    if (message.prefixLength !== 0) {
+      writer.uint32(24).int32(message.prefixLength);
+    }
+    if (message.suffixLength !== 0) {
+      writer.uint32(32).int32(message.suffixLength);
+    }
+    if (message.salt !== "") {
+      writer.uint32(42).string(message.salt);
+    }
+    if (message.dateUnit !== 0) {
+      writer.uint32(48).int32(message.dateUnit);
+    }
+    if (message.rangeWidth !== 0) {
+      writer.uint32(57).double(message.rangeWidth);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): MaskingAlgorithm {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseMaskingAlgorithm();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.type = reader.int32() as any;
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.substitution = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.prefixLength = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.suffixLength = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.salt = reader.string();
+          continue;
+        case 6:
+          if (tag !== 48) {
+            break;
+          }
+
+          message.dateUnit = reader.int32() as any;
+          continue;
+        case 7:
+          if (tag !== 57) {
+            break;
+          }
+
+          message.rangeWidth = reader.double();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): MaskingAlgorithm {
+    return {
+      type: isSet(object.type) ? maskingAlgorithm_TypeFromJSON(object.type) : 0,
+      substitution: isSet(object.substitution) ? String(object.substitution) : "",
+      prefixLength: isSet(object.prefixLength) ? Number(object.prefixLength) : 0,
+      suffixLength: isSet(object.suffixLength) ? Number(object.suffixLength) : 0,
+      salt: isSet(object.salt) ? String(object.salt) : "",
+      dateUnit: isSet(object.dateUnit) ? maskingAlgorithm_DateUnitFromJSON(object.dateUnit) : 0,
+      rangeWidth: isSet(object.rangeWidth) ? Number(object.rangeWidth) : 0,
+    };
+  },
+
+  toJSON(message: MaskingAlgorithm): unknown {
+    const obj: any = {};
+    message.type !== undefined && (obj.type = maskingAlgorithm_TypeToJSON(message.type));
+    message.substitution !== undefined && (obj.substitution = message.substitution);
+    message.prefixLength !== undefined && (obj.prefixLength = Math.round(message.prefixLength));
+    message.suffixLength !== undefined && (obj.suffixLength = Math.round(message.suffixLength));
+    message.salt !== undefined && (obj.salt = message.salt);
+    message.dateUnit !== undefined && (obj.dateUnit = maskingAlgorithm_DateUnitToJSON(message.dateUnit));
+    message.rangeWidth !== undefined && (obj.rangeWidth = message.rangeWidth);
+    return obj;
+  },
+
+  create(base?: DeepPartial<MaskingAlgorithm>): MaskingAlgorithm {
+    return MaskingAlgorithm.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<MaskingAlgorithm>): MaskingAlgorithm {
+    const message = createBaseMaskingAlgorithm();
+    message.type = object.type ?? 0;
+    message.substitution = object.substitution ?? "";
+    message.prefixLength = object.prefixLength ?? 0;
+    message.suffixLength = object.suffixLength ?? 0;
+    message.salt = object.salt ?? "";
+    message.dateUnit = object.dateUnit ?? 0;
+    message.rangeWidth = object.rangeWidth ?? 0;
+    return message;
+  },
+};
+
+function createBaseSCIMSetting(): SCIMSetting {
+  return { token: "", groupMappings: [] };
+}
+
+export const SCIMSetting = {
+  encode(message: SCIMSetting, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.token !== "") {
+      writer.uint32(10).string(message.token);
+    }
+    for (const v of message.groupMappings) {
+      SCIMSetting_GroupMapping.encode(v!, writer.uint32(18).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SCIMSetting {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSCIMSetting();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.token = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.groupMappings.push(SCIMSetting_GroupMapping.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SCIMSetting {
+    return {
+      token: isSet(object.token) ? String(object.token) : "",
+      groupMappings: Array.isArray(object?.groupMappings)
+        ? object.groupMappings.map((e: any) => SCIMSetting_GroupMapping.fromJSON(e))
+        : [],
+    };
+  },
+
+  toJSON(message: SCIMSetting): unknown {
+    const obj: any = {};
+    message.token !== undefined && (obj.token = message.token);
+    if (message.groupMappings) {
+      obj.groupMappings = message.groupMappings.map((e) => e ? SCIMSetting_GroupMapping.toJSON(e) : undefined);
+    } else {
+      obj.groupMappings = [];
+    }
+    return obj;
+  },
+
+  create(base?: DeepPartial<SCIMSetting>): SCIMSetting {
+    return SCIMSetting.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SCIMSetting>): SCIMSetting {
+    const message = createBaseSCIMSetting();
+    message.token = object.token ?? "";
+    message.groupMappings = object.groupMappings?.map((e) => SCIMSetting_GroupMapping.fromPartial(e)) || [];
+    return message;
+  },
+};
+
+function createBaseSCIMSetting_GroupMapping(): SCIMSetting_GroupMapping {
+  return { group: "", project: "", role: "" };
+}
+
+export const SCIMSetting_GroupMapping = {
+  encode(message: SCIMSetting_GroupMapping, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.group !== "") {
+      writer.uint32(10).string(message.group);
+    }
+    if (message.project !== "") {
+      writer.uint32(18).string(message.project);
+    }
+    if (message.role !== "") {
+      writer.uint32(26).string(message.role);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SCIMSetting_GroupMapping {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSCIMSetting_GroupMapping();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.group = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.project = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.role = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SCIMSetting_GroupMapping {
+    return {
+      group: isSet(object.group) ? String(object.group) : "",
+      project: isSet(object.project) ? String(object.project) : "",
+      role: isSet(object.role) ? String(object.role) : "",
+    };
+  },
+
+  toJSON(message: SCIMSetting_GroupMapping): unknown {
+    const obj: any = {};
+    message.group !== undefined && (obj.group = message.group);
+    message.project !== undefined && (obj.project = message.project);
+    message.role !== undefined && (obj.role = message.role);
+    return obj;
+  },
+
+  create(base?: DeepPartial<SCIMSetting_GroupMapping>): SCIMSetting_GroupMapping {
+    return SCIMSetting_GroupMapping.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SCIMSetting_GroupMapping>): SCIMSetting_GroupMapping {
+    const message = createBaseSCIMSetting_GroupMapping();
+    message.group = object.group ?? "";
+    message.project = object.project ?? "";
+    message.role = object.role ?? "";
     return message;
   },
 };
@@ -60,6 +60,7 @@ export interface Anomaly {
   databaseBackupPolicyViolationDetail?: Anomaly_DatabaseBackupPolicyViolationDetail | undefined;
   databaseBackupMissingDetail?: Anomaly_DatabaseBackupMissingDetail | undefined;
   databaseSchemaDriftDetail?: Anomaly_DatabaseSchemaDriftDetail | undefined;
+  databaseBackupVerificationFailedDetail?: Anomaly_DatabaseBackupVerificationFailedDetail | undefined;
   createTime?: Date | undefined;
   updateTime?: Date | undefined;
 }
@@ -92,6 +93,11 @@ export enum Anomaly_AnomalyType {
    * e.g. the database schema had been changed without bytebase migration.
    */
   DATABASE_SCHEMA_DRIFT = 6,
+  /**
+   * DATABASE_BACKUP_VERIFICATION_FAILED - DATABASE_BACKUP_VERIFICATION_FAILED is the anomaly type for the backup verification failure,
+   * e.g. the latest backup cannot be restored or the restored data does not match the backup.
+   */
+  DATABASE_BACKUP_VERIFICATION_FAILED = 7,
   UNRECOGNIZED = -1,
 }
 
@@ -118,6 +124,9 @@ export function anomaly_AnomalyTypeFromJSON(object: any): Anomaly_AnomalyType {
     case 6:
     case "DATABASE_SCHEMA_DRIFT":
       return Anomaly_AnomalyType.DATABASE_SCHEMA_DRIFT;
+    case 7:
+    case "DATABASE_BACKUP_VERIFICATION_FAILED":
+      return Anomaly_AnomalyType.DATABASE_BACKUP_VERIFICATION_FAILED;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -141,6 +150,8 @@ export function anomaly_AnomalyTypeToJSON(object: Anomaly_AnomalyType): string {
       return "DATABASE_CONNECTION";
     case Anomaly_AnomalyType.DATABASE_SCHEMA_DRIFT:
       return "DATABASE_SCHEMA_DRIFT";
+    case Anomaly_AnomalyType.DATABASE_BACKUP_VERIFICATION_FAILED:
+      return "DATABASE_BACKUP_VERIFICATION_FAILED";
     case Anomaly_AnomalyType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -248,6 +259,19 @@ export interface Anomaly_DatabaseSchemaDriftDetail {
   actualSchema: string;
 }
 
+/** DatabaseBackupVerificationFailedDetail is the detail for database backup verification failure anomaly. */
+export interface Anomaly_DatabaseBackupVerificationFailedDetail {
+  /**
+   * backup is the resource name of the verified backup.
+   * Format: instances/{instance}/databases/{database}/backups/{backup}
+   */
+  backup: string;
+  /** verify_time is the time when the backup was verified. */
+  verifyTime?: Date | undefined;
+  /** detail is the detail of the backup verification failure. */
+  detail: string;
+}
+
 function createBaseSearchAnomaliesRequest(): SearchAnomaliesRequest {
   return { filter: "", pageSize: 0, pageToken: "" };
 }
@@ -417,6 +441,7 @@ function createBaseAnomaly(): Anomaly {
     databaseBackupPolicyViolationDetail: undefined,
     databaseBackupMissingDetail: undefined,
     databaseSchemaDriftDetail: undefined,
+    databaseBackupVerificationFailedDetail: undefined,
     createTime: undefined,
     updateTime: undefined,
   };
@@ -452,6 +477,12 @@ export const Anomaly = {
     if (message.databaseSchemaDriftDetail !== undefined) {
       Anomaly_DatabaseSchemaDriftDetail.encode(message.databaseSchemaDriftDetail, writer.uint32(66).fork()).ldelim();
     }
+    if (message.databaseBackupVerificationFailedDetail !== undefined) {
+      Anomaly_DatabaseBackupVerificationFailedDetail.encode(
+        message.databaseBackupVerificationFailedDetail,
+        writer.uint32(90).fork(),
+      ).ldelim();
+    }
     if (message.createTime !== undefined) {
       Timestamp.encode(toTimestamp(message.createTime), writer.uint32(74).fork()).ldelim();
     }
@@ -527,6 +558,16 @@ export const Anomaly = {
 
           message.databaseSchemaDriftDetail = Anomaly_DatabaseSchemaDriftDetail.decode(reader, reader.uint32());
           continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.databaseBackupVerificationFailedDetail = Anomaly_DatabaseBackupVerificationFailedDetail.decode(
+            reader,
+            reader.uint32(),
+          );
+          continue;
         case 9:
           if (tag !== 74) {
             break;
@@ -570,6 +611,9 @@ export const Anomaly = {
       databaseSchemaDriftDetail: isSet(object.databaseSchemaDriftDetail)
         ? Anomaly_DatabaseSchemaDriftDetail.fromJSON(object.databaseSchemaDriftDetail)
         : undefined,
+      databaseBackupVerificationFailedDetail: isSet(object.databaseBackupVerificationFailedDetail)
+        ? Anomaly_DatabaseBackupVerificationFailedDetail.fromJSON(object.databaseBackupVerificationFailedDetail)
+        : undefined,
       createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
       updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
     };
@@ -598,6 +642,10 @@ export const Anomaly = {
       (obj.databaseSchemaDriftDetail = message.databaseSchemaDriftDetail
         ? Anomaly_DatabaseSchemaDriftDetail.toJSON(message.databaseSchemaDriftDetail)
         : undefined);
+    message.databaseBackupVerificationFailedDetail !== undefined &&
+      (obj.databaseBackupVerificationFailedDetail = message.databaseBackupVerificationFailedDetail
+        ? Anomaly_DatabaseBackupVerificationFailedDetail.toJSON(message.databaseBackupVerificationFailedDetail)
+        : undefined);
     message.createTime !== undefined && (obj.createTime = message.createTime.toISOString());
     message.updateTime !== undefined && (obj.updateTime = message.updateTime.toISOString());
     return obj;
@@ -632,6 +680,11 @@ export const Anomaly = {
       (object.databaseSchemaDriftDetail !== undefined && object.databaseSchemaDriftDetail !== null)
         ? Anomaly_DatabaseSchemaDriftDetail.fromPartial(object.databaseSchemaDriftDetail)
         : undefined;
+    message.databaseBackupVerificationFailedDetail =
+      (object.databaseBackupVerificationFailedDetail !== undefined &&
+          object.databaseBackupVerificationFailedDetail !== null)
+        ? Anomaly_DatabaseBackupVerificationFailedDetail.fromPartial(object.databaseBackupVerificationFailedDetail)
+        : undefined;
     message.createTime = object.createTime ?? undefined;
     message.updateTime = object.updateTime ?? undefined;
     return message;
@@ -993,6 +1046,94 @@ export const Anomaly_DatabaseSchemaDriftDetail = {
   },
 };
 
+function createBaseAnomaly_DatabaseBackupVerificationFailedDetail(): Anomaly_DatabaseBackupVerificationFailedDetail {
+  return { backup: "", verifyTime: undefined, detail: "" };
+}
+
+export const Anomaly_DatabaseBackupVerificationFailedDetail = {
+  encode(message: Anomaly_DatabaseBackupVerificationFailedDetail, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.backup !== "") {
+      writer.uint32(10).string(message.backup);
+    }
+    if (message.verifyTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.verifyTime), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.detail !== "") {
+      writer.uint32(26).string(message.detail);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Anomaly_DatabaseBackupVerificationFailedDetail {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseAnomaly_DatabaseBackupVerificationFailedDetail();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.backup = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.verifyTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.detail = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Anomaly_DatabaseBackupVerificationFailedDetail {
+    return {
+      backup: isSet(object.backup) ? String(object.backup) : "",
+      verifyTime: isSet(object.verifyTime) ? fromJsonTimestamp(object.verifyTime) : undefined,
+      detail: isSet(object.detail) ? String(object.detail) : "",
+    };
+  },
+
+  toJSON(message: Anomaly_DatabaseBackupVerificationFailedDetail): unknown {
+    const obj: any = {};
+    message.backup !== undefined && (obj.backup = message.backup);
+    message.verifyTime !== undefined && (obj.verifyTime = message.verifyTime.toISOString());
+    message.detail !== undefined && (obj.detail = message.detail);
+    return obj;
+  },
+
+  create(
+    base?: DeepPartial<Anomaly_DatabaseBackupVerificationFailedDetail>,
+  ): Anomaly_DatabaseBackupVerificationFailedDetail {
+    return Anomaly_DatabaseBackupVerificationFailedDetail.fromPartial(base ?? {});
+  },
+
+  fromPartial(
+    object: DeepPartial<Anomaly_DatabaseBackupVerificationFailedDetail>,
+  ): Anomaly_DatabaseBackupVerificationFailedDetail {
+    const message = createBaseAnomaly_DatabaseBackupVerificationFailedDetail();
+    message.backup = object.backup ?? "";
+    message.verifyTime = object.verifyTime ?? undefined;
+    message.detail = object.detail ?? "";
+    return message;
+  },
+};
+
 export type AnomalyServiceDefinition = typeof AnomalyServiceDefinition;
 export const AnomalyServiceDefinition = {
   name: "AnomalyService",
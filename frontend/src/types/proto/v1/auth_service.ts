@@ -212,6 +212,7 @@ export interface LoginRequest {
 export interface IdentityProviderContext {
   oauth2Context?: OAuth2IdentityProviderContext | undefined;
   oidcContext?: OIDCIdentityProviderContext | undefined;
+  samlContext?: SAMLIdentityProviderContext | undefined;
 }
 
 export interface OAuth2IdentityProviderContext {
@@ -221,6 +222,14 @@ export interface OAuth2IdentityProviderContext {
 export interface OIDCIdentityProviderContext {
 }
 
+export interface SAMLIdentityProviderContext {
+  /**
+   * The base64 encoded SAML response posted by the identity provider to the
+   * assertion consumer service.
+   */
+  samlResponse: string;
+}
+
 export interface LoginResponse {
   token: string;
   mfaTempToken?: string | undefined;
@@ -229,6 +238,23 @@ export interface LoginResponse {
 export interface LogoutRequest {
 }
 
+export interface GetSQLProxyCredentialRequest {
+}
+
+export interface SQLProxyCredential {
+  /** The user to connect the SQL proxy, which is the email of the current user. */
+  user: string;
+  /**
+   * The password to connect the SQL proxy.
+   * It changes once the password of the current user is changed.
+   */
+  password: string;
+  /** The port of the SQL proxy for the MySQL clients. 0 means the proxy is disabled. */
+  mysqlPort: number;
+  /** The port of the SQL proxy for the PostgreSQL clients. 0 means the proxy is disabled. */
+  postgresPort: number;
+}
+
 export interface User {
   /**
    * The name of the user.
@@ -917,7 +943,7 @@ export const LoginRequest = {
 };
 
 function createBaseIdentityProviderContext(): IdentityProviderContext {
-  return { oauth2Context: undefined, oidcContext: undefined };
+  return { oauth2Context: undefined, oidcContext: undefined, samlContext: undefined };
 }
 
 export const IdentityProviderContext = {
@@ -928,6 +954,9 @@ export const IdentityProviderContext = {
     if (message.oidcContext !== undefined) {
       OIDCIdentityProviderContext.encode(message.oidcContext, writer.uint32(18).fork()).ldelim();
     }
+    if (message.samlContext !== undefined) {
+      SAMLIdentityProviderContext.encode(message.samlContext, writer.uint32(26).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -952,6 +981,13 @@ export const IdentityProviderContext = {
 
           message.oidcContext = OIDCIdentityProviderContext.decode(reader, reader.uint32());
           continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.samlContext = SAMLIdentityProviderContext.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -967,6 +1003,7 @@ export const IdentityProviderContext = {
         ? OAuth2IdentityProviderContext.fromJSON(object.oauth2Context)
         : undefined,
       oidcContext: isSet(object.oidcContext) ? OIDCIdentityProviderContext.fromJSON(object.oidcContext) : undefined,
+      samlContext: isSet(object.samlContext) ? SAMLIdentityProviderContext.fromJSON(object.samlContext) : undefined,
     };
   },
 
@@ -977,6 +1014,8 @@ export const IdentityProviderContext = {
       : undefined);
     message.oidcContext !== undefined &&
       (obj.oidcContext = message.oidcContext ? OIDCIdentityProviderContext.toJSON(message.oidcContext) : undefined);
+    message.samlContext !== undefined &&
+      (obj.samlContext = message.samlContext ? SAMLIdentityProviderContext.toJSON(message.samlContext) : undefined);
     return obj;
   },
 
@@ -992,6 +1031,9 @@ export const IdentityProviderContext = {
     message.oidcContext = (object.oidcContext !== undefined && object.oidcContext !== null)
       ? OIDCIdentityProviderContext.fromPartial(object.oidcContext)
       : undefined;
+    message.samlContext = (object.samlContext !== undefined && object.samlContext !== null)
+      ? SAMLIdentityProviderContext.fromPartial(object.samlContext)
+      : undefined;
     return message;
   },
 };
@@ -1096,6 +1138,62 @@ export const OIDCIdentityProviderContext = {
   },
 };
 
+function createBaseSAMLIdentityProviderContext(): SAMLIdentityProviderContext {
+  return { samlResponse: "" };
+}
+
+export const SAMLIdentityProviderContext = {
+  encode(message: SAMLIdentityProviderContext, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.samlResponse !== "") {
+      writer.uint32(10).string(message.samlResponse);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SAMLIdentityProviderContext {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSAMLIdentityProviderContext();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.samlResponse = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SAMLIdentityProviderContext {
+    return { samlResponse: isSet(object.samlResponse) ? String(object.samlResponse) : "" };
+  },
+
+  toJSON(message: SAMLIdentityProviderContext): unknown {
+    const obj: any = {};
+    message.samlResponse !== undefined && (obj.samlResponse = message.samlResponse);
+    return obj;
+  },
+
+  create(base?: DeepPartial<SAMLIdentityProviderContext>): SAMLIdentityProviderContext {
+    return SAMLIdentityProviderContext.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SAMLIdentityProviderContext>): SAMLIdentityProviderContext {
+    const message = createBaseSAMLIdentityProviderContext();
+    message.samlResponse = object.samlResponse ?? "";
+    return message;
+  },
+};
+
 function createBaseLoginResponse(): LoginResponse {
   return { token: "", mfaTempToken: undefined };
 }
@@ -1211,6 +1309,147 @@ export const LogoutRequest = {
   },
 };
 
+function createBaseGetSQLProxyCredentialRequest(): GetSQLProxyCredentialRequest {
+  return {};
+}
+
+export const GetSQLProxyCredentialRequest = {
+  encode(_: GetSQLProxyCredentialRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): GetSQLProxyCredentialRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseGetSQLProxyCredentialRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(_: any): GetSQLProxyCredentialRequest {
+    return {};
+  },
+
+  toJSON(_: GetSQLProxyCredentialRequest): unknown {
+    const obj: any = {};
+    return obj;
+  },
+
+  create(base?: DeepPartial<GetSQLProxyCredentialRequest>): GetSQLProxyCredentialRequest {
+    return GetSQLProxyCredentialRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(_: DeepPartial<GetSQLProxyCredentialRequest>): GetSQLProxyCredentialRequest {
+    const message = createBaseGetSQLProxyCredentialRequest();
+    return message;
+  },
+};
+
+function createBaseSQLProxyCredential(): SQLProxyCredential {
+  return { user: "", password: "", mysqlPort: 0, postgresPort: 0 };
+}
+
+export const SQLProxyCredential = {
+  encode(message: SQLProxyCredential, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.user !== "") {
+      writer.uint32(10).string(message.user);
+    }
+    if (message.password !== "") {
+      writer.uint32(18).string(message.password);
+    }
+    if (message.mysqlPort !== 0) {
+      writer.uint32(24).int32(message.mysqlPort);
+    }
+    if (message.postgresPort !== 0) {
+      writer.uint32(32).int32(message.postgresPort);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SQLProxyCredential {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSQLProxyCredential();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.user = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.password = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.mysqlPort = reader.int32();
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.postgresPort = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SQLProxyCredential {
+    return {
+      user: isSet(object.user) ? String(object.user) : "",
+      password: isSet(object.password) ? String(object.password) : "",
+      mysqlPort: isSet(object.mysqlPort) ? Number(object.mysqlPort) : 0,
+      postgresPort: isSet(object.postgresPort) ? Number(object.postgresPort) : 0,
+    };
+  },
+
+  toJSON(message: SQLProxyCredential): unknown {
+    const obj: any = {};
+    message.user !== undefined && (obj.user = message.user);
+    message.password !== undefined && (obj.password = message.password);
+    message.mysqlPort !== undefined && (obj.mysqlPort = Math.round(message.mysqlPort));
+    message.postgresPort !== undefined && (obj.postgresPort = Math.round(message.postgresPort));
+    return obj;
+  },
+
+  create(base?: DeepPartial<SQLProxyCredential>): SQLProxyCredential {
+    return SQLProxyCredential.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SQLProxyCredential>): SQLProxyCredential {
+    const message = createBaseSQLProxyCredential();
+    message.user = object.user ?? "";
+    message.password = object.password ?? "";
+    message.mysqlPort = object.mysqlPort ?? 0;
+    message.postgresPort = object.postgresPort ?? 0;
+    return message;
+  },
+};
+
 function createBaseUser(): User {
   return {
     name: "",
@@ -1680,6 +1919,52 @@ export const AuthServiceDefinition = {
         },
       },
     },
+    /** GetSQLProxyCredential gets the credential of the current user to connect the SQL proxy with the native clients. */
+    getSQLProxyCredential: {
+      name: "GetSQLProxyCredential",
+      requestType: GetSQLProxyCredentialRequest,
+      requestStream: false,
+      responseType: SQLProxyCredential,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          578365826: [
+            new Uint8Array([
+              29,
+              18,
+              27,
+              47,
+              118,
+              49,
+              47,
+              97,
+              117,
+              116,
+              104,
+              47,
+              115,
+              113,
+              108,
+              80,
+              114,
+              111,
+              120,
+              121,
+              67,
+              114,
+              101,
+              100,
+              101,
+              110,
+              116,
+              105,
+              97,
+              108,
+            ]),
+          ],
+        },
+      },
+    },
   },
 } as const;
 
@@ -1692,6 +1977,11 @@ export interface AuthServiceImplementation<CallContextExt = {}> {
   undeleteUser(request: UndeleteUserRequest, context: CallContext & CallContextExt): Promise<DeepPartial<User>>;
   login(request: LoginRequest, context: CallContext & CallContextExt): Promise<DeepPartial<LoginResponse>>;
   logout(request: LogoutRequest, context: CallContext & CallContextExt): Promise<DeepPartial<Empty>>;
+  /** GetSQLProxyCredential gets the credential of the current user to connect the SQL proxy with the native clients. */
+  getSQLProxyCredential(
+    request: GetSQLProxyCredentialRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<SQLProxyCredential>>;
 }
 
 export interface AuthServiceClient<CallOptionsExt = {}> {
@@ -1703,6 +1993,11 @@ export interface AuthServiceClient<CallOptionsExt = {}> {
   undeleteUser(request: DeepPartial<UndeleteUserRequest>, options?: CallOptions & CallOptionsExt): Promise<User>;
   login(request: DeepPartial<LoginRequest>, options?: CallOptions & CallOptionsExt): Promise<LoginResponse>;
   logout(request: DeepPartial<LogoutRequest>, options?: CallOptions & CallOptionsExt): Promise<Empty>;
+  /** GetSQLProxyCredential gets the credential of the current user to connect the SQL proxy with the native clients. */
+  getSQLProxyCredential(
+    request: DeepPartial<GetSQLProxyCredentialRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<SQLProxyCredential>;
 }
 
 type Builtin = Date | Function | Uint8Array | string | number | boolean | undefined;
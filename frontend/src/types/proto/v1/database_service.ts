@@ -399,6 +399,11 @@ export interface ColumnMetadata {
   classification: string;
   /** The user_comment is the user comment of a column parsed from the comment. */
   userComment: string;
+  /**
+   * The discovered_classification is the classification detected by the sensitive data discovery of the project data classification config.
+   * It is only detected for the columns without classification in their comments.
+   */
+  discoveredClassification: string;
 }
 
 /** ViewMetadata is the metadata for views. */
@@ -708,6 +713,8 @@ export interface Backup {
   /** The comment of the backup. */
   comment: string;
   uid: string;
+  /** The latest verification result of the backup, empty if the backup has not been verified. */
+  verification?: Backup_Verification | undefined;
 }
 
 /** The type of the backup. */
@@ -810,6 +817,16 @@ export function backup_BackupStateToJSON(object: Backup_BackupState): string {
   }
 }
 
+/** The verification result of the backup. */
+export interface Backup_Verification {
+  /** The time when the backup was verified. */
+  verifyTime?: Date | undefined;
+  /** Whether the backup was restored and its data matched the stored checksum and row counts. */
+  passed: boolean;
+  /** The detail of the verification. */
+  detail: string;
+}
+
 /** ListSlowQueriesRequest is the request of listing slow query. */
 export interface ListSlowQueriesRequest {
   /** Format: instances/{instance}/databases/{database} */
@@ -3256,6 +3273,7 @@ function createBaseColumnMetadata(): ColumnMetadata {
     comment: "",
     classification: "",
     userComment: "",
+    discoveredClassification: "",
   };
 }
 
@@ -3291,6 +3309,9 @@ export const ColumnMetadata = {
     if (message.userComment !== "") {
       writer.uint32(82).string(message.userComment);
     }
+    if (message.discoveredClassification !== "") {
+      writer.uint32(90).string(message.discoveredClassification);
+    }
     return writer;
   },
 
@@ -3371,6 +3392,13 @@ export const ColumnMetadata = {
 
           message.userComment = reader.string();
           continue;
+        case 11:
+          if (tag !== 90) {
+            break;
+          }
+
+          message.discoveredClassification = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3392,6 +3420,7 @@ export const ColumnMetadata = {
       comment: isSet(object.comment) ? String(object.comment) : "",
       classification: isSet(object.classification) ? String(object.classification) : "",
       userComment: isSet(object.userComment) ? String(object.userComment) : "",
+      discoveredClassification: isSet(object.discoveredClassification) ? String(object.discoveredClassification) : "",
     };
   },
 
@@ -3407,6 +3436,7 @@ export const ColumnMetadata = {
     message.comment !== undefined && (obj.comment = message.comment);
     message.classification !== undefined && (obj.classification = message.classification);
     message.userComment !== undefined && (obj.userComment = message.userComment);
+    message.discoveredClassification !== undefined && (obj.discoveredClassification = message.discoveredClassification);
     return obj;
   },
 
@@ -3426,6 +3456,7 @@ export const ColumnMetadata = {
     message.comment = object.comment ?? "";
     message.classification = object.classification ?? "";
     message.userComment = object.userComment ?? "";
+    message.discoveredClassification = object.discoveredClassification ?? "";
     return message;
   },
 };
@@ -4592,7 +4623,16 @@ export const BackupSetting = {
 };
 
 function createBaseBackup(): Backup {
-  return { name: "", createTime: undefined, updateTime: undefined, state: 0, backupType: 0, comment: "", uid: "" };
+  return {
+    name: "",
+    createTime: undefined,
+    updateTime: undefined,
+    state: 0,
+    backupType: 0,
+    comment: "",
+    uid: "",
+    verification: undefined,
+  };
 }
 
 export const Backup = {
@@ -4618,6 +4658,9 @@ export const Backup = {
     if (message.uid !== "") {
       writer.uint32(58).string(message.uid);
     }
+    if (message.verification !== undefined) {
+      Backup_Verification.encode(message.verification, writer.uint32(66).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -4677,6 +4720,13 @@ export const Backup = {
 
           message.uid = reader.string();
           continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.verification = Backup_Verification.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -4695,6 +4745,7 @@ export const Backup = {
       backupType: isSet(object.backupType) ? backup_BackupTypeFromJSON(object.backupType) : 0,
       comment: isSet(object.comment) ? String(object.comment) : "",
       uid: isSet(object.uid) ? String(object.uid) : "",
+      verification: isSet(object.verification) ? Backup_Verification.fromJSON(object.verification) : undefined,
     };
   },
 
@@ -4707,6 +4758,8 @@ export const Backup = {
     message.backupType !== undefined && (obj.backupType = backup_BackupTypeToJSON(message.backupType));
     message.comment !== undefined && (obj.comment = message.comment);
     message.uid !== undefined && (obj.uid = message.uid);
+    message.verification !== undefined &&
+      (obj.verification = message.verification ? Backup_Verification.toJSON(message.verification) : undefined);
     return obj;
   },
 
@@ -4723,6 +4776,93 @@ export const Backup = {
     message.backupType = object.backupType ?? 0;
     message.comment = object.comment ?? "";
     message.uid = object.uid ?? "";
+    message.verification = (object.verification !== undefined && object.verification !== null)
+      ? Backup_Verification.fromPartial(object.verification)
+      : undefined;
+    return message;
+  },
+};
+
+function createBaseBackup_Verification(): Backup_Verification {
+  return { verifyTime: undefined, passed: false, detail: "" };
+}
+
+export const Backup_Verification = {
+  encode(message: Backup_Verification, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.verifyTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.verifyTime), writer.uint32(10).fork()).ldelim();
+    }
+    if (message.passed === true) {
+      writer.uint32(16).bool(message.passed);
+    }
+    if (message.detail !== "") {
+      writer.uint32(26).string(message.detail);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Backup_Verification {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseBackup_Verification();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.verifyTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.passed = reader.bool();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.detail = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Backup_Verification {
+    return {
+      verifyTime: isSet(object.verifyTime) ? fromJsonTimestamp(object.verifyTime) : undefined,
+      passed: isSet(object.passed) ? Boolean(object.passed) : false,
+      detail: isSet(object.detail) ? String(object.detail) : "",
+    };
+  },
+
+  toJSON(message: Backup_Verification): unknown {
+    const obj: any = {};
+    message.verifyTime !== undefined && (obj.verifyTime = message.verifyTime.toISOString());
+    message.passed !== undefined && (obj.passed = message.passed);
+    message.detail !== undefined && (obj.detail = message.detail);
+    return obj;
+  },
+
+  create(base?: DeepPartial<Backup_Verification>): Backup_Verification {
+    return Backup_Verification.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<Backup_Verification>): Backup_Verification {
+    const message = createBaseBackup_Verification();
+    message.verifyTime = object.verifyTime ?? undefined;
+    message.passed = object.passed ?? false;
+    message.detail = object.detail ?? "";
     return message;
   },
 };
@@ -130,6 +130,8 @@ export enum ExternalVersionControl_Type {
   BITBUCKET = 3,
   /** AZURE_DEVOPS - Azure DevOps. Using for Azure DevOps GitOps workflow. */
   AZURE_DEVOPS = 4,
+  /** GITEA - Gitea type. Using for self-hosted Gitea and Forgejo. */
+  GITEA = 5,
   UNRECOGNIZED = -1,
 }
 
@@ -150,6 +152,9 @@ export function externalVersionControl_TypeFromJSON(object: any): ExternalVersio
     case 4:
     case "AZURE_DEVOPS":
       return ExternalVersionControl_Type.AZURE_DEVOPS;
+    case 5:
+    case "GITEA":
+      return ExternalVersionControl_Type.GITEA;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -169,6 +174,8 @@ export function externalVersionControl_TypeToJSON(object: ExternalVersionControl
       return "BITBUCKET";
     case ExternalVersionControl_Type.AZURE_DEVOPS:
       return "AZURE_DEVOPS";
+    case ExternalVersionControl_Type.GITEA:
+      return "GITEA";
     case ExternalVersionControl_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -12,6 +12,7 @@ export enum IdentityProviderType {
   OAUTH2 = 1,
   OIDC = 2,
   LDAP = 3,
+  SAML = 4,
   UNRECOGNIZED = -1,
 }
 
@@ -29,6 +30,9 @@ export function identityProviderTypeFromJSON(object: any): IdentityProviderType
     case 3:
     case "LDAP":
       return IdentityProviderType.LDAP;
+    case 4:
+    case "SAML":
+      return IdentityProviderType.SAML;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -46,6 +50,8 @@ export function identityProviderTypeToJSON(object: IdentityProviderType): string
       return "OIDC";
     case IdentityProviderType.LDAP:
       return "LDAP";
+    case IdentityProviderType.SAML:
+      return "SAML";
     case IdentityProviderType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -211,6 +217,7 @@ export interface IdentityProviderConfig {
   oauth2Config?: OAuth2IdentityProviderConfig | undefined;
   oidcConfig?: OIDCIdentityProviderConfig | undefined;
   ldapConfig?: LDAPIdentityProviderConfig | undefined;
+  samlConfig?: SAMLIdentityProviderConfig | undefined;
 }
 
 /** OAuth2IdentityProviderConfig is the structure for OAuth2 identity provider config. */
@@ -276,6 +283,44 @@ export interface LDAPIdentityProviderConfig {
   fieldMapping?: FieldMapping | undefined;
 }
 
+/**
+ * SAMLIdentityProviderConfig is the structure for SAML 2.0 identity provider config.
+ * Bytebase acts as the service provider, whose metadata is served at
+ * "{external_url}/saml/metadata/{identity provider resource id}".
+ */
+export interface SAMLIdentityProviderConfig {
+  /**
+   * EntityID is the entity ID of the identity provider, which is the expected
+   * issuer of the SAML responses.
+   */
+  entityId: string;
+  /**
+   * SSOURL is the single sign-on URL of the identity provider that the signed
+   * AuthnRequest is sent to with the HTTP-Redirect binding.
+   */
+  ssoUrl: string;
+  /**
+   * Certificate is the PEM encoded X.509 signing certificate of the identity
+   * provider used to verify the signature of the SAML responses.
+   */
+  certificate: string;
+  /**
+   * SPCertificate is the PEM encoded X.509 certificate of the service provider
+   * published in the service provider metadata.
+   */
+  spCertificate: string;
+  /**
+   * SPPrivateKey is the PEM encoded RSA private key of the service provider
+   * used to sign the AuthnRequest. It is never returned in responses.
+   */
+  spPrivateKey: string;
+  /**
+   * FieldMapping is the mapping of the SAML assertion attributes. The
+   * "NameID" field refers to the name identifier of the assertion subject.
+   */
+  fieldMapping?: FieldMapping | undefined;
+}
+
 /**
  * FieldMapping saves the field names from user info API of identity provider.
  * As we save all raw json string of user info response data into `principal.idp_user_info`,
@@ -1094,7 +1139,7 @@ export const IdentityProvider = {
 };
 
 function createBaseIdentityProviderConfig(): IdentityProviderConfig {
-  return { oauth2Config: undefined, oidcConfig: undefined, ldapConfig: undefined };
+  return { oauth2Config: undefined, oidcConfig: undefined, ldapConfig: undefined, samlConfig: undefined };
 }
 
 export const IdentityProviderConfig = {
@@ -1108,6 +1153,9 @@ export const IdentityProviderConfig = {
     if (message.ldapConfig !== undefined) {
       LDAPIdentityProviderConfig.encode(message.ldapConfig, writer.uint32(26).fork()).ldelim();
     }
+    if (message.samlConfig !== undefined) {
+      SAMLIdentityProviderConfig.encode(message.samlConfig, writer.uint32(34).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1139,6 +1187,13 @@ export const IdentityProviderConfig = {
 
           message.ldapConfig = LDAPIdentityProviderConfig.decode(reader, reader.uint32());
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.samlConfig = SAMLIdentityProviderConfig.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1153,6 +1208,7 @@ export const IdentityProviderConfig = {
       oauth2Config: isSet(object.oauth2Config) ? OAuth2IdentityProviderConfig.fromJSON(object.oauth2Config) : undefined,
       oidcConfig: isSet(object.oidcConfig) ? OIDCIdentityProviderConfig.fromJSON(object.oidcConfig) : undefined,
       ldapConfig: isSet(object.ldapConfig) ? LDAPIdentityProviderConfig.fromJSON(object.ldapConfig) : undefined,
+      samlConfig: isSet(object.samlConfig) ? SAMLIdentityProviderConfig.fromJSON(object.samlConfig) : undefined,
     };
   },
 
@@ -1164,6 +1220,8 @@ export const IdentityProviderConfig = {
       (obj.oidcConfig = message.oidcConfig ? OIDCIdentityProviderConfig.toJSON(message.oidcConfig) : undefined);
     message.ldapConfig !== undefined &&
       (obj.ldapConfig = message.ldapConfig ? LDAPIdentityProviderConfig.toJSON(message.ldapConfig) : undefined);
+    message.samlConfig !== undefined &&
+      (obj.samlConfig = message.samlConfig ? SAMLIdentityProviderConfig.toJSON(message.samlConfig) : undefined);
     return obj;
   },
 
@@ -1182,6 +1240,9 @@ export const IdentityProviderConfig = {
     message.ldapConfig = (object.ldapConfig !== undefined && object.ldapConfig !== null)
       ? LDAPIdentityProviderConfig.fromPartial(object.ldapConfig)
       : undefined;
+    message.samlConfig = (object.samlConfig !== undefined && object.samlConfig !== null)
+      ? SAMLIdentityProviderConfig.fromPartial(object.samlConfig)
+      : undefined;
     return message;
   },
 };
@@ -1691,6 +1752,132 @@ export const LDAPIdentityProviderConfig = {
   },
 };
 
+function createBaseSAMLIdentityProviderConfig(): SAMLIdentityProviderConfig {
+  return { entityId: "", ssoUrl: "", certificate: "", spCertificate: "", spPrivateKey: "", fieldMapping: undefined };
+}
+
+export const SAMLIdentityProviderConfig = {
+  encode(message: SAMLIdentityProviderConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.entityId !== "") {
+      writer.uint32(10).string(message.entityId);
+    }
+    if (message.ssoUrl !== "") {
+      writer.uint32(18).string(message.ssoUrl);
+    }
+    if (message.certificate !== "") {
+      writer.uint32(26).string(message.certificate);
+    }
+    if (message.spCertificate !== "") {
+      writer.uint32(34).string(message.spCertificate);
+    }
+    if (message.spPrivateKey !== "") {
+      writer.uint32(42).string(message.spPrivateKey);
+    }
+    if (message.fieldMapping !== undefined) {
+      FieldMapping.encode(message.fieldMapping, writer.uint32(50).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): SAMLIdentityProviderConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseSAMLIdentityProviderConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.entityId = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.ssoUrl = reader.string();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.certificate = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.spCertificate = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.spPrivateKey = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.fieldMapping = FieldMapping.decode(reader, reader.uint32());
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): SAMLIdentityProviderConfig {
+    return {
+      entityId: isSet(object.entityId) ? String(object.entityId) : "",
+      ssoUrl: isSet(object.ssoUrl) ? String(object.ssoUrl) : "",
+      certificate: isSet(object.certificate) ? String(object.certificate) : "",
+      spCertificate: isSet(object.spCertificate) ? String(object.spCertificate) : "",
+      spPrivateKey: isSet(object.spPrivateKey) ? String(object.spPrivateKey) : "",
+      fieldMapping: isSet(object.fieldMapping) ? FieldMapping.fromJSON(object.fieldMapping) : undefined,
+    };
+  },
+
+  toJSON(message: SAMLIdentityProviderConfig): unknown {
+    const obj: any = {};
+    message.entityId !== undefined && (obj.entityId = message.entityId);
+    message.ssoUrl !== undefined && (obj.ssoUrl = message.ssoUrl);
+    message.certificate !== undefined && (obj.certificate = message.certificate);
+    message.spCertificate !== undefined && (obj.spCertificate = message.spCertificate);
+    message.spPrivateKey !== undefined && (obj.spPrivateKey = message.spPrivateKey);
+    message.fieldMapping !== undefined &&
+      (obj.fieldMapping = message.fieldMapping ? FieldMapping.toJSON(message.fieldMapping) : undefined);
+    return obj;
+  },
+
+  create(base?: DeepPartial<SAMLIdentityProviderConfig>): SAMLIdentityProviderConfig {
+    return SAMLIdentityProviderConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<SAMLIdentityProviderConfig>): SAMLIdentityProviderConfig {
+    const message = createBaseSAMLIdentityProviderConfig();
+    message.entityId = object.entityId ?? "";
+    message.ssoUrl = object.ssoUrl ?? "";
+    message.certificate = object.certificate ?? "";
+    message.spCertificate = object.spCertificate ?? "";
+    message.spPrivateKey = object.spPrivateKey ?? "";
+    message.fieldMapping = (object.fieldMapping !== undefined && object.fieldMapping !== null)
+      ? FieldMapping.fromPartial(object.fieldMapping)
+      : undefined;
+    return message;
+  },
+};
+
 function createBaseFieldMapping(): FieldMapping {
   return { identifier: "", displayName: "", email: "", phone: "" };
 }
@@ -116,8 +116,29 @@ export interface InstanceRole {
    * The role attribute.
    * For PostgreSQL, it containt super_user, no_inherit, create_role, create_db, can_login, replication and bypass_rls. Docs: https://www.postgresql.org/docs/current/role-attributes.html
    * For MySQL, it's the global privileges as GRANT statements, which means it only contains "GRANT ... ON *.* TO ...". Docs: https://dev.mysql.com/doc/refman/8.0/en/grant.html
+   * For MSSQL, it's the comma separated server roles and database roles of the login, such as "sysadmin, db1.db_datareader".
+   * For Redshift, it's the user options followed by the groups, such as "CREATEDB NOCREATEUSER IN GROUP analyst, etl".
+   * For MongoDB, it's the JSON array of the granted roles, such as [{"role": "readWrite", "db": "app"}].
+   * For Spanner, it's the comma separated granted database roles.
    */
-  attribute?: string | undefined;
+  attribute?:
+    | string
+    | undefined;
+  /**
+   * The roles or groups granted to the role.
+   * For MSSQL, it's the server roles and the database roles in "database.role" format.
+   * For Redshift, it's the user groups.
+   * For MongoDB, it's the granted roles in "database.role" format.
+   * For Spanner, it's the granted database roles.
+   */
+  memberOf: string[];
+  /**
+   * The database that the role belongs to.
+   * For MSSQL, it's the default database of the login.
+   * For MongoDB, it's the authentication database of the user or the database that the role is defined in.
+   * For Spanner, it's the database that the database role is defined in.
+   */
+  database: string;
 }
 
 function createBaseGetInstanceRoleRequest(): GetInstanceRoleRequest {
@@ -614,6 +635,8 @@ function createBaseInstanceRole(): InstanceRole {
     connectionLimit: undefined,
     validUntil: undefined,
     attribute: undefined,
+    memberOf: [],
+    database: "",
   };
 }
 
@@ -637,6 +660,12 @@ export const InstanceRole = {
     if (message.attribute !== undefined) {
       writer.uint32(50).string(message.attribute);
     }
+    for (const v of message.memberOf) {
+      writer.uint32(58).string(v!);
+    }
+    if (message.database !== "") {
+      writer.uint32(66).string(message.database);
+    }
     return writer;
   },
 
@@ -689,6 +718,20 @@ export const InstanceRole = {
 
           message.attribute = reader.string();
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.memberOf.push(reader.string());
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.database = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -706,6 +749,8 @@ export const InstanceRole = {
       connectionLimit: isSet(object.connectionLimit) ? Number(object.connectionLimit) : undefined,
       validUntil: isSet(object.validUntil) ? String(object.validUntil) : undefined,
       attribute: isSet(object.attribute) ? String(object.attribute) : undefined,
+      memberOf: Array.isArray(object?.memberOf) ? object.memberOf.map((e: any) => String(e)) : [],
+      database: isSet(object.database) ? String(object.database) : "",
     };
   },
 
@@ -717,6 +762,12 @@ export const InstanceRole = {
     message.connectionLimit !== undefined && (obj.connectionLimit = Math.round(message.connectionLimit));
     message.validUntil !== undefined && (obj.validUntil = message.validUntil);
     message.attribute !== undefined && (obj.attribute = message.attribute);
+    if (message.memberOf) {
+      obj.memberOf = message.memberOf.map((e) => e);
+    } else {
+      obj.memberOf = [];
+    }
+    message.database !== undefined && (obj.database = message.database);
     return obj;
   },
 
@@ -732,6 +783,8 @@ export const InstanceRole = {
     message.connectionLimit = object.connectionLimit ?? undefined;
     message.validUntil = object.validUntil ?? undefined;
     message.attribute = object.attribute ?? undefined;
+    message.memberOf = object.memberOf?.map((e) => e) || [];
+    message.database = object.database ?? "";
     return message;
   },
 };
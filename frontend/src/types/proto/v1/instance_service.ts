@@ -208,6 +208,11 @@ export interface InstanceOptions {
    * For Oracle schema tenant mode, the instance a Oracle database and the database is the Oracle schema.
    */
   schemaTenantMode: boolean;
+  /**
+   * pitr_enabled enables the point-in-time recovery of the PostgreSQL instance.
+   * Bytebase streams the WAL and takes a daily base backup of the instance if it's enabled.
+   */
+  pitrEnabled: boolean;
 }
 
 export interface Instance {
@@ -1191,7 +1196,7 @@ export const SyncSlowQueriesRequest = {
 };
 
 function createBaseInstanceOptions(): InstanceOptions {
-  return { schemaTenantMode: false };
+  return { schemaTenantMode: false, pitrEnabled: false };
 }
 
 export const InstanceOptions = {
@@ -1199,6 +1204,9 @@ export const InstanceOptions = {
     if (message.schemaTenantMode === true) {
       writer.uint32(8).bool(message.schemaTenantMode);
     }
+    if (message.pitrEnabled === true) {
+      writer.uint32(16).bool(message.pitrEnabled);
+    }
     return writer;
   },
 
@@ -1216,6 +1224,13 @@ export const InstanceOptions = {
 
           message.schemaTenantMode = reader.bool();
           continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pitrEnabled = reader.bool();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1226,12 +1241,16 @@ export const InstanceOptions = {
   },
 
   fromJSON(object: any): InstanceOptions {
-    return { schemaTenantMode: isSet(object.schemaTenantMode) ? Boolean(object.schemaTenantMode) : false };
+    return {
+      schemaTenantMode: isSet(object.schemaTenantMode) ? Boolean(object.schemaTenantMode) : false,
+      pitrEnabled: isSet(object.pitrEnabled) ? Boolean(object.pitrEnabled) : false,
+    };
   },
 
   toJSON(message: InstanceOptions): unknown {
     const obj: any = {};
     message.schemaTenantMode !== undefined && (obj.schemaTenantMode = message.schemaTenantMode);
+    message.pitrEnabled !== undefined && (obj.pitrEnabled = message.pitrEnabled);
     return obj;
   },
 
@@ -1242,6 +1261,7 @@ export const InstanceOptions = {
   fromPartial(object: DeepPartial<InstanceOptions>): InstanceOptions {
     const message = createBaseInstanceOptions();
     message.schemaTenantMode = object.schemaTenantMode ?? false;
+    message.pitrEnabled = object.pitrEnabled ?? false;
     return message;
   },
 };
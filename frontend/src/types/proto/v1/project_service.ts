@@ -3,6 +3,7 @@ import type { CallContext, CallOptions } from "nice-grpc-common";
 import * as _m0 from "protobufjs/minimal";
 import { Empty } from "../google/protobuf/empty";
 import { FieldMask } from "../google/protobuf/field_mask";
+import { Timestamp } from "../google/protobuf/timestamp";
 import { Expr } from "../google/type/expr";
 import { State, stateFromJSON, stateToJSON } from "./common";
 import { ProjectGitOpsInfo } from "./externalvs_service";
@@ -623,6 +624,12 @@ export interface Webhook {
    * - TYPE_ISSUE_COMMENT_CREAT
    */
   notificationTypes: Activity_Type[];
+  /**
+   * secret is used to sign the payload of the custom webhook with HMAC-SHA256.
+   * The signature is sent in the X-Bytebase-Signature-256 header in the format of "sha256=<hex digest>".
+   * The payload is not signed if the secret is empty.
+   */
+  secret: string;
 }
 
 export enum Webhook_Type {
@@ -694,6 +701,126 @@ export function webhook_TypeToJSON(object: Webhook_Type): string {
   }
 }
 
+export interface ListWebhookDeliveriesRequest {
+  /**
+   * The parent webhook, which owns the deliveries.
+   * Format: projects/{project}/webhooks/{webhook}
+   */
+  parent: string;
+  /**
+   * The maximum number of deliveries to return. The service may return fewer than
+   * this value.
+   * If unspecified, at most 50 deliveries will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   */
+  pageSize: number;
+  /**
+   * A page token, received from a previous `ListWebhookDeliveries` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * When paginating, all other parameters provided to `ListWebhookDeliveries` must match
+   * the call that provided the page token.
+   */
+  pageToken: string;
+}
+
+export interface ListWebhookDeliveriesResponse {
+  /** The deliveries of the webhook, the most recent first. */
+  deliveries: WebhookDelivery[];
+  /**
+   * A token, which can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   */
+  nextPageToken: string;
+}
+
+export interface ReplayWebhookDeliveryRequest {
+  /**
+   * The name of the delivery to replay.
+   * The event is delivered again as a new delivery with the same payload.
+   * Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+   */
+  name: string;
+}
+
+/**
+ * WebhookDelivery is the delivery of an event to a webhook.
+ * Failed deliveries are retried with exponential backoff until they run out of attempts.
+ */
+export interface WebhookDelivery {
+  /**
+   * The name of the delivery.
+   * Format: projects/{project}/webhooks/{webhook}/deliveries/{delivery}
+   */
+  name: string;
+  /** The type of the activity triggering the event. */
+  activityType: Activity_Type;
+  status: WebhookDelivery_Status;
+  /** The number of attempts made. */
+  attempt: number;
+  /** The error of the last failed attempt. */
+  error: string;
+  /** The JSON encoded event. */
+  payload: string;
+  createTime?:
+    | Date
+    | undefined;
+  /** The time of the last attempt. */
+  updateTime?:
+    | Date
+    | undefined;
+  /** The time of the next attempt if the delivery is pending. */
+  nextAttemptTime?: Date | undefined;
+}
+
+export enum WebhookDelivery_Status {
+  STATUS_UNSPECIFIED = 0,
+  /** PENDING - The delivery is waiting for the next attempt. */
+  PENDING = 1,
+  /** DONE - The event is delivered. */
+  DONE = 2,
+  /** FAILED - The delivery has run out of attempts. */
+  FAILED = 3,
+  UNRECOGNIZED = -1,
+}
+
+export function webhookDelivery_StatusFromJSON(object: any): WebhookDelivery_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return WebhookDelivery_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "PENDING":
+      return WebhookDelivery_Status.PENDING;
+    case 2:
+    case "DONE":
+      return WebhookDelivery_Status.DONE;
+    case 3:
+    case "FAILED":
+      return WebhookDelivery_Status.FAILED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return WebhookDelivery_Status.UNRECOGNIZED;
+  }
+}
+
+export function webhookDelivery_StatusToJSON(object: WebhookDelivery_Status): string {
+  switch (object) {
+    case WebhookDelivery_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case WebhookDelivery_Status.PENDING:
+      return "PENDING";
+    case WebhookDelivery_Status.DONE:
+      return "DONE";
+    case WebhookDelivery_Status.FAILED:
+      return "FAILED";
+    case WebhookDelivery_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
 export interface DeploymentConfig {
   /**
    * The name of the resource.
@@ -3142,7 +3269,7 @@ export const TestWebhookResponse = {
 };
 
 function createBaseWebhook(): Webhook {
-  return { name: "", type: 0, title: "", url: "", notificationTypes: [] };
+  return { name: "", type: 0, title: "", url: "", notificationTypes: [], secret: "" };
 }
 
 export const Webhook = {
@@ -3164,6 +3291,9 @@ export const Webhook = {
       writer.int32(v);
     }
     writer.ldelim();
+    if (message.secret !== "") {
+      writer.uint32(50).string(message.secret);
+    }
     return writer;
   },
 
@@ -3219,6 +3349,13 @@ export const Webhook = {
           }
 
           break;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.secret = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -3237,6 +3374,7 @@ export const Webhook = {
       notificationTypes: Array.isArray(object?.notificationTypes)
         ? object.notificationTypes.map((e: any) => activity_TypeFromJSON(e))
         : [],
+      secret: isSet(object.secret) ? String(object.secret) : "",
     };
   },
 
@@ -3251,6 +3389,7 @@ export const Webhook = {
     } else {
       obj.notificationTypes = [];
     }
+    message.secret !== undefined && (obj.secret = message.secret);
     return obj;
   },
 
@@ -3265,6 +3404,394 @@ export const Webhook = {
     message.title = object.title ?? "";
     message.url = object.url ?? "";
     message.notificationTypes = object.notificationTypes?.map((e) => e) || [];
+    message.secret = object.secret ?? "";
+    return message;
+  },
+};
+
+function createBaseListWebhookDeliveriesRequest(): ListWebhookDeliveriesRequest {
+  return { parent: "", pageSize: 0, pageToken: "" };
+}
+
+export const ListWebhookDeliveriesRequest = {
+  encode(message: ListWebhookDeliveriesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListWebhookDeliveriesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListWebhookDeliveriesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListWebhookDeliveriesRequest {
+    return {
+      parent: isSet(object.parent) ? String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? String(object.pageToken) : "",
+    };
+  },
+
+  toJSON(message: ListWebhookDeliveriesRequest): unknown {
+    const obj: any = {};
+    message.parent !== undefined && (obj.parent = message.parent);
+    message.pageSize !== undefined && (obj.pageSize = Math.round(message.pageSize));
+    message.pageToken !== undefined && (obj.pageToken = message.pageToken);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListWebhookDeliveriesRequest>): ListWebhookDeliveriesRequest {
+    return ListWebhookDeliveriesRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListWebhookDeliveriesRequest>): ListWebhookDeliveriesRequest {
+    const message = createBaseListWebhookDeliveriesRequest();
+    message.parent = object.parent ?? "";
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseListWebhookDeliveriesResponse(): ListWebhookDeliveriesResponse {
+  return { deliveries: [], nextPageToken: "" };
+}
+
+export const ListWebhookDeliveriesResponse = {
+  encode(message: ListWebhookDeliveriesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.deliveries) {
+      WebhookDelivery.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListWebhookDeliveriesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListWebhookDeliveriesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.deliveries.push(WebhookDelivery.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListWebhookDeliveriesResponse {
+    return {
+      deliveries: Array.isArray(object?.deliveries) ? object.deliveries.map((e: any) => WebhookDelivery.fromJSON(e)) : [],
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
+    };
+  },
+
+  toJSON(message: ListWebhookDeliveriesResponse): unknown {
+    const obj: any = {};
+    if (message.deliveries) {
+      obj.deliveries = message.deliveries.map((e) => e ? WebhookDelivery.toJSON(e) : undefined);
+    } else {
+      obj.deliveries = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListWebhookDeliveriesResponse>): ListWebhookDeliveriesResponse {
+    return ListWebhookDeliveriesResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListWebhookDeliveriesResponse>): ListWebhookDeliveriesResponse {
+    const message = createBaseListWebhookDeliveriesResponse();
+    message.deliveries = object.deliveries?.map((e) => WebhookDelivery.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseReplayWebhookDeliveryRequest(): ReplayWebhookDeliveryRequest {
+  return { name: "" };
+}
+
+export const ReplayWebhookDeliveryRequest = {
+  encode(message: ReplayWebhookDeliveryRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ReplayWebhookDeliveryRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseReplayWebhookDeliveryRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ReplayWebhookDeliveryRequest {
+    return { name: isSet(object.name) ? String(object.name) : "" };
+  },
+
+  toJSON(message: ReplayWebhookDeliveryRequest): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ReplayWebhookDeliveryRequest>): ReplayWebhookDeliveryRequest {
+    return ReplayWebhookDeliveryRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ReplayWebhookDeliveryRequest>): ReplayWebhookDeliveryRequest {
+    const message = createBaseReplayWebhookDeliveryRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseWebhookDelivery(): WebhookDelivery {
+  return {
+    name: "",
+    activityType: 0,
+    status: 0,
+    attempt: 0,
+    error: "",
+    payload: "",
+    createTime: undefined,
+    updateTime: undefined,
+    nextAttemptTime: undefined,
+  };
+}
+
+export const WebhookDelivery = {
+  encode(message: WebhookDelivery, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.activityType !== 0) {
+      writer.uint32(16).int32(message.activityType);
+    }
+    if (message.status !== 0) {
+      writer.uint32(24).int32(message.status);
+    }
+    if (message.attempt !== 0) {
+      writer.uint32(32).int32(message.attempt);
+    }
+    if (message.error !== "") {
+      writer.uint32(42).string(message.error);
+    }
+    if (message.payload !== "") {
+      writer.uint32(50).string(message.payload);
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(58).fork()).ldelim();
+    }
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(66).fork()).ldelim();
+    }
+    if (message.nextAttemptTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.nextAttemptTime), writer.uint32(74).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): WebhookDelivery {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseWebhookDelivery();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.activityType = reader.int32() as any;
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.attempt = reader.int32();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.error = reader.string();
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.payload = reader.string();
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 9:
+          if (tag !== 74) {
+            break;
+          }
+
+          message.nextAttemptTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): WebhookDelivery {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      activityType: isSet(object.activityType) ? activity_TypeFromJSON(object.activityType) : 0,
+      status: isSet(object.status) ? webhookDelivery_StatusFromJSON(object.status) : 0,
+      attempt: isSet(object.attempt) ? Number(object.attempt) : 0,
+      error: isSet(object.error) ? String(object.error) : "",
+      payload: isSet(object.payload) ? String(object.payload) : "",
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+      nextAttemptTime: isSet(object.nextAttemptTime) ? fromJsonTimestamp(object.nextAttemptTime) : undefined,
+    };
+  },
+
+  toJSON(message: WebhookDelivery): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.activityType !== undefined && (obj.activityType = activity_TypeToJSON(message.activityType));
+    message.status !== undefined && (obj.status = webhookDelivery_StatusToJSON(message.status));
+    message.attempt !== undefined && (obj.attempt = Math.round(message.attempt));
+    message.error !== undefined && (obj.error = message.error);
+    message.payload !== undefined && (obj.payload = message.payload);
+    message.createTime !== undefined && (obj.createTime = message.createTime.toISOString());
+    message.updateTime !== undefined && (obj.updateTime = message.updateTime.toISOString());
+    message.nextAttemptTime !== undefined && (obj.nextAttemptTime = message.nextAttemptTime.toISOString());
+    return obj;
+  },
+
+  create(base?: DeepPartial<WebhookDelivery>): WebhookDelivery {
+    return WebhookDelivery.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<WebhookDelivery>): WebhookDelivery {
+    const message = createBaseWebhookDelivery();
+    message.name = object.name ?? "";
+    message.activityType = object.activityType ?? 0;
+    message.status = object.status ?? 0;
+    message.attempt = object.attempt ?? 0;
+    message.error = object.error ?? "";
+    message.payload = object.payload ?? "";
+    message.createTime = object.createTime ?? undefined;
+    message.updateTime = object.updateTime ?? undefined;
+    message.nextAttemptTime = object.nextAttemptTime ?? undefined;
     return message;
   },
 };
@@ -5949,6 +6476,144 @@ export const ProjectServiceDefinition = {
         },
       },
     },
+    listWebhookDeliveries: {
+      name: "ListWebhookDeliveries",
+      requestType: ListWebhookDeliveriesRequest,
+      requestStream: false,
+      responseType: ListWebhookDeliveriesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          578365826: [
+            new Uint8Array([
+              47,
+              18,
+              45,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              119,
+              101,
+              98,
+              104,
+              111,
+              111,
+              107,
+              115,
+              47,
+              42,
+              125,
+              47,
+              100,
+              101,
+              108,
+              105,
+              118,
+              101,
+              114,
+              105,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
+    replayWebhookDelivery: {
+      name: "ReplayWebhookDelivery",
+      requestType: ReplayWebhookDeliveryRequest,
+      requestStream: false,
+      responseType: WebhookDelivery,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          578365826: [
+            new Uint8Array([
+              57,
+              58,
+              1,
+              42,
+              34,
+              52,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              119,
+              101,
+              98,
+              104,
+              111,
+              111,
+              107,
+              115,
+              47,
+              42,
+              47,
+              100,
+              101,
+              108,
+              105,
+              118,
+              101,
+              114,
+              105,
+              101,
+              115,
+              47,
+              42,
+              125,
+              58,
+              114,
+              101,
+              112,
+              108,
+              97,
+              121,
+            ]),
+          ],
+        },
+      },
+    },
     updateProjectGitOpsInfo: {
       name: "UpdateProjectGitOpsInfo",
       requestType: UpdateProjectGitOpsInfoRequest,
@@ -7074,6 +7739,14 @@ export interface ProjectServiceImplementation<CallContextExt = {}> {
     request: TestWebhookRequest,
     context: CallContext & CallContextExt,
   ): Promise<DeepPartial<TestWebhookResponse>>;
+  listWebhookDeliveries(
+    request: ListWebhookDeliveriesRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<ListWebhookDeliveriesResponse>>;
+  replayWebhookDelivery(
+    request: ReplayWebhookDeliveryRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<WebhookDelivery>>;
   updateProjectGitOpsInfo(
     request: UpdateProjectGitOpsInfoRequest,
     context: CallContext & CallContextExt,
@@ -7171,6 +7844,14 @@ export interface ProjectServiceClient<CallOptionsExt = {}> {
     request: DeepPartial<TestWebhookRequest>,
     options?: CallOptions & CallOptionsExt,
   ): Promise<TestWebhookResponse>;
+  listWebhookDeliveries(
+    request: DeepPartial<ListWebhookDeliveriesRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<ListWebhookDeliveriesResponse>;
+  replayWebhookDelivery(
+    request: DeepPartial<ReplayWebhookDeliveryRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<WebhookDelivery>;
   updateProjectGitOpsInfo(
     request: DeepPartial<UpdateProjectGitOpsInfoRequest>,
     options?: CallOptions & CallOptionsExt,
@@ -7236,6 +7917,28 @@ export type DeepPartial<T> = T extends Builtin ? T
   : T extends {} ? { [K in keyof T]?: DeepPartial<T[K]> }
   : Partial<T>;
 
+function toTimestamp(date: Date): Timestamp {
+  const seconds = date.getTime() / 1_000;
+  const nanos = (date.getTime() % 1_000) * 1_000_000;
+  return { seconds, nanos };
+}
+
+function fromTimestamp(t: Timestamp): Date {
+  let millis = (t.seconds || 0) * 1_000;
+  millis += (t.nanos || 0) / 1_000_000;
+  return new Date(millis);
+}
+
+function fromJsonTimestamp(o: any): Date {
+  if (o instanceof Date) {
+    return o;
+  } else if (typeof o === "string") {
+    return new Date(o);
+  } else {
+    return fromTimestamp(Timestamp.fromJSON(o));
+  }
+}
+
 function isSet(value: any): boolean {
   return value !== null && value !== undefined;
 }
@@ -2,6 +2,7 @@
 import * as Long from "long";
 import type { CallContext, CallOptions } from "nice-grpc-common";
 import * as _m0 from "protobufjs/minimal";
+import { Duration } from "../google/protobuf/duration";
 import { FieldMask } from "../google/protobuf/field_mask";
 import { Timestamp } from "../google/protobuf/timestamp";
 import { ChangedResources } from "./database_service";
@@ -91,12 +92,30 @@ export interface Plan {
   title: string;
   description: string;
   steps: Plan_Step[];
+  /**
+   * schedule is present if the data changes of the plan run repeatedly on a cron schedule.
+   * The occurrences start after the issue of the plan is approved.
+   */
+  schedule?: Plan_Schedule | undefined;
 }
 
 export interface Plan_Step {
   specs: Plan_Spec[];
 }
 
+export interface Plan_Schedule {
+  /** cron is the standard 5-field cron expression, e.g. "0 2 * * *". */
+  cron: string;
+  /**
+   * time_zone is the IANA time zone name the cron expression is evaluated in, e.g. "America/Los_Angeles".
+   * Empty means UTC.
+   */
+  timeZone: string;
+  paused: boolean;
+  /** next_run_time is unset if the schedule is paused. */
+  nextRunTime?: Date | undefined;
+}
+
 export interface Plan_Spec {
   /** earliest_allowed_time the earliest execution time of the change. */
   earliestAllowedTime?:
@@ -170,6 +189,11 @@ export interface Plan_ChangeDatabaseConfig {
   /** If RollbackEnabled, build the RollbackSheetID of the task. */
   rollbackEnabled: boolean;
   rollbackDetail?: Plan_ChangeDatabaseConfig_RollbackDetail | undefined;
+  /**
+   * batch_config is present if the DML statement runs in primary key ranged chunks.
+   * It only applies to the data changes on MySQL and PostgreSQL.
+   */
+  batchConfig?: Plan_ChangeDatabaseConfig_BatchConfig | undefined;
 }
 
 /** Type is the database change type. */
@@ -259,6 +283,23 @@ export interface Plan_ChangeDatabaseConfig_RollbackDetail {
   rollbackFromIssue: string;
 }
 
+export interface Plan_ChangeDatabaseConfig_BatchConfig {
+  /** chunk_size is the number of rows in the primary key range of each chunk. */
+  chunkSize: number;
+  /** pause is the time to pause between chunks. */
+  pause?: Duration | undefined;
+  /**
+   * max_replica_lag pauses the execution while the replica lag exceeds it.
+   * Zero means no limit.
+   */
+  maxReplicaLag?: Duration | undefined;
+  /**
+   * max_threads_running pauses the execution while the number of running threads exceeds it.
+   * Zero means no limit.
+   */
+  maxThreadsRunning: number;
+}
+
 export interface Plan_RestoreDatabaseConfig {
   /**
    * The resource name of the target to restore.
@@ -271,7 +312,154 @@ export interface Plan_RestoreDatabaseConfig {
     | string
     | undefined;
   /** After the PITR operations, the database will be recovered to the state at this time. */
-  pointInTime?: Date | undefined;
+  pointInTime?:
+    | Date
+    | undefined;
+  /**
+   * After the PITR operations, the database will be recovered to the state at this WAL location.
+   * Only supported for PostgreSQL.
+   * Format: {hex}/{hex}, e.g. 0/16B3748
+   */
+  lsn?: string | undefined;
+}
+
+export interface PausePlanScheduleRequest {
+  /**
+   * The plan to pause the schedule.
+   * Format: projects/{project}/plans/{plan}
+   */
+  name: string;
+}
+
+export interface ResumePlanScheduleRequest {
+  /**
+   * The plan to resume the schedule.
+   * Format: projects/{project}/plans/{plan}
+   */
+  name: string;
+}
+
+export interface ListPlanScheduleOccurrencesRequest {
+  /**
+   * The parent, which owns this collection of occurrences.
+   * Format: projects/{project}/plans/{plan}
+   */
+  parent: string;
+  /**
+   * The maximum number of occurrences to return. The service may return fewer than
+   * this value.
+   * If unspecified, at most 50 occurrences will be returned.
+   * The maximum value is 1000; values above 1000 will be coerced to 1000.
+   */
+  pageSize: number;
+  /**
+   * A page token, received from a previous `ListPlanScheduleOccurrences` call.
+   * Provide this to retrieve the subsequent page.
+   *
+   * When paginating, all other parameters provided to `ListPlanScheduleOccurrences` must match
+   * the call that provided the page token.
+   */
+  pageToken: string;
+}
+
+export interface ListPlanScheduleOccurrencesResponse {
+  /** The occurrences from the specified request, ordered by schedule time descending. */
+  occurrences: PlanScheduleOccurrence[];
+  /**
+   * A token, which can be sent as `page_token` to retrieve the next page.
+   * If this field is omitted, there are no subsequent pages.
+   */
+  nextPageToken: string;
+}
+
+export interface PlanScheduleOccurrence {
+  /** Format: projects/{project}/plans/{plan}/occurrences/{occurrence} */
+  name: string;
+  /** The time the occurrence is scheduled at. */
+  scheduleTime?: Date | undefined;
+  status: PlanScheduleOccurrence_Status;
+  /** detail is the reason why the occurrence is failed or skipped. */
+  detail: string;
+  /**
+   * The task runs of the occurrence.
+   * Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task}/taskRuns/{taskRun}
+   */
+  taskRuns: string[];
+  /** The rollback statements generated for the task runs of the occurrence. */
+  rollbacks: PlanScheduleOccurrence_Rollback[];
+  createTime?: Date | undefined;
+  updateTime?: Date | undefined;
+}
+
+export enum PlanScheduleOccurrence_Status {
+  STATUS_UNSPECIFIED = 0,
+  /** CHECKING - The plan checks of the occurrence are running. */
+  CHECKING = 1,
+  /** RUNNING - The task runs of the occurrence are running. */
+  RUNNING = 2,
+  DONE = 3,
+  FAILED = 4,
+  /** SKIPPED - The occurrence is skipped because the previous occurrence is still active. */
+  SKIPPED = 5,
+  UNRECOGNIZED = -1,
+}
+
+export function planScheduleOccurrence_StatusFromJSON(object: any): PlanScheduleOccurrence_Status {
+  switch (object) {
+    case 0:
+    case "STATUS_UNSPECIFIED":
+      return PlanScheduleOccurrence_Status.STATUS_UNSPECIFIED;
+    case 1:
+    case "CHECKING":
+      return PlanScheduleOccurrence_Status.CHECKING;
+    case 2:
+    case "RUNNING":
+      return PlanScheduleOccurrence_Status.RUNNING;
+    case 3:
+    case "DONE":
+      return PlanScheduleOccurrence_Status.DONE;
+    case 4:
+    case "FAILED":
+      return PlanScheduleOccurrence_Status.FAILED;
+    case 5:
+    case "SKIPPED":
+      return PlanScheduleOccurrence_Status.SKIPPED;
+    case -1:
+    case "UNRECOGNIZED":
+    default:
+      return PlanScheduleOccurrence_Status.UNRECOGNIZED;
+  }
+}
+
+export function planScheduleOccurrence_StatusToJSON(object: PlanScheduleOccurrence_Status): string {
+  switch (object) {
+    case PlanScheduleOccurrence_Status.STATUS_UNSPECIFIED:
+      return "STATUS_UNSPECIFIED";
+    case PlanScheduleOccurrence_Status.CHECKING:
+      return "CHECKING";
+    case PlanScheduleOccurrence_Status.RUNNING:
+      return "RUNNING";
+    case PlanScheduleOccurrence_Status.DONE:
+      return "DONE";
+    case PlanScheduleOccurrence_Status.FAILED:
+      return "FAILED";
+    case PlanScheduleOccurrence_Status.SKIPPED:
+      return "SKIPPED";
+    case PlanScheduleOccurrence_Status.UNRECOGNIZED:
+    default:
+      return "UNRECOGNIZED";
+  }
+}
+
+export interface PlanScheduleOccurrence_Rollback {
+  /** Format: projects/{project}/rollouts/{rollout}/stages/{stage}/tasks/{task} */
+  task: string;
+  /**
+   * The sheet of the generated rollback statement.
+   * Format: projects/{project}/sheets/{sheet}
+   */
+  sheet: string;
+  error: string;
 }
 
 export interface ListPlanCheckRunsRequest {
@@ -397,6 +585,8 @@ export enum PlanCheckRun_Type {
   DATABASE_CONNECT = 6,
   DATABASE_GHOST_SYNC = 7,
   DATABASE_PITR_MYSQL = 8,
+  DATABASE_PG_ONLINE_SYNC = 9,
+  DATABASE_PITR_POSTGRES = 10,
   UNRECOGNIZED = -1,
 }
 
@@ -429,6 +619,12 @@ export function planCheckRun_TypeFromJSON(object: any): PlanCheckRun_Type {
     case 8:
     case "DATABASE_PITR_MYSQL":
       return PlanCheckRun_Type.DATABASE_PITR_MYSQL;
+    case 9:
+    case "DATABASE_PG_ONLINE_SYNC":
+      return PlanCheckRun_Type.DATABASE_PG_ONLINE_SYNC;
+    case 10:
+    case "DATABASE_PITR_POSTGRES":
+      return PlanCheckRun_Type.DATABASE_PITR_POSTGRES;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -456,6 +652,10 @@ export function planCheckRun_TypeToJSON(object: PlanCheckRun_Type): string {
       return "DATABASE_GHOST_SYNC";
     case PlanCheckRun_Type.DATABASE_PITR_MYSQL:
       return "DATABASE_PITR_MYSQL";
+    case PlanCheckRun_Type.DATABASE_PG_ONLINE_SYNC:
+      return "DATABASE_PG_ONLINE_SYNC";
+    case PlanCheckRun_Type.DATABASE_PITR_POSTGRES:
+      return "DATABASE_PITR_POSTGRES";
     case PlanCheckRun_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -796,6 +996,10 @@ export enum Task_Type {
   DATABASE_RESTORE_RESTORE = 10,
   /** DATABASE_RESTORE_CUTOVER - use payload nil */
   DATABASE_RESTORE_CUTOVER = 11,
+  /** DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC - use payload DatabaseSchemaUpdate */
+  DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC = 12,
+  /** DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER - use payload nil */
+  DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER = 13,
   UNRECOGNIZED = -1,
 }
 
@@ -837,6 +1041,12 @@ export function task_TypeFromJSON(object: any): Task_Type {
     case 11:
     case "DATABASE_RESTORE_CUTOVER":
       return Task_Type.DATABASE_RESTORE_CUTOVER;
+    case 12:
+    case "DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC":
+      return Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC;
+    case 13:
+    case "DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER":
+      return Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -870,6 +1080,10 @@ export function task_TypeToJSON(object: Task_Type): string {
       return "DATABASE_RESTORE_RESTORE";
     case Task_Type.DATABASE_RESTORE_CUTOVER:
       return "DATABASE_RESTORE_CUTOVER";
+    case Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC:
+      return "DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC";
+    case Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER:
+      return "DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER";
     case Task_Type.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -1012,7 +1226,15 @@ export interface Task_DatabaseRestoreRestore {
     | string
     | undefined;
   /** After the PITR operations, the database will be recovered to the state at this time. */
-  pointInTime?: Date | undefined;
+  pointInTime?:
+    | Date
+    | undefined;
+  /**
+   * After the PITR operations, the database will be recovered to the state at this WAL location.
+   * Only supported for PostgreSQL.
+   * Format: {hex}/{hex}, e.g. 0/16B3748
+   */
+  lsn?: string | undefined;
 }
 
 export interface TaskRun {
@@ -1453,7 +1675,7 @@ export const UpdatePlanRequest = {
 };
 
 function createBasePlan(): Plan {
-  return { name: "", uid: "", issue: "", title: "", description: "", steps: [] };
+  return { name: "", uid: "", issue: "", title: "", description: "", steps: [], schedule: undefined };
 }
 
 export const Plan = {
@@ -1476,6 +1698,9 @@ export const Plan = {
     for (const v of message.steps) {
       Plan_Step.encode(v!, writer.uint32(50).fork()).ldelim();
     }
+    if (message.schedule !== undefined) {
+      Plan_Schedule.encode(message.schedule, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -1528,6 +1753,13 @@ export const Plan = {
 
           message.steps.push(Plan_Step.decode(reader, reader.uint32()));
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.schedule = Plan_Schedule.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -1545,6 +1777,7 @@ export const Plan = {
       title: isSet(object.title) ? String(object.title) : "",
       description: isSet(object.description) ? String(object.description) : "",
       steps: Array.isArray(object?.steps) ? object.steps.map((e: any) => Plan_Step.fromJSON(e)) : [],
+      schedule: isSet(object.schedule) ? Plan_Schedule.fromJSON(object.schedule) : undefined,
     };
   },
 
@@ -1560,6 +1793,8 @@ export const Plan = {
     } else {
       obj.steps = [];
     }
+    message.schedule !== undefined &&
+      (obj.schedule = message.schedule ? Plan_Schedule.toJSON(message.schedule) : undefined);
     return obj;
   },
 
@@ -1575,6 +1810,9 @@ export const Plan = {
     message.title = object.title ?? "";
     message.description = object.description ?? "";
     message.steps = object.steps?.map((e) => Plan_Step.fromPartial(e)) || [];
+    message.schedule = (object.schedule !== undefined && object.schedule !== null)
+      ? Plan_Schedule.fromPartial(object.schedule)
+      : undefined;
     return message;
   },
 };
@@ -1639,6 +1877,103 @@ export const Plan_Step = {
   },
 };
 
+function createBasePlan_Schedule(): Plan_Schedule {
+  return { cron: "", timeZone: "", paused: false, nextRunTime: undefined };
+}
+
+export const Plan_Schedule = {
+  encode(message: Plan_Schedule, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.cron !== "") {
+      writer.uint32(10).string(message.cron);
+    }
+    if (message.timeZone !== "") {
+      writer.uint32(18).string(message.timeZone);
+    }
+    if (message.paused === true) {
+      writer.uint32(24).bool(message.paused);
+    }
+    if (message.nextRunTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.nextRunTime), writer.uint32(34).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_Schedule {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlan_Schedule();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.cron = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.timeZone = reader.string();
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.paused = reader.bool();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.nextRunTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Plan_Schedule {
+    return {
+      cron: isSet(object.cron) ? String(object.cron) : "",
+      timeZone: isSet(object.timeZone) ? String(object.timeZone) : "",
+      paused: isSet(object.paused) ? Boolean(object.paused) : false,
+      nextRunTime: isSet(object.nextRunTime) ? fromJsonTimestamp(object.nextRunTime) : undefined,
+    };
+  },
+
+  toJSON(message: Plan_Schedule): unknown {
+    const obj: any = {};
+    message.cron !== undefined && (obj.cron = message.cron);
+    message.timeZone !== undefined && (obj.timeZone = message.timeZone);
+    message.paused !== undefined && (obj.paused = message.paused);
+    message.nextRunTime !== undefined && (obj.nextRunTime = message.nextRunTime.toISOString());
+    return obj;
+  },
+
+  create(base?: DeepPartial<Plan_Schedule>): Plan_Schedule {
+    return Plan_Schedule.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<Plan_Schedule>): Plan_Schedule {
+    const message = createBasePlan_Schedule();
+    message.cron = object.cron ?? "";
+    message.timeZone = object.timeZone ?? "";
+    message.paused = object.paused ?? false;
+    message.nextRunTime = object.nextRunTime ?? undefined;
+    return message;
+  },
+};
+
 function createBasePlan_Spec(): Plan_Spec {
   return {
     earliestAllowedTime: undefined,
@@ -2049,7 +2384,15 @@ export const Plan_CreateDatabaseConfig_LabelsEntry = {
 };
 
 function createBasePlan_ChangeDatabaseConfig(): Plan_ChangeDatabaseConfig {
-  return { target: "", sheet: "", type: 0, schemaVersion: "", rollbackEnabled: false, rollbackDetail: undefined };
+  return {
+    target: "",
+    sheet: "",
+    type: 0,
+    schemaVersion: "",
+    rollbackEnabled: false,
+    rollbackDetail: undefined,
+    batchConfig: undefined,
+  };
 }
 
 export const Plan_ChangeDatabaseConfig = {
@@ -2072,6 +2415,9 @@ export const Plan_ChangeDatabaseConfig = {
     if (message.rollbackDetail !== undefined) {
       Plan_ChangeDatabaseConfig_RollbackDetail.encode(message.rollbackDetail, writer.uint32(50).fork()).ldelim();
     }
+    if (message.batchConfig !== undefined) {
+      Plan_ChangeDatabaseConfig_BatchConfig.encode(message.batchConfig, writer.uint32(58).fork()).ldelim();
+    }
     return writer;
   },
 
@@ -2124,6 +2470,13 @@ export const Plan_ChangeDatabaseConfig = {
 
           message.rollbackDetail = Plan_ChangeDatabaseConfig_RollbackDetail.decode(reader, reader.uint32());
           continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.batchConfig = Plan_ChangeDatabaseConfig_BatchConfig.decode(reader, reader.uint32());
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -2143,6 +2496,9 @@ export const Plan_ChangeDatabaseConfig = {
       rollbackDetail: isSet(object.rollbackDetail)
         ? Plan_ChangeDatabaseConfig_RollbackDetail.fromJSON(object.rollbackDetail)
         : undefined,
+      batchConfig: isSet(object.batchConfig)
+        ? Plan_ChangeDatabaseConfig_BatchConfig.fromJSON(object.batchConfig)
+        : undefined,
     };
   },
 
@@ -2156,6 +2512,9 @@ export const Plan_ChangeDatabaseConfig = {
     message.rollbackDetail !== undefined && (obj.rollbackDetail = message.rollbackDetail
       ? Plan_ChangeDatabaseConfig_RollbackDetail.toJSON(message.rollbackDetail)
       : undefined);
+    message.batchConfig !== undefined && (obj.batchConfig = message.batchConfig
+      ? Plan_ChangeDatabaseConfig_BatchConfig.toJSON(message.batchConfig)
+      : undefined);
     return obj;
   },
 
@@ -2173,6 +2532,9 @@ export const Plan_ChangeDatabaseConfig = {
     message.rollbackDetail = (object.rollbackDetail !== undefined && object.rollbackDetail !== null)
       ? Plan_ChangeDatabaseConfig_RollbackDetail.fromPartial(object.rollbackDetail)
       : undefined;
+    message.batchConfig = (object.batchConfig !== undefined && object.batchConfig !== null)
+      ? Plan_ChangeDatabaseConfig_BatchConfig.fromPartial(object.batchConfig)
+      : undefined;
     return message;
   },
 };
@@ -2248,31 +2610,687 @@ export const Plan_ChangeDatabaseConfig_RollbackDetail = {
   },
 };
 
-function createBasePlan_RestoreDatabaseConfig(): Plan_RestoreDatabaseConfig {
-  return { target: "", createDatabaseConfig: undefined, backup: undefined, pointInTime: undefined };
+function createBasePlan_ChangeDatabaseConfig_BatchConfig(): Plan_ChangeDatabaseConfig_BatchConfig {
+  return { chunkSize: 0, pause: undefined, maxReplicaLag: undefined, maxThreadsRunning: 0 };
+}
+
+export const Plan_ChangeDatabaseConfig_BatchConfig = {
+  encode(message: Plan_ChangeDatabaseConfig_BatchConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.chunkSize !== 0) {
+      writer.uint32(8).int32(message.chunkSize);
+    }
+    if (message.pause !== undefined) {
+      Duration.encode(message.pause, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.maxReplicaLag !== undefined) {
+      Duration.encode(message.maxReplicaLag, writer.uint32(26).fork()).ldelim();
+    }
+    if (message.maxThreadsRunning !== 0) {
+      writer.uint32(32).int32(message.maxThreadsRunning);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_ChangeDatabaseConfig_BatchConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlan_ChangeDatabaseConfig_BatchConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 8) {
+            break;
+          }
+
+          message.chunkSize = reader.int32();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.pause = Duration.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.maxReplicaLag = Duration.decode(reader, reader.uint32());
+          continue;
+        case 4:
+          if (tag !== 32) {
+            break;
+          }
+
+          message.maxThreadsRunning = reader.int32();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Plan_ChangeDatabaseConfig_BatchConfig {
+    return {
+      chunkSize: isSet(object.chunkSize) ? Number(object.chunkSize) : 0,
+      pause: isSet(object.pause) ? Duration.fromJSON(object.pause) : undefined,
+      maxReplicaLag: isSet(object.maxReplicaLag) ? Duration.fromJSON(object.maxReplicaLag) : undefined,
+      maxThreadsRunning: isSet(object.maxThreadsRunning) ? Number(object.maxThreadsRunning) : 0,
+    };
+  },
+
+  toJSON(message: Plan_ChangeDatabaseConfig_BatchConfig): unknown {
+    const obj: any = {};
+    message.chunkSize !== undefined && (obj.chunkSize = Math.round(message.chunkSize));
+    message.pause !== undefined && (obj.pause = message.pause ? Duration.toJSON(message.pause) : undefined);
+    message.maxReplicaLag !== undefined &&
+      (obj.maxReplicaLag = message.maxReplicaLag ? Duration.toJSON(message.maxReplicaLag) : undefined);
+    message.maxThreadsRunning !== undefined && (obj.maxThreadsRunning = Math.round(message.maxThreadsRunning));
+    return obj;
+  },
+
+  create(base?: DeepPartial<Plan_ChangeDatabaseConfig_BatchConfig>): Plan_ChangeDatabaseConfig_BatchConfig {
+    return Plan_ChangeDatabaseConfig_BatchConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<Plan_ChangeDatabaseConfig_BatchConfig>): Plan_ChangeDatabaseConfig_BatchConfig {
+    const message = createBasePlan_ChangeDatabaseConfig_BatchConfig();
+    message.chunkSize = object.chunkSize ?? 0;
+    message.pause = (object.pause !== undefined && object.pause !== null)
+      ? Duration.fromPartial(object.pause)
+      : undefined;
+    message.maxReplicaLag = (object.maxReplicaLag !== undefined && object.maxReplicaLag !== null)
+      ? Duration.fromPartial(object.maxReplicaLag)
+      : undefined;
+    message.maxThreadsRunning = object.maxThreadsRunning ?? 0;
+    return message;
+  },
+};
+
+function createBasePlan_RestoreDatabaseConfig(): Plan_RestoreDatabaseConfig {
+  return { target: "", createDatabaseConfig: undefined, backup: undefined, pointInTime: undefined, lsn: undefined };
+}
+
+export const Plan_RestoreDatabaseConfig = {
+  encode(message: Plan_RestoreDatabaseConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.target !== "") {
+      writer.uint32(10).string(message.target);
+    }
+    if (message.createDatabaseConfig !== undefined) {
+      Plan_CreateDatabaseConfig.encode(message.createDatabaseConfig, writer.uint32(18).fork()).ldelim();
+    }
+    if (message.backup !== undefined) {
+      writer.uint32(26).string(message.backup);
+    }
+    if (message.pointInTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.pointInTime), writer.uint32(34).fork()).ldelim();
+    }
+    if (message.lsn !== undefined) {
+      writer.uint32(42).string(message.lsn);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_RestoreDatabaseConfig {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlan_RestoreDatabaseConfig();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.target = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.createDatabaseConfig = Plan_CreateDatabaseConfig.decode(reader, reader.uint32());
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.backup = reader.string();
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.pointInTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.lsn = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): Plan_RestoreDatabaseConfig {
+    return {
+      target: isSet(object.target) ? String(object.target) : "",
+      createDatabaseConfig: isSet(object.createDatabaseConfig)
+        ? Plan_CreateDatabaseConfig.fromJSON(object.createDatabaseConfig)
+        : undefined,
+      backup: isSet(object.backup) ? String(object.backup) : undefined,
+      pointInTime: isSet(object.pointInTime) ? fromJsonTimestamp(object.pointInTime) : undefined,
+      lsn: isSet(object.lsn) ? String(object.lsn) : undefined,
+    };
+  },
+
+  toJSON(message: Plan_RestoreDatabaseConfig): unknown {
+    const obj: any = {};
+    message.target !== undefined && (obj.target = message.target);
+    message.createDatabaseConfig !== undefined && (obj.createDatabaseConfig = message.createDatabaseConfig
+      ? Plan_CreateDatabaseConfig.toJSON(message.createDatabaseConfig)
+      : undefined);
+    message.backup !== undefined && (obj.backup = message.backup);
+    message.pointInTime !== undefined && (obj.pointInTime = message.pointInTime.toISOString());
+    message.lsn !== undefined && (obj.lsn = message.lsn);
+    return obj;
+  },
+
+  create(base?: DeepPartial<Plan_RestoreDatabaseConfig>): Plan_RestoreDatabaseConfig {
+    return Plan_RestoreDatabaseConfig.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<Plan_RestoreDatabaseConfig>): Plan_RestoreDatabaseConfig {
+    const message = createBasePlan_RestoreDatabaseConfig();
+    message.target = object.target ?? "";
+    message.createDatabaseConfig = (object.createDatabaseConfig !== undefined && object.createDatabaseConfig !== null)
+      ? Plan_CreateDatabaseConfig.fromPartial(object.createDatabaseConfig)
+      : undefined;
+    message.backup = object.backup ?? undefined;
+    message.pointInTime = object.pointInTime ?? undefined;
+    message.lsn = object.lsn ?? undefined;
+    return message;
+  },
+};
+
+function createBasePausePlanScheduleRequest(): PausePlanScheduleRequest {
+  return { name: "" };
+}
+
+export const PausePlanScheduleRequest = {
+  encode(message: PausePlanScheduleRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PausePlanScheduleRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePausePlanScheduleRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PausePlanScheduleRequest {
+    return { name: isSet(object.name) ? String(object.name) : "" };
+  },
+
+  toJSON(message: PausePlanScheduleRequest): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    return obj;
+  },
+
+  create(base?: DeepPartial<PausePlanScheduleRequest>): PausePlanScheduleRequest {
+    return PausePlanScheduleRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<PausePlanScheduleRequest>): PausePlanScheduleRequest {
+    const message = createBasePausePlanScheduleRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseResumePlanScheduleRequest(): ResumePlanScheduleRequest {
+  return { name: "" };
+}
+
+export const ResumePlanScheduleRequest = {
+  encode(message: ResumePlanScheduleRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ResumePlanScheduleRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseResumePlanScheduleRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ResumePlanScheduleRequest {
+    return { name: isSet(object.name) ? String(object.name) : "" };
+  },
+
+  toJSON(message: ResumePlanScheduleRequest): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ResumePlanScheduleRequest>): ResumePlanScheduleRequest {
+    return ResumePlanScheduleRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ResumePlanScheduleRequest>): ResumePlanScheduleRequest {
+    const message = createBaseResumePlanScheduleRequest();
+    message.name = object.name ?? "";
+    return message;
+  },
+};
+
+function createBaseListPlanScheduleOccurrencesRequest(): ListPlanScheduleOccurrencesRequest {
+  return { parent: "", pageSize: 0, pageToken: "" };
+}
+
+export const ListPlanScheduleOccurrencesRequest = {
+  encode(message: ListPlanScheduleOccurrencesRequest, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.parent !== "") {
+      writer.uint32(10).string(message.parent);
+    }
+    if (message.pageSize !== 0) {
+      writer.uint32(16).int32(message.pageSize);
+    }
+    if (message.pageToken !== "") {
+      writer.uint32(26).string(message.pageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlanScheduleOccurrencesRequest {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPlanScheduleOccurrencesRequest();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.parent = reader.string();
+          continue;
+        case 2:
+          if (tag !== 16) {
+            break;
+          }
+
+          message.pageSize = reader.int32();
+          continue;
+        case 3:
+          if (tag !== 26) {
+            break;
+          }
+
+          message.pageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListPlanScheduleOccurrencesRequest {
+    return {
+      parent: isSet(object.parent) ? String(object.parent) : "",
+      pageSize: isSet(object.pageSize) ? Number(object.pageSize) : 0,
+      pageToken: isSet(object.pageToken) ? String(object.pageToken) : "",
+    };
+  },
+
+  toJSON(message: ListPlanScheduleOccurrencesRequest): unknown {
+    const obj: any = {};
+    message.parent !== undefined && (obj.parent = message.parent);
+    message.pageSize !== undefined && (obj.pageSize = Math.round(message.pageSize));
+    message.pageToken !== undefined && (obj.pageToken = message.pageToken);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListPlanScheduleOccurrencesRequest>): ListPlanScheduleOccurrencesRequest {
+    return ListPlanScheduleOccurrencesRequest.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListPlanScheduleOccurrencesRequest>): ListPlanScheduleOccurrencesRequest {
+    const message = createBaseListPlanScheduleOccurrencesRequest();
+    message.parent = object.parent ?? "";
+    message.pageSize = object.pageSize ?? 0;
+    message.pageToken = object.pageToken ?? "";
+    return message;
+  },
+};
+
+function createBaseListPlanScheduleOccurrencesResponse(): ListPlanScheduleOccurrencesResponse {
+  return { occurrences: [], nextPageToken: "" };
+}
+
+export const ListPlanScheduleOccurrencesResponse = {
+  encode(message: ListPlanScheduleOccurrencesResponse, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    for (const v of message.occurrences) {
+      PlanScheduleOccurrence.encode(v!, writer.uint32(10).fork()).ldelim();
+    }
+    if (message.nextPageToken !== "") {
+      writer.uint32(18).string(message.nextPageToken);
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): ListPlanScheduleOccurrencesResponse {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBaseListPlanScheduleOccurrencesResponse();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.occurrences.push(PlanScheduleOccurrence.decode(reader, reader.uint32()));
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.nextPageToken = reader.string();
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): ListPlanScheduleOccurrencesResponse {
+    return {
+      occurrences: Array.isArray(object?.occurrences)
+        ? object.occurrences.map((e: any) => PlanScheduleOccurrence.fromJSON(e))
+        : [],
+      nextPageToken: isSet(object.nextPageToken) ? String(object.nextPageToken) : "",
+    };
+  },
+
+  toJSON(message: ListPlanScheduleOccurrencesResponse): unknown {
+    const obj: any = {};
+    if (message.occurrences) {
+      obj.occurrences = message.occurrences.map((e) => e ? PlanScheduleOccurrence.toJSON(e) : undefined);
+    } else {
+      obj.occurrences = [];
+    }
+    message.nextPageToken !== undefined && (obj.nextPageToken = message.nextPageToken);
+    return obj;
+  },
+
+  create(base?: DeepPartial<ListPlanScheduleOccurrencesResponse>): ListPlanScheduleOccurrencesResponse {
+    return ListPlanScheduleOccurrencesResponse.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<ListPlanScheduleOccurrencesResponse>): ListPlanScheduleOccurrencesResponse {
+    const message = createBaseListPlanScheduleOccurrencesResponse();
+    message.occurrences = object.occurrences?.map((e) => PlanScheduleOccurrence.fromPartial(e)) || [];
+    message.nextPageToken = object.nextPageToken ?? "";
+    return message;
+  },
+};
+
+function createBasePlanScheduleOccurrence(): PlanScheduleOccurrence {
+  return {
+    name: "",
+    scheduleTime: undefined,
+    status: 0,
+    detail: "",
+    taskRuns: [],
+    rollbacks: [],
+    createTime: undefined,
+    updateTime: undefined,
+  };
+}
+
+export const PlanScheduleOccurrence = {
+  encode(message: PlanScheduleOccurrence, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.name !== "") {
+      writer.uint32(10).string(message.name);
+    }
+    if (message.scheduleTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.scheduleTime), writer.uint32(18).fork()).ldelim();
+    }
+    if (message.status !== 0) {
+      writer.uint32(24).int32(message.status);
+    }
+    if (message.detail !== "") {
+      writer.uint32(34).string(message.detail);
+    }
+    for (const v of message.taskRuns) {
+      writer.uint32(42).string(v!);
+    }
+    for (const v of message.rollbacks) {
+      PlanScheduleOccurrence_Rollback.encode(v!, writer.uint32(50).fork()).ldelim();
+    }
+    if (message.createTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.createTime), writer.uint32(58).fork()).ldelim();
+    }
+    if (message.updateTime !== undefined) {
+      Timestamp.encode(toTimestamp(message.updateTime), writer.uint32(66).fork()).ldelim();
+    }
+    return writer;
+  },
+
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanScheduleOccurrence {
+    const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
+    let end = length === undefined ? reader.len : reader.pos + length;
+    const message = createBasePlanScheduleOccurrence();
+    while (reader.pos < end) {
+      const tag = reader.uint32();
+      switch (tag >>> 3) {
+        case 1:
+          if (tag !== 10) {
+            break;
+          }
+
+          message.name = reader.string();
+          continue;
+        case 2:
+          if (tag !== 18) {
+            break;
+          }
+
+          message.scheduleTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 3:
+          if (tag !== 24) {
+            break;
+          }
+
+          message.status = reader.int32() as any;
+          continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.detail = reader.string();
+          continue;
+        case 5:
+          if (tag !== 42) {
+            break;
+          }
+
+          message.taskRuns.push(reader.string());
+          continue;
+        case 6:
+          if (tag !== 50) {
+            break;
+          }
+
+          message.rollbacks.push(PlanScheduleOccurrence_Rollback.decode(reader, reader.uint32()));
+          continue;
+        case 7:
+          if (tag !== 58) {
+            break;
+          }
+
+          message.createTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+        case 8:
+          if (tag !== 66) {
+            break;
+          }
+
+          message.updateTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          continue;
+      }
+      if ((tag & 7) === 4 || tag === 0) {
+        break;
+      }
+      reader.skipType(tag & 7);
+    }
+    return message;
+  },
+
+  fromJSON(object: any): PlanScheduleOccurrence {
+    return {
+      name: isSet(object.name) ? String(object.name) : "",
+      scheduleTime: isSet(object.scheduleTime) ? fromJsonTimestamp(object.scheduleTime) : undefined,
+      status: isSet(object.status) ? planScheduleOccurrence_StatusFromJSON(object.status) : 0,
+      detail: isSet(object.detail) ? String(object.detail) : "",
+      taskRuns: Array.isArray(object?.taskRuns) ? object.taskRuns.map((e: any) => String(e)) : [],
+      rollbacks: Array.isArray(object?.rollbacks)
+        ? object.rollbacks.map((e: any) => PlanScheduleOccurrence_Rollback.fromJSON(e))
+        : [],
+      createTime: isSet(object.createTime) ? fromJsonTimestamp(object.createTime) : undefined,
+      updateTime: isSet(object.updateTime) ? fromJsonTimestamp(object.updateTime) : undefined,
+    };
+  },
+
+  toJSON(message: PlanScheduleOccurrence): unknown {
+    const obj: any = {};
+    message.name !== undefined && (obj.name = message.name);
+    message.scheduleTime !== undefined && (obj.scheduleTime = message.scheduleTime.toISOString());
+    message.status !== undefined && (obj.status = planScheduleOccurrence_StatusToJSON(message.status));
+    message.detail !== undefined && (obj.detail = message.detail);
+    if (message.taskRuns) {
+      obj.taskRuns = message.taskRuns.map((e) => e);
+    } else {
+      obj.taskRuns = [];
+    }
+    if (message.rollbacks) {
+      obj.rollbacks = message.rollbacks.map((e) => e ? PlanScheduleOccurrence_Rollback.toJSON(e) : undefined);
+    } else {
+      obj.rollbacks = [];
+    }
+    message.createTime !== undefined && (obj.createTime = message.createTime.toISOString());
+    message.updateTime !== undefined && (obj.updateTime = message.updateTime.toISOString());
+    return obj;
+  },
+
+  create(base?: DeepPartial<PlanScheduleOccurrence>): PlanScheduleOccurrence {
+    return PlanScheduleOccurrence.fromPartial(base ?? {});
+  },
+
+  fromPartial(object: DeepPartial<PlanScheduleOccurrence>): PlanScheduleOccurrence {
+    const message = createBasePlanScheduleOccurrence();
+    message.name = object.name ?? "";
+    message.scheduleTime = object.scheduleTime ?? undefined;
+    message.status = object.status ?? 0;
+    message.detail = object.detail ?? "";
+    message.taskRuns = object.taskRuns?.map((e) => e) || [];
+    message.rollbacks = object.rollbacks?.map((e) => PlanScheduleOccurrence_Rollback.fromPartial(e)) || [];
+    message.createTime = object.createTime ?? undefined;
+    message.updateTime = object.updateTime ?? undefined;
+    return message;
+  },
+};
+
+function createBasePlanScheduleOccurrence_Rollback(): PlanScheduleOccurrence_Rollback {
+  return { task: "", sheet: "", error: "" };
 }
 
-export const Plan_RestoreDatabaseConfig = {
-  encode(message: Plan_RestoreDatabaseConfig, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
-    if (message.target !== "") {
-      writer.uint32(10).string(message.target);
+export const PlanScheduleOccurrence_Rollback = {
+  encode(message: PlanScheduleOccurrence_Rollback, writer: _m0.Writer = _m0.Writer.create()): _m0.Writer {
+    if (message.task !== "") {
+      writer.uint32(10).string(message.task);
     }
-    if (message.createDatabaseConfig !== undefined) {
-      Plan_CreateDatabaseConfig.encode(message.createDatabaseConfig, writer.uint32(18).fork()).ldelim();
-    }
-    if (message.backup !== undefined) {
-      writer.uint32(26).string(message.backup);
+    if (message.sheet !== "") {
+      writer.uint32(18).string(message.sheet);
     }
-    if (message.pointInTime !== undefined) {
-      Timestamp.encode(toTimestamp(message.pointInTime), writer.uint32(34).fork()).ldelim();
+    if (message.error !== "") {
+      writer.uint32(26).string(message.error);
     }
     return writer;
   },
 
-  decode(input: _m0.Reader | Uint8Array, length?: number): Plan_RestoreDatabaseConfig {
+  decode(input: _m0.Reader | Uint8Array, length?: number): PlanScheduleOccurrence_Rollback {
     const reader = input instanceof _m0.Reader ? input : _m0.Reader.create(input);
     let end = length === undefined ? reader.len : reader.pos + length;
-    const message = createBasePlan_RestoreDatabaseConfig();
+    const message = createBasePlanScheduleOccurrence_Rollback();
     while (reader.pos < end) {
       const tag = reader.uint32();
       switch (tag >>> 3) {
@@ -2281,28 +3299,21 @@ export const Plan_RestoreDatabaseConfig = {
             break;
           }
 
-          message.target = reader.string();
+          message.task = reader.string();
           continue;
         case 2:
           if (tag !== 18) {
             break;
           }
 
-          message.createDatabaseConfig = Plan_CreateDatabaseConfig.decode(reader, reader.uint32());
+          message.sheet = reader.string();
           continue;
         case 3:
           if (tag !== 26) {
             break;
           }
 
-          message.backup = reader.string();
-          continue;
-        case 4:
-          if (tag !== 34) {
-            break;
-          }
-
-          message.pointInTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
+          message.error = reader.string();
           continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
@@ -2313,40 +3324,31 @@ export const Plan_RestoreDatabaseConfig = {
     return message;
   },
 
-  fromJSON(object: any): Plan_RestoreDatabaseConfig {
+  fromJSON(object: any): PlanScheduleOccurrence_Rollback {
     return {
-      target: isSet(object.target) ? String(object.target) : "",
-      createDatabaseConfig: isSet(object.createDatabaseConfig)
-        ? Plan_CreateDatabaseConfig.fromJSON(object.createDatabaseConfig)
-        : undefined,
-      backup: isSet(object.backup) ? String(object.backup) : undefined,
-      pointInTime: isSet(object.pointInTime) ? fromJsonTimestamp(object.pointInTime) : undefined,
+      task: isSet(object.task) ? String(object.task) : "",
+      sheet: isSet(object.sheet) ? String(object.sheet) : "",
+      error: isSet(object.error) ? String(object.error) : "",
     };
   },
 
-  toJSON(message: Plan_RestoreDatabaseConfig): unknown {
+  toJSON(message: PlanScheduleOccurrence_Rollback): unknown {
     const obj: any = {};
-    message.target !== undefined && (obj.target = message.target);
-    message.createDatabaseConfig !== undefined && (obj.createDatabaseConfig = message.createDatabaseConfig
-      ? Plan_CreateDatabaseConfig.toJSON(message.createDatabaseConfig)
-      : undefined);
-    message.backup !== undefined && (obj.backup = message.backup);
-    message.pointInTime !== undefined && (obj.pointInTime = message.pointInTime.toISOString());
+    message.task !== undefined && (obj.task = message.task);
+    message.sheet !== undefined && (obj.sheet = message.sheet);
+    message.error !== undefined && (obj.error = message.error);
     return obj;
   },
 
-  create(base?: DeepPartial<Plan_RestoreDatabaseConfig>): Plan_RestoreDatabaseConfig {
-    return Plan_RestoreDatabaseConfig.fromPartial(base ?? {});
+  create(base?: DeepPartial<PlanScheduleOccurrence_Rollback>): PlanScheduleOccurrence_Rollback {
+    return PlanScheduleOccurrence_Rollback.fromPartial(base ?? {});
   },
 
-  fromPartial(object: DeepPartial<Plan_RestoreDatabaseConfig>): Plan_RestoreDatabaseConfig {
-    const message = createBasePlan_RestoreDatabaseConfig();
-    message.target = object.target ?? "";
-    message.createDatabaseConfig = (object.createDatabaseConfig !== undefined && object.createDatabaseConfig !== null)
-      ? Plan_CreateDatabaseConfig.fromPartial(object.createDatabaseConfig)
-      : undefined;
-    message.backup = object.backup ?? undefined;
-    message.pointInTime = object.pointInTime ?? undefined;
+  fromPartial(object: DeepPartial<PlanScheduleOccurrence_Rollback>): PlanScheduleOccurrence_Rollback {
+    const message = createBasePlanScheduleOccurrence_Rollback();
+    message.task = object.task ?? "";
+    message.sheet = object.sheet ?? "";
+    message.error = object.error ?? "";
     return message;
   },
 };
@@ -4986,7 +5988,7 @@ export const Task_DatabaseBackup = {
 };
 
 function createBaseTask_DatabaseRestoreRestore(): Task_DatabaseRestoreRestore {
-  return { target: "", backup: undefined, pointInTime: undefined };
+  return { target: "", backup: undefined, pointInTime: undefined, lsn: undefined };
 }
 
 export const Task_DatabaseRestoreRestore = {
@@ -5000,6 +6002,9 @@ export const Task_DatabaseRestoreRestore = {
     if (message.pointInTime !== undefined) {
       Timestamp.encode(toTimestamp(message.pointInTime), writer.uint32(26).fork()).ldelim();
     }
+    if (message.lsn !== undefined) {
+      writer.uint32(34).string(message.lsn);
+    }
     return writer;
   },
 
@@ -5031,6 +6036,13 @@ export const Task_DatabaseRestoreRestore = {
 
           message.pointInTime = fromTimestamp(Timestamp.decode(reader, reader.uint32()));
           continue;
+        case 4:
+          if (tag !== 34) {
+            break;
+          }
+
+          message.lsn = reader.string();
+          continue;
       }
       if ((tag & 7) === 4 || tag === 0) {
         break;
@@ -5045,6 +6057,7 @@ export const Task_DatabaseRestoreRestore = {
       target: isSet(object.target) ? String(object.target) : "",
       backup: isSet(object.backup) ? String(object.backup) : undefined,
       pointInTime: isSet(object.pointInTime) ? fromJsonTimestamp(object.pointInTime) : undefined,
+      lsn: isSet(object.lsn) ? String(object.lsn) : undefined,
     };
   },
 
@@ -5053,6 +6066,7 @@ export const Task_DatabaseRestoreRestore = {
     message.target !== undefined && (obj.target = message.target);
     message.backup !== undefined && (obj.backup = message.backup);
     message.pointInTime !== undefined && (obj.pointInTime = message.pointInTime.toISOString());
+    message.lsn !== undefined && (obj.lsn = message.lsn);
     return obj;
   },
 
@@ -5065,6 +6079,7 @@ export const Task_DatabaseRestoreRestore = {
     message.target = object.target ?? "";
     message.backup = object.backup ?? undefined;
     message.pointInTime = object.pointInTime ?? undefined;
+    message.lsn = object.lsn ?? undefined;
     return message;
   },
 };
@@ -5483,6 +6498,199 @@ export const RolloutServiceDefinition = {
         },
       },
     },
+    pausePlanSchedule: {
+      name: "PausePlanSchedule",
+      requestType: PausePlanScheduleRequest,
+      requestStream: false,
+      responseType: Plan,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          578365826: [
+            new Uint8Array([
+              48,
+              58,
+              1,
+              42,
+              34,
+              43,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              112,
+              108,
+              97,
+              110,
+              115,
+              47,
+              42,
+              125,
+              58,
+              112,
+              97,
+              117,
+              115,
+              101,
+              83,
+              99,
+              104,
+              101,
+              100,
+              117,
+              108,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    resumePlanSchedule: {
+      name: "ResumePlanSchedule",
+      requestType: ResumePlanScheduleRequest,
+      requestStream: false,
+      responseType: Plan,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([4, 110, 97, 109, 101])],
+          578365826: [
+            new Uint8Array([
+              49,
+              58,
+              1,
+              42,
+              34,
+              44,
+              47,
+              118,
+              49,
+              47,
+              123,
+              110,
+              97,
+              109,
+              101,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              112,
+              108,
+              97,
+              110,
+              115,
+              47,
+              42,
+              125,
+              58,
+              114,
+              101,
+              115,
+              117,
+              109,
+              101,
+              83,
+              99,
+              104,
+              101,
+              100,
+              117,
+              108,
+              101,
+            ]),
+          ],
+        },
+      },
+    },
+    listPlanScheduleOccurrences: {
+      name: "ListPlanScheduleOccurrences",
+      requestType: ListPlanScheduleOccurrencesRequest,
+      requestStream: false,
+      responseType: ListPlanScheduleOccurrencesResponse,
+      responseStream: false,
+      options: {
+        _unknownFields: {
+          8410: [new Uint8Array([6, 112, 97, 114, 101, 110, 116])],
+          578365826: [
+            new Uint8Array([
+              45,
+              18,
+              43,
+              47,
+              118,
+              49,
+              47,
+              123,
+              112,
+              97,
+              114,
+              101,
+              110,
+              116,
+              61,
+              112,
+              114,
+              111,
+              106,
+              101,
+              99,
+              116,
+              115,
+              47,
+              42,
+              47,
+              112,
+              108,
+              97,
+              110,
+              115,
+              47,
+              42,
+              125,
+              47,
+              111,
+              99,
+              99,
+              117,
+              114,
+              114,
+              101,
+              110,
+              99,
+              101,
+              115,
+            ]),
+          ],
+        },
+      },
+    },
     getRollout: {
       name: "GetRollout",
       requestType: GetRolloutRequest,
@@ -6123,6 +7331,18 @@ export interface RolloutServiceImplementation<CallContextExt = {}> {
   listPlans(request: ListPlansRequest, context: CallContext & CallContextExt): Promise<DeepPartial<ListPlansResponse>>;
   createPlan(request: CreatePlanRequest, context: CallContext & CallContextExt): Promise<DeepPartial<Plan>>;
   updatePlan(request: UpdatePlanRequest, context: CallContext & CallContextExt): Promise<DeepPartial<Plan>>;
+  pausePlanSchedule(
+    request: PausePlanScheduleRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<Plan>>;
+  resumePlanSchedule(
+    request: ResumePlanScheduleRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<Plan>>;
+  listPlanScheduleOccurrences(
+    request: ListPlanScheduleOccurrencesRequest,
+    context: CallContext & CallContextExt,
+  ): Promise<DeepPartial<ListPlanScheduleOccurrencesResponse>>;
   getRollout(request: GetRolloutRequest, context: CallContext & CallContextExt): Promise<DeepPartial<Rollout>>;
   createRollout(request: CreateRolloutRequest, context: CallContext & CallContextExt): Promise<DeepPartial<Rollout>>;
   previewRollout(request: PreviewRolloutRequest, context: CallContext & CallContextExt): Promise<DeepPartial<Rollout>>;
@@ -6157,6 +7377,18 @@ export interface RolloutServiceClient<CallOptionsExt = {}> {
   listPlans(request: DeepPartial<ListPlansRequest>, options?: CallOptions & CallOptionsExt): Promise<ListPlansResponse>;
   createPlan(request: DeepPartial<CreatePlanRequest>, options?: CallOptions & CallOptionsExt): Promise<Plan>;
   updatePlan(request: DeepPartial<UpdatePlanRequest>, options?: CallOptions & CallOptionsExt): Promise<Plan>;
+  pausePlanSchedule(
+    request: DeepPartial<PausePlanScheduleRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<Plan>;
+  resumePlanSchedule(
+    request: DeepPartial<ResumePlanScheduleRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<Plan>;
+  listPlanScheduleOccurrences(
+    request: DeepPartial<ListPlanScheduleOccurrencesRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): Promise<ListPlanScheduleOccurrencesResponse>;
   getRollout(request: DeepPartial<GetRolloutRequest>, options?: CallOptions & CallOptionsExt): Promise<Rollout>;
   createRollout(request: DeepPartial<CreateRolloutRequest>, options?: CallOptions & CallOptionsExt): Promise<Rollout>;
   previewRollout(request: DeepPartial<PreviewRolloutRequest>, options?: CallOptions & CallOptionsExt): Promise<Rollout>;
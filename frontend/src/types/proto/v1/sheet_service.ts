@@ -250,6 +250,8 @@ export enum Sheet_Source {
   SOURCE_GITHUB = 4,
   /** SOURCE_BITBUCKET - BITBUCKET is the sheet synced from Bitbucket (for both Bitbucket.org and Bitbucket Server). */
   SOURCE_BITBUCKET = 5,
+  /** SOURCE_GITEA - GITEA is the sheet synced from Gitea (for both Gitea and Forgejo). */
+  SOURCE_GITEA = 6,
   UNRECOGNIZED = -1,
 }
 
@@ -273,6 +275,9 @@ export function sheet_SourceFromJSON(object: any): Sheet_Source {
     case 5:
     case "SOURCE_BITBUCKET":
       return Sheet_Source.SOURCE_BITBUCKET;
+    case 6:
+    case "SOURCE_GITEA":
+      return Sheet_Source.SOURCE_GITEA;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -294,6 +299,8 @@ export function sheet_SourceToJSON(object: Sheet_Source): string {
       return "SOURCE_GITHUB";
     case Sheet_Source.SOURCE_BITBUCKET:
       return "SOURCE_BITBUCKET";
+    case Sheet_Source.SOURCE_GITEA:
+      return "SOURCE_GITEA";
     case Sheet_Source.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
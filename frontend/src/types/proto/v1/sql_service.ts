@@ -75,6 +75,9 @@ export enum ExportRequest_Format {
   JSON = 2,
   SQL = 3,
   XLSX = 4,
+  PARQUET = 5,
+  /** NDJSON - NDJSON is the newline-delimited JSON. */
+  NDJSON = 6,
   UNRECOGNIZED = -1,
 }
 
@@ -95,6 +98,12 @@ export function exportRequest_FormatFromJSON(object: any): ExportRequest_Format
     case 4:
     case "XLSX":
       return ExportRequest_Format.XLSX;
+    case 5:
+    case "PARQUET":
+      return ExportRequest_Format.PARQUET;
+    case 6:
+    case "NDJSON":
+      return ExportRequest_Format.NDJSON;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -114,6 +123,10 @@ export function exportRequest_FormatToJSON(object: ExportRequest_Format): string
       return "SQL";
     case ExportRequest_Format.XLSX:
       return "XLSX";
+    case ExportRequest_Format.PARQUET:
+      return "PARQUET";
+    case ExportRequest_Format.NDJSON:
+      return "NDJSON";
     case ExportRequest_Format.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -121,7 +134,10 @@ export function exportRequest_FormatToJSON(object: ExportRequest_Format): string
 }
 
 export interface ExportResponse {
-  /** The export file content. */
+  /**
+   * The chunk of the export file content.
+   * The chunks are concatenated in order to get the whole export file.
+   */
   content: Uint8Array;
 }
 
@@ -1795,7 +1811,7 @@ export const SQLServiceDefinition = {
       requestType: ExportRequest,
       requestStream: false,
       responseType: ExportResponse,
-      responseStream: false,
+      responseStream: true,
       options: {
         _unknownFields: {
           578365826: [
@@ -1902,7 +1918,11 @@ export const SQLServiceDefinition = {
 export interface SQLServiceImplementation<CallContextExt = {}> {
   pretty(request: PrettyRequest, context: CallContext & CallContextExt): Promise<DeepPartial<PrettyResponse>>;
   query(request: QueryRequest, context: CallContext & CallContextExt): Promise<DeepPartial<QueryResponse>>;
-  export(request: ExportRequest, context: CallContext & CallContextExt): Promise<DeepPartial<ExportResponse>>;
+  /** Export streams the export file content in chunks, so that large results are not held in memory. */
+  export(
+    request: ExportRequest,
+    context: CallContext & CallContextExt,
+  ): ServerStreamingMethodResult<DeepPartial<ExportResponse>>;
   adminExecute(
     request: AsyncIterable<AdminExecuteRequest>,
     context: CallContext & CallContextExt,
@@ -1916,7 +1936,11 @@ export interface SQLServiceImplementation<CallContextExt = {}> {
 export interface SQLServiceClient<CallOptionsExt = {}> {
   pretty(request: DeepPartial<PrettyRequest>, options?: CallOptions & CallOptionsExt): Promise<PrettyResponse>;
   query(request: DeepPartial<QueryRequest>, options?: CallOptions & CallOptionsExt): Promise<QueryResponse>;
-  export(request: DeepPartial<ExportRequest>, options?: CallOptions & CallOptionsExt): Promise<ExportResponse>;
+  /** Export streams the export file content in chunks, so that large results are not held in memory. */
+  export(
+    request: DeepPartial<ExportRequest>,
+    options?: CallOptions & CallOptionsExt,
+  ): AsyncIterable<ExportResponse>;
   adminExecute(
     request: AsyncIterable<DeepPartial<AdminExecuteRequest>>,
     options?: CallOptions & CallOptionsExt,
@@ -9,6 +9,7 @@ export enum VcsType {
   GITLAB = 1,
   GITHUB = 2,
   BITBUCKET = 3,
+  GITEA = 4,
   UNRECOGNIZED = -1,
 }
 
@@ -26,6 +27,9 @@ export function vcsTypeFromJSON(object: any): VcsType {
     case 3:
     case "BITBUCKET":
       return VcsType.BITBUCKET;
+    case 4:
+    case "GITEA":
+      return VcsType.GITEA;
     case -1:
     case "UNRECOGNIZED":
     default:
@@ -43,6 +47,8 @@ export function vcsTypeToJSON(object: VcsType): string {
       return "GITHUB";
     case VcsType.BITBUCKET:
       return "BITBUCKET";
+    case VcsType.GITEA:
+      return "GITEA";
     case VcsType.UNRECOGNIZED:
     default:
       return "UNRECOGNIZED";
@@ -30,6 +30,7 @@ export const sheetIdOfTask = (task: Task) => {
         undefined
       );
     case "bb.task.database.schema.update.ghost.sync":
+    case "bb.task.database.schema.update.pg-online.sync":
       return (
         ((task as Task).payload as TaskDatabaseSchemaUpdateGhostSyncPayload)
           .sheetId || undefined
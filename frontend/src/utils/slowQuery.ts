@@ -6,6 +6,9 @@ import { semverCompare } from "./util";
 export const InstanceListSupportSlowQuery: [EngineType, string][] = [
   ["MYSQL", "5.7"],
   ["POSTGRES", "0"],
+  ["MSSQL", "0"],
+  ["ORACLE", "0"],
+  ["SNOWFLAKE", "0"],
 ];
 
 export const instanceSupportSlowQuery = (instance: Instance) => {
@@ -22,6 +25,9 @@ export const instanceSupportSlowQuery = (instance: Instance) => {
 export const InstanceV1ListSupportSlowQuery: [Engine, string][] = [
   [Engine.MYSQL, "5.7"],
   [Engine.POSTGRES, "0"],
+  [Engine.MSSQL, "0"],
+  [Engine.ORACLE, "0"],
+  [Engine.SNOWFLAKE, "0"],
 ];
 
 export const instanceV1SupportSlowQuery = (instance: InstanceV1) => {
@@ -40,7 +46,13 @@ export const instanceV1SupportSlowQuery = (instance: InstanceV1) => {
 export const slowQueryTypeOfInstance = (instance: Instance) => {
   if (!instanceSupportSlowQuery(instance)) return undefined;
   const { engine } = instance;
-  if (engine === "MYSQL") return "INSTANCE";
+  if (
+    engine === "MYSQL" ||
+    engine === "MSSQL" ||
+    engine === "ORACLE" ||
+    engine === "SNOWFLAKE"
+  )
+    return "INSTANCE";
   if (engine === "POSTGRES") return "DATABASE";
   return undefined;
 };
@@ -1,6 +1,7 @@
 import axios from "axios";
 import { trimEnd, uniq } from "lodash-es";
 import { stringify } from "qs";
+import { getIdentityProviderResourceId } from "@/store/modules/v1/common";
 import { OAuthState } from "@/types";
 import {
   IdentityProvider,
@@ -17,6 +18,16 @@ export async function openWindowForSSO(
   popup = true,
   redirect = ""
 ) {
+  if (identityProvider.type === IdentityProviderType.SAML) {
+    // The SAML response is posted to the backend assertion consumer service,
+    // which completes the login and redirects back, so popup is not supported.
+    const resourceId = getIdentityProviderResourceId(identityProvider.name);
+    window.location.href = `/saml/sso/${resourceId}?${stringify({
+      redirect,
+    })}`;
+    return;
+  }
+
   const state: OAuthState = {
     // we use type to determine oauth type when receiving the callback
     event: `bb.oauth.signin.${identityProvider.name}`,
@@ -11,6 +11,7 @@ import {
   TaskDatabasePITRRestorePayload,
   TaskDatabaseSchemaBaselinePayload,
   TaskDatabaseSchemaUpdateGhostSyncPayload,
+  TaskDatabaseSchemaUpdatePGOnlineSyncPayload,
   TaskDatabaseSchemaUpdatePayload,
   TaskDatabaseSchemaUpdateSDLPayload,
   TaskStatus,
@@ -173,6 +174,7 @@ export const isTaskTriggeredByVCS = (task: Task): boolean => {
     "bb.task.database.schema.update",
     "bb.task.database.schema.update-sdl",
     "bb.task.database.schema.update.ghost.sync",
+    "bb.task.database.schema.update.pg-online.sync",
     "bb.task.database.schema.baseline",
   ];
 
@@ -181,6 +183,7 @@ export const isTaskTriggeredByVCS = (task: Task): boolean => {
     | TaskDatabaseSchemaUpdatePayload
     | TaskDatabaseSchemaUpdateSDLPayload
     | TaskDatabaseSchemaUpdateGhostSyncPayload
+    | TaskDatabaseSchemaUpdatePGOnlineSyncPayload
     | TaskDatabaseSchemaBaselinePayload;
 
   if (taskTypesWithPushEvent.includes(task.type)) {
@@ -194,6 +197,24 @@ export const isTaskTriggeredByVCS = (task: Task): boolean => {
   return false;
 };
 
+// The sync task of gh-ost (MySQL) or the online migration (PostgreSQL).
+export const isOnlineMigrationSyncTask = (task: Task | TaskCreate): boolean => {
+  return (
+    task.type === "bb.task.database.schema.update.ghost.sync" ||
+    task.type === "bb.task.database.schema.update.pg-online.sync"
+  );
+};
+
+// The cutover task of gh-ost (MySQL) or the online migration (PostgreSQL).
+export const isOnlineMigrationCutoverTask = (
+  task: Task | TaskCreate
+): boolean => {
+  return (
+    task.type === "bb.task.database.schema.update.ghost.cutover" ||
+    task.type === "bb.task.database.schema.update.pg-online.cutover"
+  );
+};
+
 export const isTaskEntity = (task: Task | TaskCreate): task is Task => {
   const obj = task as any;
   return typeof obj["id"] === "number";
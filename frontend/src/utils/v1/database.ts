@@ -319,12 +319,20 @@ export function allowGhostMigrationV1(
 ): boolean {
   const subscriptionV1Store = useSubscriptionV1Store();
   return databaseList.every((db) => {
-    return (
-      db.instanceEntity.engine === Engine.MYSQL &&
-      subscriptionV1Store.hasInstanceFeature(
+    if (
+      !subscriptionV1Store.hasInstanceFeature(
         "bb.feature.online-migration",
         db.instanceEntity
-      ) &&
+      )
+    ) {
+      return false;
+    }
+    // PostgreSQL uses the trigger-based online migration instead of gh-ost.
+    if (db.instanceEntity.engine === Engine.POSTGRES) {
+      return true;
+    }
+    return (
+      db.instanceEntity.engine === Engine.MYSQL &&
       semverCompare(
         db.instanceEntity.engineVersion,
         MIN_GHOST_SUPPORT_MYSQL_VERSION,
@@ -33,6 +33,8 @@ export const isDatabaseRelatedIssue = (issue: ComposedIssue): boolean => {
       Task_Type.DATABASE_SCHEMA_UPDATE,
       Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_SYNC,
       Task_Type.DATABASE_SCHEMA_UPDATE_GHOST_CUTOVER,
+      Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_SYNC,
+      Task_Type.DATABASE_SCHEMA_UPDATE_PG_ONLINE_CUTOVER,
       Task_Type.DATABASE_SCHEMA_UPDATE_SDL,
       Task_Type.DATABASE_DATA_UPDATE,
       Task_Type.DATABASE_BACKUP,
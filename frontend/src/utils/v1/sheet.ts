@@ -129,6 +129,7 @@ export const sheetNameOfTask = (task: Task) => {
       sheetId = (task.payload as TaskDatabaseDataUpdatePayload).sheetId || "";
       break;
     case "bb.task.database.schema.update.ghost.sync":
+    case "bb.task.database.schema.update.pg-online.sync":
       sheetId =
         (task.payload as TaskDatabaseSchemaUpdateGhostSyncPayload).sheetId ||
         "";
@@ -1,3 +1,4 @@
+import type { ExportFormat } from "@/components/DataExportButton.vue";
 import { ComposedDatabase, DatabaseResource } from "@/types";
 import { Database } from "@/types/proto/v1/database_service";
 import { Instance } from "@/types/proto/v1/instance_service";
@@ -15,7 +16,7 @@ export interface ExportRecord {
   expiration: string;
   statement: string;
   maxRowCount: number;
-  exportFormat: "CSV" | "JSON" | "SQL" | "XLSX";
+  exportFormat: ExportFormat;
   // issueId is the uid of an issue.
   issueId: string;
 }
@@ -66,6 +66,10 @@ export default defineConfig(() => {
           target: "http://localhost:8080/",
           changeOrigin: true,
         },
+        "/saml": {
+          target: "http://localhost:8080/",
+          changeOrigin: true,
+        },
         "/v1": {
           target: "http://localhost:8080/v1",
           changeOrigin: true,
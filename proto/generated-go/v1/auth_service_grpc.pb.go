@@ -20,14 +20,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuthService_GetUser_FullMethodName      = "/bytebase.v1.AuthService/GetUser"
-	AuthService_ListUsers_FullMethodName    = "/bytebase.v1.AuthService/ListUsers"
-	AuthService_CreateUser_FullMethodName   = "/bytebase.v1.AuthService/CreateUser"
-	AuthService_UpdateUser_FullMethodName   = "/bytebase.v1.AuthService/UpdateUser"
-	AuthService_DeleteUser_FullMethodName   = "/bytebase.v1.AuthService/DeleteUser"
-	AuthService_UndeleteUser_FullMethodName = "/bytebase.v1.AuthService/UndeleteUser"
-	AuthService_Login_FullMethodName        = "/bytebase.v1.AuthService/Login"
-	AuthService_Logout_FullMethodName       = "/bytebase.v1.AuthService/Logout"
+	AuthService_GetUser_FullMethodName               = "/bytebase.v1.AuthService/GetUser"
+	AuthService_ListUsers_FullMethodName             = "/bytebase.v1.AuthService/ListUsers"
+	AuthService_CreateUser_FullMethodName            = "/bytebase.v1.AuthService/CreateUser"
+	AuthService_UpdateUser_FullMethodName            = "/bytebase.v1.AuthService/UpdateUser"
+	AuthService_DeleteUser_FullMethodName            = "/bytebase.v1.AuthService/DeleteUser"
+	AuthService_UndeleteUser_FullMethodName          = "/bytebase.v1.AuthService/UndeleteUser"
+	AuthService_Login_FullMethodName                 = "/bytebase.v1.AuthService/Login"
+	AuthService_Logout_FullMethodName                = "/bytebase.v1.AuthService/Logout"
+	AuthService_GetSQLProxyCredential_FullMethodName = "/bytebase.v1.AuthService/GetSQLProxyCredential"
 )
 
 // AuthServiceClient is the client API for AuthService service.
@@ -42,6 +43,8 @@ type AuthServiceClient interface {
 	UndeleteUser(ctx context.Context, in *UndeleteUserRequest, opts ...grpc.CallOption) (*User, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetSQLProxyCredential gets the credential of the current user to connect the SQL proxy with the native clients.
+	GetSQLProxyCredential(ctx context.Context, in *GetSQLProxyCredentialRequest, opts ...grpc.CallOption) (*SQLProxyCredential, error)
 }
 
 type authServiceClient struct {
@@ -124,6 +127,15 @@ func (c *authServiceClient) Logout(ctx context.Context, in *LogoutRequest, opts
 	return out, nil
 }
 
+func (c *authServiceClient) GetSQLProxyCredential(ctx context.Context, in *GetSQLProxyCredentialRequest, opts ...grpc.CallOption) (*SQLProxyCredential, error) {
+	out := new(SQLProxyCredential)
+	err := c.cc.Invoke(ctx, AuthService_GetSQLProxyCredential_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthServiceServer is the server API for AuthService service.
 // All implementations must embed UnimplementedAuthServiceServer
 // for forward compatibility
@@ -136,6 +148,8 @@ type AuthServiceServer interface {
 	UndeleteUser(context.Context, *UndeleteUserRequest) (*User, error)
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error)
+	// GetSQLProxyCredential gets the credential of the current user to connect the SQL proxy with the native clients.
+	GetSQLProxyCredential(context.Context, *GetSQLProxyCredentialRequest) (*SQLProxyCredential, error)
 	mustEmbedUnimplementedAuthServiceServer()
 }
 
@@ -167,6 +181,9 @@ func (UnimplementedAuthServiceServer) Login(context.Context, *LoginRequest) (*Lo
 func (UnimplementedAuthServiceServer) Logout(context.Context, *LogoutRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Logout not implemented")
 }
+func (UnimplementedAuthServiceServer) GetSQLProxyCredential(context.Context, *GetSQLProxyCredentialRequest) (*SQLProxyCredential, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSQLProxyCredential not implemented")
+}
 func (UnimplementedAuthServiceServer) mustEmbedUnimplementedAuthServiceServer() {}
 
 // UnsafeAuthServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -324,6 +341,24 @@ func _AuthService_Logout_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuthService_GetSQLProxyCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSQLProxyCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthServiceServer).GetSQLProxyCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuthService_GetSQLProxyCredential_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthServiceServer).GetSQLProxyCredential(ctx, req.(*GetSQLProxyCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AuthService_ServiceDesc is the grpc.ServiceDesc for AuthService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -363,6 +398,10 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Logout",
 			Handler:    _AuthService_Logout_Handler,
 		},
+		{
+			MethodName: "GetSQLProxyCredential",
+			Handler:    _AuthService_GetSQLProxyCredential_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/auth_service.proto",
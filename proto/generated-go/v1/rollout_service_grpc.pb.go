@@ -19,19 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	RolloutService_GetPlan_FullMethodName             = "/bytebase.v1.RolloutService/GetPlan"
-	RolloutService_ListPlans_FullMethodName           = "/bytebase.v1.RolloutService/ListPlans"
-	RolloutService_CreatePlan_FullMethodName          = "/bytebase.v1.RolloutService/CreatePlan"
-	RolloutService_UpdatePlan_FullMethodName          = "/bytebase.v1.RolloutService/UpdatePlan"
-	RolloutService_GetRollout_FullMethodName          = "/bytebase.v1.RolloutService/GetRollout"
-	RolloutService_CreateRollout_FullMethodName       = "/bytebase.v1.RolloutService/CreateRollout"
-	RolloutService_PreviewRollout_FullMethodName      = "/bytebase.v1.RolloutService/PreviewRollout"
-	RolloutService_ListTaskRuns_FullMethodName        = "/bytebase.v1.RolloutService/ListTaskRuns"
-	RolloutService_ListPlanCheckRuns_FullMethodName   = "/bytebase.v1.RolloutService/ListPlanCheckRuns"
-	RolloutService_RunPlanChecks_FullMethodName       = "/bytebase.v1.RolloutService/RunPlanChecks"
-	RolloutService_BatchRunTasks_FullMethodName       = "/bytebase.v1.RolloutService/BatchRunTasks"
-	RolloutService_BatchSkipTasks_FullMethodName      = "/bytebase.v1.RolloutService/BatchSkipTasks"
-	RolloutService_BatchCancelTaskRuns_FullMethodName = "/bytebase.v1.RolloutService/BatchCancelTaskRuns"
+	RolloutService_GetPlan_FullMethodName                     = "/bytebase.v1.RolloutService/GetPlan"
+	RolloutService_ListPlans_FullMethodName                   = "/bytebase.v1.RolloutService/ListPlans"
+	RolloutService_CreatePlan_FullMethodName                  = "/bytebase.v1.RolloutService/CreatePlan"
+	RolloutService_UpdatePlan_FullMethodName                  = "/bytebase.v1.RolloutService/UpdatePlan"
+	RolloutService_PausePlanSchedule_FullMethodName           = "/bytebase.v1.RolloutService/PausePlanSchedule"
+	RolloutService_ResumePlanSchedule_FullMethodName          = "/bytebase.v1.RolloutService/ResumePlanSchedule"
+	RolloutService_ListPlanScheduleOccurrences_FullMethodName = "/bytebase.v1.RolloutService/ListPlanScheduleOccurrences"
+	RolloutService_GetRollout_FullMethodName                  = "/bytebase.v1.RolloutService/GetRollout"
+	RolloutService_CreateRollout_FullMethodName               = "/bytebase.v1.RolloutService/CreateRollout"
+	RolloutService_PreviewRollout_FullMethodName              = "/bytebase.v1.RolloutService/PreviewRollout"
+	RolloutService_ListTaskRuns_FullMethodName                = "/bytebase.v1.RolloutService/ListTaskRuns"
+	RolloutService_ListPlanCheckRuns_FullMethodName           = "/bytebase.v1.RolloutService/ListPlanCheckRuns"
+	RolloutService_RunPlanChecks_FullMethodName               = "/bytebase.v1.RolloutService/RunPlanChecks"
+	RolloutService_BatchRunTasks_FullMethodName               = "/bytebase.v1.RolloutService/BatchRunTasks"
+	RolloutService_BatchSkipTasks_FullMethodName              = "/bytebase.v1.RolloutService/BatchSkipTasks"
+	RolloutService_BatchCancelTaskRuns_FullMethodName         = "/bytebase.v1.RolloutService/BatchCancelTaskRuns"
 )
 
 // RolloutServiceClient is the client API for RolloutService service.
@@ -42,6 +45,9 @@ type RolloutServiceClient interface {
 	ListPlans(ctx context.Context, in *ListPlansRequest, opts ...grpc.CallOption) (*ListPlansResponse, error)
 	CreatePlan(ctx context.Context, in *CreatePlanRequest, opts ...grpc.CallOption) (*Plan, error)
 	UpdatePlan(ctx context.Context, in *UpdatePlanRequest, opts ...grpc.CallOption) (*Plan, error)
+	PausePlanSchedule(ctx context.Context, in *PausePlanScheduleRequest, opts ...grpc.CallOption) (*Plan, error)
+	ResumePlanSchedule(ctx context.Context, in *ResumePlanScheduleRequest, opts ...grpc.CallOption) (*Plan, error)
+	ListPlanScheduleOccurrences(ctx context.Context, in *ListPlanScheduleOccurrencesRequest, opts ...grpc.CallOption) (*ListPlanScheduleOccurrencesResponse, error)
 	GetRollout(ctx context.Context, in *GetRolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
 	CreateRollout(ctx context.Context, in *CreateRolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
 	PreviewRollout(ctx context.Context, in *PreviewRolloutRequest, opts ...grpc.CallOption) (*Rollout, error)
@@ -97,6 +103,33 @@ func (c *rolloutServiceClient) UpdatePlan(ctx context.Context, in *UpdatePlanReq
 	return out, nil
 }
 
+func (c *rolloutServiceClient) PausePlanSchedule(ctx context.Context, in *PausePlanScheduleRequest, opts ...grpc.CallOption) (*Plan, error) {
+	out := new(Plan)
+	err := c.cc.Invoke(ctx, RolloutService_PausePlanSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolloutServiceClient) ResumePlanSchedule(ctx context.Context, in *ResumePlanScheduleRequest, opts ...grpc.CallOption) (*Plan, error) {
+	out := new(Plan)
+	err := c.cc.Invoke(ctx, RolloutService_ResumePlanSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolloutServiceClient) ListPlanScheduleOccurrences(ctx context.Context, in *ListPlanScheduleOccurrencesRequest, opts ...grpc.CallOption) (*ListPlanScheduleOccurrencesResponse, error) {
+	out := new(ListPlanScheduleOccurrencesResponse)
+	err := c.cc.Invoke(ctx, RolloutService_ListPlanScheduleOccurrences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rolloutServiceClient) GetRollout(ctx context.Context, in *GetRolloutRequest, opts ...grpc.CallOption) (*Rollout, error) {
 	out := new(Rollout)
 	err := c.cc.Invoke(ctx, RolloutService_GetRollout_FullMethodName, in, out, opts...)
@@ -186,6 +219,9 @@ type RolloutServiceServer interface {
 	ListPlans(context.Context, *ListPlansRequest) (*ListPlansResponse, error)
 	CreatePlan(context.Context, *CreatePlanRequest) (*Plan, error)
 	UpdatePlan(context.Context, *UpdatePlanRequest) (*Plan, error)
+	PausePlanSchedule(context.Context, *PausePlanScheduleRequest) (*Plan, error)
+	ResumePlanSchedule(context.Context, *ResumePlanScheduleRequest) (*Plan, error)
+	ListPlanScheduleOccurrences(context.Context, *ListPlanScheduleOccurrencesRequest) (*ListPlanScheduleOccurrencesResponse, error)
 	GetRollout(context.Context, *GetRolloutRequest) (*Rollout, error)
 	CreateRollout(context.Context, *CreateRolloutRequest) (*Rollout, error)
 	PreviewRollout(context.Context, *PreviewRolloutRequest) (*Rollout, error)
@@ -214,6 +250,15 @@ func (UnimplementedRolloutServiceServer) CreatePlan(context.Context, *CreatePlan
 func (UnimplementedRolloutServiceServer) UpdatePlan(context.Context, *UpdatePlanRequest) (*Plan, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePlan not implemented")
 }
+func (UnimplementedRolloutServiceServer) PausePlanSchedule(context.Context, *PausePlanScheduleRequest) (*Plan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausePlanSchedule not implemented")
+}
+func (UnimplementedRolloutServiceServer) ResumePlanSchedule(context.Context, *ResumePlanScheduleRequest) (*Plan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumePlanSchedule not implemented")
+}
+func (UnimplementedRolloutServiceServer) ListPlanScheduleOccurrences(context.Context, *ListPlanScheduleOccurrencesRequest) (*ListPlanScheduleOccurrencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlanScheduleOccurrences not implemented")
+}
 func (UnimplementedRolloutServiceServer) GetRollout(context.Context, *GetRolloutRequest) (*Rollout, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRollout not implemented")
 }
@@ -326,6 +371,60 @@ func _RolloutService_UpdatePlan_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RolloutService_PausePlanSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PausePlanScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).PausePlanSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_PausePlanSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).PausePlanSchedule(ctx, req.(*PausePlanScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_ResumePlanSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumePlanScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).ResumePlanSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_ResumePlanSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).ResumePlanSchedule(ctx, req.(*ResumePlanScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RolloutService_ListPlanScheduleOccurrences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlanScheduleOccurrencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolloutServiceServer).ListPlanScheduleOccurrences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RolloutService_ListPlanScheduleOccurrences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolloutServiceServer).ListPlanScheduleOccurrences(ctx, req.(*ListPlanScheduleOccurrencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RolloutService_GetRollout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRolloutRequest)
 	if err := dec(in); err != nil {
@@ -511,6 +610,18 @@ var RolloutService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdatePlan",
 			Handler:    _RolloutService_UpdatePlan_Handler,
 		},
+		{
+			MethodName: "PausePlanSchedule",
+			Handler:    _RolloutService_PausePlanSchedule_Handler,
+		},
+		{
+			MethodName: "ResumePlanSchedule",
+			Handler:    _RolloutService_ResumePlanSchedule_Handler,
+		},
+		{
+			MethodName: "ListPlanScheduleOccurrences",
+			Handler:    _RolloutService_ListPlanScheduleOccurrences_Handler,
+		},
 		{
 			MethodName: "GetRollout",
 			Handler:    _RolloutService_GetRollout_Handler,
@@ -32,7 +32,8 @@ const (
 type SQLServiceClient interface {
 	Pretty(ctx context.Context, in *PrettyRequest, opts ...grpc.CallOption) (*PrettyResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResponse, error)
-	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
+	// Export streams the export file content in chunks, so that large results are not held in memory.
+	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (SQLService_ExportClient, error)
 	AdminExecute(ctx context.Context, opts ...grpc.CallOption) (SQLService_AdminExecuteClient, error)
 	DifferPreview(ctx context.Context, in *DifferPreviewRequest, opts ...grpc.CallOption) (*DifferPreviewResponse, error)
 }
@@ -63,17 +64,40 @@ func (c *sQLServiceClient) Query(ctx context.Context, in *QueryRequest, opts ...
 	return out, nil
 }
 
-func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error) {
-	out := new(ExportResponse)
-	err := c.cc.Invoke(ctx, SQLService_Export_FullMethodName, in, out, opts...)
+func (c *sQLServiceClient) Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (SQLService_ExportClient, error) {
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_Export_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &sQLServiceExportClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type SQLService_ExportClient interface {
+	Recv() (*ExportResponse, error)
+	grpc.ClientStream
+}
+
+type sQLServiceExportClient struct {
+	grpc.ClientStream
+}
+
+func (x *sQLServiceExportClient) Recv() (*ExportResponse, error) {
+	m := new(ExportResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 func (c *sQLServiceClient) AdminExecute(ctx context.Context, opts ...grpc.CallOption) (SQLService_AdminExecuteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[0], SQLService_AdminExecute_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &SQLService_ServiceDesc.Streams[1], SQLService_AdminExecute_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -118,7 +142,8 @@ func (c *sQLServiceClient) DifferPreview(ctx context.Context, in *DifferPreviewR
 type SQLServiceServer interface {
 	Pretty(context.Context, *PrettyRequest) (*PrettyResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResponse, error)
-	Export(context.Context, *ExportRequest) (*ExportResponse, error)
+	// Export streams the export file content in chunks, so that large results are not held in memory.
+	Export(*ExportRequest, SQLService_ExportServer) error
 	AdminExecute(SQLService_AdminExecuteServer) error
 	DifferPreview(context.Context, *DifferPreviewRequest) (*DifferPreviewResponse, error)
 	mustEmbedUnimplementedSQLServiceServer()
@@ -134,8 +159,8 @@ func (UnimplementedSQLServiceServer) Pretty(context.Context, *PrettyRequest) (*P
 func (UnimplementedSQLServiceServer) Query(context.Context, *QueryRequest) (*QueryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Query not implemented")
 }
-func (UnimplementedSQLServiceServer) Export(context.Context, *ExportRequest) (*ExportResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Export not implemented")
+func (UnimplementedSQLServiceServer) Export(*ExportRequest, SQLService_ExportServer) error {
+	return status.Errorf(codes.Unimplemented, "method Export not implemented")
 }
 func (UnimplementedSQLServiceServer) AdminExecute(SQLService_AdminExecuteServer) error {
 	return status.Errorf(codes.Unimplemented, "method AdminExecute not implemented")
@@ -192,22 +217,25 @@ func _SQLService_Query_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SQLService_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExportRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(SQLServiceServer).Export(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: SQLService_Export_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SQLServiceServer).Export(ctx, req.(*ExportRequest))
+func _SQLService_Export_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(SQLServiceServer).Export(m, &sQLServiceExportServer{stream})
+}
+
+type SQLService_ExportServer interface {
+	Send(*ExportResponse) error
+	grpc.ServerStream
+}
+
+type sQLServiceExportServer struct {
+	grpc.ServerStream
+}
+
+func (x *sQLServiceExportServer) Send(m *ExportResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
 func _SQLService_AdminExecute_Handler(srv interface{}, stream grpc.ServerStream) error {
@@ -269,16 +297,17 @@ var SQLService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Query",
 			Handler:    _SQLService_Query_Handler,
 		},
-		{
-			MethodName: "Export",
-			Handler:    _SQLService_Export_Handler,
-		},
 		{
 			MethodName: "DifferPreview",
 			Handler:    _SQLService_DifferPreview_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Export",
+			Handler:       _SQLService_Export_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "AdminExecute",
 			Handler:       _SQLService_AdminExecute_Handler,
@@ -28,6 +28,7 @@ const (
 	VcsType_GITLAB               VcsType = 1
 	VcsType_GITHUB               VcsType = 2
 	VcsType_BITBUCKET            VcsType = 3
+	VcsType_GITEA                VcsType = 4
 )
 
 // Enum value maps for VcsType.
@@ -37,12 +38,14 @@ var (
 		1: "GITLAB",
 		2: "GITHUB",
 		3: "BITBUCKET",
+		4: "GITEA",
 	}
 	VcsType_value = map[string]int32{
 		"VCS_TYPE_UNSPECIFIED": 0,
 		"GITLAB":               1,
 		"GITHUB":               2,
 		"BITBUCKET":            3,
+		"GITEA":                4,
 	}
 )
 
@@ -480,14 +483,14 @@ var file_v1_vcs_proto_rawDesc = []byte{
 	0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x5f, 0x65, 0x6d,
 	0x61, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x6f,
 	0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x2a, 0x4a, 0x0a, 0x07,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x2a, 0x55, 0x0a, 0x07,
 	0x56, 0x63, 0x73, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x43, 0x53, 0x5f, 0x54,
 	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
 	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x49, 0x54, 0x4c, 0x41, 0x42, 0x10, 0x01, 0x12, 0x0a, 0x0a,
 	0x06, 0x47, 0x49, 0x54, 0x48, 0x55, 0x42, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x42, 0x49, 0x54,
-	0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x03, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x64, 0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x42, 0x55, 0x43, 0x4b, 0x45, 0x54, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x49, 0x54, 0x45,
+	0x41, 0x10, 0x04, 0x42, 0x11, 0x5a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x2d, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (